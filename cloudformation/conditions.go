@@ -0,0 +1,75 @@
+package cloudformation
+
+import (
+	"reflect"
+
+	"github.com/KablamoOSS/kombustion/types"
+)
+
+/*
+	applyCondition
+	sets the Condition on a generated resource or output, unless it already has one.
+	Returns false if the object can't take a condition.
+*/
+func applyCondition(object interface{}, condition interface{}) (interface{}, bool) {
+	switch obj := object.(type) {
+
+	case types.ValueMap:
+		return applyConditionMap(obj, condition), true
+
+	case map[string]interface{}:
+		return map[string]interface{}(applyConditionMap(obj, condition)), true
+
+	case map[interface{}]interface{}:
+		if _, ok := obj["Condition"]; ok {
+			return obj, true
+		}
+		conditional := make(map[interface{}]interface{}, len(obj)+1)
+		for k, v := range obj {
+			conditional[k] = v
+		}
+		conditional["Condition"] = condition
+		return conditional, true
+	}
+
+	// generated resource structs (eg. resources.S3Bucket)
+	value := reflect.ValueOf(object)
+	isPtr := value.Kind() == reflect.Ptr
+	if isPtr {
+		if value.IsNil() {
+			return object, false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return object, false
+	}
+
+	field := value.FieldByName("Condition")
+	if !field.IsValid() || field.Kind() != reflect.Interface {
+		return object, false
+	}
+	if !field.IsNil() {
+		return object, true
+	}
+
+	conditional := reflect.New(value.Type())
+	conditional.Elem().Set(value)
+	conditional.Elem().FieldByName("Condition").Set(reflect.ValueOf(condition))
+	if isPtr {
+		return conditional.Interface(), true
+	}
+	return conditional.Elem().Interface(), true
+}
+
+func applyConditionMap(obj map[string]interface{}, condition interface{}) types.ValueMap {
+	if _, ok := obj["Condition"]; ok {
+		return obj
+	}
+	conditional := make(types.ValueMap, len(obj)+1)
+	for k, v := range obj {
+		conditional[k] = v
+	}
+	conditional["Condition"] = condition
+	return conditional
+}
@@ -0,0 +1,69 @@
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/parsers/resources"
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyCondition(t *testing.T) {
+	bucket := resources.NewS3Bucket(resources.S3BucketProperties{})
+	conditional, ok := applyCondition(bucket, "IsProd")
+	assert.True(t, ok)
+	assert.Equal(t, "IsProd", conditional.(resources.S3Bucket).Condition)
+	assert.Nil(t, bucket.Condition)
+
+	conditional, ok = applyCondition(&bucket, "IsProd")
+	assert.True(t, ok)
+	assert.Equal(t, "IsProd", conditional.(*resources.S3Bucket).Condition)
+
+	bucket.Condition = "IsDev"
+	conditional, ok = applyCondition(bucket, "IsProd")
+	assert.True(t, ok)
+	assert.Equal(t, "IsDev", conditional.(resources.S3Bucket).Condition)
+
+	conditional, ok = applyCondition(types.ValueMap{"Value": "test"}, "IsProd")
+	assert.True(t, ok)
+	assert.EqualValues(t, types.ValueMap{"Value": "test", "Condition": "IsProd"}, conditional)
+
+	conditional, ok = applyCondition(types.ValueMap{"Condition": "IsDev"}, "IsProd")
+	assert.True(t, ok)
+	assert.EqualValues(t, types.ValueMap{"Condition": "IsDev"}, conditional)
+
+	_, ok = applyCondition("test", "IsProd")
+	assert.False(t, ok)
+}
+
+func TestTemplateYamlCF_pluginCondition(t *testing.T) {
+	parsers := ParserMap{
+		"Test::Plugin::Thing": func(name, data string) (types.ValueMap, error) {
+			queue := resources.NewSQSQueue(resources.SQSQueueProperties{})
+			queue.Condition = "IsDev"
+			return types.ValueMap{
+				name + "Bucket": resources.NewS3Bucket(resources.S3BucketProperties{}),
+				name + "Queue":  queue,
+			}, nil
+		},
+	}
+	testResources := types.ResourceMap{
+		"thing": types.CfResource{
+			Type:      "Test::Plugin::Thing",
+			Condition: "IsProd",
+		},
+	}
+
+	compiled, err := yamlTemplateCF(testResources, parsers, resourceParsersKind, true)
+	assert.Nil(t, err)
+	assert.Equal(t, "IsProd", compiled["thingBucket"].(resources.S3Bucket).Condition)
+	assert.Equal(t, "IsDev", compiled["thingQueue"].(resources.SQSQueue).Condition)
+
+	mappings, err := yamlTemplateCF(testResources, ParserMap{
+		"Test::Plugin::Thing": func(name, data string) (types.ValueMap, error) {
+			return types.ValueMap{name + "Map": types.ValueMap{"key": types.ValueMap{"value": 1}}}, nil
+		},
+	}, mappingParsersKind, true)
+	assert.Nil(t, err)
+	assert.EqualValues(t, types.ValueMap{"key": types.ValueMap{"value": 1}}, mappings["thingMap"])
+}
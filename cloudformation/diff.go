@@ -0,0 +1,191 @@
+package cloudformation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KablamoOSS/kombustion/types"
+)
+
+// Change actions
+const (
+	ChangeAdd    = "Add"
+	ChangeRemove = "Remove"
+	ChangeModify = "Modify"
+)
+
+// TemplateDiff - the structural differences between two templates, and the parameters they're deployed with
+type TemplateDiff struct {
+	Resources  []ResourceDiff `json:"resources"`
+	Outputs    []ValueChange  `json:"outputs"`
+	Parameters []ValueChange  `json:"parameters"`
+
+	// Template - changes to the other sections, eg. Parameters, Mappings and Conditions
+	Template []ValueChange `json:"template"`
+}
+
+// ResourceDiff - a resource that was added, removed or modified
+type ResourceDiff struct {
+	LogicalID   string        `json:"logicalId"`
+	Type        string        `json:"type"`
+	Action      string        `json:"action"`
+	Changes     []ValueChange `json:"changes,omitempty"`
+	Replacement *Replacement  `json:"replacement,omitempty"`
+}
+
+// ValueChange - a value that was added, removed or modified, by its path
+type ValueChange struct {
+	Path   string      `json:"path"`
+	Action string      `json:"action"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// Empty - whether there are no differences
+func (diff TemplateDiff) Empty() bool {
+	return len(diff.Resources) == 0 && len(diff.Outputs) == 0 && len(diff.Parameters) == 0 && len(diff.Template) == 0
+}
+
+/*
+	DiffStack
+	compares a deployed (or previously compiled) template with a compiled stack
+*/
+func DiffStack(before map[string]interface{}, stack YamlCloudformation) (TemplateDiff, error) {
+	after, err := templateValue(stack)
+	if err != nil {
+		return TemplateDiff{}, err
+	}
+	return DiffTemplates(before, after), nil
+}
+
+/*
+	DiffStacks
+	compares two compiled stacks, eg. the same config compiled for two environments
+*/
+func DiffStacks(before, after YamlCloudformation) (TemplateDiff, error) {
+	beforeTemplate, err := templateValue(before)
+	if err != nil {
+		return TemplateDiff{}, err
+	}
+	return DiffStack(beforeTemplate, after)
+}
+
+/*
+	DiffTemplates
+	structurally compares two templates, resource by resource and property by
+	property, predicting which modified resources will be replaced
+*/
+func DiffTemplates(before, after map[string]interface{}) (diff TemplateDiff) {
+	diff.Resources = []ResourceDiff{}
+	diff.Outputs = []ValueChange{}
+	diff.Template = []ValueChange{}
+
+	replacements := make(map[string]Replacement)
+	for _, replacement := range predictReplacements(before, after) {
+		replacements[replacement.LogicalID] = replacement
+	}
+
+	beforeResources, _ := before["Resources"].(map[string]interface{})
+	afterResources, _ := after["Resources"].(map[string]interface{})
+	for _, logicalID := range changedKeys(beforeResources, afterResources) {
+		beforeResource, _ := beforeResources[logicalID].(map[string]interface{})
+		afterResource, _ := afterResources[logicalID].(map[string]interface{})
+
+		resource := ResourceDiff{LogicalID: logicalID}
+		switch {
+		case beforeResource == nil:
+			resource.Action = ChangeAdd
+			resource.Type, _ = afterResource["Type"].(string)
+		case afterResource == nil:
+			resource.Action = ChangeRemove
+			resource.Type, _ = beforeResource["Type"].(string)
+		default:
+			resource.Action = ChangeModify
+			resource.Type, _ = afterResource["Type"].(string)
+			resource.Changes = diffValues("", beforeResource, afterResource)
+			if replacement, ok := replacements[logicalID]; ok {
+				resource.Replacement = &replacement
+			}
+		}
+		diff.Resources = append(diff.Resources, resource)
+	}
+
+	beforeOutputs, _ := before["Outputs"].(map[string]interface{})
+	afterOutputs, _ := after["Outputs"].(map[string]interface{})
+	diff.Outputs = append(diff.Outputs, diffValues("", beforeOutputs, afterOutputs)...)
+
+	beforeSections := make(map[string]interface{})
+	afterSections := make(map[string]interface{})
+	for k, v := range before {
+		if k != "Resources" && k != "Outputs" {
+			beforeSections[k] = v
+		}
+	}
+	for k, v := range after {
+		if k != "Resources" && k != "Outputs" {
+			afterSections[k] = v
+		}
+	}
+	diff.Template = append(diff.Template, diffValues("", beforeSections, afterSections)...)
+
+	return
+}
+
+// DiffParameters - compares the parameter values two stacks are deployed with
+func DiffParameters(before, after map[string]string) (changes []ValueChange) {
+	beforeValues := make(map[string]interface{}, len(before))
+	for k, v := range before {
+		beforeValues[k] = v
+	}
+	afterValues := make(map[string]interface{}, len(after))
+	for k, v := range after {
+		afterValues[k] = v
+	}
+	return append([]ValueChange{}, diffValues("", beforeValues, afterValues)...)
+}
+
+/*
+	diffValues
+	recursively compares two template values, returning a change for each
+	value that differs. Intrinsic functions are compared as a whole
+*/
+func diffValues(path string, before, after interface{}) (changes []ValueChange) {
+	before, after = fixYamlKeys(before), fixYamlKeys(after)
+	if equalValues(before, after) {
+		return
+	}
+
+	if before == nil {
+		return []ValueChange{{Path: path, Action: ChangeAdd, After: after}}
+	}
+	if after == nil {
+		return []ValueChange{{Path: path, Action: ChangeRemove, Before: before}}
+	}
+
+	beforeMap, beforeIsMap := before.(map[string]interface{})
+	afterMap, afterIsMap := after.(map[string]interface{})
+	if beforeIsMap && afterIsMap && !types.IsIntrinsic(beforeMap) && !types.IsIntrinsic(afterMap) {
+		for _, key := range changedKeys(beforeMap, afterMap) {
+			changes = append(changes, diffValues(joinPath(path, key), beforeMap[key], afterMap[key])...)
+		}
+		return
+	}
+
+	beforeList, beforeIsList := before.([]interface{})
+	afterList, afterIsList := after.([]interface{})
+	if beforeIsList && afterIsList && len(beforeList) == len(afterList) {
+		for i := range beforeList {
+			changes = append(changes, diffValues(fmt.Sprintf("%v[%v]", path, i), beforeList[i], afterList[i])...)
+		}
+		return
+	}
+
+	return []ValueChange{{Path: path, Action: ChangeModify, Before: before, After: after}}
+}
+
+func joinPath(path, key string) string {
+	if len(path) == 0 {
+		return key
+	}
+	return strings.Join([]string{path, key}, ".")
+}
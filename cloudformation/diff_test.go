@@ -0,0 +1,145 @@
+package cloudformation
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffTemplates(t *testing.T) {
+	before, err := ParseTemplate([]byte(`
+Description: old
+Parameters:
+  Env:
+    Type: String
+Resources:
+  testBucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: !Sub "${Env}-bucket"
+      AccessControl: Private
+      Tags:
+        - Key: Env
+          Value: !Ref Env
+  testTopic:
+    Type: AWS::SNS::Topic
+  testQueue:
+    Type: AWS::SQS::Queue
+    Properties:
+      DelaySeconds: 5
+Outputs:
+  testBucketArn:
+    Value: !GetAtt testBucket.Arn
+`))
+	assert.Nil(t, err)
+
+	after, err := ParseTemplate([]byte(`{
+  "Description": "new",
+  "Parameters": { "Env": { "Type": "String", "Default": "dev" } },
+  "Resources": {
+    "testBucket": {
+      "Type": "AWS::S3::Bucket",
+      "Properties": {
+        "BucketName": { "Fn::Sub": "${Env}-bucket-2" },
+        "Tags": [ { "Key": "Env", "Value": { "Ref": "Env" } } ]
+      }
+    },
+    "testQueue": {
+      "Type": "AWS::SQS::Queue",
+      "Properties": { "DelaySeconds": 5 }
+    },
+    "testFunction": { "Type": "AWS::Lambda::Function" }
+  },
+  "Outputs": {
+    "testBucketArn": { "Value": { "Fn::GetAtt": [ "testBucket", "Arn" ] } },
+    "testBucketName": { "Value": { "Ref": "testBucket" } }
+  }
+}`))
+	assert.Nil(t, err)
+
+	diff := DiffTemplates(before, after)
+	assert.Equal(t, []ResourceDiff{
+		{LogicalID: "testBucket", Type: "AWS::S3::Bucket", Action: ChangeModify,
+			Changes: []ValueChange{
+				{Path: "Properties.AccessControl", Action: ChangeRemove, Before: "Private"},
+				{Path: "Properties.BucketName", Action: ChangeModify,
+					Before: map[string]interface{}{"Fn::Sub": "${Env}-bucket"},
+					After:  map[string]interface{}{"Fn::Sub": "${Env}-bucket-2"},
+				},
+			},
+			Replacement: &Replacement{LogicalID: "testBucket", Type: "AWS::S3::Bucket", Properties: []string{"BucketName"}},
+		},
+		{LogicalID: "testFunction", Type: "AWS::Lambda::Function", Action: ChangeAdd},
+		{LogicalID: "testTopic", Type: "AWS::SNS::Topic", Action: ChangeRemove},
+	}, diff.Resources)
+	assert.Equal(t, []ValueChange{
+		{Path: "testBucketName", Action: ChangeAdd, After: map[string]interface{}{"Value": map[string]interface{}{"Ref": "testBucket"}}},
+	}, diff.Outputs)
+	assert.Equal(t, []ValueChange{
+		{Path: "Description", Action: ChangeModify, Before: "old", After: "new"},
+		{Path: "Parameters.Env.Default", Action: ChangeAdd, After: "dev"},
+	}, diff.Template)
+	assert.False(t, diff.Empty())
+
+	assert.True(t, DiffTemplates(before, before).Empty())
+}
+
+func TestDiffParameters(t *testing.T) {
+	changes := DiffParameters(
+		map[string]string{"Env": "dev", "Size": "small", "Old": "x"},
+		map[string]string{"Env": "prod", "Size": "small", "New": "y"},
+	)
+	assert.Equal(t, []ValueChange{
+		{Path: "Env", Action: ChangeModify, Before: "dev", After: "prod"},
+		{Path: "New", Action: ChangeAdd, After: "y"},
+		{Path: "Old", Action: ChangeRemove, Before: "x"},
+	}, changes)
+}
+
+func TestDiffStacks_environments(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kombustion")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	configPath := filepath.Join(dir, "api.yaml")
+	envPath := filepath.Join(dir, "environment.yaml")
+	assert.Nil(t, ioutil.WriteFile(configPath, []byte(`
+Resources:
+  testQueue:
+    Type: AWS::SQS::Queue
+    Properties:
+      DelaySeconds: {{.Delay}}
+      QueueName: {{.Env}}-queue
+`), 0644))
+	assert.Nil(t, ioutil.WriteFile(envPath, []byte(`
+dev:
+  Env: dev
+  Delay: 5
+prod:
+  Env: prod
+  Delay: 5
+`), 0644))
+
+	stacks := map[string]YamlCloudformation{}
+	for _, env := range []string{"dev", "prod"} {
+		stacks[env], err = GenerateYamlStack(GenerateParams{
+			Filename:           configPath,
+			EnvFile:            envPath,
+			Env:                env,
+			DisableBaseOutputs: true,
+		})
+		assert.Nil(t, err)
+	}
+
+	diff, err := DiffStacks(stacks["dev"], stacks["prod"])
+	assert.Nil(t, err)
+	assert.Len(t, diff.Resources, 1)
+	assert.Equal(t, ChangeModify, diff.Resources[0].Action)
+	assert.Equal(t, []ValueChange{
+		{Path: "Properties.QueueName", Action: ChangeModify, Before: "dev-queue", After: "prod-queue"},
+	}, diff.Resources[0].Changes)
+	assert.NotNil(t, diff.Resources[0].Replacement)
+}
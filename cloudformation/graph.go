@@ -0,0 +1,158 @@
+package cloudformation
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DependencyGraph - the resources of a compiled stack, and the dependencies between them
+type DependencyGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+
+	// Cycle - a circular dependency, as a path that starts and ends at the same resource
+	Cycle []string `json:"cycle,omitempty"`
+}
+
+// GraphNode - a resource in a dependency graph
+type GraphNode struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// GraphEdge - a resource (From) depending on another resource (To), and how it refers to it
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Via  string `json:"via"`
+}
+
+/*
+	BuildDependencyGraph
+	builds the dependency graph of the resources in a compiled stack, from their
+	Ref, Fn::GetAtt, Fn::Sub and DependsOn references
+*/
+func BuildDependencyGraph(stack YamlCloudformation) (graph DependencyGraph, err error) {
+	template, err := templateValue(stack)
+	if err != nil {
+		return
+	}
+	resources, _ := template["Resources"].(map[string]interface{})
+
+	graph.Nodes = []GraphNode{}
+	graph.Edges = []GraphEdge{}
+	edges := make(map[GraphEdge]bool)
+	for _, resourceName := range sortedKeys(resources) {
+		resource, _ := resources[resourceName].(map[string]interface{})
+		resourceType, _ := resource["Type"].(string)
+		graph.Nodes = append(graph.Nodes, GraphNode{ID: resourceName, Type: resourceType})
+
+		path := "Resources." + resourceName
+		refs := append(findDependsOn(path, resource), findReferences(path, resource)...)
+		for _, ref := range refs {
+			if _, ok := resources[ref.Target]; !ok || ref.Function == "Fn::FindInMap" {
+				continue
+			}
+			edge := GraphEdge{From: resourceName, To: ref.Target, Via: ref.Function}
+			if len(ref.Source) > 0 {
+				edge.Via = ref.Source
+			}
+			if !edges[edge] {
+				edges[edge] = true
+				graph.Edges = append(graph.Edges, edge)
+			}
+		}
+	}
+
+	sort.SliceStable(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From != graph.Edges[j].From {
+			return graph.Edges[i].From < graph.Edges[j].From
+		}
+		if graph.Edges[i].To != graph.Edges[j].To {
+			return graph.Edges[i].To < graph.Edges[j].To
+		}
+		return graph.Edges[i].Via < graph.Edges[j].Via
+	})
+
+	graph.Cycle = graph.findCycle()
+	return
+}
+
+/*
+	findCycle
+	depth first search for a circular dependency, returning its path
+	eg. [A, B, C, A], or nil when the graph has no cycles
+*/
+func (graph DependencyGraph) findCycle() []string {
+	dependencies := make(map[string][]string)
+	for _, edge := range graph.Edges {
+		dependencies[edge.From] = append(dependencies[edge.From], edge.To)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var path []string
+
+	var visit func(node string) []string
+	visit = func(node string) []string {
+		state[node] = visiting
+		path = append(path, node)
+		for _, dependency := range dependencies[node] {
+			switch state[dependency] {
+			case visiting:
+				for i, pathNode := range path {
+					if pathNode == dependency {
+						cycle := append([]string{}, path[i:]...)
+						return append(cycle, dependency)
+					}
+				}
+			case unvisited:
+				if cycle := visit(dependency); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[node] = visited
+		return nil
+	}
+
+	for _, node := range graph.Nodes {
+		if state[node.ID] == unvisited {
+			if cycle := visit(node.ID); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// Dot - renders the graph in Graphviz DOT format, with any circular dependency in red
+func (graph DependencyGraph) Dot(name string) string {
+	cycleEdges := make(map[string]bool)
+	for i := 1; i < len(graph.Cycle); i++ {
+		cycleEdges[graph.Cycle[i-1]+"->"+graph.Cycle[i]] = true
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "digraph %v {\n", strconv.Quote(name))
+	for _, node := range graph.Nodes {
+		fmt.Fprintf(buf, "  %v [label=%v];\n", strconv.Quote(node.ID), strconv.Quote(node.ID+"\n"+node.Type))
+	}
+	for _, edge := range graph.Edges {
+		attributes := []string{"label=" + strconv.Quote(edge.Via)}
+		if cycleEdges[edge.From+"->"+edge.To] {
+			attributes = append(attributes, "color=red")
+		}
+		fmt.Fprintf(buf, "  %v -> %v [%v];\n", strconv.Quote(edge.From), strconv.Quote(edge.To), strings.Join(attributes, ", "))
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
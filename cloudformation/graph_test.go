@@ -0,0 +1,84 @@
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildDependencyGraph(t *testing.T) {
+	stack := YamlCloudformation{
+		Parameters: types.ValueMap{
+			"Env": map[string]interface{}{"Type": "String"},
+		},
+		Resources: types.ValueMap{
+			"testBucket": map[string]interface{}{
+				"Type":      "AWS::S3::Bucket",
+				"DependsOn": "testTopic",
+				"Properties": map[string]interface{}{
+					"BucketName": map[string]interface{}{"Fn::Sub": "${Env}-${testTopic.TopicName}"},
+				},
+			},
+			"testPolicy": map[string]interface{}{
+				"Type": "AWS::S3::BucketPolicy",
+				"Properties": map[string]interface{}{
+					"Bucket":         map[string]interface{}{"Ref": "testBucket"},
+					"PolicyDocument": map[string]interface{}{"Resource": map[string]interface{}{"Fn::GetAtt": "testBucket.Arn"}},
+				},
+			},
+			"testTopic": map[string]interface{}{
+				"Type": "AWS::SNS::Topic",
+			},
+		},
+	}
+
+	graph, err := BuildDependencyGraph(stack)
+	assert.Nil(t, err)
+	assert.Equal(t, []GraphNode{
+		{ID: "testBucket", Type: "AWS::S3::Bucket"},
+		{ID: "testPolicy", Type: "AWS::S3::BucketPolicy"},
+		{ID: "testTopic", Type: "AWS::SNS::Topic"},
+	}, graph.Nodes)
+	assert.Equal(t, []GraphEdge{
+		{From: "testBucket", To: "testTopic", Via: "DependsOn"},
+		{From: "testBucket", To: "testTopic", Via: "Fn::Sub"},
+		{From: "testPolicy", To: "testBucket", Via: "Fn::GetAtt"},
+		{From: "testPolicy", To: "testBucket", Via: "Ref"},
+	}, graph.Edges)
+	assert.Nil(t, graph.Cycle)
+
+	assert.Equal(t, `digraph "test" {
+  "testBucket" [label="testBucket\nAWS::S3::Bucket"];
+  "testPolicy" [label="testPolicy\nAWS::S3::BucketPolicy"];
+  "testTopic" [label="testTopic\nAWS::SNS::Topic"];
+  "testBucket" -> "testTopic" [label="DependsOn"];
+  "testBucket" -> "testTopic" [label="Fn::Sub"];
+  "testPolicy" -> "testBucket" [label="Fn::GetAtt"];
+  "testPolicy" -> "testBucket" [label="Ref"];
+}
+`, graph.Dot("test"))
+}
+
+func TestBuildDependencyGraph_cycle(t *testing.T) {
+	stack := YamlCloudformation{
+		Resources: types.ValueMap{
+			"a": map[string]interface{}{"Type": "AWS::SNS::Topic", "DependsOn": "b"},
+			"b": map[string]interface{}{"Type": "AWS::SNS::Topic", "DependsOn": []interface{}{"c"}},
+			"c": map[string]interface{}{
+				"Type":       "AWS::SNS::Topic",
+				"Properties": map[string]interface{}{"DisplayName": map[string]interface{}{"Fn::GetAtt": []interface{}{"a", "TopicName"}}},
+			},
+			"d": map[string]interface{}{"Type": "AWS::SNS::Topic", "DependsOn": "a"},
+		},
+	}
+
+	graph, err := BuildDependencyGraph(stack)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "a"}, graph.Cycle)
+	assert.Contains(t, graph.Dot("test"), `"c" -> "a" [label="Fn::GetAtt", color=red];`)
+	assert.Contains(t, graph.Dot("test"), `"d" -> "a" [label="DependsOn"];`)
+
+	problems := ValidateStack(stack, nil)
+	assert.Contains(t, problems, ValidationProblem{Path: "Resources.a", Check: "cycle", Message: "circular dependency: a -> b -> c -> a"})
+}
@@ -0,0 +1,186 @@
+package cloudformation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	yaml "github.com/KablamoOSS/yaml"
+)
+
+// MarshalJson - marshal a compiled stack into a canonical JSON CloudFormation template
+func MarshalJson(stack YamlCloudformation) ([]byte, error) {
+	template, err := templateValue(stack)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(jsonIntrinsics(template), "", "  ")
+}
+
+/*
+	MarshalCompactJson
+	marshals a parsed template (see ParseTemplate) into JSON without
+	whitespace, with its intrinsic functions in their JSON-legal shapes
+*/
+func MarshalCompactJson(template map[string]interface{}) ([]byte, error) {
+	var output bytes.Buffer
+	encoder := json.NewEncoder(&output)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(jsonIntrinsics(template)); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSpace(output.Bytes()), nil
+}
+
+/*
+	templateValue
+	round trips a compiled stack through yaml, so the generated resource structs
+	are flattened into plain maps using their yaml tags (omitempty etc.)
+*/
+func templateValue(stack YamlCloudformation) (map[string]interface{}, error) {
+	data, err := yaml.Marshal(stack)
+	if err != nil {
+		return nil, err
+	}
+
+	var template interface{}
+	if err = yaml.Unmarshal(data, &template); err != nil {
+		return nil, err
+	}
+
+	templateMap, _ := fixYamlKeys(template).(map[string]interface{})
+	return templateMap, nil
+}
+
+/*
+	jsonIntrinsics
+	recursively rewrites intrinsic functions into their JSON-legal shapes
+	eg. the short form `!GetAtt Resource.Attribute` => ["Resource", "Attribute"]
+*/
+func jsonIntrinsics(o interface{}) interface{} {
+	switch obj := o.(type) {
+
+	case map[string]interface{}:
+		fixed := make(map[string]interface{})
+		for k, v := range obj {
+			if k == "Fn::GetAtt" {
+				if s, ok := v.(string); ok {
+					var parts []interface{}
+					for _, part := range strings.SplitN(s, ".", 2) {
+						parts = append(parts, part)
+					}
+					fixed[k] = parts
+					continue
+				}
+			}
+			fixed[k] = jsonIntrinsics(v)
+		}
+		return fixed
+
+	case []interface{}:
+		fixed := make([]interface{}, len(obj))
+		for i, v := range obj {
+			fixed[i] = jsonIntrinsics(v)
+		}
+		return fixed
+
+	default:
+		return o
+	}
+}
+
+/*
+	isJsonConfig
+	detects a JSON config by its file extension, or by its content when
+	the extension doesn't say
+*/
+func isJsonConfig(filename string, data []byte) bool {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		return true
+	case ".yaml", ".yml":
+		return false
+	}
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte("{"))
+}
+
+/*
+	unmarshalJsonConfig
+	parses a JSON config into the same shape as a yaml config, so it can be
+	handled by the same resource, output and mapping parsers
+*/
+func unmarshalJsonConfig(data []byte, config *YamlConfig) error {
+	var template interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&template); err != nil {
+		return jsonLineError(data, err)
+	}
+
+	yamlData, err := yaml.Marshal(fixJsonNumbers(template))
+	if err != nil {
+		return err
+	}
+
+	return yaml.Unmarshal(yamlData, config)
+}
+
+/*
+	fixJsonNumbers
+	recursively converts json.Number values into ints or floats
+*/
+func fixJsonNumbers(o interface{}) interface{} {
+	switch obj := o.(type) {
+
+	case map[string]interface{}:
+		for k, v := range obj {
+			obj[k] = fixJsonNumbers(v)
+		}
+		return obj
+
+	case []interface{}:
+		for i, v := range obj {
+			obj[i] = fixJsonNumbers(v)
+		}
+		return obj
+
+	case json.Number:
+		if i, err := obj.Int64(); err == nil {
+			return i
+		}
+		if f, err := obj.Float64(); err == nil {
+			return f
+		}
+		return obj.String()
+
+	default:
+		return o
+	}
+}
+
+/*
+	jsonLineError
+	converts the byte offset of a json error into a line number, so it can be
+	reported like a yaml error
+*/
+func jsonLineError(data []byte, err error) error {
+	var offset int64
+	switch jsonErr := err.(type) {
+	case *json.SyntaxError:
+		offset = jsonErr.Offset
+	case *json.UnmarshalTypeError:
+		offset = jsonErr.Offset
+	default:
+		return err
+	}
+
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	line := bytes.Count(data[:offset], []byte("\n")) + 1
+	return fmt.Errorf("json: line %d: %v", line, err)
+}
@@ -0,0 +1,104 @@
+package cloudformation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/parsers/resources"
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalJson_intrinsics(t *testing.T) {
+	stack := YamlCloudformation{
+		AWSTemplateFormatVersion: "2010-09-09",
+		Resources: types.ValueMap{
+			"testBucket": resources.NewS3Bucket(
+				resources.S3BucketProperties{
+					BucketName: map[string]interface{}{"Ref": "BucketName"},
+				},
+			),
+		},
+		Outputs: types.ValueMap{
+			"testBucketArn": map[interface{}]interface{}{
+				"Value": map[string]interface{}{"Fn::GetAtt": "testBucket.Arn"},
+			},
+			"testBucketDomainName": map[string]interface{}{
+				"Value": map[string]interface{}{"Fn::GetAtt": []string{"testBucket", "DomainName"}},
+			},
+		},
+	}
+
+	expected := map[string]interface{}{
+		"AWSTemplateFormatVersion": "2010-09-09",
+		"Resources": map[string]interface{}{
+			"testBucket": map[string]interface{}{
+				"Type": "AWS::S3::Bucket",
+				"Properties": map[string]interface{}{
+					"BucketName": map[string]interface{}{"Ref": "BucketName"},
+				},
+			},
+		},
+		"Outputs": map[string]interface{}{
+			"testBucketArn": map[string]interface{}{
+				"Value": map[string]interface{}{"Fn::GetAtt": []interface{}{"testBucket", "Arn"}},
+			},
+			"testBucketDomainName": map[string]interface{}{
+				"Value": map[string]interface{}{"Fn::GetAtt": []interface{}{"testBucket", "DomainName"}},
+			},
+		},
+	}
+
+	output, err := MarshalJson(stack)
+	assert.Nil(t, err)
+
+	var result interface{}
+	err = json.Unmarshal(output, &result)
+	assert.Nil(t, err)
+	assert.EqualValues(t, expected, result)
+}
+
+func TestUnmarshalJsonConfig(t *testing.T) {
+	inputJson := `{
+  "AWSTemplateFormatVersion": "2010-09-09",
+  "Resources": {
+    "testQueue": {
+      "Type": "AWS::SQS::Queue",
+      "Condition": "IsProd",
+      "Properties": {
+        "QueueName": { "Fn::Sub": "${AWS::StackName}-queue" },
+        "DelaySeconds": 5,
+        "FifoQueue": true
+      }
+    }
+  }
+}`
+
+	var config YamlConfig
+	err := unmarshalJsonConfig([]byte(inputJson), &config)
+	assert.Nil(t, err)
+	assert.Equal(t, "2010-09-09", config.AWSTemplateFormatVersion)
+	assert.Equal(t, "AWS::SQS::Queue", config.Resources["testQueue"].Type)
+	assert.Equal(t, "IsProd", config.Resources["testQueue"].Condition)
+	assert.EqualValues(t, map[interface{}]interface{}{
+		"QueueName":    map[interface{}]interface{}{"Fn::Sub": "${AWS::StackName}-queue"},
+		"DelaySeconds": 5,
+		"FifoQueue":    true,
+	}, config.Resources["testQueue"].Properties)
+}
+
+func TestUnmarshalJsonConfig_errorLine(t *testing.T) {
+	inputJson := "{\n  \"Resources\": {\n    \"testQueue\": }\n}"
+
+	var config YamlConfig
+	err := unmarshalJsonConfig([]byte(inputJson), &config)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "line 3:")
+}
+
+func TestIsJsonConfig(t *testing.T) {
+	assert.True(t, isJsonConfig("configs/legacy.json", []byte("")))
+	assert.False(t, isJsonConfig("configs/test.yaml", []byte("{}")))
+	assert.True(t, isJsonConfig("configs/legacy", []byte("  {\"Resources\": {}}")))
+	assert.False(t, isJsonConfig("configs/test", []byte("Resources: {}")))
+}
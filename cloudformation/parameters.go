@@ -0,0 +1,184 @@
+package cloudformation
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KablamoOSS/kombustion/types"
+)
+
+/*
+	ParameterValues
+	converts the environment values of the parameters a template declares into
+	the strings CloudFormation takes, by the Type of each parameter. Numbers
+	and booleans are formatted, and lists are joined with commas for list
+	types. Each value is checked against the constraints of its parameter
+*/
+func ParameterValues(declared types.ValueMap, env types.ValueMap) (values map[string]string, errs []error) {
+	values = make(map[string]string)
+	for _, name := range sortedKeys(declared) {
+		value, ok := env[name]
+		if !ok || value == nil {
+			continue
+		}
+		parameter, _ := fixYamlKeys(declared[name]).(map[string]interface{})
+
+		elements, err := parameterElements(name, parameter, value)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, element := range elements {
+			errs = append(errs, checkParameterValue(name, parameter, element)...)
+		}
+		values[name] = strings.Join(elements, ",")
+	}
+	return
+}
+
+/*
+	MissingParameters
+	the parameters a template declares without a Default, that haven't been
+	given a value
+*/
+func MissingParameters(declared types.ValueMap, given []string) (missing []string) {
+	isGiven := make(map[string]bool)
+	for _, name := range given {
+		isGiven[name] = true
+	}
+	for _, name := range sortedKeys(declared) {
+		parameter, _ := fixYamlKeys(declared[name]).(map[string]interface{})
+		if _, ok := parameter["Default"]; !ok && !isGiven[name] {
+			missing = append(missing, name)
+		}
+	}
+	return
+}
+
+// isListParameter - whether a parameter type takes a comma delimited list
+func isListParameter(parameterType string) bool {
+	return parameterType == "CommaDelimitedList" || strings.HasPrefix(parameterType, "List<")
+}
+
+// parameterElements - the value of a parameter as strings, with an element for each item of a list
+func parameterElements(name string, parameter map[string]interface{}, value interface{}) ([]string, error) {
+	parameterType, _ := parameter["Type"].(string)
+
+	list, isList := value.([]interface{})
+	if !isList {
+		element, err := parameterString(value)
+		if err != nil {
+			return nil, fmt.Errorf("Parameter %v: %v", name, err)
+		}
+		if isListParameter(parameterType) {
+			return strings.Split(element, ","), nil
+		}
+		return []string{element}, nil
+	}
+
+	if !isListParameter(parameterType) {
+		return nil, fmt.Errorf("Parameter %v: a list was given, but its Type is %v", name, parameterType)
+	}
+	elements := []string{}
+	for _, item := range list {
+		element, err := parameterString(item)
+		if err != nil {
+			return nil, fmt.Errorf("Parameter %v: %v", name, err)
+		}
+		elements = append(elements, element)
+	}
+	return elements, nil
+}
+
+func parameterString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	}
+	return "", fmt.Errorf("%v can't be converted to a parameter value", value)
+}
+
+// checkParameterValue - checks a value, or an element of a list, against the constraints of its parameter
+func checkParameterValue(name string, parameter map[string]interface{}, value string) (errs []error) {
+	parameterType, _ := parameter["Type"].(string)
+	invalid := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("Parameter %v: %q %v", name, value, fmt.Sprintf(format, args...)))
+	}
+
+	if allowed, ok := parameter["AllowedValues"].([]interface{}); ok {
+		found := false
+		for _, allowedValue := range allowed {
+			if s, err := parameterString(allowedValue); err == nil && s == value {
+				found = true
+			}
+		}
+		if !found {
+			invalid("is not one of the AllowedValues %v", allowed)
+		}
+	}
+
+	if pattern, ok := parameter["AllowedPattern"].(string); ok {
+		// patterns use java regular expressions, so some (eg. lookaheads) can
+		// only be checked by cloudformation
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			log.WithFields(log.Fields{
+				"parameter": name,
+				"pattern":   pattern,
+			}).Warn("Can't check the AllowedPattern of parameter, leaving it to cloudformation")
+		} else if !re.MatchString(value) {
+			invalid("doesn't match the AllowedPattern %v", pattern)
+		}
+	}
+
+	if parameterType == "String" {
+		if minLength, ok := parameterNumber(parameter["MinLength"]); ok && float64(len(value)) < minLength {
+			invalid("is shorter than the MinLength %v", minLength)
+		}
+		if maxLength, ok := parameterNumber(parameter["MaxLength"]); ok && float64(len(value)) > maxLength {
+			invalid("is longer than the MaxLength %v", maxLength)
+		}
+	}
+
+	if parameterType == "Number" || parameterType == "List<Number>" {
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			invalid("is not a number")
+			return
+		}
+		if minValue, ok := parameterNumber(parameter["MinValue"]); ok && number < minValue {
+			invalid("is less than the MinValue %v", minValue)
+		}
+		if maxValue, ok := parameterNumber(parameter["MaxValue"]); ok && number > maxValue {
+			invalid("is more than the MaxValue %v", maxValue)
+		}
+	}
+	return
+}
+
+// parameterNumber - a numeric parameter attribute, which may be written as a string
+func parameterNumber(value interface{}) (float64, bool) {
+	if value == nil {
+		return 0, false
+	}
+	s, err := parameterString(value)
+	if err != nil {
+		return 0, false
+	}
+	number, err := strconv.ParseFloat(s, 64)
+	return number, err == nil
+}
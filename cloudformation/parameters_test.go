@@ -0,0 +1,103 @@
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/types"
+	yaml "github.com/KablamoOSS/yaml"
+	"github.com/stretchr/testify/assert"
+)
+
+const testParameters = `
+ClusterSize:
+  Type: Number
+  MinValue: 1
+  MaxValue: 10
+Public:
+  Type: String
+  AllowedValues: [true, false]
+Subnets:
+  Type: List<AWS::EC2::Subnet::Id>
+  AllowedPattern: subnet-[0-9a-f]+
+Zones:
+  Type: CommaDelimitedList
+  Default: a,b
+Name:
+  Type: String
+  MinLength: "3"
+  MaxLength: 8
+`
+
+func parseParameters(t *testing.T) types.ValueMap {
+	var declared types.ValueMap
+	assert.Nil(t, yaml.Unmarshal([]byte(testParameters), &declared))
+	return declared
+}
+
+func TestParameterValues(t *testing.T) {
+	var env types.ValueMap
+	assert.Nil(t, yaml.Unmarshal([]byte(`
+ClusterSize: 4
+Public: true
+Subnets: [subnet-1a, subnet-2b]
+Zones: ap-southeast-2a,ap-southeast-2b
+Name: api
+Other: [not, a, parameter]
+`), &env))
+
+	values, errs := ParameterValues(parseParameters(t), env)
+	assert.Len(t, errs, 0)
+	assert.Equal(t, map[string]string{
+		"ClusterSize": "4",
+		"Public":      "true",
+		"Subnets":     "subnet-1a,subnet-2b",
+		"Zones":       "ap-southeast-2a,ap-southeast-2b",
+		"Name":        "api",
+	}, values)
+}
+
+func TestParameterValues_invalid(t *testing.T) {
+	var env types.ValueMap
+	assert.Nil(t, yaml.Unmarshal([]byte(`
+ClusterSize: 12
+Public: maybe
+Subnets: [subnet-1a, vpc-2b]
+Name: [a, list]
+`), &env))
+
+	_, errs := ParameterValues(parseParameters(t), env)
+	messages := []string{}
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	assert.Equal(t, []string{
+		`Parameter ClusterSize: "12" is more than the MaxValue 10`,
+		`Parameter Name: a list was given, but its Type is String`,
+		`Parameter Public: "maybe" is not one of the AllowedValues [true false]`,
+		`Parameter Subnets: "vpc-2b" doesn't match the AllowedPattern subnet-[0-9a-f]+`,
+	}, messages)
+
+	_, errs = ParameterValues(parseParameters(t), types.ValueMap{"ClusterSize": "four", "Name": "ab"})
+	assert.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], `Parameter ClusterSize: "four" is not a number`)
+	assert.EqualError(t, errs[1], `Parameter Name: "ab" is shorter than the MinLength 3`)
+}
+
+func TestParameterValues_javaPattern(t *testing.T) {
+	declared := types.ValueMap{
+		"BucketName": map[interface{}]interface{}{
+			"Type":           "String",
+			"AllowedPattern": "(?!aws).*",
+		},
+	}
+
+	// go can't compile the lookahead, so the pattern is left to cloudformation
+	values, errs := ParameterValues(declared, types.ValueMap{"BucketName": "aws-logs"})
+	assert.Len(t, errs, 0)
+	assert.Equal(t, map[string]string{"BucketName": "aws-logs"}, values)
+}
+
+func TestMissingParameters(t *testing.T) {
+	assert.Equal(t, []string{"Name", "Public"}, MissingParameters(parseParameters(t), []string{"ClusterSize", "Subnets"}))
+	assert.Nil(t, MissingParameters(parseParameters(t), []string{"ClusterSize", "Subnets", "Name", "Public"}))
+}
@@ -75,8 +75,12 @@ func downloadPlugin(pluginname string) error {
 	return nil
 }
 
+// pluginTypes - the plugin file each loaded plugin resource type is from
+var pluginTypes = make(map[string]string)
+
 func loadPlugins() (resources, outputs, mappings map[string]types.ParserFunc) {
 	resources, outputs, mappings = make(map[string]types.ParserFunc), make(map[string]types.ParserFunc), make(map[string]types.ParserFunc)
+	pluginTypes = make(map[string]string)
 
 	pluginBaseDir := ""
 	if len(os.Getenv("PLUGINS")) > 0 {
@@ -159,6 +163,7 @@ func loadPlugin(filename, pluginDir string, resources, outputs, mappings map[str
 			}).Warn("duplicate resource definition for resource")
 		} else {
 			resources[k] = v
+			pluginTypes[k] = filename
 		}
 	}
 	for k, v := range *o.(*map[string]types.ParserFunc) {
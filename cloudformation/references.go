@@ -0,0 +1,289 @@
+package cloudformation
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/KablamoOSS/kombustion/parsers"
+)
+
+// reference - a Ref, Fn::GetAtt, Fn::Sub, DependsOn or Fn::FindInMap target, and where it was found
+type reference struct {
+	Path      string
+	Function  string
+	Target    string
+	Attribute string
+	Keys      []interface{}
+
+	// Source - the intrinsic the reference was written with, when it isn't Function (eg. Fn::Sub)
+	Source string
+}
+
+var pseudoParameters = map[string]bool{
+	"AWS::AccountId":        true,
+	"AWS::NotificationARNs": true,
+	"AWS::NoValue":          true,
+	"AWS::Partition":        true,
+	"AWS::Region":           true,
+	"AWS::StackId":          true,
+	"AWS::StackName":        true,
+	"AWS::URLSuffix":        true,
+}
+
+var subVariablePattern = regexp.MustCompile(`\$\{([^!}][^}]*)\}`)
+
+/*
+	checkReferences
+	checks every Ref, Fn::GetAtt, Fn::Sub variable, DependsOn and Fn::FindInMap in
+	a compiled stack points at a resource, parameter, pseudo parameter, attribute
+	or mapping key that exists
+*/
+func checkReferences(stack YamlCloudformation) (problems []ValidationProblem) {
+	if len(stack.Transform) > 0 {
+		// transforms (eg. AWS::Serverless) create resources we can't see
+		return
+	}
+
+	template, err := templateValue(stack)
+	if err != nil {
+		return []ValidationProblem{{Path: "Template", Check: "template", Message: err.Error()}}
+	}
+
+	resources, _ := template["Resources"].(map[string]interface{})
+	parameters, _ := template["Parameters"].(map[string]interface{})
+	mappings, _ := template["Mappings"].(map[string]interface{})
+	attributes := parsers.GetAttributes_resources()
+
+	var refs []reference
+	for _, section := range []string{"Conditions", "Resources", "Outputs"} {
+		values, _ := template[section].(map[string]interface{})
+		for _, name := range sortedKeys(values) {
+			path := section + "." + name
+			if section == "Resources" {
+				refs = append(refs, findDependsOn(path, values[name])...)
+			}
+			refs = append(refs, findReferences(path, values[name])...)
+		}
+	}
+
+	for _, ref := range refs {
+		var msg string
+		switch ref.Function {
+		case "Ref":
+			_, isResource := resources[ref.Target]
+			_, isParameter := parameters[ref.Target]
+			if !isResource && !isParameter && !pseudoParameters[ref.Target] {
+				msg = fmt.Sprintf("%v to missing resource or parameter '%v'", ref.Function, ref.Target)
+			}
+
+		case "Fn::GetAtt":
+			resource, ok := resources[ref.Target]
+			if !ok {
+				msg = fmt.Sprintf("%v to missing resource '%v'", ref.Function, ref.Target)
+				break
+			}
+			resourceType, _ := resource.(map[string]interface{})["Type"].(string)
+			if !hasAttribute(attributes, resourceType, ref.Attribute) {
+				msg = fmt.Sprintf("%v to unknown attribute '%v' of %v (%v)", ref.Function, ref.Attribute, ref.Target, resourceType)
+			}
+
+		case "DependsOn":
+			if _, ok := resources[ref.Target]; !ok {
+				msg = fmt.Sprintf("%v on missing resource '%v'", ref.Function, ref.Target)
+			}
+
+		case "Fn::FindInMap":
+			msg = checkMappingKeys(mappings, ref)
+		}
+
+		if len(msg) > 0 {
+			problems = append(problems, ValidationProblem{Path: ref.Path, Check: "reference", Message: msg})
+		}
+	}
+	return
+}
+
+/*
+	hasAttribute
+	checks an attribute against the spec for a resource type. Attributes of
+	types without a spec (eg. Custom:: or plugin types) can't be checked
+*/
+func hasAttribute(attributes map[string][]string, resourceType, attribute string) bool {
+	if len(attribute) == 0 {
+		// not a literal attribute name
+		return true
+	}
+	if resourceType == "AWS::CloudFormation::Stack" && strings.HasPrefix(attribute, "Outputs.") {
+		return true
+	}
+	typeAttributes, ok := attributes[resourceType]
+	if !ok {
+		return true
+	}
+	for _, typeAttribute := range typeAttributes {
+		if typeAttribute == attribute {
+			return true
+		}
+	}
+	return false
+}
+
+func checkMappingKeys(mappings map[string]interface{}, ref reference) string {
+	mapping, ok := mappings[ref.Target].(map[string]interface{})
+	if !ok {
+		return fmt.Sprintf("%v to missing mapping '%v'", ref.Function, ref.Target)
+	}
+
+	path := ref.Target
+	values := mapping
+	for _, key := range ref.Keys {
+		name, ok := key.(string)
+		if !ok || values == nil {
+			// not a literal key, so it can only be checked when deployed
+			return ""
+		}
+		value, ok := values[name]
+		if !ok {
+			return fmt.Sprintf("%v to missing mapping key '%v' in %v", ref.Function, name, path)
+		}
+		path += "." + name
+		values, _ = value.(map[string]interface{})
+	}
+	return ""
+}
+
+/*
+	findDependsOn
+	collects the DependsOn targets of a resource
+*/
+func findDependsOn(path string, resource interface{}) (refs []reference) {
+	resourceMap, _ := resource.(map[string]interface{})
+	switch dependsOn := resourceMap["DependsOn"].(type) {
+	case string:
+		refs = append(refs, reference{Path: path + ".DependsOn", Function: "DependsOn", Target: dependsOn})
+	case []interface{}:
+		for i, v := range dependsOn {
+			if target, ok := v.(string); ok {
+				refs = append(refs, reference{Path: fmt.Sprintf("%v.DependsOn[%v]", path, i), Function: "DependsOn", Target: target})
+			}
+		}
+	}
+	return
+}
+
+/*
+	findReferences
+	recursively collects the Ref, Fn::GetAtt, Fn::Sub and Fn::FindInMap targets
+	in a template value
+*/
+func findReferences(path string, o interface{}) (refs []reference) {
+	switch obj := o.(type) {
+
+	case map[string]interface{}:
+		for _, k := range sortedKeys(obj) {
+			refs = append(refs, findFunctionReferences(path, k, obj[k])...)
+		}
+
+	case map[interface{}]interface{}:
+		return findReferences(path, fixYamlKeys(obj))
+
+	case []interface{}:
+		for i, v := range obj {
+			refs = append(refs, findReferences(fmt.Sprintf("%v[%v]", path, i), v)...)
+		}
+	}
+	return
+}
+
+func findFunctionReferences(path, key string, value interface{}) []reference {
+	keyPath := path + "." + key
+
+	switch key {
+	case "Ref":
+		if target, ok := value.(string); ok {
+			return []reference{{Path: keyPath, Function: key, Target: target}}
+		}
+
+	case "Fn::GetAtt":
+		switch args := value.(type) {
+		case string:
+			parts := strings.SplitN(args, ".", 2)
+			if len(parts) == 2 {
+				return []reference{{Path: keyPath, Function: key, Target: parts[0], Attribute: parts[1]}}
+			}
+		case []interface{}:
+			if len(args) == 2 {
+				target, targetOk := args[0].(string)
+				attribute, attributeOk := args[1].(string)
+				if targetOk {
+					ref := reference{Path: keyPath, Function: key, Target: target}
+					if attributeOk {
+						ref.Attribute = attribute
+					}
+					return append([]reference{ref}, findReferences(keyPath, args[1])...)
+				}
+			}
+		case []string:
+			if len(args) == 2 {
+				return []reference{{Path: keyPath, Function: key, Target: args[0], Attribute: args[1]}}
+			}
+		}
+
+	case "Fn::Sub":
+		switch args := value.(type) {
+		case string:
+			return findSubReferences(keyPath, args, nil)
+		case []interface{}:
+			if len(args) == 2 {
+				if text, ok := args[0].(string); ok {
+					variables, _ := args[1].(map[string]interface{})
+					refs := findSubReferences(keyPath, text, variables)
+					return append(refs, findReferences(keyPath, variables)...)
+				}
+			}
+		}
+
+	case "Fn::FindInMap":
+		if args, ok := value.([]interface{}); ok && len(args) == 3 {
+			if target, ok := args[0].(string); ok {
+				refs := []reference{{Path: keyPath, Function: key, Target: target, Keys: args[1:]}}
+				return append(refs, findReferences(keyPath, args[1:])...)
+			}
+		}
+	}
+
+	return findReferences(keyPath, value)
+}
+
+/*
+	findSubReferences
+	collects the ${Name} and ${Name.Attribute} variables in a Fn::Sub string,
+	that aren't defined in its variable map
+*/
+func findSubReferences(path, text string, variables map[string]interface{}) (refs []reference) {
+	for _, match := range subVariablePattern.FindAllStringSubmatch(text, -1) {
+		name := strings.TrimSpace(match[1])
+		if _, ok := variables[name]; ok {
+			continue
+		}
+
+		parts := strings.SplitN(name, ".", 2)
+		if len(parts) == 2 && !pseudoParameters[name] {
+			refs = append(refs, reference{Path: path, Function: "Fn::GetAtt", Target: parts[0], Attribute: parts[1], Source: "Fn::Sub"})
+			continue
+		}
+		refs = append(refs, reference{Path: path, Function: "Ref", Target: name, Source: "Fn::Sub"})
+	}
+	return
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,91 @@
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckReferences(t *testing.T) {
+	stack := YamlCloudformation{
+		Parameters: types.ValueMap{
+			"Env": map[string]interface{}{"Type": "String"},
+		},
+		Mappings: types.ValueMap{
+			"EnvMap": map[string]interface{}{
+				"prod": map[string]interface{}{"Size": "large"},
+			},
+		},
+		Conditions: types.ValueMap{
+			"IsProd": map[string]interface{}{
+				"Fn::Equals": []interface{}{map[string]interface{}{"Ref": "Environment"}, "prod"},
+			},
+		},
+		Resources: types.ValueMap{
+			"testBucket": map[string]interface{}{
+				"Type":      "AWS::S3::Bucket",
+				"DependsOn": []interface{}{"testTopic", "missingTopic"},
+				"Properties": map[string]interface{}{
+					"BucketName": map[string]interface{}{"Fn::Sub": "${AWS::StackName}-${Env}-${testTopic.Nmae}-${!Literal}"},
+					"Tags": []interface{}{
+						map[string]interface{}{
+							"Key":   "Size",
+							"Value": map[string]interface{}{"Fn::FindInMap": []interface{}{"EnvMap", map[string]interface{}{"Ref": "Env"}, "Size"}},
+						},
+						map[string]interface{}{
+							"Key":   "Missing",
+							"Value": map[string]interface{}{"Fn::FindInMap": []interface{}{"EnvMap", "dev", "Size"}},
+						},
+					},
+				},
+			},
+			"testTopic": map[string]interface{}{
+				"Type": "AWS::SNS::Topic",
+				"Properties": map[string]interface{}{
+					"DisplayName": map[string]interface{}{"Fn::Sub": []interface{}{"${Name}", map[string]interface{}{"Name": map[string]interface{}{"Ref": "AWS::Region"}}}},
+				},
+			},
+			"testResource": map[string]interface{}{
+				"Type": "Custom::Resource",
+			},
+		},
+		Outputs: types.ValueMap{
+			"testBucketArn": map[string]interface{}{
+				"Value": map[string]interface{}{"Fn::GetAtt": "testBucket.Arn"},
+			},
+			"testBucketUrl": map[string]interface{}{
+				"Value": map[string]interface{}{"Fn::GetAtt": []interface{}{"testBucket", "Url"}},
+			},
+			"testResourceValue": map[string]interface{}{
+				"Value": map[string]interface{}{"Fn::GetAtt": "testResource.Value"},
+			},
+			"testQueueArn": map[string]interface{}{
+				"Value": map[string]interface{}{"Fn::GetAtt": "testQueue.Arn"},
+			},
+		},
+	}
+
+	problems := checkReferences(stack)
+	assert.Equal(t, []ValidationProblem{
+		{Path: "Conditions.IsProd.Fn::Equals[0].Ref", Check: "reference", Message: "Ref to missing resource or parameter 'Environment'"},
+		{Path: "Resources.testBucket.DependsOn[1]", Check: "reference", Message: "DependsOn on missing resource 'missingTopic'"},
+		{Path: "Resources.testBucket.Properties.BucketName.Fn::Sub", Check: "reference", Message: "Fn::GetAtt to unknown attribute 'Nmae' of testTopic (AWS::SNS::Topic)"},
+		{Path: "Resources.testBucket.Properties.Tags[1].Value.Fn::FindInMap", Check: "reference", Message: "Fn::FindInMap to missing mapping key 'dev' in EnvMap"},
+		{Path: "Outputs.testBucketUrl.Value.Fn::GetAtt", Check: "reference", Message: "Fn::GetAtt to unknown attribute 'Url' of testBucket (AWS::S3::Bucket)"},
+		{Path: "Outputs.testQueueArn.Value.Fn::GetAtt", Check: "reference", Message: "Fn::GetAtt to missing resource 'testQueue'"},
+	}, problems)
+}
+
+func TestCheckReferences_transform(t *testing.T) {
+	stack := YamlCloudformation{
+		Transform: types.ValueMap{"Name": "AWS::Serverless-2016-10-31"},
+		Outputs: types.ValueMap{
+			"functionRole": map[string]interface{}{
+				"Value": map[string]interface{}{"Ref": "testFunctionRole"},
+			},
+		},
+	}
+
+	assert.Empty(t, checkReferences(stack))
+}
@@ -0,0 +1,216 @@
+package cloudformation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/KablamoOSS/kombustion/types"
+	yaml "github.com/KablamoOSS/yaml"
+)
+
+// Replacement - a resource that will be replaced by an update, and the changes that cause it
+type Replacement struct {
+	LogicalID  string   `json:"logicalId"`
+	Type       string   `json:"type"`
+	Properties []string `json:"properties"`
+
+	// Conditional - the resource may be replaced, depending on the new values
+	Conditional bool `json:"conditional"`
+}
+
+func (replacement Replacement) String() string {
+	return fmt.Sprintf("%v (%v) %v", replacement.LogicalID, replacement.Type, replacement.Reason())
+}
+
+// Reason - why the resource will be replaced, eg. "will be replaced, because BucketName changed"
+func (replacement Replacement) Reason() string {
+	verb := "will be replaced"
+	if replacement.Conditional {
+		verb = "may be replaced"
+	}
+	return fmt.Sprintf("%v, because %v changed", verb, strings.Join(replacement.Properties, ", "))
+}
+
+/*
+	ParseTemplate
+	parses a yaml or json CloudFormation template, eg. a deployed template or a
+	previously compiled one, into plain maps
+*/
+func ParseTemplate(data []byte) (map[string]interface{}, error) {
+	var template interface{}
+	if err := yaml.Unmarshal(data, &template); err != nil {
+		return nil, err
+	}
+	templateMap, ok := fixYamlKeys(template).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Template is not an object")
+	}
+	return templateMap, nil
+}
+
+/*
+	PredictReplacements
+	compares the resources of a deployed template with a compiled stack, and
+	uses the UpdateType of each changed property from the CloudFormation
+	specification to predict which resources the update will replace
+*/
+func PredictReplacements(deployed map[string]interface{}, stack YamlCloudformation) ([]Replacement, error) {
+	compiled, err := templateValue(stack)
+	if err != nil {
+		return nil, err
+	}
+	return predictReplacements(deployed, compiled), nil
+}
+
+// PredictTemplateReplacements - PredictReplacements, for a template that's already compiled
+func PredictTemplateReplacements(deployed, template map[string]interface{}) []Replacement {
+	return predictReplacements(deployed, template)
+}
+
+func predictReplacements(before, after map[string]interface{}) (replacements []Replacement) {
+	resourceSpecs := resourceTypeSpecs()
+	beforeResources, _ := before["Resources"].(map[string]interface{})
+	afterResources, _ := after["Resources"].(map[string]interface{})
+
+	for _, logicalID := range sortedKeys(afterResources) {
+		beforeResource, ok := beforeResources[logicalID].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		afterResource, _ := afterResources[logicalID].(map[string]interface{})
+		resourceType, _ := afterResource["Type"].(string)
+
+		replacement := Replacement{LogicalID: logicalID, Type: resourceType, Conditional: true}
+		if beforeResource["Type"] != afterResource["Type"] {
+			replacement.Properties = append(replacement.Properties, "Type")
+			replacement.Conditional = false
+		}
+
+		beforeProperties, _ := beforeResource["Properties"].(map[string]interface{})
+		afterProperties, _ := afterResource["Properties"].(map[string]interface{})
+		for _, change := range propertyChanges("", beforeProperties, afterProperties, resourceSpecs[resourceType]) {
+			switch change.UpdateType {
+			case "Immutable":
+				replacement.Properties = append(replacement.Properties, change.Path)
+				replacement.Conditional = false
+			case "Conditional":
+				replacement.Properties = append(replacement.Properties, change.Path)
+			}
+		}
+
+		if len(replacement.Properties) > 0 {
+			replacements = append(replacements, replacement)
+		}
+	}
+	return
+}
+
+// propertyChange - a changed property, and the UpdateType that decides how it's updated
+type propertyChange struct {
+	Path       string
+	UpdateType string
+}
+
+/*
+	propertyChanges
+	the changed properties of an object, with their UpdateType from the spec.
+	Changes inside a property type are followed into it, so an Immutable
+	property of a Mutable property type is found, and reported by its path (eg.
+	ComputeResources.Subnets). A property is reported itself when it's Immutable,
+	or when no nested change replaces the resource
+*/
+func propertyChanges(path string, before, after map[string]interface{}, specs map[string]types.PropertySpec) (changes []propertyChange) {
+	for _, name := range changedKeys(before, after) {
+		spec := specs[name]
+		propertyPath := path + name
+		if spec.UpdateType != "Immutable" {
+			nested := []propertyChange{}
+			for _, change := range nestedPropertyChanges(propertyPath, before[name], after[name], spec) {
+				if change.UpdateType == "Immutable" || change.UpdateType == "Conditional" {
+					nested = append(nested, change)
+				}
+			}
+			if len(nested) > 0 {
+				changes = append(changes, nested...)
+				continue
+			}
+		}
+		changes = append(changes, propertyChange{Path: propertyPath, UpdateType: spec.UpdateType})
+	}
+	return
+}
+
+/*
+	nestedPropertyChanges
+	the changes inside a property type value, or inside the items of a List or
+	Map of them. List items are compared by index, so lists that changed length
+	aren't followed
+*/
+func nestedPropertyChanges(path string, before, after interface{}, spec types.PropertySpec) (changes []propertyChange) {
+	specs, ok := propertyTypeSpecs()[spec.PropertyType]
+	if !ok {
+		return nil
+	}
+
+	switch spec.Container {
+	case "List":
+		beforeItems, _ := before.([]interface{})
+		afterItems, _ := after.([]interface{})
+		if len(beforeItems) != len(afterItems) {
+			return nil
+		}
+		for i := range afterItems {
+			changes = append(changes, objectChanges(fmt.Sprintf("%v[%v].", path, i), beforeItems[i], afterItems[i], specs)...)
+		}
+	case "Map":
+		beforeItems, _ := stringKeys(before)
+		afterItems, _ := stringKeys(after)
+		for _, key := range sortedKeys(afterItems) {
+			if beforeItem, ok := beforeItems[key]; ok {
+				changes = append(changes, objectChanges(path+"."+key+".", beforeItem, afterItems[key], specs)...)
+			}
+		}
+	default:
+		changes = objectChanges(path+".", before, after, specs)
+	}
+	return
+}
+
+// objectChanges - the property changes between two property type values, unless either is an intrinsic function
+func objectChanges(path string, before, after interface{}, specs map[string]types.PropertySpec) []propertyChange {
+	if types.IsIntrinsic(before) || types.IsIntrinsic(after) {
+		return nil
+	}
+	beforeMap, beforeOk := stringKeys(before)
+	afterMap, afterOk := stringKeys(after)
+	if !beforeOk || !afterOk {
+		return nil
+	}
+	return propertyChanges(path, beforeMap, afterMap, specs)
+}
+
+// changedKeys - the sorted keys that were added, removed or changed between two maps
+func changedKeys(before, after map[string]interface{}) (keys []string) {
+	all := make(map[string]interface{})
+	for k := range before {
+		all[k] = nil
+	}
+	for k := range after {
+		all[k] = nil
+	}
+	for _, k := range sortedKeys(all) {
+		if !equalValues(before[k], after[k]) {
+			keys = append(keys, k)
+		}
+	}
+	return
+}
+
+// equalValues - compares template values, ignoring differences in how intrinsics are written
+func equalValues(a, b interface{}) bool {
+	return reflect.DeepEqual(
+		jsonIntrinsics(fixYamlKeys(a)),
+		jsonIntrinsics(fixYamlKeys(b)),
+	)
+}
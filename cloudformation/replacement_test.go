@@ -0,0 +1,115 @@
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/parsers/resources"
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPredictReplacements(t *testing.T) {
+	deployed, err := ParseTemplate([]byte(`
+Resources:
+  testBucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: old-bucket
+      AccessControl: Private
+  testQueue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: !GetAtt testBucket.Arn
+      DelaySeconds: 5
+  testTopic:
+    Type: AWS::SNS::Topic
+  testInstance:
+    Type: AWS::EC2::Instance
+    Properties:
+      ImageId: ami-1
+      InstanceType: t2.micro
+`))
+	assert.Nil(t, err)
+
+	stack := YamlCloudformation{
+		Resources: types.ValueMap{
+			"testBucket": resources.NewS3Bucket(resources.S3BucketProperties{
+				BucketName:    "new-bucket",
+				AccessControl: "PublicRead",
+			}),
+			"testQueue": resources.NewSQSQueue(resources.SQSQueueProperties{
+				QueueName:    map[string]interface{}{"Fn::GetAtt": []string{"testBucket", "Arn"}},
+				DelaySeconds: 10,
+			}),
+			"testTopic": map[string]interface{}{"Type": "AWS::SNS::Subscription"},
+			"testInstance": resources.NewEC2Instance(resources.EC2InstanceProperties{
+				ImageId:      "ami-1",
+				InstanceType: "t2.large",
+			}),
+			"newBucket": resources.NewS3Bucket(resources.S3BucketProperties{}),
+		},
+	}
+
+	replacements, err := PredictReplacements(deployed, stack)
+	assert.Nil(t, err)
+	assert.Equal(t, []Replacement{
+		{LogicalID: "testBucket", Type: "AWS::S3::Bucket", Properties: []string{"BucketName"}},
+		{LogicalID: "testInstance", Type: "AWS::EC2::Instance", Properties: []string{"InstanceType"}, Conditional: true},
+		{LogicalID: "testTopic", Type: "AWS::SNS::Subscription", Properties: []string{"Type"}},
+	}, replacements)
+
+	assert.Equal(t, "testBucket (AWS::S3::Bucket) will be replaced, because BucketName changed", replacements[0].String())
+	assert.Equal(t, "testInstance (AWS::EC2::Instance) may be replaced, because InstanceType changed", replacements[1].String())
+}
+
+func TestPredictTemplateReplacements_nested(t *testing.T) {
+	deployed, err := ParseTemplate([]byte(`
+Resources:
+  compute:
+    Type: AWS::Batch::ComputeEnvironment
+    Properties:
+      ComputeResources:
+        MaxvCpus: 4
+        Subnets: [subnet-1]
+  scaled:
+    Type: AWS::Batch::ComputeEnvironment
+    Properties:
+      ComputeResources:
+        MaxvCpus: 4
+        Subnets: [subnet-1]
+  topic:
+    Type: AWS::SNS::Topic
+    Properties:
+      Subscription:
+        - Endpoint: a@example.com
+          Protocol: email
+`))
+	assert.Nil(t, err)
+	template, err := ParseTemplate([]byte(`
+Resources:
+  compute:
+    Type: AWS::Batch::ComputeEnvironment
+    Properties:
+      ComputeResources:
+        MaxvCpus: 4
+        Subnets: [subnet-2]
+  scaled:
+    Type: AWS::Batch::ComputeEnvironment
+    Properties:
+      ComputeResources:
+        MaxvCpus: 8
+        Subnets: [subnet-1]
+  topic:
+    Type: AWS::SNS::Topic
+    Properties:
+      Subscription:
+        - Endpoint: b@example.com
+          Protocol: email
+`))
+	assert.Nil(t, err)
+
+	assert.Equal(t, []Replacement{
+		{LogicalID: "compute", Type: "AWS::Batch::ComputeEnvironment", Properties: []string{"ComputeResources.Subnets"}},
+		{LogicalID: "topic", Type: "AWS::SNS::Topic", Properties: []string{"Subscription[0].Endpoint"}},
+	}, PredictTemplateReplacements(deployed, template))
+}
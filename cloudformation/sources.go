@@ -0,0 +1,24 @@
+package cloudformation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ResourceSource - the config resource a compiled resource was generated from
+type ResourceSource struct {
+	ConfigResource string `json:"configResource"`
+	ConfigType     string `json:"configType"`
+
+	// Plugin - the plugin file that provides ConfigType, if a plugin does
+	Plugin string `json:"plugin,omitempty"`
+}
+
+// String - describes where a resource came from, eg. "config resource Queue (Kablamo::SQS::Queue, from plugin sqs.so)"
+func (source ResourceSource) String() string {
+	details := []string{source.ConfigType}
+	if len(source.Plugin) > 0 {
+		details = append(details, "from plugin "+source.Plugin)
+	}
+	return fmt.Sprintf("config resource %v (%v)", source.ConfigResource, strings.Join(details, ", "))
+}
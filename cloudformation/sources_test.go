@@ -0,0 +1,39 @@
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/parsers/resources"
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileTemplateCF_sources(t *testing.T) {
+	pluginTypes["Test::Plugin::Thing"] = "thing.so"
+	defer delete(pluginTypes, "Test::Plugin::Thing")
+
+	parsers := ParserMap{
+		"Test::Plugin::Thing": func(name, data string) (types.ValueMap, error) {
+			return types.ValueMap{
+				name + "Bucket": resources.NewS3Bucket(resources.S3BucketProperties{}),
+				name + "Queue":  resources.NewSQSQueue(resources.SQSQueueProperties{}),
+			}, nil
+		},
+	}
+	testResources := types.ResourceMap{
+		"thing":  types.CfResource{Type: "Test::Plugin::Thing"},
+		"custom": types.CfResource{Type: "Custom::Thing"},
+	}
+
+	sources := make(map[string]ResourceSource)
+	_, err := compileTemplateCF(testResources, parsers, resourceParsersKind, false, sources, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]ResourceSource{
+		"thingBucket": {ConfigResource: "thing", ConfigType: "Test::Plugin::Thing", Plugin: "thing.so"},
+		"thingQueue":  {ConfigResource: "thing", ConfigType: "Test::Plugin::Thing", Plugin: "thing.so"},
+		"custom":      {ConfigResource: "custom", ConfigType: "Custom::Thing"},
+	}, sources)
+
+	assert.Equal(t, "config resource thing (Test::Plugin::Thing, from plugin thing.so)", sources["thingQueue"].String())
+	assert.Equal(t, "config resource custom (Custom::Thing)", sources["custom"].String())
+}
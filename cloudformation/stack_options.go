@@ -0,0 +1,151 @@
+package cloudformation
+
+import (
+	"fmt"
+
+	"github.com/KablamoOSS/kombustion/types"
+	yaml "github.com/KablamoOSS/yaml"
+)
+
+// alarmTriggerType - the only type of rollback trigger cloudformation supports
+const alarmTriggerType = "AWS::CloudWatch::Alarm"
+
+/*
+	StackOptions
+	the settings of a stack other than its template and parameters, from the
+	StackOptions of a config, or of an environment in the environment file.
+	Unset options keep their deployed value when a stack is updated
+*/
+type StackOptions struct {
+	// Tags - tags for the stack, which cloudformation propagates to its resources
+	Tags map[string]string `yaml:"Tags,omitempty"`
+
+	// RoleARN - the service role cloudformation deploys the stack with
+	RoleARN string `yaml:"RoleARN,omitempty"`
+
+	// NotificationARNs - SNS topics the events of the stack are published to
+	NotificationARNs []string `yaml:"NotificationARNs,omitempty"`
+
+	EnableTerminationProtection *bool                  `yaml:"EnableTerminationProtection,omitempty"`
+	RollbackConfiguration       *RollbackConfiguration `yaml:"RollbackConfiguration,omitempty"`
+
+	// TimeoutInMinutes and OnFailure only apply when a stack is created
+	TimeoutInMinutes int64  `yaml:"TimeoutInMinutes,omitempty"`
+	OnFailure        string `yaml:"OnFailure,omitempty"`
+}
+
+// RollbackConfiguration - alarms that roll back a deployment if they go off while it's monitored
+type RollbackConfiguration struct {
+	MonitoringTimeInMinutes int64             `yaml:"MonitoringTimeInMinutes,omitempty"`
+	RollbackTriggers        []RollbackTrigger `yaml:"RollbackTriggers,omitempty"`
+}
+
+// RollbackTrigger - an alarm, the Type defaults to AWS::CloudWatch::Alarm
+type RollbackTrigger struct {
+	Arn  string `yaml:"Arn"`
+	Type string `yaml:"Type,omitempty"`
+}
+
+/*
+	EnvironmentStackOptions
+	the StackOptions of an environment from the environment file, eg.
+
+		prod:
+		  StackOptions:
+		    EnableTerminationProtection: true
+*/
+func EnvironmentStackOptions(env types.ValueMap) (options StackOptions, err error) {
+	value, ok := env["StackOptions"]
+	if !ok || value == nil {
+		return
+	}
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return
+	}
+	if err = yaml.Unmarshal(data, &options); err != nil {
+		err = fmt.Errorf("Invalid StackOptions in the environment file: %v", err)
+	}
+	return
+}
+
+/*
+	Merge
+	the options, with the options that are set in override replacing them.
+	Tags are merged, with the tags of override replacing those with the same key
+*/
+func (options StackOptions) Merge(override StackOptions) StackOptions {
+	merged := options
+	if len(override.Tags) > 0 {
+		merged.Tags = make(map[string]string)
+		for k, v := range options.Tags {
+			merged.Tags[k] = v
+		}
+		for k, v := range override.Tags {
+			merged.Tags[k] = v
+		}
+	}
+	if len(override.RoleARN) > 0 {
+		merged.RoleARN = override.RoleARN
+	}
+	if len(override.NotificationARNs) > 0 {
+		merged.NotificationARNs = override.NotificationARNs
+	}
+	if override.EnableTerminationProtection != nil {
+		merged.EnableTerminationProtection = override.EnableTerminationProtection
+	}
+	if override.RollbackConfiguration != nil {
+		merged.RollbackConfiguration = override.RollbackConfiguration
+	}
+	if override.TimeoutInMinutes > 0 {
+		merged.TimeoutInMinutes = override.TimeoutInMinutes
+	}
+	if len(override.OnFailure) > 0 {
+		merged.OnFailure = override.OnFailure
+	}
+	return merged
+}
+
+// Validate - checks the options against the limits of cloudformation
+func (options StackOptions) Validate() error {
+	if len(options.Tags) > 50 {
+		return fmt.Errorf("StackOptions: a stack can have at most 50 Tags, not %v", len(options.Tags))
+	}
+	if len(options.NotificationARNs) > 5 {
+		return fmt.Errorf("StackOptions: a stack can have at most 5 NotificationARNs, not %v", len(options.NotificationARNs))
+	}
+	if options.TimeoutInMinutes < 0 {
+		return fmt.Errorf("StackOptions: TimeoutInMinutes must be positive")
+	}
+	switch options.OnFailure {
+	case "", "DO_NOTHING", "ROLLBACK", "DELETE":
+	default:
+		return fmt.Errorf("StackOptions: OnFailure must be DO_NOTHING, ROLLBACK or DELETE, not %v", options.OnFailure)
+	}
+
+	if rollback := options.RollbackConfiguration; rollback != nil {
+		if rollback.MonitoringTimeInMinutes < 0 || rollback.MonitoringTimeInMinutes > 180 {
+			return fmt.Errorf("StackOptions: RollbackConfiguration MonitoringTimeInMinutes must be from 0 to 180")
+		}
+		if len(rollback.RollbackTriggers) > 5 {
+			return fmt.Errorf("StackOptions: a stack can have at most 5 RollbackTriggers, not %v", len(rollback.RollbackTriggers))
+		}
+		for _, trigger := range rollback.RollbackTriggers {
+			if len(trigger.Arn) == 0 {
+				return fmt.Errorf("StackOptions: each of the RollbackTriggers needs an Arn")
+			}
+			if len(trigger.Type) > 0 && trigger.Type != alarmTriggerType {
+				return fmt.Errorf("StackOptions: RollbackTriggers must be of Type %v, not %v", alarmTriggerType, trigger.Type)
+			}
+		}
+	}
+	return nil
+}
+
+// TriggerType - the Type of a rollback trigger, defaulting to AWS::CloudWatch::Alarm
+func (trigger RollbackTrigger) TriggerType() string {
+	if len(trigger.Type) > 0 {
+		return trigger.Type
+	}
+	return alarmTriggerType
+}
@@ -0,0 +1,90 @@
+package cloudformation
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStackOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kombustion")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	configPath := filepath.Join(dir, "api.yaml")
+	envPath := filepath.Join(dir, "environment.yaml")
+	assert.Nil(t, ioutil.WriteFile(configPath, []byte(`
+StackOptions:
+  Tags:
+    Team: api
+    Env: {{.Env}}
+  TimeoutInMinutes: 30
+  RollbackConfiguration:
+    MonitoringTimeInMinutes: 10
+    RollbackTriggers:
+      - Arn: arn:aws:cloudwatch:ap-southeast-2:123456789012:alarm:errors
+Resources:
+  testQueue:
+    Type: AWS::SQS::Queue
+`), 0644))
+	assert.Nil(t, ioutil.WriteFile(envPath, []byte(`
+prod:
+  Env: prod
+  StackOptions:
+    Tags:
+      CostCentre: 1234
+    RoleARN: arn:aws:iam::123456789012:role/deploy
+    EnableTerminationProtection: true
+`), 0644))
+
+	stack, err := GenerateYamlStack(GenerateParams{
+		Filename:           configPath,
+		EnvFile:            envPath,
+		Env:                "prod",
+		DisableBaseOutputs: true,
+	})
+	assert.Nil(t, err)
+
+	envOptions, err := EnvironmentStackOptions(ResolveEnvironment(envPath, "prod"))
+	assert.Nil(t, err)
+
+	enabled := true
+	options := stack.StackOptions.Merge(envOptions)
+	assert.Equal(t, StackOptions{
+		Tags:                        map[string]string{"Team": "api", "Env": "prod", "CostCentre": "1234"},
+		RoleARN:                     "arn:aws:iam::123456789012:role/deploy",
+		EnableTerminationProtection: &enabled,
+		RollbackConfiguration: &RollbackConfiguration{
+			MonitoringTimeInMinutes: 10,
+			RollbackTriggers: []RollbackTrigger{
+				{Arn: "arn:aws:cloudwatch:ap-southeast-2:123456789012:alarm:errors"},
+			},
+		},
+		TimeoutInMinutes: 30,
+	}, options)
+	assert.Nil(t, options.Validate())
+	assert.Equal(t, "AWS::CloudWatch::Alarm", options.RollbackConfiguration.RollbackTriggers[0].TriggerType())
+
+	// the options aren't part of the template
+	data, err := MarshalJson(stack)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(data), "StackOptions")
+
+	// environments without StackOptions keep the config's
+	envOptions, err = EnvironmentStackOptions(ResolveEnvironment(envPath, "dev"))
+	assert.Nil(t, err)
+	assert.Equal(t, stack.StackOptions, stack.StackOptions.Merge(envOptions))
+}
+
+func TestStackOptions_Validate(t *testing.T) {
+	assert.Nil(t, StackOptions{}.Validate())
+	assert.NotNil(t, StackOptions{OnFailure: "PANIC"}.Validate())
+	assert.NotNil(t, StackOptions{RollbackConfiguration: &RollbackConfiguration{MonitoringTimeInMinutes: 200}}.Validate())
+	assert.NotNil(t, StackOptions{RollbackConfiguration: &RollbackConfiguration{
+		RollbackTriggers: []RollbackTrigger{{Arn: "arn", Type: "AWS::SNS::Topic"}},
+	}}.Validate())
+	assert.NotNil(t, StackOptions{NotificationARNs: []string{"1", "2", "3", "4", "5", "6"}}.Validate())
+}
@@ -0,0 +1,280 @@
+package cloudformation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/KablamoOSS/kombustion/parsers"
+	"github.com/KablamoOSS/kombustion/types"
+	yaml "github.com/KablamoOSS/yaml"
+)
+
+/*
+	checkDuplicateKeys
+	strictly parses a yaml config, returning an error for each duplicated key
+*/
+func checkDuplicateKeys(data []byte) (errs []error) {
+	var config interface{}
+	err := yaml.UnmarshalStrict(data, &config)
+	if err == nil {
+		return
+	}
+
+	if typeErr, ok := err.(*yaml.TypeError); ok {
+		for _, msg := range typeErr.Errors {
+			errs = append(errs, fmt.Errorf("%v", msg))
+		}
+		return
+	}
+	return []error{err}
+}
+
+// jsonContainer - an object or array being read by checkJsonDuplicateKeys
+type jsonContainer struct {
+	isObject  bool
+	expectKey bool
+	keys      map[string]bool
+}
+
+/*
+	checkJsonDuplicateKeys
+	reads a json config token by token, returning an error for each key that's
+	duplicated in an object, which json.Unmarshal silently overwrites
+*/
+func checkJsonDuplicateKeys(data []byte) (errs []error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var stack []*jsonContainer
+	valueDone := func() {
+		if len(stack) > 0 && stack[len(stack)-1].isObject {
+			stack[len(stack)-1].expectKey = true
+		}
+	}
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			// syntax errors are reported when the config is parsed
+			return
+		}
+
+		if len(stack) > 0 && stack[len(stack)-1].isObject && stack[len(stack)-1].expectKey {
+			top := stack[len(stack)-1]
+			if key, ok := token.(string); ok {
+				if top.keys[key] {
+					line := bytes.Count(data[:decoder.InputOffset()], []byte("\n")) + 1
+					errs = append(errs, fmt.Errorf("json: line %d: key %q already set in object", line, key))
+				}
+				top.keys[key] = true
+				top.expectKey = false
+				continue
+			}
+		}
+
+		switch token {
+		case json.Delim('{'):
+			stack = append(stack, &jsonContainer{isObject: true, expectKey: true, keys: make(map[string]bool)})
+		case json.Delim('['):
+			stack = append(stack, &jsonContainer{})
+		case json.Delim('}'), json.Delim(']'):
+			stack = stack[:len(stack)-1]
+			valueDone()
+		default:
+			valueDone()
+		}
+	}
+}
+
+/*
+	checkResourceProperties
+	compares the properties written in the config against the properties of
+	the typed resource the parser produced, returning a problem for each property
+	that isn't in the spec
+*/
+func checkResourceProperties(resourceName string, resource types.CfResource, typed interface{}) []ValidationProblem {
+	value := reflect.ValueOf(typed)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	properties := value.FieldByName("Properties")
+	if !properties.IsValid() {
+		return nil
+	}
+
+	specs, ok := resourceTypeSpecs()[resource.Type]
+	if !ok {
+		return nil
+	}
+
+	path := fmt.Sprintf("Resources.%v.Properties", resourceName)
+	return checkProperties(path, resource.Type, resource.Properties, specs)
+}
+
+/*
+	checkProperties
+	returns a problem for each property of an object that isn't in the specs of
+	its type (a resource or property type), and checks the properties of its
+	property type values, including the items of lists and maps of them
+*/
+func checkProperties(path, typeName string, raw interface{}, specs map[string]types.PropertySpec) (problems []ValidationProblem) {
+	if types.IsIntrinsic(raw) {
+		return
+	}
+	rawMap, ok := stringKeys(raw)
+	if !ok {
+		return
+	}
+
+	names := make([]string, 0, len(specs))
+	for name := range specs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	keys := make([]string, 0, len(rawMap))
+	for key := range rawMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		propertyPath := path + "." + key
+		spec, ok := specs[key]
+		if !ok {
+			msg := fmt.Sprintf("unknown property for %v", typeName)
+			if suggestion := suggestName(key, names); len(suggestion) > 0 {
+				msg += fmt.Sprintf(", did you mean '%v'?", suggestion)
+			}
+			problems = append(problems, ValidationProblem{Path: propertyPath, Check: "property", Message: msg})
+			continue
+		}
+		problems = append(problems, checkPropertyValue(propertyPath, rawMap[key], spec)...)
+	}
+	return
+}
+
+// checkPropertyValue - checks a property type value, or each item of a List or Map of them
+func checkPropertyValue(path string, raw interface{}, spec types.PropertySpec) (problems []ValidationProblem) {
+	specs, ok := propertyTypeSpecs()[spec.PropertyType]
+	if !ok || types.IsIntrinsic(raw) {
+		return
+	}
+
+	switch spec.Container {
+	case "List":
+		items, _ := raw.([]interface{})
+		for i, item := range items {
+			problems = append(problems, checkProperties(fmt.Sprintf("%v[%v]", path, i), spec.PropertyType, item, specs)...)
+		}
+	case "Map":
+		items, _ := stringKeys(raw)
+		keys := make([]string, 0, len(items))
+		for key := range items {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			problems = append(problems, checkProperties(path+"."+key, spec.PropertyType, items[key], specs)...)
+		}
+	default:
+		problems = checkProperties(path, spec.PropertyType, raw, specs)
+	}
+	return
+}
+
+var resourceTypeSpecsCache map[string]map[string]types.PropertySpec
+
+// resourceTypeSpecs - the specs of the properties of each resource type
+func resourceTypeSpecs() map[string]map[string]types.PropertySpec {
+	if resourceTypeSpecsCache == nil {
+		resourceTypeSpecsCache = parsers.GetPropertySpecs_resources()
+	}
+	return resourceTypeSpecsCache
+}
+
+var propertyTypeSpecsCache map[string]map[string]types.PropertySpec
+
+// propertyTypeSpecs - the specs of the properties of each property type, by its full name
+func propertyTypeSpecs() map[string]map[string]types.PropertySpec {
+	if propertyTypeSpecsCache == nil {
+		propertyTypeSpecsCache = parsers.GetPropertySpecs_properties()
+	}
+	return propertyTypeSpecsCache
+}
+
+func stringKeys(o interface{}) (map[string]interface{}, bool) {
+	switch obj := o.(type) {
+	case map[string]interface{}:
+		return obj, true
+	case types.ValueMap:
+		return obj, true
+	case map[interface{}]interface{}:
+		fixed := make(map[string]interface{}, len(obj))
+		for k, v := range obj {
+			fixed[fmt.Sprintf("%v", k)] = v
+		}
+		return fixed, true
+	case map[string]string:
+		fixed := make(map[string]interface{}, len(obj))
+		for k, v := range obj {
+			fixed[k] = v
+		}
+		return fixed, true
+	}
+	return nil, false
+}
+
+/*
+	suggestName
+	finds the closest name to a misspelled one, if there's one close enough
+*/
+func suggestName(name string, names []string) string {
+	suggestion := ""
+	best := len(name)/3 + 1
+	for _, candidate := range names {
+		if strings.EqualFold(candidate, name) {
+			return candidate
+		}
+		if distance := levenshtein(strings.ToLower(name), strings.ToLower(candidate)); distance <= best {
+			suggestion = candidate
+			best = distance - 1
+		}
+	}
+	return suggestion
+}
+
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(minInt(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
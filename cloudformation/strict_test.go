@@ -0,0 +1,111 @@
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/parsers/resources"
+	"github.com/KablamoOSS/kombustion/types"
+	yaml "github.com/KablamoOSS/yaml"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckResourceProperties(t *testing.T) {
+	inputYaml := `
+Type: AWS::S3::Bucket
+Properties:
+  BucketNmae: test
+  AccessControl: Private
+  WebsiteConfiguration:
+    IndexDocument: index.html
+    ErrorDocumnet: error.html
+  LoggingConfiguration: !If [IsProd, {DestinationBucketName: logs}, !Ref "AWS::NoValue"]
+  Unsupported: true
+`
+	var resource types.CfResource
+	err := yaml.Unmarshal([]byte(inputYaml), &resource)
+	assert.Nil(t, err)
+
+	problems := checkResourceProperties("testBucket", resource, resources.S3Bucket{})
+	assert.Len(t, problems, 3)
+	assert.Equal(t, "Resources.testBucket.Properties.BucketNmae: unknown property for AWS::S3::Bucket, did you mean 'BucketName'?", problems[0].String())
+	assert.Equal(t, "Resources.testBucket.Properties.Unsupported: unknown property for AWS::S3::Bucket", problems[1].String())
+	assert.Equal(t, "Resources.testBucket.Properties.WebsiteConfiguration.ErrorDocumnet: unknown property for AWS::S3::Bucket.WebsiteConfiguration, did you mean 'ErrorDocument'?", problems[2].String())
+}
+
+func TestCheckResourceProperties_lists(t *testing.T) {
+	inputYaml := `
+Type: AWS::EC2::SecurityGroup
+Properties:
+  GroupDescription: test
+  SecurityGroupIngress:
+    - IpProtocol: tcp
+      CidrIp: 0.0.0.0/0
+    - IpProtocol: tcp
+      CidrIP: 10.0.0.0/8
+    - !If [IsProd, {IpProtocol: tcp, Unknown: true}, !Ref "AWS::NoValue"]
+  Tags:
+    - Key: Name
+      Vaule: test
+`
+	var resource types.CfResource
+	err := yaml.Unmarshal([]byte(inputYaml), &resource)
+	assert.Nil(t, err)
+
+	problems := checkResourceProperties("testGroup", resource, resources.EC2SecurityGroup{})
+	assert.Len(t, problems, 2)
+	assert.Equal(t, "Resources.testGroup.Properties.SecurityGroupIngress[1].CidrIP: unknown property for AWS::EC2::SecurityGroup.Ingress, did you mean 'CidrIp'?", problems[0].String())
+	assert.Equal(t, "Resources.testGroup.Properties.Tags[0].Vaule: unknown property for Tag, did you mean 'Value'?", problems[1].String())
+}
+
+func TestCheckDuplicateKeys(t *testing.T) {
+	inputYaml := `
+Resources:
+  testBucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: one
+      BucketName: two
+`
+	errs := checkDuplicateKeys([]byte(inputYaml))
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "line 7")
+	assert.Contains(t, errs[0].Error(), "BucketName")
+
+	assert.Empty(t, checkDuplicateKeys([]byte("Resources: {}")))
+}
+
+func TestTemplateYamlCF_strictProperties(t *testing.T) {
+	testResources := types.ResourceMap{
+		"testBucket": types.CfResource{
+			Type:       "AWS::S3::Bucket",
+			Properties: map[string]string{"BucketNmae": "test"},
+		},
+	}
+
+	populateParsers(false)
+	_, err := yamlTemplateCF(testResources, resourceParsers, resourceParsersKind, false)
+	assert.Nil(t, err)
+
+	_, err = yamlTemplateCF(testResources, resourceParsers, resourceParsersKind, true)
+	assert.NotNil(t, err)
+}
+
+func TestCheckJsonDuplicateKeys(t *testing.T) {
+	inputJson := `{
+  "Resources": {
+    "testBucket": {
+      "Type": "AWS::S3::Bucket",
+      "Properties": {
+        "Tags": [{"Key": "a", "Value": "b"}, {"Key": "c", "Value": "d"}],
+        "BucketName": "one",
+        "BucketName": "two"
+      }
+    }
+  }
+}`
+	errs := checkJsonDuplicateKeys([]byte(inputJson))
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], `json: line 8: key "BucketName" already set in object`)
+
+	assert.Empty(t, checkJsonDuplicateKeys([]byte(`{"Resources": {"a": {"Type": "x"}, "b": {"Type": "x"}}}`)))
+}
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -26,6 +28,9 @@ type YamlConfig struct {
 	Transform                types.ValueMap    `yaml:"Transform,omitempty"`
 	Resources                types.ResourceMap `yaml:"Resources"`
 	Outputs                  types.ValueMap    `yaml:"Outputs,omitempty"`
+
+	// StackOptions - settings for the stack, which aren't part of the template
+	StackOptions StackOptions `yaml:"StackOptions,omitempty"`
 }
 
 // YamlCloudformation -
@@ -38,6 +43,16 @@ type YamlCloudformation struct {
 	Transform                types.ValueMap `yaml:"Transform,omitempty"`
 	Resources                types.ValueMap `yaml:"Resources"`
 	Outputs                  types.ValueMap `yaml:"Outputs,omitempty"`
+
+	// Sources - the config resource each resource was generated from, by logical ID
+	Sources map[string]ResourceSource `yaml:"-" json:"-"`
+
+	// StackOptions - the StackOptions of the config
+	StackOptions StackOptions `yaml:"-" json:"-"`
+
+	// Problems - the problems found compiling the config, when they're
+	// collected with CollectProblems
+	Problems []ValidationProblem `yaml:"-" json:"-"`
 }
 
 type GenerateParams struct {
@@ -46,11 +61,26 @@ type GenerateParams struct {
 	Env                string
 	DisableBaseOutputs bool
 	ParamMap           map[string]string
+	Strict             bool
+
+	// CollectProblems - record duplicate keys, unknown types and properties,
+	// and resources that fail validation (emitted verbatim) in Problems,
+	// instead of logging them or failing. References are left to ValidateStack
+	CollectProblems bool
 }
 
 // ParserMap - a map of parsers
 type ParserMap map[string]types.ParserFunc
 
+// parserKind - which section of the template a set of parsers produces
+type parserKind int
+
+const (
+	resourceParsersKind parserKind = iota
+	outputParsersKind
+	mappingParsersKind
+)
+
 var resourceParsers ParserMap
 var outputParsers ParserMap
 var mappingParsers ParserMap
@@ -109,7 +139,7 @@ func GenerateYamlStack(params GenerateParams) (out YamlCloudformation, err error
 	// populate the parser variables
 	populateParsers(params.DisableBaseOutputs)
 
-	configPath := fmt.Sprintf(params.Filename)
+	configPath := params.Filename
 	//configPath := fmt.Sprintf("./configs/%v.yaml", filename)
 	if configData, err = ioutil.ReadFile(configPath); err != nil {
 		return
@@ -131,27 +161,51 @@ func GenerateYamlStack(params GenerateParams) (out YamlCloudformation, err error
 		return
 	}
 
-	// parse the config yaml
+	var problems *[]ValidationProblem
+	if params.CollectProblems {
+		problems = &[]ValidationProblem{}
+	}
+
+	// parse the config (yaml or json)
 	data := buf.Bytes()
 	var config YamlConfig
-	if err = yaml.Unmarshal(data, &config); err != nil {
+	isJson := isJsonConfig(configPath, data)
+	duplicateKeys := checkDuplicateKeys
+	if isJson {
+		duplicateKeys = checkJsonDuplicateKeys
+	}
+	if problems != nil {
+		for _, duplicateKey := range duplicateKeys(data) {
+			*problems = append(*problems, ValidationProblem{Path: configPath, Check: "duplicate-key", Message: duplicateKey.Error()})
+		}
+	} else if err = reportErrors("Duplicate key", duplicateKeys(data), params.Strict); err != nil {
+		logFileError(string(data), err)
+		return
+	}
+	if isJson {
+		err = unmarshalJsonConfig(data, &config)
+	} else {
+		err = yaml.Unmarshal(data, &config)
+	}
+	if err != nil {
 		logFileError(string(data), err)
 		return
 	}
 
 	// compile the cloudformation
 	var outputs, resources, mappings types.ValueMap
-	if resources, err = yamlTemplateCF(config.Resources, resourceParsers, true); err != nil {
+	sources := make(map[string]ResourceSource)
+	if resources, err = compileTemplateCF(config.Resources, resourceParsers, resourceParsersKind, params.Strict, sources, problems); err != nil {
 		return
 	}
 
 	//Adding(Replacing) base objects for correct outputs by type
 	config.Resources = addBaseResources(resources, config.Resources)
 
-	if outputs, err = yamlTemplateCF(config.Resources, outputParsers, false); err != nil {
+	if outputs, err = yamlTemplateCF(config.Resources, outputParsers, outputParsersKind, params.Strict); err != nil {
 		return
 	}
-	if mappings, err = yamlTemplateCF(config.Resources, mappingParsers, false); err != nil {
+	if mappings, err = yamlTemplateCF(config.Resources, mappingParsers, mappingParsersKind, params.Strict); err != nil {
 		return
 	}
 
@@ -184,8 +238,25 @@ func GenerateYamlStack(params GenerateParams) (out YamlCloudformation, err error
 		Mappings:                 mappings,
 		Resources:                resources,
 		Outputs:                  outputs,
+		Sources:                  sources,
+		StackOptions:             config.StackOptions,
+	}
+	if problems != nil {
+		// resources are compiled in map order. ValidateStack checks the references
+		sort.SliceStable(*problems, func(i, j int) bool {
+			return (*problems)[i].Path < (*problems)[j].Path
+		})
+		out.Problems = *problems
+		return
 	}
 
+	// check references once plugins have expanded their resources
+	var referenceErrs []error
+	for _, problem := range checkReferences(out) {
+		referenceErrs = append(referenceErrs, fmt.Errorf("%v", problem))
+	}
+	err = reportErrors("Invalid reference", referenceErrs, params.Strict)
+
 	return
 }
 
@@ -203,22 +274,68 @@ func addBaseResources(baseResources types.ValueMap, configResources types.Resour
 	return
 }
 
-func yamlTemplateCF(resources types.ResourceMap, parsers ParserMap, warn bool) (compiled types.ValueMap, err error) {
+/*
+	yamlTemplateCF
+	runs each resource through the parser for its type.
+	In the resources pass, resources without a parser are emitted verbatim,
+	or are an error in strict mode.
+*/
+func yamlTemplateCF(resources types.ResourceMap, parsers ParserMap, kind parserKind, strict bool) (compiled types.ValueMap, err error) {
+	return compileTemplateCF(resources, parsers, kind, strict, nil, nil)
+}
+
+/*
+	compileTemplateCF
+	is yamlTemplateCF, also recording the config resource each compiled
+	object was generated from in sources, when it isn't nil.
+	When problems isn't nil, unknown types and properties are recorded in it,
+	and resources their parser rejects are emitted verbatim and their errors
+	recorded in it, instead of failing
+*/
+func compileTemplateCF(resources types.ResourceMap, parsers ParserMap, kind parserKind, strict bool, sources map[string]ResourceSource, problems *[]ValidationProblem) (compiled types.ValueMap, err error) {
 	compiled = make(types.ValueMap)
+	record := func(logicalID, resourceName string, resource types.CfResource) {
+		if sources != nil {
+			sources[logicalID] = ResourceSource{
+				ConfigResource: resourceName,
+				ConfigType:     resource.Type,
+				Plugin:         pluginTypes[resource.Type],
+			}
+		}
+	}
 
 	for resourceName, resource := range resources {
-		if resource.Condition != nil { // if there is a condition on the source resource, warn the user
-			log.WithFields(log.Fields{
-				"resource": resourceName,
-			}).Warn("Condition being applied on resource, this is not yet supported")
+		if kind == resourceParsersKind && isCustomResourceType(resource.Type) {
+			// custom resources have free-form properties, so are always emitted verbatim
+			compiled[resourceName] = resource
+			record(resourceName, resourceName, resource)
+			continue
 		}
 
 		parser, ok := parsers[resource.Type]
 		if !ok {
-			if warn {
-				log.WithFields(log.Fields{
-					"type": resource.Type,
-				}).Warn("Type not found")
+			if kind == resourceParsersKind {
+				if problems != nil {
+					*problems = append(*problems, ValidationProblem{
+						Path:    "Resources." + resourceName,
+						Check:   "type",
+						Message: fmt.Sprintf("Type not found: %v", resource.Type),
+					})
+				} else if strict {
+					err = fmt.Errorf("Type not found for resource %v: %v", resourceName, resource.Type)
+					log.WithFields(log.Fields{
+						"resource": resourceName,
+						"type":     resource.Type,
+					}).Error("Type not found")
+					return
+				} else {
+					log.WithFields(log.Fields{
+						"resource": resourceName,
+						"type":     resource.Type,
+					}).Warn("Type not found, passing resource through unchanged")
+				}
+				compiled[resourceName] = resource
+				record(resourceName, resourceName, resource)
 			}
 			continue
 		}
@@ -230,6 +347,13 @@ func yamlTemplateCF(resources types.ResourceMap, parsers ParserMap, warn bool) (
 
 		var output types.ValueMap
 		if output, err = parser(resourceName, string(resourseData)); err != nil {
+			if problems != nil {
+				*problems = append(*problems, resourceProblems(resourceName, err)...)
+				err = nil
+				compiled[resourceName] = resource
+				record(resourceName, resourceName, resource)
+				continue
+			}
 			log.WithFields(log.Fields{
 				"resource": resourceName,
 			}).Error("Error parsing resource")
@@ -237,14 +361,90 @@ func yamlTemplateCF(resources types.ResourceMap, parsers ParserMap, warn bool) (
 			return
 		}
 
+		if kind == resourceParsersKind {
+			if err = checkTypedResource(resourceName, resource, output[resourceName], strict, problems); err != nil {
+				return
+			}
+		}
+
 		// collect all output resources in one list
 		for k, v := range output {
+			// propagate the source resource condition to everything the parser
+			// generated. Mappings are static lookup tables and can't have a condition.
+			if resource.Condition != nil && kind != mappingParsersKind {
+				conditional, ok := applyCondition(v, resource.Condition)
+				if !ok {
+					log.WithFields(log.Fields{
+						"resource": resourceName,
+						"object":   k,
+					}).Warn("Condition could not be applied to generated object")
+				}
+				v = conditional
+			}
 			compiled[k] = v
+			record(k, resourceName, resource)
 		}
 	}
 	return
 }
 
+/*
+	checkTypedResource
+	reports properties in the config that aren't in the spec for a base AWS type,
+	recording them in problems when it isn't nil
+*/
+func checkTypedResource(resourceName string, resource types.CfResource, typed interface{}, strict bool, problems *[]ValidationProblem) error {
+	if typed == nil {
+		return nil
+	}
+	// only check resources the parser produced from this config resource
+	value := reflect.Indirect(reflect.ValueOf(typed))
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+	if typeField := value.FieldByName("Type"); !typeField.IsValid() || typeField.Interface() != resource.Type {
+		return nil
+	}
+
+	found := checkResourceProperties(resourceName, resource, typed)
+	if problems != nil {
+		*problems = append(*problems, found...)
+		return nil
+	}
+	errs := make([]error, len(found))
+	for i, problem := range found {
+		errs[i] = fmt.Errorf("%v", problem)
+	}
+	return reportErrors("Invalid property", errs, strict)
+}
+
+/*
+	reportErrors
+	logs each error as a warning, or as an error in strict mode, where the
+	first error is returned
+*/
+func reportErrors(msg string, errs []error, strict bool) error {
+	for _, err := range errs {
+		if strict {
+			log.WithFields(log.Fields{
+				"err": err,
+			}).Error(msg)
+		} else {
+			log.WithFields(log.Fields{
+				"err": err,
+			}).Warn(msg)
+		}
+	}
+	if strict && len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func isCustomResourceType(resourceType string) bool {
+	return strings.HasPrefix(resourceType, "Custom::") || resourceType == "AWS::CloudFormation::CustomResource"
+}
+
 func logFileError(file string, err error) {
 	errorLocation := -1
 	re := regexp.MustCompile(`([0-9]+)`)
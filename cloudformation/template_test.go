@@ -5,6 +5,7 @@ import (
 
 	"github.com/KablamoOSS/kombustion/parsers/resources"
 	"github.com/KablamoOSS/kombustion/types"
+	yaml "github.com/KablamoOSS/yaml"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -35,7 +36,117 @@ func TestTemplateYamlCF_iamRole_success(t *testing.T) {
 	}
 
 	populateParsers(false)
-	compiledResources, err := yamlTemplateCF(testResources, resourceParsers, true)
+	compiledResources, err := yamlTemplateCF(testResources, resourceParsers, resourceParsersKind, false)
 	assert.Nil(t, err)
 	assert.EqualValues(t, expectedResources, compiledResources)
 }
+
+func TestTemplateYamlCF_resourceAttributes(t *testing.T) {
+	bucketName := "testBucket"
+	updatePolicy := map[string]interface{}{
+		"AutoScalingRollingUpdate": map[string]interface{}{"MinInstancesInService": 1},
+	}
+	testResources := types.ResourceMap{
+		bucketName: types.CfResource{
+			Type:                "AWS::S3::Bucket",
+			Properties:          map[string]string{"BucketName": bucketName},
+			DeletionPolicy:      "Retain",
+			UpdateReplacePolicy: "Retain",
+			UpdatePolicy:        updatePolicy,
+		},
+	}
+
+	populateParsers(false)
+	compiledResources, err := yamlTemplateCF(testResources, resourceParsers, resourceParsersKind, false)
+	assert.Nil(t, err)
+
+	bucket, ok := compiledResources[bucketName].(resources.S3Bucket)
+	assert.True(t, ok)
+	assert.Equal(t, "Retain", bucket.DeletionPolicy)
+	assert.Equal(t, "Retain", bucket.UpdateReplacePolicy)
+	assert.Nil(t, bucket.CreationPolicy)
+	assert.NotNil(t, bucket.UpdatePolicy)
+}
+
+func TestTemplateYamlCF_unknownTypes(t *testing.T) {
+	customResource := types.CfResource{
+		Type: "Custom::Thing",
+		Properties: map[string]interface{}{
+			"ServiceToken": "arn:aws:lambda:ap-southeast-2:123456789012:function:thing",
+			"Size":         5,
+		},
+	}
+	unknownResource := types.CfResource{
+		Type:       "AWS::Unreleased::Thing",
+		Properties: map[string]interface{}{"Name": "thing"},
+	}
+	testResources := types.ResourceMap{
+		"customThing":  customResource,
+		"unknownThing": unknownResource,
+	}
+
+	populateParsers(false)
+	compiledResources, err := yamlTemplateCF(testResources, resourceParsers, resourceParsersKind, false)
+	assert.Nil(t, err)
+	assert.EqualValues(t, types.ValueMap{
+		"customThing":  customResource,
+		"unknownThing": unknownResource,
+	}, compiledResources)
+
+	_, err = yamlTemplateCF(testResources, resourceParsers, resourceParsersKind, true)
+	assert.NotNil(t, err)
+
+	compiledOutputs, err := yamlTemplateCF(testResources, outputParsers, outputParsersKind, true)
+	assert.Nil(t, err)
+	assert.Empty(t, compiledOutputs)
+}
+
+func TestTemplateYamlCF_intrinsicProperties(t *testing.T) {
+	inputYaml := `
+testBucket:
+  Type: AWS::S3::Bucket
+  Properties:
+    WebsiteConfiguration: !If
+      - IsProd
+      - IndexDocument: index.html
+      - !Ref AWS::NoValue
+    LoggingConfiguration:
+      DestinationBucketName: !Ref LogBucket
+`
+	var testResources types.ResourceMap
+	err := yaml.Unmarshal([]byte(inputYaml), &testResources)
+	assert.Nil(t, err)
+
+	populateParsers(false)
+	compiledResources, err := yamlTemplateCF(testResources, resourceParsers, resourceParsersKind, true)
+	assert.Nil(t, err)
+
+	bucket := compiledResources["testBucket"].(resources.S3Bucket)
+	assert.NotNil(t, bucket.Properties.WebsiteConfiguration.Intrinsic)
+	assert.Nil(t, bucket.Properties.LoggingConfiguration.Intrinsic)
+	assert.Empty(t, bucket.Validate())
+
+	output, err := yaml.Marshal(compiledResources)
+	assert.Nil(t, err)
+
+	var result interface{}
+	err = yaml.Unmarshal(output, &result)
+	assert.Nil(t, err)
+	assert.EqualValues(t, map[interface{}]interface{}{
+		"testBucket": map[interface{}]interface{}{
+			"Type": "AWS::S3::Bucket",
+			"Properties": map[interface{}]interface{}{
+				"WebsiteConfiguration": map[interface{}]interface{}{
+					"Fn::If": []interface{}{
+						"IsProd",
+						map[interface{}]interface{}{"IndexDocument": "index.html"},
+						map[interface{}]interface{}{"Ref": "AWS::NoValue"},
+					},
+				},
+				"LoggingConfiguration": map[interface{}]interface{}{
+					"DestinationBucketName": map[interface{}]interface{}{"Ref": "LogBucket"},
+				},
+			},
+		},
+	}, result)
+}
@@ -0,0 +1,211 @@
+package cloudformation
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/KablamoOSS/kombustion/types"
+)
+
+// CloudFormation service limits
+const (
+	MaxResources        = 200
+	MaxOutputs          = 60
+	MaxParameters       = 60
+	MaxMappings         = 100
+	MaxLogicalIDLength  = 255
+	MaxTemplateBodySize = 51200
+	MaxTemplateURLSize  = 460800
+)
+
+var logicalIDPattern = regexp.MustCompile("^[a-zA-Z0-9]+$")
+
+// ValidationProblem - a problem found in a compiled stack
+type ValidationProblem struct {
+	Path    string `json:"path"`
+	Check   string `json:"check"`
+	Message string `json:"message"`
+}
+
+func (problem ValidationProblem) String() string {
+	return fmt.Sprintf("%v: %v", problem.Path, problem.Message)
+}
+
+/*
+	ValidateStack
+	checks a compiled stack against the rules CloudFormation will apply to it,
+	without calling AWS. templateBody is the rendered template that would be
+	deployed, and is only used for the size limit. Problems collected while
+	compiling the stack (see GenerateParams.CollectProblems) come first
+*/
+func ValidateStack(stack YamlCloudformation, templateBody []byte) (problems []ValidationProblem) {
+	// the problems found compiling the config, when they were collected
+	problems = append(problems, stack.Problems...)
+	problems = append(problems, validateLimits(stack, templateBody)...)
+	problems = append(problems, validateLogicalIDs(stack)...)
+
+	template, err := templateValue(stack)
+	if err != nil {
+		return append(problems, ValidationProblem{Path: "Template", Check: "template", Message: err.Error()})
+	}
+	outputs, _ := template["Outputs"].(map[string]interface{})
+
+	problems = append(problems, validateExportNames(outputs)...)
+	problems = append(problems, checkReferences(stack)...)
+	problems = append(problems, validateDependencies(stack)...)
+	problems = append(problems, validateResources(stack)...)
+	return
+}
+
+func validateLimits(stack YamlCloudformation, templateBody []byte) (problems []ValidationProblem) {
+	counts := []struct {
+		section string
+		count   int
+		limit   int
+	}{
+		{"Resources", len(stack.Resources), MaxResources},
+		{"Outputs", len(stack.Outputs), MaxOutputs},
+		{"Parameters", len(stack.Parameters), MaxParameters},
+		{"Mappings", len(stack.Mappings), MaxMappings},
+	}
+	for _, section := range counts {
+		if section.count > section.limit {
+			problems = append(problems, ValidationProblem{
+				Path:    section.section,
+				Check:   "limit",
+				Message: fmt.Sprintf("%v %v declared, the limit is %v", section.count, section.section, section.limit),
+			})
+		}
+	}
+
+	if size := len(templateBody); size > MaxTemplateURLSize {
+		problems = append(problems, ValidationProblem{
+			Path:    "Template",
+			Check:   "limit",
+			Message: fmt.Sprintf("template is %v bytes, the limit is %v bytes", size, MaxTemplateURLSize),
+		})
+	} else if size > MaxTemplateBodySize {
+		problems = append(problems, ValidationProblem{
+			Path:    "Template",
+			Check:   "limit",
+			Message: fmt.Sprintf("template is %v bytes, the limit for a TemplateBody is %v bytes, it must be uploaded to S3", size, MaxTemplateBodySize),
+		})
+	}
+	return
+}
+
+func validateLogicalIDs(stack YamlCloudformation) (problems []ValidationProblem) {
+	sections := []struct {
+		name   string
+		values types.ValueMap
+	}{
+		{"Parameters", stack.Parameters},
+		{"Mappings", stack.Mappings},
+		{"Conditions", stack.Conditions},
+		{"Resources", stack.Resources},
+		{"Outputs", stack.Outputs},
+	}
+	for _, section := range sections {
+		for _, logicalID := range sortedKeys(section.values) {
+			path := section.name + "." + logicalID
+			if !logicalIDPattern.MatchString(logicalID) {
+				problems = append(problems, ValidationProblem{
+					Path:    path,
+					Check:   "logical-id",
+					Message: "logical IDs must only contain the characters A-Z, a-z and 0-9",
+				})
+			} else if len(logicalID) > MaxLogicalIDLength {
+				problems = append(problems, ValidationProblem{
+					Path:    path,
+					Check:   "logical-id",
+					Message: fmt.Sprintf("logical IDs must be %v characters or less", MaxLogicalIDLength),
+				})
+			}
+		}
+	}
+	return
+}
+
+/*
+	validateExportNames
+	finds outputs exporting the same name. Export names using intrinsic
+	functions are compared by their expression
+*/
+func validateExportNames(outputs map[string]interface{}) (problems []ValidationProblem) {
+	exported := make(map[string]string)
+	for _, outputName := range sortedKeys(outputs) {
+		output, _ := outputs[outputName].(map[string]interface{})
+		export, _ := output["Export"].(map[string]interface{})
+		if export["Name"] == nil {
+			continue
+		}
+
+		name, ok := export["Name"].(string)
+		if !ok {
+			expression, err := json.Marshal(export["Name"])
+			if err != nil {
+				continue
+			}
+			name = string(expression)
+		}
+
+		if previous, ok := exported[name]; ok {
+			problems = append(problems, ValidationProblem{
+				Path:    fmt.Sprintf("Outputs.%v.Export.Name", outputName),
+				Check:   "export-name",
+				Message: fmt.Sprintf("export name %v is already exported by Outputs.%v", name, previous),
+			})
+			continue
+		}
+		exported[name] = outputName
+	}
+	return
+}
+
+func validateDependencies(stack YamlCloudformation) (problems []ValidationProblem) {
+	graph, err := BuildDependencyGraph(stack)
+	if err != nil || graph.Cycle == nil {
+		return
+	}
+	return []ValidationProblem{{
+		Path:    "Resources." + graph.Cycle[0],
+		Check:   "cycle",
+		Message: "circular dependency: " + strings.Join(graph.Cycle, " -> "),
+	}}
+}
+
+/*
+	validateResources
+	the validation errors of each resource
+*/
+func validateResources(stack YamlCloudformation) (problems []ValidationProblem) {
+	for _, resourceName := range sortedKeys(stack.Resources) {
+		if resource, ok := stack.Resources[resourceName].(types.Validatable); ok {
+			problems = append(problems, resourceProblems(resourceName, types.ValidationErrors(resource.Validate()))...)
+		}
+	}
+	return
+}
+
+/*
+	resourceProblems
+	a problem for each error of a resource, expanding the ValidationErrors
+	returned by its parser
+*/
+func resourceProblems(resourceName string, err error) (problems []ValidationProblem) {
+	errs := []error{err}
+	if validationErrs, ok := err.(types.ValidationErrors); ok {
+		errs = validationErrs
+	}
+	for _, err := range errs {
+		problems = append(problems, ValidationProblem{
+			Path:    "Resources." + resourceName,
+			Check:   "resource",
+			Message: err.Error(),
+		})
+	}
+	return
+}
+
@@ -0,0 +1,153 @@
+package cloudformation
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/parsers/resources"
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateStack(t *testing.T) {
+	stack := YamlCloudformation{
+		AWSTemplateFormatVersion: "2010-09-09",
+		Parameters: types.ValueMap{
+			"BucketName": map[string]interface{}{"Type": "String"},
+		},
+		Resources: types.ValueMap{
+			"testBucket": resources.NewS3Bucket(
+				resources.S3BucketProperties{
+					BucketName: map[string]interface{}{"Ref": "BucketName"},
+				},
+			),
+			"test_policy": resources.NewS3BucketPolicy(resources.S3BucketPolicyProperties{}),
+		},
+		Outputs: types.ValueMap{
+			"testBucket": map[string]interface{}{
+				"Value":  map[string]interface{}{"Ref": "testBucket"},
+				"Export": map[string]interface{}{"Name": map[string]interface{}{"Fn::Sub": "${AWS::StackName}-bucket"}},
+			},
+			"testBucketArn": map[string]interface{}{
+				"Value":  map[string]interface{}{"Fn::GetAtt": "missingBucket.Arn"},
+				"Export": map[string]interface{}{"Name": map[string]interface{}{"Fn::Sub": "${AWS::StackName}-bucket"}},
+			},
+			"testRegion": map[string]interface{}{
+				"Value": map[string]interface{}{"Fn::Sub": []interface{}{"${Region}", map[string]interface{}{"Region": map[string]interface{}{"Ref": "AWS::Region"}}}},
+			},
+		},
+	}
+
+	problems := ValidateStack(stack, []byte("AWSTemplateFormatVersion: 2010-09-09"))
+	assert.Equal(t, []ValidationProblem{
+		{Path: "Resources.test_policy", Check: "logical-id", Message: "logical IDs must only contain the characters A-Z, a-z and 0-9"},
+		{Path: "Outputs.testBucketArn.Export.Name", Check: "export-name", Message: `export name {"Fn::Sub":"${AWS::StackName}-bucket"} is already exported by Outputs.testBucket`},
+		{Path: "Outputs.testBucketArn.Value.Fn::GetAtt", Check: "reference", Message: "Fn::GetAtt to missing resource 'missingBucket'"},
+		{Path: "Resources.test_policy", Check: "resource", Message: "Missing required field 'Bucket'"},
+		{Path: "Resources.test_policy", Check: "resource", Message: "Missing required field 'PolicyDocument'"},
+	}, problems)
+}
+
+func TestValidateStack_limits(t *testing.T) {
+	stack := YamlCloudformation{
+		Resources: types.ValueMap{},
+		Outputs:   types.ValueMap{},
+	}
+	for i := 0; i <= MaxOutputs; i++ {
+		stack.Outputs[fmt.Sprintf("output%v", i)] = map[string]interface{}{"Value": "test"}
+	}
+
+	problems := ValidateStack(stack, []byte(strings.Repeat("#", MaxTemplateBodySize+1)))
+	assert.Equal(t, []ValidationProblem{
+		{Path: "Outputs", Check: "limit", Message: "61 Outputs declared, the limit is 60"},
+		{Path: "Template", Check: "limit", Message: "template is 51201 bytes, the limit for a TemplateBody is 51200 bytes, it must be uploaded to S3"},
+	}, problems)
+}
+
+func TestValidateStack_invalidResources(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kombustion")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	configPath := filepath.Join(dir, "queue.yaml")
+	assert.Nil(t, ioutil.WriteFile(configPath, []byte(`
+Resources:
+  testQueue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: [a, b]
+      DelaySeconds: soon
+  testTopic:
+    Type: AWS::SNS::Topic
+`), 0644))
+
+	params := GenerateParams{Filename: configPath, DisableBaseOutputs: true}
+	_, err = GenerateYamlStack(params)
+	assert.NotNil(t, err)
+
+	params.CollectProblems = true
+	stack, err := GenerateYamlStack(params)
+	assert.Nil(t, err)
+	assert.Contains(t, stack.Resources, "testQueue")
+	assert.Contains(t, stack.Resources, "testTopic")
+
+	problems := ValidateStack(stack, []byte{})
+	assert.Equal(t, []ValidationProblem{
+		{Path: "Resources.testQueue", Check: "resource", Message: "Invalid value for 'DelaySeconds', expected Integer but got 'soon'"},
+		{Path: "Resources.testQueue", Check: "resource", Message: "Invalid value for 'QueueName', expected String but got a List"},
+	}, problems)
+}
+
+func TestValidateStack_collectedProblems(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kombustion")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	configPath := filepath.Join(dir, "bucket.yaml")
+	assert.Nil(t, ioutil.WriteFile(configPath, []byte(`
+Resources:
+  testBucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketNmae: test
+      Tags:
+        - Key: Name
+          Value: !Ref missingParam
+  testWidget:
+    Type: AWS::Widget::Widget
+Outputs:
+  testBucket:
+    Value: one
+  testBucket:
+    Value: two
+`), 0644))
+
+	params := GenerateParams{Filename: configPath, DisableBaseOutputs: true, CollectProblems: true}
+	stack, err := GenerateYamlStack(params)
+	assert.Nil(t, err)
+
+	problems := ValidateStack(stack, []byte{})
+	assert.Len(t, problems, 4)
+	assert.Equal(t, configPath, problems[0].Path)
+	assert.Equal(t, "duplicate-key", problems[0].Check)
+	assert.Contains(t, problems[0].Message, "testBucket")
+	assert.Contains(t, problems[1:], ValidationProblem{
+		Path:    "Resources.testBucket.Properties.BucketNmae",
+		Check:   "property",
+		Message: "unknown property for AWS::S3::Bucket, did you mean 'BucketName'?",
+	})
+	assert.Contains(t, problems[1:], ValidationProblem{
+		Path:    "Resources.testWidget",
+		Check:   "type",
+		Message: "Type not found: AWS::Widget::Widget",
+	})
+	assert.Equal(t, ValidationProblem{
+		Path:    "Resources.testBucket.Properties.Tags[0].Value.Ref",
+		Check:   "reference",
+		Message: "Ref to missing resource or parameter 'missingParam'",
+	}, problems[3])
+}
@@ -39,6 +39,7 @@ type CfnProperty struct {
 	Type              string
 	PrimitiveType     string
 	ItemType          string
+	PrimitiveItemType string
 	Required          bool
 	DuplicatesAllowed bool
 	UpdateType        string
@@ -91,21 +92,81 @@ func GetParsers_{{$PackageName}}() map[string]types.ParserFunc {
 }
 `
 
+const attributeMapTemplate = `package {{.MainPackageName}}
+
+func GetAttributes_resources() map[string][]string {
+	return map[string][]string{
+		{{range $ResourceType, $Attributes := .ResourceAttributes}}
+		"{{$ResourceType}}": []string{ {{- range $Attributes}}"{{.}}", {{end -}} },
+		{{end}}
+	}
+}
+`
+
+const propertySpecMapTemplate = `package {{.MainPackageName}}
+
+import "github.com/KablamoOSS/kombustion/types"
+
+func GetPropertySpecs_resources() map[string]map[string]types.PropertySpec {
+	return map[string]map[string]types.PropertySpec{
+		{{range $Type, $Properties := .ResourcePropertySpecs}}
+		"{{$Type}}": map[string]types.PropertySpec{ {{- range $Name, $Spec := $Properties}}"{{$Name}}": { {{- $Spec -}} }, {{end -}} },
+		{{end}}
+	}
+}
+
+func GetPropertySpecs_properties() map[string]map[string]types.PropertySpec {
+	return map[string]map[string]types.PropertySpec{
+		{{range $Type, $Properties := .PropertyPropertySpecs}}
+		"{{$Type}}": map[string]types.PropertySpec{ {{- range $Name, $Spec := $Properties}}"{{$Name}}": { {{- $Spec -}} }, {{end -}} },
+		{{end}}
+	}
+}
+`
+
 const propertyTemplate = `package properties
 {{$PropertyName := .PropertyName}}
+{{- $BT := "` + "`" + `"}}
 
-{{- if .NeedsFmtImport}}
-	import "fmt"
-{{- end}}
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	{{- if .NeedsFmtImport}}
+	"fmt"
+	{{- end}}
+)
 
 type {{$PropertyName}} struct {
 	{{- range $property := .PropertyStrings}}
 	{{$property}}
 	{{- end}}
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} {{$BT}}yaml:"-"{{$BT}}
+}
+
+func (resource *{{$PropertyName}}) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain {{$PropertyName}}
+	return unmarshal((*plain)(resource))
+}
+
+func (resource {{$PropertyName}}) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain {{$PropertyName}}
+	return plain(resource), nil
 }
 
 func (resource {{$PropertyName}}) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	{{- range $validator := .ValidatorStrings}}
 	{{$validator}}
 	{{- end}}
@@ -131,11 +192,15 @@ import (
 )
 
 type {{$ResourceName}} struct {
-	Type       string                      {{$BT}}yaml:"Type"{{$BT}}
-	Properties {{$ResourceName}}Properties {{$BT}}yaml:"Properties"{{$BT}}
-	Condition  interface{}                 {{$BT}}yaml:"Condition,omitempty"{{$BT}}
-	Metadata   interface{}                 {{$BT}}yaml:"Metadata,omitempty"{{$BT}}
-	DependsOn  interface{}                 {{$BT}}yaml:"DependsOn,omitempty"{{$BT}}
+	Type                string                      {{$BT}}yaml:"Type"{{$BT}}
+	Properties          {{$ResourceName}}Properties {{$BT}}yaml:"Properties"{{$BT}}
+	Condition           interface{}                 {{$BT}}yaml:"Condition,omitempty"{{$BT}}
+	Metadata            interface{}                 {{$BT}}yaml:"Metadata,omitempty"{{$BT}}
+	DependsOn           interface{}                 {{$BT}}yaml:"DependsOn,omitempty"{{$BT}}
+	DeletionPolicy      interface{}                 {{$BT}}yaml:"DeletionPolicy,omitempty"{{$BT}}
+	UpdateReplacePolicy interface{}                 {{$BT}}yaml:"UpdateReplacePolicy,omitempty"{{$BT}}
+	CreationPolicy      interface{}                 {{$BT}}yaml:"CreationPolicy,omitempty"{{$BT}}
+	UpdatePolicy        interface{}                 {{$BT}}yaml:"UpdatePolicy,omitempty"{{$BT}}
 }
 
 type {{$ResourceName}}Properties struct {
@@ -158,9 +223,10 @@ func Parse{{$ResourceName}}(name string, data string) (cf types.ValueMap, err er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: {{$ResourceName}} - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -234,20 +300,17 @@ func Parse{{$ResourceName}}(name string, data string) (cf types.ValueMap, err er
 `
 
 const validatorTemplate = `
-	{{- if .PrimitiveType -}}
-	if resource.{{.Name}} == nil {
-		errs = append(errs, fmt.Errorf("Missing required field '{{.Name}}'"))
-	}
-	{{- else if .ListMapType -}}
+	{{- if .Required -}}
 	if resource.{{.Name}} == nil {
 		errs = append(errs, fmt.Errorf("Missing required field '{{.Name}}'"))
-	} else {
-		errs = append(errs, resource.{{.Name}}.Validate()...)
 	}
-	{{- else -}}
-	if resource.{{.Name}} == nil {
-		errs = append(errs, fmt.Errorf("Missing required field '{{.Name}}'"))
+	{{- end}}
+	{{- if .PropertyType}}
+	if resource.{{.Name}} != nil {
+		errs = append(errs, types.PrefixErrors("{{.Name}}", resource.{{.Name}}.Validate())...)
 	}
+	{{- else}}
+	errs = append(errs, {{.TypeValidator}}("{{.Name}}", resource.{{.Name}})...)
 	{{- end -}}
 `
 
@@ -356,7 +419,7 @@ func buildJsonParsers(cfnSpec CfnSpec) {
 
 func buildYamlParsers(cfnSpec CfnSpec) {
 	// check for global types
-	globalPropertyTypes := []string{}
+	globalPropertyTypes = []string{}
 	for k := range cfnSpec.PropertyTypes {
 		if isPropertyGlobal(k) {
 			globalPropertyTypes = append(globalPropertyTypes, k)
@@ -373,6 +436,16 @@ func buildYamlParsers(cfnSpec CfnSpec) {
 	err = ioutil.WriteFile(filePath, []byte(outputParsersObject), 0644)
 	checkError(err)
 
+	attributesObject := buildAttributeMapping(cfnSpec)
+	filePath = fmt.Sprintf("%vattributes.go", parsersDir)
+	err = ioutil.WriteFile(filePath, []byte(attributesObject), 0644)
+	checkError(err)
+
+	propertySpecsObject := buildPropertySpecMapping(cfnSpec)
+	filePath = fmt.Sprintf("%vpropertyspecs.go", parsersDir)
+	err = ioutil.WriteFile(filePath, []byte(propertySpecsObject), 0644)
+	checkError(err)
+
 	// properties
 	for k, cfnType := range cfnSpec.PropertyTypes {
 		propertyObject := buildPropertyYaml(k, cfnType)
@@ -416,6 +489,81 @@ func buildParserMapping(cfnSpec CfnSpec, packageName string) string {
 	return buf.String()
 }
 
+// buildAttributeMapping - maps each resource type to the attributes it supports with Fn::GetAtt
+func buildAttributeMapping(cfnSpec CfnSpec) string {
+	resourceAttributes := make(map[string][]string)
+	for k, cfnType := range cfnSpec.ResourceTypes {
+		resourceAttributes[k] = sortAttributeNames(cfnType.Attributes)
+	}
+
+	buf := bytes.NewBufferString("")
+	t := template.Must(template.New("").Parse(attributeMapTemplate))
+	err := t.Execute(buf, map[string]interface{}{
+		"ResourceAttributes": resourceAttributes,
+		"MainPackageName":    mainPackageName,
+	})
+	checkError(err)
+	return buf.String()
+}
+
+/*
+	buildPropertySpecMapping
+	maps each resource and property type to the specs of its properties, with
+	the full name of the property type of each property, so nested properties
+	can be looked up
+*/
+func buildPropertySpecMapping(cfnSpec CfnSpec) string {
+	specsOf := func(obj string, cfnType CfnType) map[string]string {
+		specs := make(map[string]string)
+		for name, property := range cfnType.Properties {
+			fields := []string{}
+			propertyType := property.Type
+			switch property.Type {
+			case "List", "Map":
+				fields = append(fields, fmt.Sprintf("Container: %q", property.Type))
+				propertyType = property.ItemType
+			}
+			if len(propertyType) > 0 {
+				fields = append(fields, fmt.Sprintf("PropertyType: %q", fullPropertyTypeName(obj, propertyType)))
+			}
+			if len(property.UpdateType) > 0 {
+				fields = append(fields, fmt.Sprintf("UpdateType: %q", property.UpdateType))
+			}
+			specs[name] = strings.Join(fields, ", ")
+		}
+		return specs
+	}
+
+	resourceSpecs := make(map[string]map[string]string)
+	for k, cfnType := range cfnSpec.ResourceTypes {
+		resourceSpecs[k] = specsOf(k, cfnType)
+	}
+	propertySpecs := make(map[string]map[string]string)
+	for k, cfnType := range cfnSpec.PropertyTypes {
+		propertySpecs[k] = specsOf(k, cfnType)
+	}
+
+	buf := bytes.NewBufferString("")
+	t := template.Must(template.New("").Parse(propertySpecMapTemplate))
+	err := t.Execute(buf, map[string]interface{}{
+		"ResourcePropertySpecs": resourceSpecs,
+		"PropertyPropertySpecs": propertySpecs,
+		"MainPackageName":       mainPackageName,
+	})
+	checkError(err)
+	return buf.String()
+}
+
+// fullPropertyTypeName - the spec name of a property type used by a resource or property type, eg. AWS::EC2::SecurityGroup.Ingress
+func fullPropertyTypeName(typeName, propertyName string) string {
+	for _, v := range globalPropertyTypes {
+		if v == propertyName {
+			return propertyName
+		}
+	}
+	return strings.Split(typeName, ".")[0] + "." + propertyName
+}
+
 func buildPropertyYaml(obj string, cfnType CfnType) string {
 	propertyStrings := make([]string, len(cfnType.Properties))
 	validatorStrings := make([]string, len(cfnType.Properties))
@@ -425,7 +573,7 @@ func buildPropertyYaml(obj string, cfnType CfnType) string {
 		}
 	}
 	for _, property := range sortProperties(cfnType.Properties) {
-		if str := validatorYaml(obj, property.name, property.CfnProperty); len(str) > 0 {
+		if str := validatorYaml("", obj, property.name, property.CfnProperty); len(str) > 0 {
 			validatorStrings = append(validatorStrings, str)
 		}
 	}
@@ -451,7 +599,7 @@ func buildResourceYaml(obj string, cfnType CfnType) string {
 		}
 	}
 	for _, property := range sortProperties(cfnType.Properties) {
-		if str := validatorYaml(obj, property.name, property.CfnProperty); len(str) > 0 {
+		if str := validatorYaml("properties.", obj, property.name, property.CfnProperty); len(str) > 0 {
 			validatorStrings = append(validatorStrings, str)
 		}
 	}
@@ -502,22 +650,44 @@ func valueStringYaml(propPackage, obj, name string, property CfnProperty) string
 	return name + " interface{} `yaml:" + `"` + name + omitempty + `"` + "`"
 }
 
-func validatorYaml(obj, name string, property CfnProperty) string {
-	if !property.Required {
-		return ""
-	}
-
+func validatorYaml(propPackage, obj, name string, property CfnProperty) string {
 	buf := bytes.NewBufferString("")
 	t := template.Must(template.New("").Parse(validatorTemplate))
 	err := t.Execute(buf, map[string]interface{}{
 		"Name":          name,
-		"PrimitiveType": len(property.PrimitiveType) > 0,
-		"ListMapType":   len(property.Type) > 0 && property.Type != "List" && property.Type != "Map",
+		"Required":      property.Required,
+		"PropertyType":  len(property.PrimitiveType) == 0 && len(property.Type) > 0 && property.Type != "List" && property.Type != "Map",
+		"TypeValidator": typeValidatorYaml(propPackage, obj, property),
 	})
 	checkError(err)
 	return buf.String()
 }
 
+/*
+	typeValidatorYaml
+	builds the types.PropertyValidator for a property from its spec type
+	eg. types.ValidateList(types.ValidatePrimitive("String"))
+*/
+func typeValidatorYaml(propPackage, obj string, property CfnProperty) string {
+	if len(property.PrimitiveType) > 0 {
+		return `types.ValidatePrimitive("` + property.PrimitiveType + `")`
+	}
+
+	itemValidator := `types.ValidatePrimitive("` + property.PrimitiveItemType + `")`
+	if len(property.ItemType) > 0 {
+		itemName := propPackage + propertyNameFromResourceType(obj, property.ItemType)
+		itemValidator = "types.ValidatePropertyType(func() types.Validatable { return &" + itemName + "{} })"
+	}
+
+	switch property.Type {
+	case "List":
+		return "types.ValidateList(" + itemValidator + ")"
+	case "Map":
+		return "types.ValidateMap(" + itemValidator + ")"
+	}
+	return `types.ValidatePrimitive("")`
+}
+
 func needsFmtImport(cfnType CfnType) bool {
 	for _, property := range cfnType.Properties {
 		if len(property.PrimitiveType) > 0 {
@@ -538,6 +708,9 @@ func needsPropertiesImport(cfnType CfnType) bool {
 		if len(property.Type) > 0 && property.Type != "List" && property.Type != "Map" {
 			return true
 		}
+		if len(property.ItemType) > 0 {
+			return true
+		}
 	}
 	return false
 }
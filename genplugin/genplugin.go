@@ -28,11 +28,15 @@ type YamlConfig struct {
 }
 
 type CfResource struct {
-	Type       string      `yaml:"Type"`
-	Properties interface{} `yaml:"Properties"`
-	Condition  interface{} `yaml:"Condition,omitempty"`
-	Metadata   interface{} `yaml:"Metadata,omitempty"`
-	DependsOn  interface{} `yaml:"DependsOn,omitempty"`
+	Type                string      `yaml:"Type"`
+	Properties          interface{} `yaml:"Properties"`
+	Condition           interface{} `yaml:"Condition,omitempty"`
+	Metadata            interface{} `yaml:"Metadata,omitempty"`
+	DependsOn           interface{} `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{} `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{} `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{} `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{} `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CfParameter struct {
@@ -297,7 +301,11 @@ func generateResources(config YamlConfig, resname string) []byte {
 	for resName, res := range config.Resources {
 		typeParts := strings.Split(res.Type, "::")
 
-		if res.Metadata != nil || res.Condition != nil || res.DependsOn != nil {
+		hasAttributes := res.Metadata != nil || res.Condition != nil || res.DependsOn != nil ||
+			res.DeletionPolicy != nil || res.UpdateReplacePolicy != nil ||
+			res.CreationPolicy != nil || res.UpdatePolicy != nil
+
+		if hasAttributes {
 			writeLine(buf, "resource", resName, " := resources.New", typeParts[1], typeParts[2], "(\n")
 		} else {
 			writeLine(buf, "cf[name+\"", resName, "\"] = resources.New", typeParts[1], typeParts[2], "(\n")
@@ -321,7 +329,23 @@ func generateResources(config YamlConfig, resname string) []byte {
 			writeLine(buf, "resource", resName, ".DependsOn = ", getVal(res.DependsOn, 1, ""), "\n\n")
 		}
 
-		if res.Metadata != nil || res.Condition != nil || res.DependsOn != nil {
+		if res.DeletionPolicy != nil {
+			writeLine(buf, "resource", resName, ".DeletionPolicy = ", getVal(res.DeletionPolicy, 1, ""), "\n\n")
+		}
+
+		if res.UpdateReplacePolicy != nil {
+			writeLine(buf, "resource", resName, ".UpdateReplacePolicy = ", getVal(res.UpdateReplacePolicy, 1, ""), "\n\n")
+		}
+
+		if res.CreationPolicy != nil {
+			writeLine(buf, "resource", resName, ".CreationPolicy = ", getVal(res.CreationPolicy, 1, ""), "\n\n")
+		}
+
+		if res.UpdatePolicy != nil {
+			writeLine(buf, "resource", resName, ".UpdatePolicy = ", getVal(res.UpdatePolicy, 1, ""), "\n\n")
+		}
+
+		if hasAttributes {
 			writeLine(buf, "cf[name+\"", resName, "\"] = resource", resName, "\n\n")
 		}
 	}
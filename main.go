@@ -20,9 +20,39 @@
 //
 //     kombustion cf generate --format=yaml test
 //
-// Upsert a cloudformation template from: ./compiled/test.yaml:
+// Validate a cloudformation template generated from: ./configs/test.yaml:
 //
-//     kombustion cf upsert test
+//     kombustion cf validate test
+//
+// Print the resource dependency graph of ./configs/test.yaml:
+//
+//     kombustion cf graph test
+//
+// Upsert a cloudformation template from: ./configs/test.yaml:
+//
+//     kombustion cf upsert configs/test.yaml
+//
+// Upsert a cloudformation template that's already compiled to: ./compiled/test.yaml:
+//
+//     kombustion cf upsert --template compiled/test.yaml
+//
+// Update the parameter values of a stack, keeping its template (stackName: test)
+//
+//     kombustion cf update-params --param Env=prod test
+//
+// Plan the changes a cloudformation template from ./configs/test.yaml will make,
+// then apply them:
+//
+//     kombustion cf plan --stackName test configs/test.yaml
+//     kombustion cf apply --changeSet [changeSetId]
+//
+// Detect drift in a deployed stack:
+//
+//     kombustion cf drift test
+//
+// Print how long each resource took to deploy (stackName: test)
+//
+//     kombustion cf timings test
 //
 // Delete a cloudformation stack (stackName: test)
 //
@@ -90,6 +120,16 @@ func main() {
 					Name:  "profile",
 					Usage: "aws credentials profile to use",
 				},
+				cli.StringFlag{
+					Name:   "endpoint",
+					Usage:  "cloudformation endpoint url to use instead of the aws default, eg. a local stand-in for testing",
+					EnvVar: "KOMBUSTION_CF_ENDPOINT",
+				},
+				cli.StringFlag{
+					Name:   "s3Endpoint",
+					Usage:  "s3 endpoint url to upload templates to instead of the aws default, eg. a local stand-in for testing",
+					EnvVar: "KOMBUSTION_S3_ENDPOINT",
+				},
 			},
 			Subcommands: []cli.Command{
 				{
@@ -99,6 +139,27 @@ func main() {
 					Action:    tasks.Generate,
 					Flags:     tasks.Generate_Flags,
 				},
+				{
+					Name:      "validate",
+					Usage:     "check a generated cloudformation template without deploying it",
+					UsageText: "kombustion cloudformation validate [command options] [stack]",
+					Action:    tasks.Validate,
+					Flags:     tasks.Validate_Flags,
+				},
+				{
+					Name:      "graph",
+					Usage:     "print the resource dependency graph of a generated cloudformation template",
+					UsageText: "kombustion cloudformation graph [command options] [stack]",
+					Action:    tasks.Graph,
+					Flags:     tasks.Graph_Flags,
+				},
+				{
+					Name:      "diff",
+					Usage:     "compare a generated cloudformation template with the deployed stack",
+					UsageText: "kombustion cloudformation diff [command options] [stack]",
+					Action:    tasks.Diff,
+					Flags:     tasks.Diff_Flags,
+				},
 				{
 					Name:      "upsert",
 					Usage:     "upsert a cloudformation template or a yaml config",
@@ -106,6 +167,27 @@ func main() {
 					Action:    tasks.Upsert,
 					Flags:     tasks.Upsert_Flags,
 				},
+				{
+					Name:      "update-params",
+					Usage:     "update the parameter values of a cloudformation stack, keeping its template",
+					UsageText: "kombustion cloudformation update-params [command options] [stackName]",
+					Action:    tasks.UpdateParams,
+					Flags:     tasks.UpdateParams_Flags,
+				},
+				{
+					Name:      "plan",
+					Usage:     "create a change set for a cloudformation template, and print what it will change",
+					UsageText: "kombustion cloudformation plan [command options] [stack]",
+					Action:    tasks.Plan,
+					Flags:     tasks.Plan_Flags,
+				},
+				{
+					Name:      "apply",
+					Usage:     "execute a change set created by plan",
+					UsageText: "kombustion cloudformation apply [command options] --changeSet [changeSetId] [stackName]",
+					Action:    tasks.Apply,
+					Flags:     tasks.Apply_Flags,
+				},
 				{
 					Name:      "delete",
 					Usage:     "delete a cloudformation stack",
@@ -113,13 +195,27 @@ func main() {
 					Action:    tasks.Delete,
 					Flags:     tasks.Delete_Flags,
 				},
+				{
+					Name:      "drift",
+					Usage:     "detect resources of a cloudformation stack that have drifted from its template",
+					UsageText: "kombustion cloudformation drift [command options] [stackName]",
+					Action:    tasks.Drift,
+					Flags:     tasks.Drift_Flags,
+				},
 				{
 					Name:      "events",
-					Usage:     "print all events for a cloudformation stack ",
-					UsageText: "kombustion cloudformation events [command options] [stackName]",
+					Usage:     "print all events for a cloudformation stack",
+					UsageText: "kombustion cloudformation events [command options] [stackName or stackId]",
 					Action:    tasks.PrintEvents,
 					Flags:     tasks.PrintEvents_Flags,
 				},
+				{
+					Name:      "timings",
+					Usage:     "print how long each resource took in the last operation on a cloudformation stack",
+					UsageText: "kombustion cloudformation timings [command options] [stackName or stackId]",
+					Action:    tasks.Timings,
+					Flags:     tasks.Timings_Flags,
+				},
 				{
 					Name:      "plugins",
 					Usage:     "get or list plugins (see cf plugins help)",
@@ -0,0 +1,539 @@
+package parsers
+
+func GetAttributes_resources() map[string][]string {
+	return map[string][]string{
+		
+		"AWS::ApiGateway::Account": []string{},
+		
+		"AWS::ApiGateway::ApiKey": []string{},
+		
+		"AWS::ApiGateway::Authorizer": []string{},
+		
+		"AWS::ApiGateway::BasePathMapping": []string{},
+		
+		"AWS::ApiGateway::ClientCertificate": []string{},
+		
+		"AWS::ApiGateway::Deployment": []string{},
+		
+		"AWS::ApiGateway::DocumentationPart": []string{},
+		
+		"AWS::ApiGateway::DocumentationVersion": []string{},
+		
+		"AWS::ApiGateway::DomainName": []string{"DistributionDomainName", "DistributionHostedZoneId", "RegionalDomainName", "RegionalHostedZoneId", },
+		
+		"AWS::ApiGateway::GatewayResponse": []string{},
+		
+		"AWS::ApiGateway::Method": []string{},
+		
+		"AWS::ApiGateway::Model": []string{},
+		
+		"AWS::ApiGateway::RequestValidator": []string{},
+		
+		"AWS::ApiGateway::Resource": []string{},
+		
+		"AWS::ApiGateway::RestApi": []string{"RootResourceId", },
+		
+		"AWS::ApiGateway::Stage": []string{},
+		
+		"AWS::ApiGateway::UsagePlan": []string{},
+		
+		"AWS::ApiGateway::UsagePlanKey": []string{},
+		
+		"AWS::ApiGateway::VpcLink": []string{},
+		
+		"AWS::AppSync::ApiKey": []string{"ApiKey", "Arn", },
+		
+		"AWS::AppSync::DataSource": []string{"DataSourceArn", "Name", },
+		
+		"AWS::AppSync::GraphQLApi": []string{"ApiId", "Arn", "GraphQLUrl", },
+		
+		"AWS::AppSync::GraphQLSchema": []string{},
+		
+		"AWS::AppSync::Resolver": []string{"FieldName", "ResolverArn", "TypeName", },
+		
+		"AWS::ApplicationAutoScaling::ScalableTarget": []string{},
+		
+		"AWS::ApplicationAutoScaling::ScalingPolicy": []string{},
+		
+		"AWS::Athena::NamedQuery": []string{},
+		
+		"AWS::AutoScaling::AutoScalingGroup": []string{},
+		
+		"AWS::AutoScaling::LaunchConfiguration": []string{},
+		
+		"AWS::AutoScaling::LifecycleHook": []string{},
+		
+		"AWS::AutoScaling::ScalingPolicy": []string{},
+		
+		"AWS::AutoScaling::ScheduledAction": []string{},
+		
+		"AWS::AutoScalingPlans::ScalingPlan": []string{},
+		
+		"AWS::Batch::ComputeEnvironment": []string{},
+		
+		"AWS::Batch::JobDefinition": []string{},
+		
+		"AWS::Batch::JobQueue": []string{},
+		
+		"AWS::CertificateManager::Certificate": []string{},
+		
+		"AWS::Cloud9::EnvironmentEC2": []string{"Arn", "Name", },
+		
+		"AWS::CloudFormation::CustomResource": []string{},
+		
+		"AWS::CloudFormation::Stack": []string{},
+		
+		"AWS::CloudFormation::WaitCondition": []string{"Data", },
+		
+		"AWS::CloudFormation::WaitConditionHandle": []string{},
+		
+		"AWS::CloudFront::CloudFrontOriginAccessIdentity": []string{"S3CanonicalUserId", },
+		
+		"AWS::CloudFront::Distribution": []string{"DomainName", },
+		
+		"AWS::CloudFront::StreamingDistribution": []string{"DomainName", },
+		
+		"AWS::CloudTrail::Trail": []string{"Arn", "SnsTopicArn", },
+		
+		"AWS::CloudWatch::Alarm": []string{"Arn", },
+		
+		"AWS::CloudWatch::Dashboard": []string{},
+		
+		"AWS::CodeBuild::Project": []string{"Arn", },
+		
+		"AWS::CodeCommit::Repository": []string{"Arn", "CloneUrlHttp", "CloneUrlSsh", "Name", },
+		
+		"AWS::CodeDeploy::Application": []string{},
+		
+		"AWS::CodeDeploy::DeploymentConfig": []string{},
+		
+		"AWS::CodeDeploy::DeploymentGroup": []string{},
+		
+		"AWS::CodePipeline::CustomActionType": []string{},
+		
+		"AWS::CodePipeline::Pipeline": []string{},
+		
+		"AWS::Cognito::IdentityPool": []string{"Name", },
+		
+		"AWS::Cognito::IdentityPoolRoleAttachment": []string{},
+		
+		"AWS::Cognito::UserPool": []string{"Arn", "ProviderName", "ProviderURL", },
+		
+		"AWS::Cognito::UserPoolClient": []string{"ClientSecret", "Name", },
+		
+		"AWS::Cognito::UserPoolGroup": []string{},
+		
+		"AWS::Cognito::UserPoolUser": []string{},
+		
+		"AWS::Cognito::UserPoolUserToGroupAttachment": []string{},
+		
+		"AWS::Config::ConfigRule": []string{"Arn", "Compliance.Type", "ConfigRuleId", },
+		
+		"AWS::Config::ConfigurationRecorder": []string{},
+		
+		"AWS::Config::DeliveryChannel": []string{},
+		
+		"AWS::DAX::Cluster": []string{"Arn", "ClusterDiscoveryEndpoint", },
+		
+		"AWS::DAX::ParameterGroup": []string{},
+		
+		"AWS::DAX::SubnetGroup": []string{},
+		
+		"AWS::DMS::Certificate": []string{},
+		
+		"AWS::DMS::Endpoint": []string{"ExternalId", },
+		
+		"AWS::DMS::EventSubscription": []string{},
+		
+		"AWS::DMS::ReplicationInstance": []string{"ReplicationInstancePrivateIpAddresses", "ReplicationInstancePublicIpAddresses", },
+		
+		"AWS::DMS::ReplicationSubnetGroup": []string{},
+		
+		"AWS::DMS::ReplicationTask": []string{},
+		
+		"AWS::DataPipeline::Pipeline": []string{},
+		
+		"AWS::DirectoryService::MicrosoftAD": []string{"Alias", "DnsIpAddresses", },
+		
+		"AWS::DirectoryService::SimpleAD": []string{"Alias", "DnsIpAddresses", },
+		
+		"AWS::DynamoDB::Table": []string{"Arn", "StreamArn", },
+		
+		"AWS::EC2::CustomerGateway": []string{},
+		
+		"AWS::EC2::DHCPOptions": []string{},
+		
+		"AWS::EC2::EIP": []string{"AllocationId", },
+		
+		"AWS::EC2::EIPAssociation": []string{},
+		
+		"AWS::EC2::EgressOnlyInternetGateway": []string{},
+		
+		"AWS::EC2::FlowLog": []string{},
+		
+		"AWS::EC2::Host": []string{},
+		
+		"AWS::EC2::Instance": []string{"AvailabilityZone", "PrivateDnsName", "PrivateIp", "PublicDnsName", "PublicIp", },
+		
+		"AWS::EC2::InternetGateway": []string{},
+		
+		"AWS::EC2::LaunchTemplate": []string{"DefaultVersionNumber", "LatestVersionNumber", },
+		
+		"AWS::EC2::NatGateway": []string{},
+		
+		"AWS::EC2::NetworkAcl": []string{},
+		
+		"AWS::EC2::NetworkAclEntry": []string{},
+		
+		"AWS::EC2::NetworkInterface": []string{"PrimaryPrivateIpAddress", "SecondaryPrivateIpAddresses", },
+		
+		"AWS::EC2::NetworkInterfaceAttachment": []string{},
+		
+		"AWS::EC2::NetworkInterfacePermission": []string{},
+		
+		"AWS::EC2::PlacementGroup": []string{},
+		
+		"AWS::EC2::Route": []string{},
+		
+		"AWS::EC2::RouteTable": []string{},
+		
+		"AWS::EC2::SecurityGroup": []string{"GroupId", "VpcId", },
+		
+		"AWS::EC2::SecurityGroupEgress": []string{},
+		
+		"AWS::EC2::SecurityGroupIngress": []string{},
+		
+		"AWS::EC2::SpotFleet": []string{},
+		
+		"AWS::EC2::Subnet": []string{"AvailabilityZone", "Ipv6CidrBlocks", "NetworkAclAssociationId", "VpcId", },
+		
+		"AWS::EC2::SubnetCidrBlock": []string{},
+		
+		"AWS::EC2::SubnetNetworkAclAssociation": []string{"AssociationId", },
+		
+		"AWS::EC2::SubnetRouteTableAssociation": []string{},
+		
+		"AWS::EC2::TrunkInterfaceAssociation": []string{},
+		
+		"AWS::EC2::VPC": []string{"CidrBlock", "CidrBlockAssociations", "DefaultNetworkAcl", "DefaultSecurityGroup", "Ipv6CidrBlocks", },
+		
+		"AWS::EC2::VPCCidrBlock": []string{},
+		
+		"AWS::EC2::VPCDHCPOptionsAssociation": []string{},
+		
+		"AWS::EC2::VPCEndpoint": []string{},
+		
+		"AWS::EC2::VPCGatewayAttachment": []string{},
+		
+		"AWS::EC2::VPCPeeringConnection": []string{},
+		
+		"AWS::EC2::VPNConnection": []string{},
+		
+		"AWS::EC2::VPNConnectionRoute": []string{},
+		
+		"AWS::EC2::VPNGateway": []string{},
+		
+		"AWS::EC2::VPNGatewayRoutePropagation": []string{},
+		
+		"AWS::EC2::Volume": []string{},
+		
+		"AWS::EC2::VolumeAttachment": []string{},
+		
+		"AWS::ECR::Repository": []string{"Arn", },
+		
+		"AWS::ECS::Cluster": []string{"Arn", },
+		
+		"AWS::ECS::Service": []string{"Name", },
+		
+		"AWS::ECS::TaskDefinition": []string{},
+		
+		"AWS::EFS::FileSystem": []string{},
+		
+		"AWS::EFS::MountTarget": []string{},
+		
+		"AWS::EMR::Cluster": []string{"MasterPublicDNS", },
+		
+		"AWS::EMR::InstanceFleetConfig": []string{},
+		
+		"AWS::EMR::InstanceGroupConfig": []string{},
+		
+		"AWS::EMR::SecurityConfiguration": []string{},
+		
+		"AWS::EMR::Step": []string{},
+		
+		"AWS::ElastiCache::CacheCluster": []string{"ConfigurationEndpoint.Address", "ConfigurationEndpoint.Port", "RedisEndpoint.Address", "RedisEndpoint.Port", },
+		
+		"AWS::ElastiCache::ParameterGroup": []string{},
+		
+		"AWS::ElastiCache::ReplicationGroup": []string{"ConfigurationEndPoint.Address", "ConfigurationEndPoint.Port", "PrimaryEndPoint.Address", "PrimaryEndPoint.Port", "ReadEndPoint.Addresses", "ReadEndPoint.Addresses.List", "ReadEndPoint.Ports", "ReadEndPoint.Ports.List", },
+		
+		"AWS::ElastiCache::SecurityGroup": []string{},
+		
+		"AWS::ElastiCache::SecurityGroupIngress": []string{},
+		
+		"AWS::ElastiCache::SubnetGroup": []string{},
+		
+		"AWS::ElasticBeanstalk::Application": []string{},
+		
+		"AWS::ElasticBeanstalk::ApplicationVersion": []string{},
+		
+		"AWS::ElasticBeanstalk::ConfigurationTemplate": []string{},
+		
+		"AWS::ElasticBeanstalk::Environment": []string{"EndpointURL", },
+		
+		"AWS::ElasticLoadBalancing::LoadBalancer": []string{"CanonicalHostedZoneName", "CanonicalHostedZoneNameID", "DNSName", "SourceSecurityGroup.GroupName", "SourceSecurityGroup.OwnerAlias", },
+		
+		"AWS::ElasticLoadBalancingV2::Listener": []string{},
+		
+		"AWS::ElasticLoadBalancingV2::ListenerCertificate": []string{},
+		
+		"AWS::ElasticLoadBalancingV2::ListenerRule": []string{},
+		
+		"AWS::ElasticLoadBalancingV2::LoadBalancer": []string{"CanonicalHostedZoneID", "DNSName", "LoadBalancerFullName", "LoadBalancerName", "SecurityGroups", },
+		
+		"AWS::ElasticLoadBalancingV2::TargetGroup": []string{"LoadBalancerArns", "TargetGroupFullName", "TargetGroupName", },
+		
+		"AWS::Elasticsearch::Domain": []string{"Arn", "DomainArn", "DomainEndpoint", },
+		
+		"AWS::Events::Rule": []string{"Arn", },
+		
+		"AWS::GameLift::Alias": []string{},
+		
+		"AWS::GameLift::Build": []string{},
+		
+		"AWS::GameLift::Fleet": []string{},
+		
+		"AWS::Glue::Classifier": []string{},
+		
+		"AWS::Glue::Connection": []string{},
+		
+		"AWS::Glue::Crawler": []string{},
+		
+		"AWS::Glue::Database": []string{},
+		
+		"AWS::Glue::DevEndpoint": []string{},
+		
+		"AWS::Glue::Job": []string{},
+		
+		"AWS::Glue::Partition": []string{},
+		
+		"AWS::Glue::Table": []string{},
+		
+		"AWS::Glue::Trigger": []string{},
+		
+		"AWS::GuardDuty::Detector": []string{},
+		
+		"AWS::GuardDuty::Filter": []string{},
+		
+		"AWS::GuardDuty::IPSet": []string{},
+		
+		"AWS::GuardDuty::Master": []string{},
+		
+		"AWS::GuardDuty::Member": []string{},
+		
+		"AWS::GuardDuty::ThreatIntelSet": []string{},
+		
+		"AWS::IAM::AccessKey": []string{"SecretAccessKey", },
+		
+		"AWS::IAM::Group": []string{"Arn", },
+		
+		"AWS::IAM::InstanceProfile": []string{"Arn", },
+		
+		"AWS::IAM::ManagedPolicy": []string{},
+		
+		"AWS::IAM::Policy": []string{},
+		
+		"AWS::IAM::Role": []string{"Arn", },
+		
+		"AWS::IAM::User": []string{"Arn", },
+		
+		"AWS::IAM::UserToGroupAddition": []string{},
+		
+		"AWS::Inspector::AssessmentTarget": []string{"Arn", },
+		
+		"AWS::Inspector::AssessmentTemplate": []string{"Arn", },
+		
+		"AWS::Inspector::ResourceGroup": []string{"Arn", },
+		
+		"AWS::IoT::Certificate": []string{"Arn", },
+		
+		"AWS::IoT::Policy": []string{"Arn", },
+		
+		"AWS::IoT::PolicyPrincipalAttachment": []string{},
+		
+		"AWS::IoT::Thing": []string{},
+		
+		"AWS::IoT::ThingPrincipalAttachment": []string{},
+		
+		"AWS::IoT::TopicRule": []string{"Arn", },
+		
+		"AWS::KMS::Alias": []string{},
+		
+		"AWS::KMS::Key": []string{"Arn", },
+		
+		"AWS::Kinesis::Stream": []string{"Arn", },
+		
+		"AWS::KinesisAnalytics::Application": []string{},
+		
+		"AWS::KinesisAnalytics::ApplicationOutput": []string{},
+		
+		"AWS::KinesisAnalytics::ApplicationReferenceDataSource": []string{},
+		
+		"AWS::KinesisFirehose::DeliveryStream": []string{"Arn", },
+		
+		"AWS::Lambda::Alias": []string{},
+		
+		"AWS::Lambda::EventSourceMapping": []string{},
+		
+		"AWS::Lambda::Function": []string{"Arn", },
+		
+		"AWS::Lambda::Permission": []string{},
+		
+		"AWS::Lambda::Version": []string{"Version", },
+		
+		"AWS::Logs::Destination": []string{"Arn", },
+		
+		"AWS::Logs::LogGroup": []string{"Arn", },
+		
+		"AWS::Logs::LogStream": []string{},
+		
+		"AWS::Logs::MetricFilter": []string{},
+		
+		"AWS::Logs::SubscriptionFilter": []string{},
+		
+		"AWS::OpsWorks::App": []string{},
+		
+		"AWS::OpsWorks::ElasticLoadBalancerAttachment": []string{},
+		
+		"AWS::OpsWorks::Instance": []string{"AvailabilityZone", "PrivateDnsName", "PrivateIp", "PublicDnsName", "PublicIp", },
+		
+		"AWS::OpsWorks::Layer": []string{},
+		
+		"AWS::OpsWorks::Stack": []string{},
+		
+		"AWS::OpsWorks::UserProfile": []string{"SshUsername", },
+		
+		"AWS::OpsWorks::Volume": []string{},
+		
+		"AWS::RDS::DBCluster": []string{"Endpoint.Address", "Endpoint.Port", "ReadEndpoint.Address", },
+		
+		"AWS::RDS::DBClusterParameterGroup": []string{},
+		
+		"AWS::RDS::DBInstance": []string{"Endpoint.Address", "Endpoint.Port", },
+		
+		"AWS::RDS::DBParameterGroup": []string{},
+		
+		"AWS::RDS::DBSecurityGroup": []string{},
+		
+		"AWS::RDS::DBSecurityGroupIngress": []string{},
+		
+		"AWS::RDS::DBSubnetGroup": []string{},
+		
+		"AWS::RDS::EventSubscription": []string{},
+		
+		"AWS::RDS::OptionGroup": []string{},
+		
+		"AWS::Redshift::Cluster": []string{"Endpoint.Address", "Endpoint.Port", },
+		
+		"AWS::Redshift::ClusterParameterGroup": []string{},
+		
+		"AWS::Redshift::ClusterSecurityGroup": []string{},
+		
+		"AWS::Redshift::ClusterSecurityGroupIngress": []string{},
+		
+		"AWS::Redshift::ClusterSubnetGroup": []string{},
+		
+		"AWS::Route53::HealthCheck": []string{},
+		
+		"AWS::Route53::HostedZone": []string{"NameServers", },
+		
+		"AWS::Route53::RecordSet": []string{},
+		
+		"AWS::Route53::RecordSetGroup": []string{},
+		
+		"AWS::S3::Bucket": []string{"Arn", "DomainName", "DualStackDomainName", "WebsiteURL", },
+		
+		"AWS::S3::BucketPolicy": []string{},
+		
+		"AWS::SDB::Domain": []string{},
+		
+		"AWS::SES::ConfigurationSet": []string{},
+		
+		"AWS::SES::ConfigurationSetEventDestination": []string{},
+		
+		"AWS::SES::ReceiptFilter": []string{},
+		
+		"AWS::SES::ReceiptRule": []string{},
+		
+		"AWS::SES::ReceiptRuleSet": []string{},
+		
+		"AWS::SES::Template": []string{},
+		
+		"AWS::SNS::Subscription": []string{},
+		
+		"AWS::SNS::Topic": []string{"TopicName", },
+		
+		"AWS::SNS::TopicPolicy": []string{},
+		
+		"AWS::SQS::Queue": []string{"Arn", "QueueName", },
+		
+		"AWS::SQS::QueuePolicy": []string{},
+		
+		"AWS::SSM::Association": []string{},
+		
+		"AWS::SSM::Document": []string{},
+		
+		"AWS::SSM::MaintenanceWindowTask": []string{},
+		
+		"AWS::SSM::Parameter": []string{"Type", "Value", },
+		
+		"AWS::SSM::PatchBaseline": []string{},
+		
+		"AWS::ServiceCatalog::CloudFormationProvisionedProduct": []string{"CloudformationStackArn", "RecordId", },
+		
+		"AWS::ServiceDiscovery::Instance": []string{},
+		
+		"AWS::ServiceDiscovery::PrivateDnsNamespace": []string{"Arn", "Id", },
+		
+		"AWS::ServiceDiscovery::PublicDnsNamespace": []string{"Arn", "Id", },
+		
+		"AWS::ServiceDiscovery::Service": []string{"Arn", "Id", "Name", },
+		
+		"AWS::StepFunctions::Activity": []string{"Name", },
+		
+		"AWS::StepFunctions::StateMachine": []string{"Name", },
+		
+		"AWS::WAF::ByteMatchSet": []string{},
+		
+		"AWS::WAF::IPSet": []string{},
+		
+		"AWS::WAF::Rule": []string{},
+		
+		"AWS::WAF::SizeConstraintSet": []string{},
+		
+		"AWS::WAF::SqlInjectionMatchSet": []string{},
+		
+		"AWS::WAF::WebACL": []string{},
+		
+		"AWS::WAF::XssMatchSet": []string{},
+		
+		"AWS::WAFRegional::ByteMatchSet": []string{},
+		
+		"AWS::WAFRegional::IPSet": []string{},
+		
+		"AWS::WAFRegional::Rule": []string{},
+		
+		"AWS::WAFRegional::SizeConstraintSet": []string{},
+		
+		"AWS::WAFRegional::SqlInjectionMatchSet": []string{},
+		
+		"AWS::WAFRegional::WebACL": []string{},
+		
+		"AWS::WAFRegional::WebACLAssociation": []string{},
+		
+		"AWS::WAFRegional::XssMatchSet": []string{},
+		
+		"AWS::WorkSpaces::Workspace": []string{},
+		
+	}
+}
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Alarm_Dimension struct {
 	
 	
 	Name interface{} `yaml:"Name"`
 	Value interface{} `yaml:"Value"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Alarm_Dimension) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Alarm_Dimension
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Alarm_Dimension) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Alarm_Dimension
+	return plain(resource), nil
 }
 
 func (resource Alarm_Dimension) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Value == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Value'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Value", resource.Value)...)
 	return errs
 }
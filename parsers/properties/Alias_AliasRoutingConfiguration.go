@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Alias_AliasRoutingConfiguration struct {
 	
 	AdditionalVersionWeights interface{} `yaml:"AdditionalVersionWeights"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Alias_AliasRoutingConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Alias_AliasRoutingConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Alias_AliasRoutingConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Alias_AliasRoutingConfiguration
+	return plain(resource), nil
 }
 
 func (resource Alias_AliasRoutingConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.AdditionalVersionWeights == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AdditionalVersionWeights'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Alias_VersionWeight{} }))("AdditionalVersionWeights", resource.AdditionalVersionWeights)...)
 	return errs
 }
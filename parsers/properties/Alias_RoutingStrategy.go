@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Alias_RoutingStrategy struct {
 	
@@ -9,15 +13,44 @@ type Alias_RoutingStrategy struct {
 	FleetId interface{} `yaml:"FleetId,omitempty"`
 	Message interface{} `yaml:"Message,omitempty"`
 	Type interface{} `yaml:"Type"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Alias_RoutingStrategy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Alias_RoutingStrategy
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Alias_RoutingStrategy) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Alias_RoutingStrategy
+	return plain(resource), nil
 }
 
 func (resource Alias_RoutingStrategy) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("FleetId", resource.FleetId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Message", resource.Message)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	return errs
 }
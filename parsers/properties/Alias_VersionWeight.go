@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Alias_VersionWeight struct {
 	
 	
 	FunctionVersion interface{} `yaml:"FunctionVersion"`
 	FunctionWeight interface{} `yaml:"FunctionWeight"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Alias_VersionWeight) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Alias_VersionWeight
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Alias_VersionWeight) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Alias_VersionWeight
+	return plain(resource), nil
 }
 
 func (resource Alias_VersionWeight) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.FunctionVersion == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FunctionVersion'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("FunctionVersion", resource.FunctionVersion)...)
 	if resource.FunctionWeight == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FunctionWeight'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Double")("FunctionWeight", resource.FunctionWeight)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ApiKey_StageKey struct {
 	
 	
 	RestApiId interface{} `yaml:"RestApiId,omitempty"`
 	StageName interface{} `yaml:"StageName,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ApiKey_StageKey) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ApiKey_StageKey
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ApiKey_StageKey) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ApiKey_StageKey
+	return plain(resource), nil
 }
 
 func (resource ApiKey_StageKey) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("RestApiId", resource.RestApiId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("StageName", resource.StageName)...)
 	return errs
 }
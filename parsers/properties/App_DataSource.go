@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type App_DataSource struct {
 	
 	
@@ -8,12 +12,42 @@ type App_DataSource struct {
 	Arn interface{} `yaml:"Arn,omitempty"`
 	DatabaseName interface{} `yaml:"DatabaseName,omitempty"`
 	Type interface{} `yaml:"Type,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *App_DataSource) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain App_DataSource
+	return unmarshal((*plain)(resource))
+}
+
+func (resource App_DataSource) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain App_DataSource
+	return plain(resource), nil
 }
 
 func (resource App_DataSource) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Arn", resource.Arn)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DatabaseName", resource.DatabaseName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	return errs
 }
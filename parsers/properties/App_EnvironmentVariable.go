@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type App_EnvironmentVariable struct {
 	
@@ -9,18 +13,46 @@ type App_EnvironmentVariable struct {
 	Key interface{} `yaml:"Key"`
 	Secure interface{} `yaml:"Secure,omitempty"`
 	Value interface{} `yaml:"Value"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *App_EnvironmentVariable) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain App_EnvironmentVariable
+	return unmarshal((*plain)(resource))
+}
+
+func (resource App_EnvironmentVariable) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain App_EnvironmentVariable
+	return plain(resource), nil
 }
 
 func (resource App_EnvironmentVariable) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Key == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Key'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Key", resource.Key)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Secure", resource.Secure)...)
 	if resource.Value == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Value'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Value", resource.Value)...)
 	return errs
 }
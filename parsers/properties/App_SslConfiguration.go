@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type App_SslConfiguration struct {
 	
 	
@@ -8,12 +12,42 @@ type App_SslConfiguration struct {
 	Certificate interface{} `yaml:"Certificate,omitempty"`
 	Chain interface{} `yaml:"Chain,omitempty"`
 	PrivateKey interface{} `yaml:"PrivateKey,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *App_SslConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain App_SslConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource App_SslConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain App_SslConfiguration
+	return plain(resource), nil
 }
 
 func (resource App_SslConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Certificate", resource.Certificate)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Chain", resource.Chain)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("PrivateKey", resource.PrivateKey)...)
 	return errs
 }
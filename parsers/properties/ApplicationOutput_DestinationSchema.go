@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ApplicationOutput_DestinationSchema struct {
 	
 	RecordFormatType interface{} `yaml:"RecordFormatType,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ApplicationOutput_DestinationSchema) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ApplicationOutput_DestinationSchema
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ApplicationOutput_DestinationSchema) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ApplicationOutput_DestinationSchema
+	return plain(resource), nil
 }
 
 func (resource ApplicationOutput_DestinationSchema) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("RecordFormatType", resource.RecordFormatType)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ApplicationOutput_KinesisFirehoseOutput struct {
 	
 	
 	ResourceARN interface{} `yaml:"ResourceARN"`
 	RoleARN interface{} `yaml:"RoleARN"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ApplicationOutput_KinesisFirehoseOutput) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ApplicationOutput_KinesisFirehoseOutput
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ApplicationOutput_KinesisFirehoseOutput) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ApplicationOutput_KinesisFirehoseOutput
+	return plain(resource), nil
 }
 
 func (resource ApplicationOutput_KinesisFirehoseOutput) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.ResourceARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ResourceARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ResourceARN", resource.ResourceARN)...)
 	if resource.RoleARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleARN", resource.RoleARN)...)
 	return errs
 }
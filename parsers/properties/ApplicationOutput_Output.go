@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ApplicationOutput_Output struct {
 	
@@ -13,19 +17,58 @@ type ApplicationOutput_Output struct {
 	KinesisStreamsOutput *ApplicationOutput_KinesisStreamsOutput `yaml:"KinesisStreamsOutput,omitempty"`
 	KinesisFirehoseOutput *ApplicationOutput_KinesisFirehoseOutput `yaml:"KinesisFirehoseOutput,omitempty"`
 	DestinationSchema *ApplicationOutput_DestinationSchema `yaml:"DestinationSchema"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ApplicationOutput_Output) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ApplicationOutput_Output
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ApplicationOutput_Output) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ApplicationOutput_Output
+	return plain(resource), nil
 }
 
 func (resource ApplicationOutput_Output) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	if resource.LambdaOutput != nil {
+		errs = append(errs, types.PrefixErrors("LambdaOutput", resource.LambdaOutput.Validate())...)
+	}
+	
+	if resource.KinesisStreamsOutput != nil {
+		errs = append(errs, types.PrefixErrors("KinesisStreamsOutput", resource.KinesisStreamsOutput.Validate())...)
+	}
+	
+	if resource.KinesisFirehoseOutput != nil {
+		errs = append(errs, types.PrefixErrors("KinesisFirehoseOutput", resource.KinesisFirehoseOutput.Validate())...)
+	}
 	if resource.DestinationSchema == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DestinationSchema'"))
-	} else {
-		errs = append(errs, resource.DestinationSchema.Validate()...)
+	}
+	if resource.DestinationSchema != nil {
+		errs = append(errs, types.PrefixErrors("DestinationSchema", resource.DestinationSchema.Validate())...)
 	}
 	return errs
 }
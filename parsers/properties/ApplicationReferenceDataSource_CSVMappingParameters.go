@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ApplicationReferenceDataSource_CSVMappingParameters struct {
 	
 	
 	RecordColumnDelimiter interface{} `yaml:"RecordColumnDelimiter"`
 	RecordRowDelimiter interface{} `yaml:"RecordRowDelimiter"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ApplicationReferenceDataSource_CSVMappingParameters) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ApplicationReferenceDataSource_CSVMappingParameters
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ApplicationReferenceDataSource_CSVMappingParameters) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ApplicationReferenceDataSource_CSVMappingParameters
+	return plain(resource), nil
 }
 
 func (resource ApplicationReferenceDataSource_CSVMappingParameters) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.RecordColumnDelimiter == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RecordColumnDelimiter'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RecordColumnDelimiter", resource.RecordColumnDelimiter)...)
 	if resource.RecordRowDelimiter == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RecordRowDelimiter'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RecordRowDelimiter", resource.RecordRowDelimiter)...)
 	return errs
 }
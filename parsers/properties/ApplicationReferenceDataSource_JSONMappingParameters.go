@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ApplicationReferenceDataSource_JSONMappingParameters struct {
 	
 	RecordRowPath interface{} `yaml:"RecordRowPath"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ApplicationReferenceDataSource_JSONMappingParameters) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ApplicationReferenceDataSource_JSONMappingParameters
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ApplicationReferenceDataSource_JSONMappingParameters) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ApplicationReferenceDataSource_JSONMappingParameters
+	return plain(resource), nil
 }
 
 func (resource ApplicationReferenceDataSource_JSONMappingParameters) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.RecordRowPath == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RecordRowPath'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RecordRowPath", resource.RecordRowPath)...)
 	return errs
 }
@@ -1,16 +1,52 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ApplicationReferenceDataSource_MappingParameters struct {
 	
 	
 	JSONMappingParameters *ApplicationReferenceDataSource_JSONMappingParameters `yaml:"JSONMappingParameters,omitempty"`
 	CSVMappingParameters *ApplicationReferenceDataSource_CSVMappingParameters `yaml:"CSVMappingParameters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ApplicationReferenceDataSource_MappingParameters) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ApplicationReferenceDataSource_MappingParameters
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ApplicationReferenceDataSource_MappingParameters) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ApplicationReferenceDataSource_MappingParameters
+	return plain(resource), nil
 }
 
 func (resource ApplicationReferenceDataSource_MappingParameters) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	if resource.JSONMappingParameters != nil {
+		errs = append(errs, types.PrefixErrors("JSONMappingParameters", resource.JSONMappingParameters.Validate())...)
+	}
 	
+	if resource.CSVMappingParameters != nil {
+		errs = append(errs, types.PrefixErrors("CSVMappingParameters", resource.CSVMappingParameters.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ApplicationReferenceDataSource_RecordColumn struct {
 	
@@ -9,18 +13,46 @@ type ApplicationReferenceDataSource_RecordColumn struct {
 	Mapping interface{} `yaml:"Mapping,omitempty"`
 	Name interface{} `yaml:"Name"`
 	SqlType interface{} `yaml:"SqlType"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ApplicationReferenceDataSource_RecordColumn) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ApplicationReferenceDataSource_RecordColumn
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ApplicationReferenceDataSource_RecordColumn) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ApplicationReferenceDataSource_RecordColumn
+	return plain(resource), nil
 }
 
 func (resource ApplicationReferenceDataSource_RecordColumn) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Mapping", resource.Mapping)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.SqlType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SqlType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("SqlType", resource.SqlType)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ApplicationReferenceDataSource_ReferenceDataSource struct {
 	
@@ -9,17 +13,48 @@ type ApplicationReferenceDataSource_ReferenceDataSource struct {
 	TableName interface{} `yaml:"TableName,omitempty"`
 	S3ReferenceDataSource *ApplicationReferenceDataSource_S3ReferenceDataSource `yaml:"S3ReferenceDataSource,omitempty"`
 	ReferenceSchema *ApplicationReferenceDataSource_ReferenceSchema `yaml:"ReferenceSchema"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ApplicationReferenceDataSource_ReferenceDataSource) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ApplicationReferenceDataSource_ReferenceDataSource
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ApplicationReferenceDataSource_ReferenceDataSource) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ApplicationReferenceDataSource_ReferenceDataSource
+	return plain(resource), nil
 }
 
 func (resource ApplicationReferenceDataSource_ReferenceDataSource) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("TableName", resource.TableName)...)
+	
+	if resource.S3ReferenceDataSource != nil {
+		errs = append(errs, types.PrefixErrors("S3ReferenceDataSource", resource.S3ReferenceDataSource.Validate())...)
+	}
 	if resource.ReferenceSchema == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ReferenceSchema'"))
-	} else {
-		errs = append(errs, resource.ReferenceSchema.Validate()...)
+	}
+	if resource.ReferenceSchema != nil {
+		errs = append(errs, types.PrefixErrors("ReferenceSchema", resource.ReferenceSchema.Validate())...)
 	}
 	return errs
 }
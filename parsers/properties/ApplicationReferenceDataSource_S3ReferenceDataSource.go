@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ApplicationReferenceDataSource_S3ReferenceDataSource struct {
 	
@@ -9,21 +13,48 @@ type ApplicationReferenceDataSource_S3ReferenceDataSource struct {
 	BucketARN interface{} `yaml:"BucketARN"`
 	FileKey interface{} `yaml:"FileKey"`
 	ReferenceRoleARN interface{} `yaml:"ReferenceRoleARN"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ApplicationReferenceDataSource_S3ReferenceDataSource) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ApplicationReferenceDataSource_S3ReferenceDataSource
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ApplicationReferenceDataSource_S3ReferenceDataSource) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ApplicationReferenceDataSource_S3ReferenceDataSource
+	return plain(resource), nil
 }
 
 func (resource ApplicationReferenceDataSource_S3ReferenceDataSource) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.BucketARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BucketARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("BucketARN", resource.BucketARN)...)
 	if resource.FileKey == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FileKey'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("FileKey", resource.FileKey)...)
 	if resource.ReferenceRoleARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ReferenceRoleARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ReferenceRoleARN", resource.ReferenceRoleARN)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ApplicationVersion_SourceBundle struct {
 	
 	
 	S3Bucket interface{} `yaml:"S3Bucket"`
 	S3Key interface{} `yaml:"S3Key"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ApplicationVersion_SourceBundle) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ApplicationVersion_SourceBundle
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ApplicationVersion_SourceBundle) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ApplicationVersion_SourceBundle
+	return plain(resource), nil
 }
 
 func (resource ApplicationVersion_SourceBundle) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.S3Bucket == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'S3Bucket'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("S3Bucket", resource.S3Bucket)...)
 	if resource.S3Key == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'S3Key'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("S3Key", resource.S3Key)...)
 	return errs
 }
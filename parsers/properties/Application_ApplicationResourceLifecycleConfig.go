@@ -1,16 +1,50 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Application_ApplicationResourceLifecycleConfig struct {
 	
 	
 	ServiceRole interface{} `yaml:"ServiceRole,omitempty"`
 	VersionLifecycleConfig *Application_ApplicationVersionLifecycleConfig `yaml:"VersionLifecycleConfig,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Application_ApplicationResourceLifecycleConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Application_ApplicationResourceLifecycleConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Application_ApplicationResourceLifecycleConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Application_ApplicationResourceLifecycleConfig
+	return plain(resource), nil
 }
 
 func (resource Application_ApplicationResourceLifecycleConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceRole", resource.ServiceRole)...)
 	
+	if resource.VersionLifecycleConfig != nil {
+		errs = append(errs, types.PrefixErrors("VersionLifecycleConfig", resource.VersionLifecycleConfig.Validate())...)
+	}
 	return errs
 }
@@ -1,16 +1,52 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Application_ApplicationVersionLifecycleConfig struct {
 	
 	
 	MaxCountRule *Application_MaxCountRule `yaml:"MaxCountRule,omitempty"`
 	MaxAgeRule *Application_MaxAgeRule `yaml:"MaxAgeRule,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Application_ApplicationVersionLifecycleConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Application_ApplicationVersionLifecycleConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Application_ApplicationVersionLifecycleConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Application_ApplicationVersionLifecycleConfig
+	return plain(resource), nil
 }
 
 func (resource Application_ApplicationVersionLifecycleConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	if resource.MaxCountRule != nil {
+		errs = append(errs, types.PrefixErrors("MaxCountRule", resource.MaxCountRule.Validate())...)
+	}
 	
+	if resource.MaxAgeRule != nil {
+		errs = append(errs, types.PrefixErrors("MaxAgeRule", resource.MaxAgeRule.Validate())...)
+	}
 	return errs
 }
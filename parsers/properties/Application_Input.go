@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Application_Input struct {
 	
@@ -15,10 +19,34 @@ type Application_Input struct {
 	InputSchema *Application_InputSchema `yaml:"InputSchema"`
 	InputProcessingConfiguration *Application_InputProcessingConfiguration `yaml:"InputProcessingConfiguration,omitempty"`
 	InputParallelism *Application_InputParallelism `yaml:"InputParallelism,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Application_Input) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Application_Input
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Application_Input) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Application_Input
+	return plain(resource), nil
 }
 
 func (resource Application_Input) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -28,10 +56,28 @@ func (resource Application_Input) Validate() []error {
 	if resource.NamePrefix == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'NamePrefix'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("NamePrefix", resource.NamePrefix)...)
+	
+	if resource.KinesisStreamsInput != nil {
+		errs = append(errs, types.PrefixErrors("KinesisStreamsInput", resource.KinesisStreamsInput.Validate())...)
+	}
+	
+	if resource.KinesisFirehoseInput != nil {
+		errs = append(errs, types.PrefixErrors("KinesisFirehoseInput", resource.KinesisFirehoseInput.Validate())...)
+	}
 	if resource.InputSchema == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InputSchema'"))
-	} else {
-		errs = append(errs, resource.InputSchema.Validate()...)
+	}
+	if resource.InputSchema != nil {
+		errs = append(errs, types.PrefixErrors("InputSchema", resource.InputSchema.Validate())...)
+	}
+	
+	if resource.InputProcessingConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("InputProcessingConfiguration", resource.InputProcessingConfiguration.Validate())...)
+	}
+	
+	if resource.InputParallelism != nil {
+		errs = append(errs, types.PrefixErrors("InputParallelism", resource.InputParallelism.Validate())...)
 	}
 	return errs
 }
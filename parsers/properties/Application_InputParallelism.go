@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Application_InputParallelism struct {
 	
 	Count interface{} `yaml:"Count,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Application_InputParallelism) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Application_InputParallelism
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Application_InputParallelism) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Application_InputParallelism
+	return plain(resource), nil
 }
 
 func (resource Application_InputParallelism) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Count", resource.Count)...)
 	return errs
 }
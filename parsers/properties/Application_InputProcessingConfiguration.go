@@ -1,13 +1,45 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Application_InputProcessingConfiguration struct {
 	
 	InputLambdaProcessor *Application_InputLambdaProcessor `yaml:"InputLambdaProcessor,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Application_InputProcessingConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Application_InputProcessingConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Application_InputProcessingConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Application_InputProcessingConfiguration
+	return plain(resource), nil
 }
 
 func (resource Application_InputProcessingConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	if resource.InputLambdaProcessor != nil {
+		errs = append(errs, types.PrefixErrors("InputLambdaProcessor", resource.InputLambdaProcessor.Validate())...)
+	}
 	return errs
 }
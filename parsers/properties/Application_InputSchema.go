@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Application_InputSchema struct {
 	
@@ -9,20 +13,48 @@ type Application_InputSchema struct {
 	RecordEncoding interface{} `yaml:"RecordEncoding,omitempty"`
 	RecordFormat *Application_RecordFormat `yaml:"RecordFormat"`
 	RecordColumns interface{} `yaml:"RecordColumns"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Application_InputSchema) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Application_InputSchema
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Application_InputSchema) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Application_InputSchema
+	return plain(resource), nil
 }
 
 func (resource Application_InputSchema) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RecordEncoding", resource.RecordEncoding)...)
 	if resource.RecordFormat == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RecordFormat'"))
-	} else {
-		errs = append(errs, resource.RecordFormat.Validate()...)
+	}
+	if resource.RecordFormat != nil {
+		errs = append(errs, types.PrefixErrors("RecordFormat", resource.RecordFormat.Validate())...)
 	}
 	if resource.RecordColumns == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RecordColumns'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Application_RecordColumn{} }))("RecordColumns", resource.RecordColumns)...)
 	return errs
 }
@@ -1,16 +1,52 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Application_MappingParameters struct {
 	
 	
 	JSONMappingParameters *Application_JSONMappingParameters `yaml:"JSONMappingParameters,omitempty"`
 	CSVMappingParameters *Application_CSVMappingParameters `yaml:"CSVMappingParameters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Application_MappingParameters) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Application_MappingParameters
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Application_MappingParameters) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Application_MappingParameters
+	return plain(resource), nil
 }
 
 func (resource Application_MappingParameters) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	if resource.JSONMappingParameters != nil {
+		errs = append(errs, types.PrefixErrors("JSONMappingParameters", resource.JSONMappingParameters.Validate())...)
+	}
 	
+	if resource.CSVMappingParameters != nil {
+		errs = append(errs, types.PrefixErrors("CSVMappingParameters", resource.CSVMappingParameters.Validate())...)
+	}
 	return errs
 }
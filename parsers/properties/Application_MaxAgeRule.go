@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Application_MaxAgeRule struct {
 	
 	
@@ -8,12 +12,42 @@ type Application_MaxAgeRule struct {
 	DeleteSourceFromS3 interface{} `yaml:"DeleteSourceFromS3,omitempty"`
 	Enabled interface{} `yaml:"Enabled,omitempty"`
 	MaxAgeInDays interface{} `yaml:"MaxAgeInDays,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Application_MaxAgeRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Application_MaxAgeRule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Application_MaxAgeRule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Application_MaxAgeRule
+	return plain(resource), nil
 }
 
 func (resource Application_MaxAgeRule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DeleteSourceFromS3", resource.DeleteSourceFromS3)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MaxAgeInDays", resource.MaxAgeInDays)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Application_MaxCountRule struct {
 	
 	
@@ -8,12 +12,42 @@ type Application_MaxCountRule struct {
 	DeleteSourceFromS3 interface{} `yaml:"DeleteSourceFromS3,omitempty"`
 	Enabled interface{} `yaml:"Enabled,omitempty"`
 	MaxCount interface{} `yaml:"MaxCount,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Application_MaxCountRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Application_MaxCountRule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Application_MaxCountRule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Application_MaxCountRule
+	return plain(resource), nil
 }
 
 func (resource Application_MaxCountRule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DeleteSourceFromS3", resource.DeleteSourceFromS3)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MaxCount", resource.MaxCount)...)
 	return errs
 }
@@ -1,20 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Application_RecordFormat struct {
 	
 	
 	RecordFormatType interface{} `yaml:"RecordFormatType"`
 	MappingParameters *Application_MappingParameters `yaml:"MappingParameters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Application_RecordFormat) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Application_RecordFormat
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Application_RecordFormat) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Application_RecordFormat
+	return plain(resource), nil
 }
 
 func (resource Application_RecordFormat) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.RecordFormatType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RecordFormatType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RecordFormatType", resource.RecordFormatType)...)
+	
+	if resource.MappingParameters != nil {
+		errs = append(errs, types.PrefixErrors("MappingParameters", resource.MappingParameters.Validate())...)
+	}
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Association_ParameterValues struct {
 	
 	ParameterValues interface{} `yaml:"ParameterValues"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Association_ParameterValues) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Association_ParameterValues
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Association_ParameterValues) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Association_ParameterValues
+	return plain(resource), nil
 }
 
 func (resource Association_ParameterValues) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.ParameterValues == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ParameterValues'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ParameterValues", resource.ParameterValues)...)
 	return errs
 }
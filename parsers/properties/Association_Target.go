@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Association_Target struct {
 	
 	
 	Key interface{} `yaml:"Key"`
 	Values interface{} `yaml:"Values"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Association_Target) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Association_Target
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Association_Target) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Association_Target
+	return plain(resource), nil
 }
 
 func (resource Association_Target) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Key == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Key'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Key", resource.Key)...)
 	if resource.Values == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Values'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Values", resource.Values)...)
 	return errs
 }
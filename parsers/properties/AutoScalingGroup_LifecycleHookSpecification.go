@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type AutoScalingGroup_LifecycleHookSpecification struct {
 	
@@ -17,22 +21,58 @@ type AutoScalingGroup_LifecycleHookSpecification struct {
 	NotificationMetadata interface{} `yaml:"NotificationMetadata,omitempty"`
 	NotificationTargetARN interface{} `yaml:"NotificationTargetARN,omitempty"`
 	RoleARN interface{} `yaml:"RoleARN,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *AutoScalingGroup_LifecycleHookSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain AutoScalingGroup_LifecycleHookSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource AutoScalingGroup_LifecycleHookSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain AutoScalingGroup_LifecycleHookSpecification
+	return plain(resource), nil
 }
 
 func (resource AutoScalingGroup_LifecycleHookSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
 	
 	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DefaultResult", resource.DefaultResult)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("HeartbeatTimeout", resource.HeartbeatTimeout)...)
 	if resource.LifecycleHookName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'LifecycleHookName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("LifecycleHookName", resource.LifecycleHookName)...)
 	if resource.LifecycleTransition == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'LifecycleTransition'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("LifecycleTransition", resource.LifecycleTransition)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NotificationMetadata", resource.NotificationMetadata)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NotificationTargetARN", resource.NotificationTargetARN)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RoleARN", resource.RoleARN)...)
 	return errs
 }
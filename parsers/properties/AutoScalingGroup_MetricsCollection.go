@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type AutoScalingGroup_MetricsCollection struct {
 	
 	
 	Granularity interface{} `yaml:"Granularity"`
 	Metrics interface{} `yaml:"Metrics,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *AutoScalingGroup_MetricsCollection) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain AutoScalingGroup_MetricsCollection
+	return unmarshal((*plain)(resource))
+}
+
+func (resource AutoScalingGroup_MetricsCollection) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain AutoScalingGroup_MetricsCollection
+	return plain(resource), nil
 }
 
 func (resource AutoScalingGroup_MetricsCollection) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Granularity == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Granularity'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Granularity", resource.Granularity)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Metrics", resource.Metrics)...)
 	return errs
 }
@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type AutoScalingGroup_NotificationConfiguration struct {
 	
 	
 	TopicARN interface{} `yaml:"TopicARN"`
 	NotificationTypes interface{} `yaml:"NotificationTypes,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *AutoScalingGroup_NotificationConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain AutoScalingGroup_NotificationConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource AutoScalingGroup_NotificationConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain AutoScalingGroup_NotificationConfiguration
+	return plain(resource), nil
 }
 
 func (resource AutoScalingGroup_NotificationConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.TopicARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TopicARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TopicARN", resource.TopicARN)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("NotificationTypes", resource.NotificationTypes)...)
 	return errs
 }
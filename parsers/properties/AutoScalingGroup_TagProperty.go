@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type AutoScalingGroup_TagProperty struct {
 	
@@ -9,21 +13,48 @@ type AutoScalingGroup_TagProperty struct {
 	Key interface{} `yaml:"Key"`
 	PropagateAtLaunch interface{} `yaml:"PropagateAtLaunch"`
 	Value interface{} `yaml:"Value"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *AutoScalingGroup_TagProperty) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain AutoScalingGroup_TagProperty
+	return unmarshal((*plain)(resource))
+}
+
+func (resource AutoScalingGroup_TagProperty) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain AutoScalingGroup_TagProperty
+	return plain(resource), nil
 }
 
 func (resource AutoScalingGroup_TagProperty) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Key == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Key'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Key", resource.Key)...)
 	if resource.PropagateAtLaunch == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PropagateAtLaunch'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("PropagateAtLaunch", resource.PropagateAtLaunch)...)
 	if resource.Value == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Value'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Value", resource.Value)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_AbortIncompleteMultipartUpload struct {
 	
 	DaysAfterInitiation interface{} `yaml:"DaysAfterInitiation"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_AbortIncompleteMultipartUpload) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_AbortIncompleteMultipartUpload
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_AbortIncompleteMultipartUpload) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_AbortIncompleteMultipartUpload
+	return plain(resource), nil
 }
 
 func (resource Bucket_AbortIncompleteMultipartUpload) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.DaysAfterInitiation == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DaysAfterInitiation'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("DaysAfterInitiation", resource.DaysAfterInitiation)...)
 	return errs
 }
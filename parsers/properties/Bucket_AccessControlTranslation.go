@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_AccessControlTranslation struct {
 	
 	Owner interface{} `yaml:"Owner"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_AccessControlTranslation) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_AccessControlTranslation
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_AccessControlTranslation) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_AccessControlTranslation
+	return plain(resource), nil
 }
 
 func (resource Bucket_AccessControlTranslation) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.Owner == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Owner'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Owner", resource.Owner)...)
 	return errs
 }
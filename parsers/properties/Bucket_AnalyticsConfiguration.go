@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_AnalyticsConfiguration struct {
 	
@@ -11,10 +15,34 @@ type Bucket_AnalyticsConfiguration struct {
 	Prefix interface{} `yaml:"Prefix,omitempty"`
 	StorageClassAnalysis *Bucket_StorageClassAnalysis `yaml:"StorageClassAnalysis"`
 	TagFilters interface{} `yaml:"TagFilters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_AnalyticsConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_AnalyticsConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_AnalyticsConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_AnalyticsConfiguration
+	return plain(resource), nil
 }
 
 func (resource Bucket_AnalyticsConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -22,10 +50,16 @@ func (resource Bucket_AnalyticsConfiguration) Validate() []error {
 	if resource.Id == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Id'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Id", resource.Id)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Prefix", resource.Prefix)...)
 	if resource.StorageClassAnalysis == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StorageClassAnalysis'"))
-	} else {
-		errs = append(errs, resource.StorageClassAnalysis.Validate()...)
 	}
+	if resource.StorageClassAnalysis != nil {
+		errs = append(errs, types.PrefixErrors("StorageClassAnalysis", resource.StorageClassAnalysis.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Bucket_TagFilter{} }))("TagFilters", resource.TagFilters)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_BucketEncryption struct {
 	
 	ServerSideEncryptionConfiguration interface{} `yaml:"ServerSideEncryptionConfiguration"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_BucketEncryption) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_BucketEncryption
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_BucketEncryption) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_BucketEncryption
+	return plain(resource), nil
 }
 
 func (resource Bucket_BucketEncryption) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.ServerSideEncryptionConfiguration == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ServerSideEncryptionConfiguration'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Bucket_ServerSideEncryptionRule{} }))("ServerSideEncryptionConfiguration", resource.ServerSideEncryptionConfiguration)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_CorsConfiguration struct {
 	
 	CorsRules interface{} `yaml:"CorsRules"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_CorsConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_CorsConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_CorsConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_CorsConfiguration
+	return plain(resource), nil
 }
 
 func (resource Bucket_CorsConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.CorsRules == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CorsRules'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Bucket_CorsRule{} }))("CorsRules", resource.CorsRules)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_CorsRule struct {
 	
@@ -15,21 +19,55 @@ type Bucket_CorsRule struct {
 	AllowedMethods interface{} `yaml:"AllowedMethods"`
 	AllowedOrigins interface{} `yaml:"AllowedOrigins"`
 	ExposedHeaders interface{} `yaml:"ExposedHeaders,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_CorsRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_CorsRule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_CorsRule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_CorsRule
+	return plain(resource), nil
 }
 
 func (resource Bucket_CorsRule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Id", resource.Id)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MaxAge", resource.MaxAge)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AllowedHeaders", resource.AllowedHeaders)...)
 	if resource.AllowedMethods == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AllowedMethods'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AllowedMethods", resource.AllowedMethods)...)
 	if resource.AllowedOrigins == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AllowedOrigins'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AllowedOrigins", resource.AllowedOrigins)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ExposedHeaders", resource.ExposedHeaders)...)
 	return errs
 }
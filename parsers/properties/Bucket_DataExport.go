@@ -1,25 +1,55 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_DataExport struct {
 	
 	
 	OutputSchemaVersion interface{} `yaml:"OutputSchemaVersion"`
 	Destination *Bucket_Destination `yaml:"Destination"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_DataExport) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_DataExport
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_DataExport) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_DataExport
+	return plain(resource), nil
 }
 
 func (resource Bucket_DataExport) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.OutputSchemaVersion == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'OutputSchemaVersion'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("OutputSchemaVersion", resource.OutputSchemaVersion)...)
 	if resource.Destination == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Destination'"))
-	} else {
-		errs = append(errs, resource.Destination.Validate()...)
+	}
+	if resource.Destination != nil {
+		errs = append(errs, types.PrefixErrors("Destination", resource.Destination.Validate())...)
 	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_Destination struct {
 	
@@ -11,19 +15,49 @@ type Bucket_Destination struct {
 	BucketArn interface{} `yaml:"BucketArn"`
 	Format interface{} `yaml:"Format"`
 	Prefix interface{} `yaml:"Prefix,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_Destination) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_Destination
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_Destination) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_Destination
+	return plain(resource), nil
 }
 
 func (resource Bucket_Destination) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("BucketAccountId", resource.BucketAccountId)...)
 	if resource.BucketArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BucketArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("BucketArn", resource.BucketArn)...)
 	if resource.Format == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Format'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Format", resource.Format)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Prefix", resource.Prefix)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_EncryptionConfiguration struct {
 	
 	ReplicaKmsKeyID interface{} `yaml:"ReplicaKmsKeyID"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_EncryptionConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_EncryptionConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_EncryptionConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_EncryptionConfiguration
+	return plain(resource), nil
 }
 
 func (resource Bucket_EncryptionConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.ReplicaKmsKeyID == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ReplicaKmsKeyID'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ReplicaKmsKeyID", resource.ReplicaKmsKeyID)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_FilterRule struct {
 	
 	
 	Name interface{} `yaml:"Name"`
 	Value interface{} `yaml:"Value"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_FilterRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_FilterRule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_FilterRule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_FilterRule
+	return plain(resource), nil
 }
 
 func (resource Bucket_FilterRule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Value == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Value'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Value", resource.Value)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_InventoryConfiguration struct {
 	
@@ -17,10 +21,34 @@ type Bucket_InventoryConfiguration struct {
 	ScheduleFrequency interface{} `yaml:"ScheduleFrequency"`
 	OptionalFields interface{} `yaml:"OptionalFields,omitempty"`
 	Destination *Bucket_Destination `yaml:"Destination"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_InventoryConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_InventoryConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_InventoryConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_InventoryConfiguration
+	return plain(resource), nil
 }
 
 func (resource Bucket_InventoryConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -31,19 +59,28 @@ func (resource Bucket_InventoryConfiguration) Validate() []error {
 	if resource.Enabled == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Enabled'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	if resource.Id == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Id'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Id", resource.Id)...)
 	if resource.IncludedObjectVersions == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IncludedObjectVersions'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IncludedObjectVersions", resource.IncludedObjectVersions)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Prefix", resource.Prefix)...)
 	if resource.ScheduleFrequency == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ScheduleFrequency'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ScheduleFrequency", resource.ScheduleFrequency)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("OptionalFields", resource.OptionalFields)...)
 	if resource.Destination == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Destination'"))
-	} else {
-		errs = append(errs, resource.Destination.Validate()...)
+	}
+	if resource.Destination != nil {
+		errs = append(errs, types.PrefixErrors("Destination", resource.Destination.Validate())...)
 	}
 	return errs
 }
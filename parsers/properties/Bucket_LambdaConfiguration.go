@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_LambdaConfiguration struct {
 	
@@ -9,18 +13,48 @@ type Bucket_LambdaConfiguration struct {
 	Event interface{} `yaml:"Event"`
 	Function interface{} `yaml:"Function"`
 	Filter *Bucket_NotificationFilter `yaml:"Filter,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_LambdaConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_LambdaConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_LambdaConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_LambdaConfiguration
+	return plain(resource), nil
 }
 
 func (resource Bucket_LambdaConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Event == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Event'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Event", resource.Event)...)
 	if resource.Function == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Function'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Function", resource.Function)...)
+	
+	if resource.Filter != nil {
+		errs = append(errs, types.PrefixErrors("Filter", resource.Filter.Validate())...)
+	}
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Bucket_LoggingConfiguration struct {
 	
 	
 	DestinationBucketName interface{} `yaml:"DestinationBucketName,omitempty"`
 	LogFilePrefix interface{} `yaml:"LogFilePrefix,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_LoggingConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_LoggingConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_LoggingConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_LoggingConfiguration
+	return plain(resource), nil
 }
 
 func (resource Bucket_LoggingConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DestinationBucketName", resource.DestinationBucketName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("LogFilePrefix", resource.LogFilePrefix)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_MetricsConfiguration struct {
 	
@@ -9,15 +13,44 @@ type Bucket_MetricsConfiguration struct {
 	Id interface{} `yaml:"Id"`
 	Prefix interface{} `yaml:"Prefix,omitempty"`
 	TagFilters interface{} `yaml:"TagFilters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_MetricsConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_MetricsConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_MetricsConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_MetricsConfiguration
+	return plain(resource), nil
 }
 
 func (resource Bucket_MetricsConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Id == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Id'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Id", resource.Id)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Prefix", resource.Prefix)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Bucket_TagFilter{} }))("TagFilters", resource.TagFilters)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_NoncurrentVersionTransition struct {
 	
 	
 	StorageClass interface{} `yaml:"StorageClass"`
 	TransitionInDays interface{} `yaml:"TransitionInDays"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_NoncurrentVersionTransition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_NoncurrentVersionTransition
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_NoncurrentVersionTransition) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_NoncurrentVersionTransition
+	return plain(resource), nil
 }
 
 func (resource Bucket_NoncurrentVersionTransition) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.StorageClass == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StorageClass'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StorageClass", resource.StorageClass)...)
 	if resource.TransitionInDays == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TransitionInDays'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("TransitionInDays", resource.TransitionInDays)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Bucket_NotificationConfiguration struct {
 	
 	
@@ -8,12 +12,42 @@ type Bucket_NotificationConfiguration struct {
 	LambdaConfigurations interface{} `yaml:"LambdaConfigurations,omitempty"`
 	QueueConfigurations interface{} `yaml:"QueueConfigurations,omitempty"`
 	TopicConfigurations interface{} `yaml:"TopicConfigurations,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_NotificationConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_NotificationConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_NotificationConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_NotificationConfiguration
+	return plain(resource), nil
 }
 
 func (resource Bucket_NotificationConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Bucket_LambdaConfiguration{} }))("LambdaConfigurations", resource.LambdaConfigurations)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Bucket_QueueConfiguration{} }))("QueueConfigurations", resource.QueueConfigurations)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Bucket_TopicConfiguration{} }))("TopicConfigurations", resource.TopicConfigurations)...)
 	return errs
 }
@@ -1,19 +1,48 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_NotificationFilter struct {
 	
 	S3Key *Bucket_S3KeyFilter `yaml:"S3Key"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_NotificationFilter) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_NotificationFilter
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_NotificationFilter) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_NotificationFilter
+	return plain(resource), nil
 }
 
 func (resource Bucket_NotificationFilter) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.S3Key == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'S3Key'"))
-	} else {
-		errs = append(errs, resource.S3Key.Validate()...)
+	}
+	if resource.S3Key != nil {
+		errs = append(errs, types.PrefixErrors("S3Key", resource.S3Key.Validate())...)
 	}
 	return errs
 }
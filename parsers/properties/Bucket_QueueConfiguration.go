@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_QueueConfiguration struct {
 	
@@ -9,18 +13,48 @@ type Bucket_QueueConfiguration struct {
 	Event interface{} `yaml:"Event"`
 	Queue interface{} `yaml:"Queue"`
 	Filter *Bucket_NotificationFilter `yaml:"Filter,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_QueueConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_QueueConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_QueueConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_QueueConfiguration
+	return plain(resource), nil
 }
 
 func (resource Bucket_QueueConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Event == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Event'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Event", resource.Event)...)
 	if resource.Queue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Queue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Queue", resource.Queue)...)
+	
+	if resource.Filter != nil {
+		errs = append(errs, types.PrefixErrors("Filter", resource.Filter.Validate())...)
+	}
 	return errs
 }
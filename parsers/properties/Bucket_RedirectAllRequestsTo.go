@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_RedirectAllRequestsTo struct {
 	
 	
 	HostName interface{} `yaml:"HostName"`
 	Protocol interface{} `yaml:"Protocol,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_RedirectAllRequestsTo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_RedirectAllRequestsTo
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_RedirectAllRequestsTo) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_RedirectAllRequestsTo
+	return plain(resource), nil
 }
 
 func (resource Bucket_RedirectAllRequestsTo) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.HostName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'HostName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("HostName", resource.HostName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Protocol", resource.Protocol)...)
 	return errs
 }
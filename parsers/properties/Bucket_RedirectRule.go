@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Bucket_RedirectRule struct {
 	
 	
@@ -12,14 +16,48 @@ type Bucket_RedirectRule struct {
 	Protocol interface{} `yaml:"Protocol,omitempty"`
 	ReplaceKeyPrefixWith interface{} `yaml:"ReplaceKeyPrefixWith,omitempty"`
 	ReplaceKeyWith interface{} `yaml:"ReplaceKeyWith,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_RedirectRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_RedirectRule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_RedirectRule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_RedirectRule
+	return plain(resource), nil
 }
 
 func (resource Bucket_RedirectRule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("HostName", resource.HostName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("HttpRedirectCode", resource.HttpRedirectCode)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Protocol", resource.Protocol)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ReplaceKeyPrefixWith", resource.ReplaceKeyPrefixWith)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ReplaceKeyWith", resource.ReplaceKeyWith)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_ReplicationConfiguration struct {
 	
 	
 	Role interface{} `yaml:"Role"`
 	Rules interface{} `yaml:"Rules"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_ReplicationConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_ReplicationConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_ReplicationConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_ReplicationConfiguration
+	return plain(resource), nil
 }
 
 func (resource Bucket_ReplicationConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Role == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Role'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Role", resource.Role)...)
 	if resource.Rules == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Rules'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Bucket_ReplicationRule{} }))("Rules", resource.Rules)...)
 	return errs
 }
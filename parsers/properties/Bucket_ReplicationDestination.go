@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_ReplicationDestination struct {
 	
@@ -13,17 +17,54 @@ type Bucket_ReplicationDestination struct {
 	StorageClass interface{} `yaml:"StorageClass,omitempty"`
 	EncryptionConfiguration *Bucket_EncryptionConfiguration `yaml:"EncryptionConfiguration,omitempty"`
 	AccessControlTranslation *Bucket_AccessControlTranslation `yaml:"AccessControlTranslation,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_ReplicationDestination) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_ReplicationDestination
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_ReplicationDestination) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_ReplicationDestination
+	return plain(resource), nil
 }
 
 func (resource Bucket_ReplicationDestination) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Account", resource.Account)...)
 	if resource.Bucket == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Bucket'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Bucket", resource.Bucket)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("StorageClass", resource.StorageClass)...)
+	
+	if resource.EncryptionConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("EncryptionConfiguration", resource.EncryptionConfiguration.Validate())...)
+	}
+	
+	if resource.AccessControlTranslation != nil {
+		errs = append(errs, types.PrefixErrors("AccessControlTranslation", resource.AccessControlTranslation.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_ReplicationRule struct {
 	
@@ -13,25 +17,58 @@ type Bucket_ReplicationRule struct {
 	Status interface{} `yaml:"Status"`
 	SourceSelectionCriteria *Bucket_SourceSelectionCriteria `yaml:"SourceSelectionCriteria,omitempty"`
 	Destination *Bucket_ReplicationDestination `yaml:"Destination"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_ReplicationRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_ReplicationRule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_ReplicationRule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_ReplicationRule
+	return plain(resource), nil
 }
 
 func (resource Bucket_ReplicationRule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Id", resource.Id)...)
 	if resource.Prefix == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Prefix'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Prefix", resource.Prefix)...)
 	if resource.Status == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Status'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Status", resource.Status)...)
+	
+	if resource.SourceSelectionCriteria != nil {
+		errs = append(errs, types.PrefixErrors("SourceSelectionCriteria", resource.SourceSelectionCriteria.Validate())...)
+	}
 	if resource.Destination == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Destination'"))
-	} else {
-		errs = append(errs, resource.Destination.Validate()...)
+	}
+	if resource.Destination != nil {
+		errs = append(errs, types.PrefixErrors("Destination", resource.Destination.Validate())...)
 	}
 	return errs
 }
@@ -1,22 +1,55 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_RoutingRule struct {
 	
 	
 	RoutingRuleCondition *Bucket_RoutingRuleCondition `yaml:"RoutingRuleCondition,omitempty"`
 	RedirectRule *Bucket_RedirectRule `yaml:"RedirectRule"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_RoutingRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_RoutingRule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_RoutingRule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_RoutingRule
+	return plain(resource), nil
 }
 
 func (resource Bucket_RoutingRule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
+	
+	if resource.RoutingRuleCondition != nil {
+		errs = append(errs, types.PrefixErrors("RoutingRuleCondition", resource.RoutingRuleCondition.Validate())...)
+	}
 	if resource.RedirectRule == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RedirectRule'"))
-	} else {
-		errs = append(errs, resource.RedirectRule.Validate()...)
+	}
+	if resource.RedirectRule != nil {
+		errs = append(errs, types.PrefixErrors("RedirectRule", resource.RedirectRule.Validate())...)
 	}
 	return errs
 }
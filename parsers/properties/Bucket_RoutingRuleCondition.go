@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Bucket_RoutingRuleCondition struct {
 	
 	
 	HttpErrorCodeReturnedEquals interface{} `yaml:"HttpErrorCodeReturnedEquals,omitempty"`
 	KeyPrefixEquals interface{} `yaml:"KeyPrefixEquals,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_RoutingRuleCondition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_RoutingRuleCondition
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_RoutingRuleCondition) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_RoutingRuleCondition
+	return plain(resource), nil
 }
 
 func (resource Bucket_RoutingRuleCondition) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("HttpErrorCodeReturnedEquals", resource.HttpErrorCodeReturnedEquals)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("KeyPrefixEquals", resource.KeyPrefixEquals)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_Rule struct {
 	
@@ -27,10 +31,37 @@ type Bucket_Rule struct {
 	TagFilters interface{} `yaml:"TagFilters,omitempty"`
 	Transitions interface{} `yaml:"Transitions,omitempty"`
 	AbortIncompleteMultipartUpload *Bucket_AbortIncompleteMultipartUpload `yaml:"AbortIncompleteMultipartUpload,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_Rule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_Rule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_Rule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_Rule
+	return plain(resource), nil
 }
 
 func (resource Bucket_Rule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
 	
 	
@@ -41,10 +72,36 @@ func (resource Bucket_Rule) Validate() []error {
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Timestamp")("ExpirationDate", resource.ExpirationDate)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ExpirationInDays", resource.ExpirationInDays)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Id", resource.Id)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("NoncurrentVersionExpirationInDays", resource.NoncurrentVersionExpirationInDays)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Prefix", resource.Prefix)...)
 	if resource.Status == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Status'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Status", resource.Status)...)
+	
+	if resource.Transition != nil {
+		errs = append(errs, types.PrefixErrors("Transition", resource.Transition.Validate())...)
+	}
+	
+	if resource.NoncurrentVersionTransition != nil {
+		errs = append(errs, types.PrefixErrors("NoncurrentVersionTransition", resource.NoncurrentVersionTransition.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Bucket_NoncurrentVersionTransition{} }))("NoncurrentVersionTransitions", resource.NoncurrentVersionTransitions)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Bucket_TagFilter{} }))("TagFilters", resource.TagFilters)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Bucket_Transition{} }))("Transitions", resource.Transitions)...)
+	
+	if resource.AbortIncompleteMultipartUpload != nil {
+		errs = append(errs, types.PrefixErrors("AbortIncompleteMultipartUpload", resource.AbortIncompleteMultipartUpload.Validate())...)
+	}
 	return errs
 }
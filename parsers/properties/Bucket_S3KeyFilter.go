@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_S3KeyFilter struct {
 	
 	Rules interface{} `yaml:"Rules"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_S3KeyFilter) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_S3KeyFilter
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_S3KeyFilter) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_S3KeyFilter
+	return plain(resource), nil
 }
 
 func (resource Bucket_S3KeyFilter) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.Rules == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Rules'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Bucket_FilterRule{} }))("Rules", resource.Rules)...)
 	return errs
 }
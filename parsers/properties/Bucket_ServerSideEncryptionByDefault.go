@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_ServerSideEncryptionByDefault struct {
 	
 	
 	KMSMasterKeyID interface{} `yaml:"KMSMasterKeyID,omitempty"`
 	SSEAlgorithm interface{} `yaml:"SSEAlgorithm"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_ServerSideEncryptionByDefault) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_ServerSideEncryptionByDefault
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_ServerSideEncryptionByDefault) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_ServerSideEncryptionByDefault
+	return plain(resource), nil
 }
 
 func (resource Bucket_ServerSideEncryptionByDefault) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("KMSMasterKeyID", resource.KMSMasterKeyID)...)
 	if resource.SSEAlgorithm == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SSEAlgorithm'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("SSEAlgorithm", resource.SSEAlgorithm)...)
 	return errs
 }
@@ -1,13 +1,45 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Bucket_ServerSideEncryptionRule struct {
 	
 	ServerSideEncryptionByDefault *Bucket_ServerSideEncryptionByDefault `yaml:"ServerSideEncryptionByDefault,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_ServerSideEncryptionRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_ServerSideEncryptionRule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_ServerSideEncryptionRule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_ServerSideEncryptionRule
+	return plain(resource), nil
 }
 
 func (resource Bucket_ServerSideEncryptionRule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	if resource.ServerSideEncryptionByDefault != nil {
+		errs = append(errs, types.PrefixErrors("ServerSideEncryptionByDefault", resource.ServerSideEncryptionByDefault.Validate())...)
+	}
 	return errs
 }
@@ -1,19 +1,48 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_SourceSelectionCriteria struct {
 	
 	SseKmsEncryptedObjects *Bucket_SseKmsEncryptedObjects `yaml:"SseKmsEncryptedObjects"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_SourceSelectionCriteria) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_SourceSelectionCriteria
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_SourceSelectionCriteria) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_SourceSelectionCriteria
+	return plain(resource), nil
 }
 
 func (resource Bucket_SourceSelectionCriteria) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.SseKmsEncryptedObjects == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SseKmsEncryptedObjects'"))
-	} else {
-		errs = append(errs, resource.SseKmsEncryptedObjects.Validate()...)
+	}
+	if resource.SseKmsEncryptedObjects != nil {
+		errs = append(errs, types.PrefixErrors("SseKmsEncryptedObjects", resource.SseKmsEncryptedObjects.Validate())...)
 	}
 	return errs
 }
@@ -1,13 +1,45 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Bucket_StorageClassAnalysis struct {
 	
 	DataExport *Bucket_DataExport `yaml:"DataExport,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_StorageClassAnalysis) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_StorageClassAnalysis
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_StorageClassAnalysis) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_StorageClassAnalysis
+	return plain(resource), nil
 }
 
 func (resource Bucket_StorageClassAnalysis) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	if resource.DataExport != nil {
+		errs = append(errs, types.PrefixErrors("DataExport", resource.DataExport.Validate())...)
+	}
 	return errs
 }
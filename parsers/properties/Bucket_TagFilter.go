@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_TagFilter struct {
 	
 	
 	Key interface{} `yaml:"Key"`
 	Value interface{} `yaml:"Value"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_TagFilter) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_TagFilter
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_TagFilter) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_TagFilter
+	return plain(resource), nil
 }
 
 func (resource Bucket_TagFilter) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Key == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Key'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Key", resource.Key)...)
 	if resource.Value == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Value'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Value", resource.Value)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_TopicConfiguration struct {
 	
@@ -9,18 +13,48 @@ type Bucket_TopicConfiguration struct {
 	Event interface{} `yaml:"Event"`
 	Topic interface{} `yaml:"Topic"`
 	Filter *Bucket_NotificationFilter `yaml:"Filter,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_TopicConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_TopicConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_TopicConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_TopicConfiguration
+	return plain(resource), nil
 }
 
 func (resource Bucket_TopicConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Event == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Event'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Event", resource.Event)...)
 	if resource.Topic == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Topic'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Topic", resource.Topic)...)
+	
+	if resource.Filter != nil {
+		errs = append(errs, types.PrefixErrors("Filter", resource.Filter.Validate())...)
+	}
 	return errs
 }
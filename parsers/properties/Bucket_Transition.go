@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_Transition struct {
 	
@@ -9,15 +13,44 @@ type Bucket_Transition struct {
 	StorageClass interface{} `yaml:"StorageClass"`
 	TransitionDate interface{} `yaml:"TransitionDate,omitempty"`
 	TransitionInDays interface{} `yaml:"TransitionInDays,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_Transition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_Transition
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_Transition) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_Transition
+	return plain(resource), nil
 }
 
 func (resource Bucket_Transition) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.StorageClass == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StorageClass'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StorageClass", resource.StorageClass)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Timestamp")("TransitionDate", resource.TransitionDate)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("TransitionInDays", resource.TransitionInDays)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Bucket_VersioningConfiguration struct {
 	
 	Status interface{} `yaml:"Status"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_VersioningConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_VersioningConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_VersioningConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_VersioningConfiguration
+	return plain(resource), nil
 }
 
 func (resource Bucket_VersioningConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.Status == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Status'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Status", resource.Status)...)
 	return errs
 }
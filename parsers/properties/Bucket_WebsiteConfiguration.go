@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Bucket_WebsiteConfiguration struct {
 	
 	
@@ -10,13 +14,47 @@ type Bucket_WebsiteConfiguration struct {
 	IndexDocument interface{} `yaml:"IndexDocument,omitempty"`
 	RedirectAllRequestsTo *Bucket_RedirectAllRequestsTo `yaml:"RedirectAllRequestsTo,omitempty"`
 	RoutingRules interface{} `yaml:"RoutingRules,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Bucket_WebsiteConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Bucket_WebsiteConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Bucket_WebsiteConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Bucket_WebsiteConfiguration
+	return plain(resource), nil
 }
 
 func (resource Bucket_WebsiteConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ErrorDocument", resource.ErrorDocument)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("IndexDocument", resource.IndexDocument)...)
 	
+	if resource.RedirectAllRequestsTo != nil {
+		errs = append(errs, types.PrefixErrors("RedirectAllRequestsTo", resource.RedirectAllRequestsTo.Validate())...)
+	}
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Bucket_RoutingRule{} }))("RoutingRules", resource.RoutingRules)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Build_S3Location struct {
 	
@@ -9,21 +13,48 @@ type Build_S3Location struct {
 	Bucket interface{} `yaml:"Bucket"`
 	Key interface{} `yaml:"Key"`
 	RoleArn interface{} `yaml:"RoleArn"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Build_S3Location) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Build_S3Location
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Build_S3Location) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Build_S3Location
+	return plain(resource), nil
 }
 
 func (resource Build_S3Location) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Bucket == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Bucket'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Bucket", resource.Bucket)...)
 	if resource.Key == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Key'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Key", resource.Key)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ByteMatchSet_ByteMatchTuple struct {
 	
@@ -13,10 +17,34 @@ type ByteMatchSet_ByteMatchTuple struct {
 	TargetStringBase64 interface{} `yaml:"TargetStringBase64,omitempty"`
 	TextTransformation interface{} `yaml:"TextTransformation"`
 	FieldToMatch *ByteMatchSet_FieldToMatch `yaml:"FieldToMatch"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ByteMatchSet_ByteMatchTuple) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ByteMatchSet_ByteMatchTuple
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ByteMatchSet_ByteMatchTuple) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ByteMatchSet_ByteMatchTuple
+	return plain(resource), nil
 }
 
 func (resource ByteMatchSet_ByteMatchTuple) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -25,13 +53,20 @@ func (resource ByteMatchSet_ByteMatchTuple) Validate() []error {
 	if resource.PositionalConstraint == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PositionalConstraint'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PositionalConstraint", resource.PositionalConstraint)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TargetString", resource.TargetString)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TargetStringBase64", resource.TargetStringBase64)...)
 	if resource.TextTransformation == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TextTransformation'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TextTransformation", resource.TextTransformation)...)
 	if resource.FieldToMatch == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FieldToMatch'"))
-	} else {
-		errs = append(errs, resource.FieldToMatch.Validate()...)
+	}
+	if resource.FieldToMatch != nil {
+		errs = append(errs, types.PrefixErrors("FieldToMatch", resource.FieldToMatch.Validate())...)
 	}
 	return errs
 }
@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ByteMatchSet_FieldToMatch struct {
 	
 	
 	Data interface{} `yaml:"Data,omitempty"`
 	Type interface{} `yaml:"Type"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ByteMatchSet_FieldToMatch) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ByteMatchSet_FieldToMatch
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ByteMatchSet_FieldToMatch) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ByteMatchSet_FieldToMatch
+	return plain(resource), nil
 }
 
 func (resource ByteMatchSet_FieldToMatch) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Data", resource.Data)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	return errs
 }
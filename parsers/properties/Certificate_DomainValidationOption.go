@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Certificate_DomainValidationOption struct {
 	
 	
 	DomainName interface{} `yaml:"DomainName"`
 	ValidationDomain interface{} `yaml:"ValidationDomain"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Certificate_DomainValidationOption) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Certificate_DomainValidationOption
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Certificate_DomainValidationOption) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Certificate_DomainValidationOption
+	return plain(resource), nil
 }
 
 func (resource Certificate_DomainValidationOption) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.DomainName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DomainName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DomainName", resource.DomainName)...)
 	if resource.ValidationDomain == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ValidationDomain'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ValidationDomain", resource.ValidationDomain)...)
 	return errs
 }
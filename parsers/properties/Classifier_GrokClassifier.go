@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Classifier_GrokClassifier struct {
 	
@@ -11,10 +15,34 @@ type Classifier_GrokClassifier struct {
 	CustomPatterns interface{} `yaml:"CustomPatterns,omitempty"`
 	GrokPattern interface{} `yaml:"GrokPattern"`
 	Name interface{} `yaml:"Name,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Classifier_GrokClassifier) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Classifier_GrokClassifier
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Classifier_GrokClassifier) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Classifier_GrokClassifier
+	return plain(resource), nil
 }
 
 func (resource Classifier_GrokClassifier) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -22,8 +50,14 @@ func (resource Classifier_GrokClassifier) Validate() []error {
 	if resource.Classification == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Classification'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Classification", resource.Classification)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CustomPatterns", resource.CustomPatterns)...)
 	if resource.GrokPattern == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'GrokPattern'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("GrokPattern", resource.GrokPattern)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	return errs
 }
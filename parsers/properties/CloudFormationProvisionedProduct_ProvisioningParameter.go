@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type CloudFormationProvisionedProduct_ProvisioningParameter struct {
 	
 	
 	Key interface{} `yaml:"Key,omitempty"`
 	Value interface{} `yaml:"Value,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *CloudFormationProvisionedProduct_ProvisioningParameter) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain CloudFormationProvisionedProduct_ProvisioningParameter
+	return unmarshal((*plain)(resource))
+}
+
+func (resource CloudFormationProvisionedProduct_ProvisioningParameter) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain CloudFormationProvisionedProduct_ProvisioningParameter
+	return plain(resource), nil
 }
 
 func (resource CloudFormationProvisionedProduct_ProvisioningParameter) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Key", resource.Key)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Value", resource.Value)...)
 	return errs
 }
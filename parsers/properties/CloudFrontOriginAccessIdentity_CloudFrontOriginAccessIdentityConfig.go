@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type CloudFrontOriginAccessIdentity_CloudFrontOriginAccessIdentityConfig struct {
 	
 	Comment interface{} `yaml:"Comment"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *CloudFrontOriginAccessIdentity_CloudFrontOriginAccessIdentityConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain CloudFrontOriginAccessIdentity_CloudFrontOriginAccessIdentityConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource CloudFrontOriginAccessIdentity_CloudFrontOriginAccessIdentityConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain CloudFrontOriginAccessIdentity_CloudFrontOriginAccessIdentityConfig
+	return plain(resource), nil
 }
 
 func (resource CloudFrontOriginAccessIdentity_CloudFrontOriginAccessIdentityConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.Comment == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Comment'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Comment", resource.Comment)...)
 	return errs
 }
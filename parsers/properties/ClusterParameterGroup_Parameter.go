@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ClusterParameterGroup_Parameter struct {
 	
 	
 	ParameterName interface{} `yaml:"ParameterName"`
 	ParameterValue interface{} `yaml:"ParameterValue"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ClusterParameterGroup_Parameter) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ClusterParameterGroup_Parameter
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ClusterParameterGroup_Parameter) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ClusterParameterGroup_Parameter
+	return plain(resource), nil
 }
 
 func (resource ClusterParameterGroup_Parameter) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.ParameterName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ParameterName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ParameterName", resource.ParameterName)...)
 	if resource.ParameterValue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ParameterValue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ParameterValue", resource.ParameterValue)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Cluster_Application struct {
 	
 	
@@ -10,13 +14,45 @@ type Cluster_Application struct {
 	Version interface{} `yaml:"Version,omitempty"`
 	AdditionalInfo interface{} `yaml:"AdditionalInfo,omitempty"`
 	Args interface{} `yaml:"Args,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_Application) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_Application
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_Application) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_Application
+	return plain(resource), nil
 }
 
 func (resource Cluster_Application) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Version", resource.Version)...)
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("AdditionalInfo", resource.AdditionalInfo)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Args", resource.Args)...)
 	return errs
 }
@@ -1,25 +1,55 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Cluster_AutoScalingPolicy struct {
 	
 	
 	Constraints *Cluster_ScalingConstraints `yaml:"Constraints"`
 	Rules interface{} `yaml:"Rules"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_AutoScalingPolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_AutoScalingPolicy
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_AutoScalingPolicy) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_AutoScalingPolicy
+	return plain(resource), nil
 }
 
 func (resource Cluster_AutoScalingPolicy) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Constraints == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Constraints'"))
-	} else {
-		errs = append(errs, resource.Constraints.Validate()...)
+	}
+	if resource.Constraints != nil {
+		errs = append(errs, types.PrefixErrors("Constraints", resource.Constraints.Validate())...)
 	}
 	if resource.Rules == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Rules'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Cluster_ScalingRule{} }))("Rules", resource.Rules)...)
 	return errs
 }
@@ -1,25 +1,55 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Cluster_BootstrapActionConfig struct {
 	
 	
 	Name interface{} `yaml:"Name"`
 	ScriptBootstrapAction *Cluster_ScriptBootstrapActionConfig `yaml:"ScriptBootstrapAction"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_BootstrapActionConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_BootstrapActionConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_BootstrapActionConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_BootstrapActionConfig
+	return plain(resource), nil
 }
 
 func (resource Cluster_BootstrapActionConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.ScriptBootstrapAction == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ScriptBootstrapAction'"))
-	} else {
-		errs = append(errs, resource.ScriptBootstrapAction.Validate()...)
+	}
+	if resource.ScriptBootstrapAction != nil {
+		errs = append(errs, types.PrefixErrors("ScriptBootstrapAction", resource.ScriptBootstrapAction.Validate())...)
 	}
 	return errs
 }
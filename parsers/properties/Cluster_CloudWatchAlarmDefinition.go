@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Cluster_CloudWatchAlarmDefinition struct {
 	
@@ -21,10 +25,34 @@ type Cluster_CloudWatchAlarmDefinition struct {
 	Threshold interface{} `yaml:"Threshold"`
 	Unit interface{} `yaml:"Unit,omitempty"`
 	Dimensions interface{} `yaml:"Dimensions,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_CloudWatchAlarmDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_CloudWatchAlarmDefinition
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_CloudWatchAlarmDefinition) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_CloudWatchAlarmDefinition
+	return plain(resource), nil
 }
 
 func (resource Cluster_CloudWatchAlarmDefinition) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -37,14 +65,28 @@ func (resource Cluster_CloudWatchAlarmDefinition) Validate() []error {
 	if resource.ComparisonOperator == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ComparisonOperator'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ComparisonOperator", resource.ComparisonOperator)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("EvaluationPeriods", resource.EvaluationPeriods)...)
 	if resource.MetricName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MetricName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MetricName", resource.MetricName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Namespace", resource.Namespace)...)
 	if resource.Period == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Period'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Period", resource.Period)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Statistic", resource.Statistic)...)
 	if resource.Threshold == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Threshold'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Double")("Threshold", resource.Threshold)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Unit", resource.Unit)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Cluster_MetricDimension{} }))("Dimensions", resource.Dimensions)...)
 	return errs
 }
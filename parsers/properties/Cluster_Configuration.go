@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Cluster_Configuration struct {
 	
 	
@@ -8,12 +12,42 @@ type Cluster_Configuration struct {
 	Classification interface{} `yaml:"Classification,omitempty"`
 	ConfigurationProperties interface{} `yaml:"ConfigurationProperties,omitempty"`
 	Configurations interface{} `yaml:"Configurations,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_Configuration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_Configuration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_Configuration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_Configuration
+	return plain(resource), nil
 }
 
 func (resource Cluster_Configuration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Classification", resource.Classification)...)
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("ConfigurationProperties", resource.ConfigurationProperties)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Cluster_Configuration{} }))("Configurations", resource.Configurations)...)
 	return errs
 }
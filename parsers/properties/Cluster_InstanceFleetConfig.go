@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Cluster_InstanceFleetConfig struct {
 	
 	
@@ -12,14 +16,50 @@ type Cluster_InstanceFleetConfig struct {
 	TargetSpotCapacity interface{} `yaml:"TargetSpotCapacity,omitempty"`
 	InstanceTypeConfigs interface{} `yaml:"InstanceTypeConfigs,omitempty"`
 	LaunchSpecifications *Cluster_InstanceFleetProvisioningSpecifications `yaml:"LaunchSpecifications,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_InstanceFleetConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_InstanceFleetConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_InstanceFleetConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_InstanceFleetConfig
+	return plain(resource), nil
 }
 
 func (resource Cluster_InstanceFleetConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("TargetOnDemandCapacity", resource.TargetOnDemandCapacity)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("TargetSpotCapacity", resource.TargetSpotCapacity)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Cluster_InstanceTypeConfig{} }))("InstanceTypeConfigs", resource.InstanceTypeConfigs)...)
 	
+	if resource.LaunchSpecifications != nil {
+		errs = append(errs, types.PrefixErrors("LaunchSpecifications", resource.LaunchSpecifications.Validate())...)
+	}
 	return errs
 }
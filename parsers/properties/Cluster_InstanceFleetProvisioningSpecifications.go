@@ -1,19 +1,48 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Cluster_InstanceFleetProvisioningSpecifications struct {
 	
 	SpotSpecification *Cluster_SpotProvisioningSpecification `yaml:"SpotSpecification"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_InstanceFleetProvisioningSpecifications) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_InstanceFleetProvisioningSpecifications
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_InstanceFleetProvisioningSpecifications) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_InstanceFleetProvisioningSpecifications
+	return plain(resource), nil
 }
 
 func (resource Cluster_InstanceFleetProvisioningSpecifications) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.SpotSpecification == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SpotSpecification'"))
-	} else {
-		errs = append(errs, resource.SpotSpecification.Validate()...)
+	}
+	if resource.SpotSpecification != nil {
+		errs = append(errs, types.PrefixErrors("SpotSpecification", resource.SpotSpecification.Validate())...)
 	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Cluster_InstanceGroupConfig struct {
 	
@@ -19,10 +23,34 @@ type Cluster_InstanceGroupConfig struct {
 	Configurations interface{} `yaml:"Configurations,omitempty"`
 	EbsConfiguration *Cluster_EbsConfiguration `yaml:"EbsConfiguration,omitempty"`
 	AutoScalingPolicy *Cluster_AutoScalingPolicy `yaml:"AutoScalingPolicy,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_InstanceGroupConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_InstanceGroupConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_InstanceGroupConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_InstanceGroupConfig
+	return plain(resource), nil
 }
 
 func (resource Cluster_InstanceGroupConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -31,11 +59,29 @@ func (resource Cluster_InstanceGroupConfig) Validate() []error {
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("BidPrice", resource.BidPrice)...)
 	if resource.InstanceCount == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceCount'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("InstanceCount", resource.InstanceCount)...)
 	if resource.InstanceType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceType", resource.InstanceType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Market", resource.Market)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Cluster_Configuration{} }))("Configurations", resource.Configurations)...)
+	
+	if resource.EbsConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("EbsConfiguration", resource.EbsConfiguration.Validate())...)
+	}
+	
+	if resource.AutoScalingPolicy != nil {
+		errs = append(errs, types.PrefixErrors("AutoScalingPolicy", resource.AutoScalingPolicy.Validate())...)
+	}
 	return errs
 }
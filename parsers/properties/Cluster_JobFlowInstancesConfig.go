@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Cluster_JobFlowInstancesConfig struct {
 	
 	
@@ -24,29 +28,91 @@ type Cluster_JobFlowInstancesConfig struct {
 	ServiceAccessSecurityGroup interface{} `yaml:"ServiceAccessSecurityGroup,omitempty"`
 	TerminationProtected interface{} `yaml:"TerminationProtected,omitempty"`
 	Placement *Cluster_PlacementType `yaml:"Placement,omitempty"`
-	AdditionalSlaveSecurityGroups interface{} `yaml:"AdditionalSlaveSecurityGroups,omitempty"`
 	AdditionalMasterSecurityGroups interface{} `yaml:"AdditionalMasterSecurityGroups,omitempty"`
+	AdditionalSlaveSecurityGroups interface{} `yaml:"AdditionalSlaveSecurityGroups,omitempty"`
 	CoreInstanceGroup *Cluster_InstanceGroupConfig `yaml:"CoreInstanceGroup,omitempty"`
 	MasterInstanceGroup *Cluster_InstanceGroupConfig `yaml:"MasterInstanceGroup,omitempty"`
 	CoreInstanceFleet *Cluster_InstanceFleetConfig `yaml:"CoreInstanceFleet,omitempty"`
 	MasterInstanceFleet *Cluster_InstanceFleetConfig `yaml:"MasterInstanceFleet,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_JobFlowInstancesConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_JobFlowInstancesConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_JobFlowInstancesConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_JobFlowInstancesConfig
+	return plain(resource), nil
 }
 
 func (resource Cluster_JobFlowInstancesConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Ec2KeyName", resource.Ec2KeyName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Ec2SubnetId", resource.Ec2SubnetId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("EmrManagedMasterSecurityGroup", resource.EmrManagedMasterSecurityGroup)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("EmrManagedSlaveSecurityGroup", resource.EmrManagedSlaveSecurityGroup)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("HadoopVersion", resource.HadoopVersion)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceAccessSecurityGroup", resource.ServiceAccessSecurityGroup)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("TerminationProtected", resource.TerminationProtected)...)
 	
+	if resource.Placement != nil {
+		errs = append(errs, types.PrefixErrors("Placement", resource.Placement.Validate())...)
+	}
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AdditionalMasterSecurityGroups", resource.AdditionalMasterSecurityGroups)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AdditionalSlaveSecurityGroups", resource.AdditionalSlaveSecurityGroups)...)
 	
+	if resource.CoreInstanceGroup != nil {
+		errs = append(errs, types.PrefixErrors("CoreInstanceGroup", resource.CoreInstanceGroup.Validate())...)
+	}
 	
+	if resource.MasterInstanceGroup != nil {
+		errs = append(errs, types.PrefixErrors("MasterInstanceGroup", resource.MasterInstanceGroup.Validate())...)
+	}
 	
+	if resource.CoreInstanceFleet != nil {
+		errs = append(errs, types.PrefixErrors("CoreInstanceFleet", resource.CoreInstanceFleet.Validate())...)
+	}
 	
+	if resource.MasterInstanceFleet != nil {
+		errs = append(errs, types.PrefixErrors("MasterInstanceFleet", resource.MasterInstanceFleet.Validate())...)
+	}
 	return errs
 }
@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Cluster_LoggingProperties struct {
 	
 	
 	BucketName interface{} `yaml:"BucketName"`
 	S3KeyPrefix interface{} `yaml:"S3KeyPrefix,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_LoggingProperties) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_LoggingProperties
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_LoggingProperties) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_LoggingProperties
+	return plain(resource), nil
 }
 
 func (resource Cluster_LoggingProperties) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.BucketName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BucketName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("BucketName", resource.BucketName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("S3KeyPrefix", resource.S3KeyPrefix)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Cluster_PlacementType struct {
 	
 	AvailabilityZone interface{} `yaml:"AvailabilityZone"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_PlacementType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_PlacementType
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_PlacementType) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_PlacementType
+	return plain(resource), nil
 }
 
 func (resource Cluster_PlacementType) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.AvailabilityZone == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AvailabilityZone'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AvailabilityZone", resource.AvailabilityZone)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Cluster_ScalingConstraints struct {
 	
 	
 	MaxCapacity interface{} `yaml:"MaxCapacity"`
 	MinCapacity interface{} `yaml:"MinCapacity"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_ScalingConstraints) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_ScalingConstraints
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_ScalingConstraints) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_ScalingConstraints
+	return plain(resource), nil
 }
 
 func (resource Cluster_ScalingConstraints) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.MaxCapacity == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MaxCapacity'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("MaxCapacity", resource.MaxCapacity)...)
 	if resource.MinCapacity == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MinCapacity'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("MinCapacity", resource.MinCapacity)...)
 	return errs
 }
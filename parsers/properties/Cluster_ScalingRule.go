@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Cluster_ScalingRule struct {
 	
@@ -11,26 +15,55 @@ type Cluster_ScalingRule struct {
 	Name interface{} `yaml:"Name"`
 	Trigger *Cluster_ScalingTrigger `yaml:"Trigger"`
 	Action *Cluster_ScalingAction `yaml:"Action"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_ScalingRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_ScalingRule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_ScalingRule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_ScalingRule
+	return plain(resource), nil
 }
 
 func (resource Cluster_ScalingRule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Trigger == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Trigger'"))
-	} else {
-		errs = append(errs, resource.Trigger.Validate()...)
+	}
+	if resource.Trigger != nil {
+		errs = append(errs, types.PrefixErrors("Trigger", resource.Trigger.Validate())...)
 	}
 	if resource.Action == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Action'"))
-	} else {
-		errs = append(errs, resource.Action.Validate()...)
+	}
+	if resource.Action != nil {
+		errs = append(errs, types.PrefixErrors("Action", resource.Action.Validate())...)
 	}
 	return errs
 }
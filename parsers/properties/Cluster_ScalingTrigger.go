@@ -1,19 +1,48 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Cluster_ScalingTrigger struct {
 	
 	CloudWatchAlarmDefinition *Cluster_CloudWatchAlarmDefinition `yaml:"CloudWatchAlarmDefinition"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_ScalingTrigger) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_ScalingTrigger
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_ScalingTrigger) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_ScalingTrigger
+	return plain(resource), nil
 }
 
 func (resource Cluster_ScalingTrigger) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.CloudWatchAlarmDefinition == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CloudWatchAlarmDefinition'"))
-	} else {
-		errs = append(errs, resource.CloudWatchAlarmDefinition.Validate()...)
+	}
+	if resource.CloudWatchAlarmDefinition != nil {
+		errs = append(errs, types.PrefixErrors("CloudWatchAlarmDefinition", resource.CloudWatchAlarmDefinition.Validate())...)
 	}
 	return errs
 }
@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Cluster_ScriptBootstrapActionConfig struct {
 	
 	
 	Path interface{} `yaml:"Path"`
 	Args interface{} `yaml:"Args,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_ScriptBootstrapActionConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_ScriptBootstrapActionConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_ScriptBootstrapActionConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_ScriptBootstrapActionConfig
+	return plain(resource), nil
 }
 
 func (resource Cluster_ScriptBootstrapActionConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Path == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Path'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Path", resource.Path)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Args", resource.Args)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Cluster_SimpleScalingPolicyConfiguration struct {
 	
@@ -9,15 +13,44 @@ type Cluster_SimpleScalingPolicyConfiguration struct {
 	AdjustmentType interface{} `yaml:"AdjustmentType,omitempty"`
 	CoolDown interface{} `yaml:"CoolDown,omitempty"`
 	ScalingAdjustment interface{} `yaml:"ScalingAdjustment"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_SimpleScalingPolicyConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_SimpleScalingPolicyConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_SimpleScalingPolicyConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_SimpleScalingPolicyConfiguration
+	return plain(resource), nil
 }
 
 func (resource Cluster_SimpleScalingPolicyConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AdjustmentType", resource.AdjustmentType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("CoolDown", resource.CoolDown)...)
 	if resource.ScalingAdjustment == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ScalingAdjustment'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("ScalingAdjustment", resource.ScalingAdjustment)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Cluster_VolumeSpecification struct {
 	
@@ -9,18 +13,46 @@ type Cluster_VolumeSpecification struct {
 	Iops interface{} `yaml:"Iops,omitempty"`
 	SizeInGB interface{} `yaml:"SizeInGB"`
 	VolumeType interface{} `yaml:"VolumeType"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Cluster_VolumeSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Cluster_VolumeSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Cluster_VolumeSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Cluster_VolumeSpecification
+	return plain(resource), nil
 }
 
 func (resource Cluster_VolumeSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Iops", resource.Iops)...)
 	if resource.SizeInGB == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SizeInGB'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("SizeInGB", resource.SizeInGB)...)
 	if resource.VolumeType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VolumeType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VolumeType", resource.VolumeType)...)
 	return errs
 }
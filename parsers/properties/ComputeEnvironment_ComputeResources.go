@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ComputeEnvironment_ComputeResources struct {
 	
@@ -29,10 +33,37 @@ type ComputeEnvironment_ComputeResources struct {
 	InstanceTypes interface{} `yaml:"InstanceTypes"`
 	SecurityGroupIds interface{} `yaml:"SecurityGroupIds"`
 	Subnets interface{} `yaml:"Subnets"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ComputeEnvironment_ComputeResources) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ComputeEnvironment_ComputeResources
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ComputeEnvironment_ComputeResources) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ComputeEnvironment_ComputeResources
+	return plain(resource), nil
 }
 
 func (resource ComputeEnvironment_ComputeResources) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
 	
 	
@@ -44,28 +75,44 @@ func (resource ComputeEnvironment_ComputeResources) Validate() []error {
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("BidPercentage", resource.BidPercentage)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("DesiredvCpus", resource.DesiredvCpus)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Ec2KeyPair", resource.Ec2KeyPair)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ImageId", resource.ImageId)...)
 	if resource.InstanceRole == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceRole'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceRole", resource.InstanceRole)...)
 	if resource.MaxvCpus == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MaxvCpus'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("MaxvCpus", resource.MaxvCpus)...)
 	if resource.MinvCpus == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MinvCpus'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("MinvCpus", resource.MinvCpus)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SpotIamFleetRole", resource.SpotIamFleetRole)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("Tags", resource.Tags)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	if resource.InstanceTypes == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceTypes'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("InstanceTypes", resource.InstanceTypes)...)
 	if resource.SecurityGroupIds == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SecurityGroupIds'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroupIds", resource.SecurityGroupIds)...)
 	if resource.Subnets == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Subnets'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Subnets", resource.Subnets)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ConfigRule_Scope struct {
 	
 	
@@ -10,13 +14,45 @@ type ConfigRule_Scope struct {
 	TagKey interface{} `yaml:"TagKey,omitempty"`
 	TagValue interface{} `yaml:"TagValue,omitempty"`
 	ComplianceResourceTypes interface{} `yaml:"ComplianceResourceTypes,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ConfigRule_Scope) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ConfigRule_Scope
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ConfigRule_Scope) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ConfigRule_Scope
+	return plain(resource), nil
 }
 
 func (resource ConfigRule_Scope) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ComplianceResourceId", resource.ComplianceResourceId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("TagKey", resource.TagKey)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("TagValue", resource.TagValue)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ComplianceResourceTypes", resource.ComplianceResourceTypes)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ConfigRule_Source struct {
 	
@@ -9,18 +13,46 @@ type ConfigRule_Source struct {
 	Owner interface{} `yaml:"Owner"`
 	SourceIdentifier interface{} `yaml:"SourceIdentifier"`
 	SourceDetails interface{} `yaml:"SourceDetails,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ConfigRule_Source) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ConfigRule_Source
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ConfigRule_Source) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ConfigRule_Source
+	return plain(resource), nil
 }
 
 func (resource ConfigRule_Source) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Owner == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Owner'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Owner", resource.Owner)...)
 	if resource.SourceIdentifier == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SourceIdentifier'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("SourceIdentifier", resource.SourceIdentifier)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &ConfigRule_SourceDetail{} }))("SourceDetails", resource.SourceDetails)...)
 	return errs
 }
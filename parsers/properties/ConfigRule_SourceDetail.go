@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ConfigRule_SourceDetail struct {
 	
@@ -9,18 +13,46 @@ type ConfigRule_SourceDetail struct {
 	EventSource interface{} `yaml:"EventSource"`
 	MaximumExecutionFrequency interface{} `yaml:"MaximumExecutionFrequency,omitempty"`
 	MessageType interface{} `yaml:"MessageType"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ConfigRule_SourceDetail) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ConfigRule_SourceDetail
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ConfigRule_SourceDetail) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ConfigRule_SourceDetail
+	return plain(resource), nil
 }
 
 func (resource ConfigRule_SourceDetail) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.EventSource == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'EventSource'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("EventSource", resource.EventSource)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("MaximumExecutionFrequency", resource.MaximumExecutionFrequency)...)
 	if resource.MessageType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MessageType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MessageType", resource.MessageType)...)
 	return errs
 }
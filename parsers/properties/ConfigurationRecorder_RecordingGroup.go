@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ConfigurationRecorder_RecordingGroup struct {
 	
 	
@@ -8,12 +12,42 @@ type ConfigurationRecorder_RecordingGroup struct {
 	AllSupported interface{} `yaml:"AllSupported,omitempty"`
 	IncludeGlobalResourceTypes interface{} `yaml:"IncludeGlobalResourceTypes,omitempty"`
 	ResourceTypes interface{} `yaml:"ResourceTypes,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ConfigurationRecorder_RecordingGroup) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ConfigurationRecorder_RecordingGroup
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ConfigurationRecorder_RecordingGroup) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ConfigurationRecorder_RecordingGroup
+	return plain(resource), nil
 }
 
 func (resource ConfigurationRecorder_RecordingGroup) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AllSupported", resource.AllSupported)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("IncludeGlobalResourceTypes", resource.IncludeGlobalResourceTypes)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ResourceTypes", resource.ResourceTypes)...)
 	return errs
 }
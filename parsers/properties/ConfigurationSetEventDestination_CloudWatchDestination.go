@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ConfigurationSetEventDestination_CloudWatchDestination struct {
 	
 	DimensionConfigurations interface{} `yaml:"DimensionConfigurations,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ConfigurationSetEventDestination_CloudWatchDestination) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ConfigurationSetEventDestination_CloudWatchDestination
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ConfigurationSetEventDestination_CloudWatchDestination) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ConfigurationSetEventDestination_CloudWatchDestination
+	return plain(resource), nil
 }
 
 func (resource ConfigurationSetEventDestination_CloudWatchDestination) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &ConfigurationSetEventDestination_DimensionConfiguration{} }))("DimensionConfigurations", resource.DimensionConfigurations)...)
 	return errs
 }
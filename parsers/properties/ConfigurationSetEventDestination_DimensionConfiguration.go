@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ConfigurationSetEventDestination_DimensionConfiguration struct {
 	
@@ -9,21 +13,48 @@ type ConfigurationSetEventDestination_DimensionConfiguration struct {
 	DefaultDimensionValue interface{} `yaml:"DefaultDimensionValue"`
 	DimensionName interface{} `yaml:"DimensionName"`
 	DimensionValueSource interface{} `yaml:"DimensionValueSource"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ConfigurationSetEventDestination_DimensionConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ConfigurationSetEventDestination_DimensionConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ConfigurationSetEventDestination_DimensionConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ConfigurationSetEventDestination_DimensionConfiguration
+	return plain(resource), nil
 }
 
 func (resource ConfigurationSetEventDestination_DimensionConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.DefaultDimensionValue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DefaultDimensionValue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DefaultDimensionValue", resource.DefaultDimensionValue)...)
 	if resource.DimensionName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DimensionName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DimensionName", resource.DimensionName)...)
 	if resource.DimensionValueSource == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DimensionValueSource'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DimensionValueSource", resource.DimensionValueSource)...)
 	return errs
 }
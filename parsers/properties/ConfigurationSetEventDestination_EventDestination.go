@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ConfigurationSetEventDestination_EventDestination struct {
 	
@@ -13,17 +17,54 @@ type ConfigurationSetEventDestination_EventDestination struct {
 	MatchingEventTypes interface{} `yaml:"MatchingEventTypes"`
 	KinesisFirehoseDestination *ConfigurationSetEventDestination_KinesisFirehoseDestination `yaml:"KinesisFirehoseDestination,omitempty"`
 	CloudWatchDestination *ConfigurationSetEventDestination_CloudWatchDestination `yaml:"CloudWatchDestination,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ConfigurationSetEventDestination_EventDestination) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ConfigurationSetEventDestination_EventDestination
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ConfigurationSetEventDestination_EventDestination) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ConfigurationSetEventDestination_EventDestination
+	return plain(resource), nil
 }
 
 func (resource ConfigurationSetEventDestination_EventDestination) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.MatchingEventTypes == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MatchingEventTypes'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("MatchingEventTypes", resource.MatchingEventTypes)...)
+	
+	if resource.KinesisFirehoseDestination != nil {
+		errs = append(errs, types.PrefixErrors("KinesisFirehoseDestination", resource.KinesisFirehoseDestination.Validate())...)
+	}
+	
+	if resource.CloudWatchDestination != nil {
+		errs = append(errs, types.PrefixErrors("CloudWatchDestination", resource.CloudWatchDestination.Validate())...)
+	}
 	return errs
 }
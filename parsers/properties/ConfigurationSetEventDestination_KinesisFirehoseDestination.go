@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ConfigurationSetEventDestination_KinesisFirehoseDestination struct {
 	
 	
 	DeliveryStreamARN interface{} `yaml:"DeliveryStreamARN"`
 	IAMRoleARN interface{} `yaml:"IAMRoleARN"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ConfigurationSetEventDestination_KinesisFirehoseDestination) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ConfigurationSetEventDestination_KinesisFirehoseDestination
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ConfigurationSetEventDestination_KinesisFirehoseDestination) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ConfigurationSetEventDestination_KinesisFirehoseDestination
+	return plain(resource), nil
 }
 
 func (resource ConfigurationSetEventDestination_KinesisFirehoseDestination) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.DeliveryStreamARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DeliveryStreamARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DeliveryStreamARN", resource.DeliveryStreamARN)...)
 	if resource.IAMRoleARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IAMRoleARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IAMRoleARN", resource.IAMRoleARN)...)
 	return errs
 }
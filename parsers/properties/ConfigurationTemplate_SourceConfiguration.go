@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ConfigurationTemplate_SourceConfiguration struct {
 	
 	
 	ApplicationName interface{} `yaml:"ApplicationName"`
 	TemplateName interface{} `yaml:"TemplateName"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ConfigurationTemplate_SourceConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ConfigurationTemplate_SourceConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ConfigurationTemplate_SourceConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ConfigurationTemplate_SourceConfiguration
+	return plain(resource), nil
 }
 
 func (resource ConfigurationTemplate_SourceConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.ApplicationName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ApplicationName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ApplicationName", resource.ApplicationName)...)
 	if resource.TemplateName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TemplateName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TemplateName", resource.TemplateName)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Connection_ConnectionInput struct {
 	
@@ -15,10 +19,34 @@ type Connection_ConnectionInput struct {
 	Name interface{} `yaml:"Name,omitempty"`
 	PhysicalConnectionRequirements *Connection_PhysicalConnectionRequirements `yaml:"PhysicalConnectionRequirements,omitempty"`
 	MatchCriteria interface{} `yaml:"MatchCriteria,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Connection_ConnectionInput) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Connection_ConnectionInput
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Connection_ConnectionInput) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Connection_ConnectionInput
+	return plain(resource), nil
 }
 
 func (resource Connection_ConnectionInput) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -28,8 +56,20 @@ func (resource Connection_ConnectionInput) Validate() []error {
 	if resource.ConnectionProperties == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ConnectionProperties'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Json")("ConnectionProperties", resource.ConnectionProperties)...)
 	if resource.ConnectionType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ConnectionType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ConnectionType", resource.ConnectionType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	if resource.PhysicalConnectionRequirements != nil {
+		errs = append(errs, types.PrefixErrors("PhysicalConnectionRequirements", resource.PhysicalConnectionRequirements.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("MatchCriteria", resource.MatchCriteria)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Connection_PhysicalConnectionRequirements struct {
 	
 	
@@ -8,12 +12,42 @@ type Connection_PhysicalConnectionRequirements struct {
 	AvailabilityZone interface{} `yaml:"AvailabilityZone,omitempty"`
 	SubnetId interface{} `yaml:"SubnetId,omitempty"`
 	SecurityGroupIdList interface{} `yaml:"SecurityGroupIdList,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Connection_PhysicalConnectionRequirements) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Connection_PhysicalConnectionRequirements
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Connection_PhysicalConnectionRequirements) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Connection_PhysicalConnectionRequirements
+	return plain(resource), nil
 }
 
 func (resource Connection_PhysicalConnectionRequirements) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AvailabilityZone", resource.AvailabilityZone)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroupIdList", resource.SecurityGroupIdList)...)
 	return errs
 }
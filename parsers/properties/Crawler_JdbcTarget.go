@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Crawler_JdbcTarget struct {
 	
 	
@@ -8,12 +12,42 @@ type Crawler_JdbcTarget struct {
 	ConnectionName interface{} `yaml:"ConnectionName,omitempty"`
 	Path interface{} `yaml:"Path,omitempty"`
 	Exclusions interface{} `yaml:"Exclusions,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Crawler_JdbcTarget) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Crawler_JdbcTarget
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Crawler_JdbcTarget) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Crawler_JdbcTarget
+	return plain(resource), nil
 }
 
 func (resource Crawler_JdbcTarget) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ConnectionName", resource.ConnectionName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Path", resource.Path)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Exclusions", resource.Exclusions)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Crawler_S3Target struct {
 	
 	
 	Path interface{} `yaml:"Path,omitempty"`
 	Exclusions interface{} `yaml:"Exclusions,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Crawler_S3Target) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Crawler_S3Target
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Crawler_S3Target) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Crawler_S3Target
+	return plain(resource), nil
 }
 
 func (resource Crawler_S3Target) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Path", resource.Path)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Exclusions", resource.Exclusions)...)
 	return errs
 }
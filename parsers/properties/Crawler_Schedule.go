@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Crawler_Schedule struct {
 	
 	ScheduleExpression interface{} `yaml:"ScheduleExpression,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Crawler_Schedule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Crawler_Schedule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Crawler_Schedule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Crawler_Schedule
+	return plain(resource), nil
 }
 
 func (resource Crawler_Schedule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ScheduleExpression", resource.ScheduleExpression)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Crawler_SchemaChangePolicy struct {
 	
 	
 	DeleteBehavior interface{} `yaml:"DeleteBehavior,omitempty"`
 	UpdateBehavior interface{} `yaml:"UpdateBehavior,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Crawler_SchemaChangePolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Crawler_SchemaChangePolicy
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Crawler_SchemaChangePolicy) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Crawler_SchemaChangePolicy
+	return plain(resource), nil
 }
 
 func (resource Crawler_SchemaChangePolicy) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DeleteBehavior", resource.DeleteBehavior)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("UpdateBehavior", resource.UpdateBehavior)...)
 	return errs
 }
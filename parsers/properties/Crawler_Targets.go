@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Crawler_Targets struct {
 	
 	
 	JdbcTargets interface{} `yaml:"JdbcTargets,omitempty"`
 	S3Targets interface{} `yaml:"S3Targets,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Crawler_Targets) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Crawler_Targets
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Crawler_Targets) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Crawler_Targets
+	return plain(resource), nil
 }
 
 func (resource Crawler_Targets) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Crawler_JdbcTarget{} }))("JdbcTargets", resource.JdbcTargets)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Crawler_S3Target{} }))("S3Targets", resource.S3Targets)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type CustomActionType_ArtifactDetails struct {
 	
 	
 	MaximumCount interface{} `yaml:"MaximumCount"`
 	MinimumCount interface{} `yaml:"MinimumCount"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *CustomActionType_ArtifactDetails) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain CustomActionType_ArtifactDetails
+	return unmarshal((*plain)(resource))
+}
+
+func (resource CustomActionType_ArtifactDetails) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain CustomActionType_ArtifactDetails
+	return plain(resource), nil
 }
 
 func (resource CustomActionType_ArtifactDetails) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.MaximumCount == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MaximumCount'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("MaximumCount", resource.MaximumCount)...)
 	if resource.MinimumCount == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MinimumCount'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("MinimumCount", resource.MinimumCount)...)
 	return errs
 }
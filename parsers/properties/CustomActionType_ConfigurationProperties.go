@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type CustomActionType_ConfigurationProperties struct {
 	
@@ -17,10 +21,34 @@ type CustomActionType_ConfigurationProperties struct {
 	Required interface{} `yaml:"Required"`
 	Secret interface{} `yaml:"Secret"`
 	Type interface{} `yaml:"Type,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *CustomActionType_ConfigurationProperties) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain CustomActionType_ConfigurationProperties
+	return unmarshal((*plain)(resource))
+}
+
+func (resource CustomActionType_ConfigurationProperties) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain CustomActionType_ConfigurationProperties
+	return plain(resource), nil
 }
 
 func (resource CustomActionType_ConfigurationProperties) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -28,17 +56,27 @@ func (resource CustomActionType_ConfigurationProperties) Validate() []error {
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.Key == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Key'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Key", resource.Key)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Queryable", resource.Queryable)...)
 	if resource.Required == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Required'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Required", resource.Required)...)
 	if resource.Secret == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Secret'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Secret", resource.Secret)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type CustomActionType_Settings struct {
 	
 	
@@ -10,13 +14,45 @@ type CustomActionType_Settings struct {
 	ExecutionUrlTemplate interface{} `yaml:"ExecutionUrlTemplate,omitempty"`
 	RevisionUrlTemplate interface{} `yaml:"RevisionUrlTemplate,omitempty"`
 	ThirdPartyConfigurationUrl interface{} `yaml:"ThirdPartyConfigurationUrl,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *CustomActionType_Settings) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain CustomActionType_Settings
+	return unmarshal((*plain)(resource))
+}
+
+func (resource CustomActionType_Settings) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain CustomActionType_Settings
+	return plain(resource), nil
 }
 
 func (resource CustomActionType_Settings) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("EntityUrlTemplate", resource.EntityUrlTemplate)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ExecutionUrlTemplate", resource.ExecutionUrlTemplate)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("RevisionUrlTemplate", resource.RevisionUrlTemplate)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ThirdPartyConfigurationUrl", resource.ThirdPartyConfigurationUrl)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DBSecurityGroup_Ingress struct {
 	
 	
@@ -10,13 +14,45 @@ type DBSecurityGroup_Ingress struct {
 	EC2SecurityGroupId interface{} `yaml:"EC2SecurityGroupId,omitempty"`
 	EC2SecurityGroupName interface{} `yaml:"EC2SecurityGroupName,omitempty"`
 	EC2SecurityGroupOwnerId interface{} `yaml:"EC2SecurityGroupOwnerId,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DBSecurityGroup_Ingress) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DBSecurityGroup_Ingress
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DBSecurityGroup_Ingress) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DBSecurityGroup_Ingress
+	return plain(resource), nil
 }
 
 func (resource DBSecurityGroup_Ingress) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CIDRIP", resource.CIDRIP)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("EC2SecurityGroupId", resource.EC2SecurityGroupId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("EC2SecurityGroupName", resource.EC2SecurityGroupName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("EC2SecurityGroupOwnerId", resource.EC2SecurityGroupOwnerId)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DataSource_DynamoDBConfig struct {
 	
@@ -9,18 +13,46 @@ type DataSource_DynamoDBConfig struct {
 	AwsRegion interface{} `yaml:"AwsRegion"`
 	TableName interface{} `yaml:"TableName"`
 	UseCallerCredentials interface{} `yaml:"UseCallerCredentials,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DataSource_DynamoDBConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DataSource_DynamoDBConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DataSource_DynamoDBConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DataSource_DynamoDBConfig
+	return plain(resource), nil
 }
 
 func (resource DataSource_DynamoDBConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.AwsRegion == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AwsRegion'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AwsRegion", resource.AwsRegion)...)
 	if resource.TableName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TableName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TableName", resource.TableName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("UseCallerCredentials", resource.UseCallerCredentials)...)
 	return errs
 }
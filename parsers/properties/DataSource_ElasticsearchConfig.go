@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DataSource_ElasticsearchConfig struct {
 	
 	
 	AwsRegion interface{} `yaml:"AwsRegion"`
 	Endpoint interface{} `yaml:"Endpoint"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DataSource_ElasticsearchConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DataSource_ElasticsearchConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DataSource_ElasticsearchConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DataSource_ElasticsearchConfig
+	return plain(resource), nil
 }
 
 func (resource DataSource_ElasticsearchConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.AwsRegion == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AwsRegion'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AwsRegion", resource.AwsRegion)...)
 	if resource.Endpoint == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Endpoint'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Endpoint", resource.Endpoint)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DataSource_LambdaConfig struct {
 	
 	LambdaFunctionArn interface{} `yaml:"LambdaFunctionArn"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DataSource_LambdaConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DataSource_LambdaConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DataSource_LambdaConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DataSource_LambdaConfig
+	return plain(resource), nil
 }
 
 func (resource DataSource_LambdaConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.LambdaFunctionArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'LambdaFunctionArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("LambdaFunctionArn", resource.LambdaFunctionArn)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Database_DatabaseInput struct {
 	
 	
@@ -10,13 +14,45 @@ type Database_DatabaseInput struct {
 	LocationUri interface{} `yaml:"LocationUri,omitempty"`
 	Name interface{} `yaml:"Name,omitempty"`
 	Parameters interface{} `yaml:"Parameters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Database_DatabaseInput) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Database_DatabaseInput
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Database_DatabaseInput) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Database_DatabaseInput
+	return plain(resource), nil
 }
 
 func (resource Database_DatabaseInput) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("LocationUri", resource.LocationUri)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Json")("Parameters", resource.Parameters)...)
 	return errs
 }
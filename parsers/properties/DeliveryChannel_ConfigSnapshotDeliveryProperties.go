@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DeliveryChannel_ConfigSnapshotDeliveryProperties struct {
 	
 	DeliveryFrequency interface{} `yaml:"DeliveryFrequency,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryChannel_ConfigSnapshotDeliveryProperties) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryChannel_ConfigSnapshotDeliveryProperties
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryChannel_ConfigSnapshotDeliveryProperties) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryChannel_ConfigSnapshotDeliveryProperties
+	return plain(resource), nil
 }
 
 func (resource DeliveryChannel_ConfigSnapshotDeliveryProperties) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DeliveryFrequency", resource.DeliveryFrequency)...)
 	return errs
 }
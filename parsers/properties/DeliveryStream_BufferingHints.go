@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeliveryStream_BufferingHints struct {
 	
 	
 	IntervalInSeconds interface{} `yaml:"IntervalInSeconds"`
 	SizeInMBs interface{} `yaml:"SizeInMBs"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_BufferingHints) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_BufferingHints
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_BufferingHints) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_BufferingHints
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_BufferingHints) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.IntervalInSeconds == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IntervalInSeconds'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("IntervalInSeconds", resource.IntervalInSeconds)...)
 	if resource.SizeInMBs == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SizeInMBs'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("SizeInMBs", resource.SizeInMBs)...)
 	return errs
 }
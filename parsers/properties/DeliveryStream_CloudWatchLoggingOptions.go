@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DeliveryStream_CloudWatchLoggingOptions struct {
 	
 	
@@ -8,12 +12,42 @@ type DeliveryStream_CloudWatchLoggingOptions struct {
 	Enabled interface{} `yaml:"Enabled,omitempty"`
 	LogGroupName interface{} `yaml:"LogGroupName,omitempty"`
 	LogStreamName interface{} `yaml:"LogStreamName,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_CloudWatchLoggingOptions) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_CloudWatchLoggingOptions
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_CloudWatchLoggingOptions) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_CloudWatchLoggingOptions
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_CloudWatchLoggingOptions) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("LogGroupName", resource.LogGroupName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("LogStreamName", resource.LogStreamName)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeliveryStream_CopyCommand struct {
 	
@@ -9,15 +13,44 @@ type DeliveryStream_CopyCommand struct {
 	CopyOptions interface{} `yaml:"CopyOptions,omitempty"`
 	DataTableColumns interface{} `yaml:"DataTableColumns,omitempty"`
 	DataTableName interface{} `yaml:"DataTableName"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_CopyCommand) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_CopyCommand
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_CopyCommand) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_CopyCommand
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_CopyCommand) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CopyOptions", resource.CopyOptions)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DataTableColumns", resource.DataTableColumns)...)
 	if resource.DataTableName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DataTableName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DataTableName", resource.DataTableName)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeliveryStream_ElasticsearchDestinationConfiguration struct {
 	
@@ -25,10 +29,34 @@ type DeliveryStream_ElasticsearchDestinationConfiguration struct {
 	RetryOptions *DeliveryStream_ElasticsearchRetryOptions `yaml:"RetryOptions"`
 	BufferingHints *DeliveryStream_ElasticsearchBufferingHints `yaml:"BufferingHints"`
 	CloudWatchLoggingOptions *DeliveryStream_CloudWatchLoggingOptions `yaml:"CloudWatchLoggingOptions,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_ElasticsearchDestinationConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_ElasticsearchDestinationConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_ElasticsearchDestinationConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_ElasticsearchDestinationConfiguration
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_ElasticsearchDestinationConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -43,35 +71,52 @@ func (resource DeliveryStream_ElasticsearchDestinationConfiguration) Validate()
 	if resource.DomainARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DomainARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DomainARN", resource.DomainARN)...)
 	if resource.IndexName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IndexName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IndexName", resource.IndexName)...)
 	if resource.IndexRotationPeriod == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IndexRotationPeriod'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IndexRotationPeriod", resource.IndexRotationPeriod)...)
 	if resource.RoleARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleARN", resource.RoleARN)...)
 	if resource.S3BackupMode == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'S3BackupMode'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("S3BackupMode", resource.S3BackupMode)...)
 	if resource.TypeName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TypeName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TypeName", resource.TypeName)...)
 	if resource.S3Configuration == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'S3Configuration'"))
-	} else {
-		errs = append(errs, resource.S3Configuration.Validate()...)
+	}
+	if resource.S3Configuration != nil {
+		errs = append(errs, types.PrefixErrors("S3Configuration", resource.S3Configuration.Validate())...)
+	}
+	
+	if resource.ProcessingConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("ProcessingConfiguration", resource.ProcessingConfiguration.Validate())...)
 	}
 	if resource.RetryOptions == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RetryOptions'"))
-	} else {
-		errs = append(errs, resource.RetryOptions.Validate()...)
+	}
+	if resource.RetryOptions != nil {
+		errs = append(errs, types.PrefixErrors("RetryOptions", resource.RetryOptions.Validate())...)
 	}
 	if resource.BufferingHints == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BufferingHints'"))
-	} else {
-		errs = append(errs, resource.BufferingHints.Validate()...)
+	}
+	if resource.BufferingHints != nil {
+		errs = append(errs, types.PrefixErrors("BufferingHints", resource.BufferingHints.Validate())...)
+	}
+	
+	if resource.CloudWatchLoggingOptions != nil {
+		errs = append(errs, types.PrefixErrors("CloudWatchLoggingOptions", resource.CloudWatchLoggingOptions.Validate())...)
 	}
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeliveryStream_ElasticsearchRetryOptions struct {
 	
 	DurationInSeconds interface{} `yaml:"DurationInSeconds"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_ElasticsearchRetryOptions) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_ElasticsearchRetryOptions
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_ElasticsearchRetryOptions) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_ElasticsearchRetryOptions
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_ElasticsearchRetryOptions) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.DurationInSeconds == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DurationInSeconds'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("DurationInSeconds", resource.DurationInSeconds)...)
 	return errs
 }
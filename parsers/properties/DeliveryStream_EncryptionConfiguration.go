@@ -1,16 +1,50 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DeliveryStream_EncryptionConfiguration struct {
 	
 	
 	NoEncryptionConfig interface{} `yaml:"NoEncryptionConfig,omitempty"`
 	KMSEncryptionConfig *DeliveryStream_KMSEncryptionConfig `yaml:"KMSEncryptionConfig,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_EncryptionConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_EncryptionConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_EncryptionConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_EncryptionConfiguration
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_EncryptionConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("NoEncryptionConfig", resource.NoEncryptionConfig)...)
 	
+	if resource.KMSEncryptionConfig != nil {
+		errs = append(errs, types.PrefixErrors("KMSEncryptionConfig", resource.KMSEncryptionConfig.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeliveryStream_ExtendedS3DestinationConfiguration struct {
 	
@@ -23,10 +27,34 @@ type DeliveryStream_ExtendedS3DestinationConfiguration struct {
 	EncryptionConfiguration *DeliveryStream_EncryptionConfiguration `yaml:"EncryptionConfiguration,omitempty"`
 	CloudWatchLoggingOptions *DeliveryStream_CloudWatchLoggingOptions `yaml:"CloudWatchLoggingOptions,omitempty"`
 	BufferingHints *DeliveryStream_BufferingHints `yaml:"BufferingHints"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_ExtendedS3DestinationConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_ExtendedS3DestinationConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_ExtendedS3DestinationConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_ExtendedS3DestinationConfiguration
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_ExtendedS3DestinationConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -40,19 +68,42 @@ func (resource DeliveryStream_ExtendedS3DestinationConfiguration) Validate() []e
 	if resource.BucketARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BucketARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("BucketARN", resource.BucketARN)...)
 	if resource.CompressionFormat == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CompressionFormat'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CompressionFormat", resource.CompressionFormat)...)
 	if resource.Prefix == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Prefix'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Prefix", resource.Prefix)...)
 	if resource.RoleARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleARN", resource.RoleARN)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("S3BackupMode", resource.S3BackupMode)...)
+	
+	if resource.S3BackupConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("S3BackupConfiguration", resource.S3BackupConfiguration.Validate())...)
+	}
+	
+	if resource.ProcessingConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("ProcessingConfiguration", resource.ProcessingConfiguration.Validate())...)
+	}
+	
+	if resource.EncryptionConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("EncryptionConfiguration", resource.EncryptionConfiguration.Validate())...)
+	}
+	
+	if resource.CloudWatchLoggingOptions != nil {
+		errs = append(errs, types.PrefixErrors("CloudWatchLoggingOptions", resource.CloudWatchLoggingOptions.Validate())...)
+	}
 	if resource.BufferingHints == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BufferingHints'"))
-	} else {
-		errs = append(errs, resource.BufferingHints.Validate()...)
+	}
+	if resource.BufferingHints != nil {
+		errs = append(errs, types.PrefixErrors("BufferingHints", resource.BufferingHints.Validate())...)
 	}
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeliveryStream_KMSEncryptionConfig struct {
 	
 	AWSKMSKeyARN interface{} `yaml:"AWSKMSKeyARN"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_KMSEncryptionConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_KMSEncryptionConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_KMSEncryptionConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_KMSEncryptionConfig
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_KMSEncryptionConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.AWSKMSKeyARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AWSKMSKeyARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AWSKMSKeyARN", resource.AWSKMSKeyARN)...)
 	return errs
 }
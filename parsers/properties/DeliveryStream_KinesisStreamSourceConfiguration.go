@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeliveryStream_KinesisStreamSourceConfiguration struct {
 	
 	
 	KinesisStreamARN interface{} `yaml:"KinesisStreamARN"`
 	RoleARN interface{} `yaml:"RoleARN"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_KinesisStreamSourceConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_KinesisStreamSourceConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_KinesisStreamSourceConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_KinesisStreamSourceConfiguration
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_KinesisStreamSourceConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.KinesisStreamARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'KinesisStreamARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("KinesisStreamARN", resource.KinesisStreamARN)...)
 	if resource.RoleARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleARN", resource.RoleARN)...)
 	return errs
 }
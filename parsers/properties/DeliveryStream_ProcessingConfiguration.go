@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeliveryStream_ProcessingConfiguration struct {
 	
 	
 	Enabled interface{} `yaml:"Enabled"`
 	Processors interface{} `yaml:"Processors"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_ProcessingConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_ProcessingConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_ProcessingConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_ProcessingConfiguration
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_ProcessingConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Enabled == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Enabled'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	if resource.Processors == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Processors'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &DeliveryStream_Processor{} }))("Processors", resource.Processors)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeliveryStream_Processor struct {
 	
 	
 	Type interface{} `yaml:"Type"`
 	Parameters interface{} `yaml:"Parameters"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_Processor) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_Processor
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_Processor) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_Processor
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_Processor) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	if resource.Parameters == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Parameters'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &DeliveryStream_ProcessorParameter{} }))("Parameters", resource.Parameters)...)
 	return errs
 }
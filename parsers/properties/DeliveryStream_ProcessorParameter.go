@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeliveryStream_ProcessorParameter struct {
 	
 	
 	ParameterName interface{} `yaml:"ParameterName"`
 	ParameterValue interface{} `yaml:"ParameterValue"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_ProcessorParameter) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_ProcessorParameter
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_ProcessorParameter) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_ProcessorParameter
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_ProcessorParameter) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.ParameterName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ParameterName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ParameterName", resource.ParameterName)...)
 	if resource.ParameterValue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ParameterValue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ParameterValue", resource.ParameterValue)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeliveryStream_RedshiftDestinationConfiguration struct {
 	
@@ -19,10 +23,34 @@ type DeliveryStream_RedshiftDestinationConfiguration struct {
 	ProcessingConfiguration *DeliveryStream_ProcessingConfiguration `yaml:"ProcessingConfiguration,omitempty"`
 	CopyCommand *DeliveryStream_CopyCommand `yaml:"CopyCommand"`
 	CloudWatchLoggingOptions *DeliveryStream_CloudWatchLoggingOptions `yaml:"CloudWatchLoggingOptions,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_RedshiftDestinationConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_RedshiftDestinationConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_RedshiftDestinationConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_RedshiftDestinationConfiguration
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_RedshiftDestinationConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -34,24 +62,38 @@ func (resource DeliveryStream_RedshiftDestinationConfiguration) Validate() []err
 	if resource.ClusterJDBCURL == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ClusterJDBCURL'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ClusterJDBCURL", resource.ClusterJDBCURL)...)
 	if resource.Password == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Password'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Password", resource.Password)...)
 	if resource.RoleARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleARN", resource.RoleARN)...)
 	if resource.Username == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Username'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Username", resource.Username)...)
 	if resource.S3Configuration == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'S3Configuration'"))
-	} else {
-		errs = append(errs, resource.S3Configuration.Validate()...)
+	}
+	if resource.S3Configuration != nil {
+		errs = append(errs, types.PrefixErrors("S3Configuration", resource.S3Configuration.Validate())...)
+	}
+	
+	if resource.ProcessingConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("ProcessingConfiguration", resource.ProcessingConfiguration.Validate())...)
 	}
 	if resource.CopyCommand == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CopyCommand'"))
-	} else {
-		errs = append(errs, resource.CopyCommand.Validate()...)
+	}
+	if resource.CopyCommand != nil {
+		errs = append(errs, types.PrefixErrors("CopyCommand", resource.CopyCommand.Validate())...)
+	}
+	
+	if resource.CloudWatchLoggingOptions != nil {
+		errs = append(errs, types.PrefixErrors("CloudWatchLoggingOptions", resource.CloudWatchLoggingOptions.Validate())...)
 	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeliveryStream_S3DestinationConfiguration struct {
 	
@@ -17,10 +21,34 @@ type DeliveryStream_S3DestinationConfiguration struct {
 	EncryptionConfiguration *DeliveryStream_EncryptionConfiguration `yaml:"EncryptionConfiguration,omitempty"`
 	CloudWatchLoggingOptions *DeliveryStream_CloudWatchLoggingOptions `yaml:"CloudWatchLoggingOptions,omitempty"`
 	BufferingHints *DeliveryStream_BufferingHints `yaml:"BufferingHints"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeliveryStream_S3DestinationConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeliveryStream_S3DestinationConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeliveryStream_S3DestinationConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeliveryStream_S3DestinationConfiguration
+	return plain(resource), nil
 }
 
 func (resource DeliveryStream_S3DestinationConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -31,16 +59,30 @@ func (resource DeliveryStream_S3DestinationConfiguration) Validate() []error {
 	if resource.BucketARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BucketARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("BucketARN", resource.BucketARN)...)
 	if resource.CompressionFormat == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CompressionFormat'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CompressionFormat", resource.CompressionFormat)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Prefix", resource.Prefix)...)
 	if resource.RoleARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleARN", resource.RoleARN)...)
+	
+	if resource.EncryptionConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("EncryptionConfiguration", resource.EncryptionConfiguration.Validate())...)
+	}
+	
+	if resource.CloudWatchLoggingOptions != nil {
+		errs = append(errs, types.PrefixErrors("CloudWatchLoggingOptions", resource.CloudWatchLoggingOptions.Validate())...)
+	}
 	if resource.BufferingHints == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BufferingHints'"))
-	} else {
-		errs = append(errs, resource.BufferingHints.Validate()...)
+	}
+	if resource.BufferingHints != nil {
+		errs = append(errs, types.PrefixErrors("BufferingHints", resource.BufferingHints.Validate())...)
 	}
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeploymentConfig_MinimumHealthyHosts struct {
 	
 	
 	Type interface{} `yaml:"Type"`
 	Value interface{} `yaml:"Value"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeploymentConfig_MinimumHealthyHosts) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeploymentConfig_MinimumHealthyHosts
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeploymentConfig_MinimumHealthyHosts) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeploymentConfig_MinimumHealthyHosts
+	return plain(resource), nil
 }
 
 func (resource DeploymentConfig_MinimumHealthyHosts) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	if resource.Value == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Value'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Value", resource.Value)...)
 	return errs
 }
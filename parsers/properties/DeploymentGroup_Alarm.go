@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DeploymentGroup_Alarm struct {
 	
 	Name interface{} `yaml:"Name,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeploymentGroup_Alarm) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeploymentGroup_Alarm
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeploymentGroup_Alarm) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeploymentGroup_Alarm
+	return plain(resource), nil
 }
 
 func (resource DeploymentGroup_Alarm) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	return errs
 }
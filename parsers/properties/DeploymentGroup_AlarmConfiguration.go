@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DeploymentGroup_AlarmConfiguration struct {
 	
 	
@@ -8,12 +12,42 @@ type DeploymentGroup_AlarmConfiguration struct {
 	Enabled interface{} `yaml:"Enabled,omitempty"`
 	IgnorePollAlarmFailure interface{} `yaml:"IgnorePollAlarmFailure,omitempty"`
 	Alarms interface{} `yaml:"Alarms,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeploymentGroup_AlarmConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeploymentGroup_AlarmConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeploymentGroup_AlarmConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeploymentGroup_AlarmConfiguration
+	return plain(resource), nil
 }
 
 func (resource DeploymentGroup_AlarmConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("IgnorePollAlarmFailure", resource.IgnorePollAlarmFailure)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &DeploymentGroup_Alarm{} }))("Alarms", resource.Alarms)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DeploymentGroup_AutoRollbackConfiguration struct {
 	
 	
 	Enabled interface{} `yaml:"Enabled,omitempty"`
 	Events interface{} `yaml:"Events,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeploymentGroup_AutoRollbackConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeploymentGroup_AutoRollbackConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeploymentGroup_AutoRollbackConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeploymentGroup_AutoRollbackConfiguration
+	return plain(resource), nil
 }
 
 func (resource DeploymentGroup_AutoRollbackConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Events", resource.Events)...)
 	return errs
 }
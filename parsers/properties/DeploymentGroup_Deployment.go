@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeploymentGroup_Deployment struct {
 	
@@ -9,17 +13,46 @@ type DeploymentGroup_Deployment struct {
 	Description interface{} `yaml:"Description,omitempty"`
 	IgnoreApplicationStopFailures interface{} `yaml:"IgnoreApplicationStopFailures,omitempty"`
 	Revision *DeploymentGroup_RevisionLocation `yaml:"Revision"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeploymentGroup_Deployment) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeploymentGroup_Deployment
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeploymentGroup_Deployment) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeploymentGroup_Deployment
+	return plain(resource), nil
 }
 
 func (resource DeploymentGroup_Deployment) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("IgnoreApplicationStopFailures", resource.IgnoreApplicationStopFailures)...)
 	if resource.Revision == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Revision'"))
-	} else {
-		errs = append(errs, resource.Revision.Validate()...)
+	}
+	if resource.Revision != nil {
+		errs = append(errs, types.PrefixErrors("Revision", resource.Revision.Validate())...)
 	}
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DeploymentGroup_DeploymentStyle struct {
 	
 	
 	DeploymentOption interface{} `yaml:"DeploymentOption,omitempty"`
 	DeploymentType interface{} `yaml:"DeploymentType,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeploymentGroup_DeploymentStyle) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeploymentGroup_DeploymentStyle
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeploymentGroup_DeploymentStyle) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeploymentGroup_DeploymentStyle
+	return plain(resource), nil
 }
 
 func (resource DeploymentGroup_DeploymentStyle) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DeploymentOption", resource.DeploymentOption)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DeploymentType", resource.DeploymentType)...)
 	return errs
 }
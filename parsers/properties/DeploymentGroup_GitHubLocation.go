@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeploymentGroup_GitHubLocation struct {
 	
 	
 	CommitId interface{} `yaml:"CommitId"`
 	Repository interface{} `yaml:"Repository"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeploymentGroup_GitHubLocation) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeploymentGroup_GitHubLocation
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeploymentGroup_GitHubLocation) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeploymentGroup_GitHubLocation
+	return plain(resource), nil
 }
 
 func (resource DeploymentGroup_GitHubLocation) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.CommitId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CommitId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CommitId", resource.CommitId)...)
 	if resource.Repository == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Repository'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Repository", resource.Repository)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DeploymentGroup_LoadBalancerInfo struct {
 	
 	
 	ElbInfoList interface{} `yaml:"ElbInfoList,omitempty"`
 	TargetGroupInfoList interface{} `yaml:"TargetGroupInfoList,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeploymentGroup_LoadBalancerInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeploymentGroup_LoadBalancerInfo
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeploymentGroup_LoadBalancerInfo) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeploymentGroup_LoadBalancerInfo
+	return plain(resource), nil
 }
 
 func (resource DeploymentGroup_LoadBalancerInfo) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &DeploymentGroup_ELBInfo{} }))("ElbInfoList", resource.ElbInfoList)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &DeploymentGroup_TargetGroupInfo{} }))("TargetGroupInfoList", resource.TargetGroupInfoList)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DeploymentGroup_RevisionLocation struct {
 	
 	
@@ -8,12 +12,46 @@ type DeploymentGroup_RevisionLocation struct {
 	RevisionType interface{} `yaml:"RevisionType,omitempty"`
 	S3Location *DeploymentGroup_S3Location `yaml:"S3Location,omitempty"`
 	GitHubLocation *DeploymentGroup_GitHubLocation `yaml:"GitHubLocation,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeploymentGroup_RevisionLocation) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeploymentGroup_RevisionLocation
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeploymentGroup_RevisionLocation) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeploymentGroup_RevisionLocation
+	return plain(resource), nil
 }
 
 func (resource DeploymentGroup_RevisionLocation) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("RevisionType", resource.RevisionType)...)
 	
+	if resource.S3Location != nil {
+		errs = append(errs, types.PrefixErrors("S3Location", resource.S3Location.Validate())...)
+	}
 	
+	if resource.GitHubLocation != nil {
+		errs = append(errs, types.PrefixErrors("GitHubLocation", resource.GitHubLocation.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type DeploymentGroup_S3Location struct {
 	
@@ -13,10 +17,34 @@ type DeploymentGroup_S3Location struct {
 	ETag interface{} `yaml:"ETag,omitempty"`
 	Key interface{} `yaml:"Key"`
 	Version interface{} `yaml:"Version,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeploymentGroup_S3Location) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeploymentGroup_S3Location
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeploymentGroup_S3Location) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeploymentGroup_S3Location
+	return plain(resource), nil
 }
 
 func (resource DeploymentGroup_S3Location) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -25,8 +53,16 @@ func (resource DeploymentGroup_S3Location) Validate() []error {
 	if resource.Bucket == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Bucket'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Bucket", resource.Bucket)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("BundleType", resource.BundleType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ETag", resource.ETag)...)
 	if resource.Key == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Key'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Key", resource.Key)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Version", resource.Version)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DeploymentGroup_TagFilter struct {
 	
 	
@@ -8,12 +12,42 @@ type DeploymentGroup_TagFilter struct {
 	Key interface{} `yaml:"Key,omitempty"`
 	Type interface{} `yaml:"Type,omitempty"`
 	Value interface{} `yaml:"Value,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeploymentGroup_TagFilter) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeploymentGroup_TagFilter
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeploymentGroup_TagFilter) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeploymentGroup_TagFilter
+	return plain(resource), nil
 }
 
 func (resource DeploymentGroup_TagFilter) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Key", resource.Key)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Value", resource.Value)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DeploymentGroup_TriggerConfig struct {
 	
 	
@@ -8,12 +12,42 @@ type DeploymentGroup_TriggerConfig struct {
 	TriggerName interface{} `yaml:"TriggerName,omitempty"`
 	TriggerTargetArn interface{} `yaml:"TriggerTargetArn,omitempty"`
 	TriggerEvents interface{} `yaml:"TriggerEvents,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DeploymentGroup_TriggerConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DeploymentGroup_TriggerConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DeploymentGroup_TriggerConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DeploymentGroup_TriggerConfig
+	return plain(resource), nil
 }
 
 func (resource DeploymentGroup_TriggerConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("TriggerName", resource.TriggerName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("TriggerTargetArn", resource.TriggerTargetArn)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("TriggerEvents", resource.TriggerEvents)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Deployment_MethodSetting struct {
 	
 	
@@ -22,19 +26,63 @@ type Deployment_MethodSetting struct {
 	ResourcePath interface{} `yaml:"ResourcePath,omitempty"`
 	ThrottlingBurstLimit interface{} `yaml:"ThrottlingBurstLimit,omitempty"`
 	ThrottlingRateLimit interface{} `yaml:"ThrottlingRateLimit,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Deployment_MethodSetting) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Deployment_MethodSetting
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Deployment_MethodSetting) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Deployment_MethodSetting
+	return plain(resource), nil
 }
 
 func (resource Deployment_MethodSetting) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("CacheDataEncrypted", resource.CacheDataEncrypted)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("CacheTtlInSeconds", resource.CacheTtlInSeconds)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("CachingEnabled", resource.CachingEnabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DataTraceEnabled", resource.DataTraceEnabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("HttpMethod", resource.HttpMethod)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("LoggingLevel", resource.LoggingLevel)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("MetricsEnabled", resource.MetricsEnabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ResourcePath", resource.ResourcePath)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ThrottlingBurstLimit", resource.ThrottlingBurstLimit)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("ThrottlingRateLimit", resource.ThrottlingRateLimit)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Deployment_StageDescription struct {
 	
 	
@@ -32,24 +36,78 @@ type Deployment_StageDescription struct {
 	ThrottlingRateLimit interface{} `yaml:"ThrottlingRateLimit,omitempty"`
 	Variables interface{} `yaml:"Variables,omitempty"`
 	MethodSettings interface{} `yaml:"MethodSettings,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Deployment_StageDescription) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Deployment_StageDescription
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Deployment_StageDescription) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Deployment_StageDescription
+	return plain(resource), nil
 }
 
 func (resource Deployment_StageDescription) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("CacheClusterEnabled", resource.CacheClusterEnabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CacheClusterSize", resource.CacheClusterSize)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("CacheDataEncrypted", resource.CacheDataEncrypted)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("CacheTtlInSeconds", resource.CacheTtlInSeconds)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("CachingEnabled", resource.CachingEnabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ClientCertificateId", resource.ClientCertificateId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DataTraceEnabled", resource.DataTraceEnabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DocumentationVersion", resource.DocumentationVersion)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("LoggingLevel", resource.LoggingLevel)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("MetricsEnabled", resource.MetricsEnabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ThrottlingBurstLimit", resource.ThrottlingBurstLimit)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("ThrottlingRateLimit", resource.ThrottlingRateLimit)...)
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Variables", resource.Variables)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Deployment_MethodSetting{} }))("MethodSettings", resource.MethodSettings)...)
 	return errs
 }
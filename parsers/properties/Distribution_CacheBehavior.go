@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Distribution_CacheBehavior struct {
 	
@@ -29,10 +33,35 @@ type Distribution_CacheBehavior struct {
 	LambdaFunctionAssociations interface{} `yaml:"LambdaFunctionAssociations,omitempty"`
 	TrustedSigners interface{} `yaml:"TrustedSigners,omitempty"`
 	ForwardedValues *Distribution_ForwardedValues `yaml:"ForwardedValues"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Distribution_CacheBehavior) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Distribution_CacheBehavior
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Distribution_CacheBehavior) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Distribution_CacheBehavior
+	return plain(resource), nil
 }
 
 func (resource Distribution_CacheBehavior) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
@@ -46,19 +75,40 @@ func (resource Distribution_CacheBehavior) Validate() []error {
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Compress", resource.Compress)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Double")("DefaultTTL", resource.DefaultTTL)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Double")("MaxTTL", resource.MaxTTL)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Double")("MinTTL", resource.MinTTL)...)
 	if resource.PathPattern == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PathPattern'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PathPattern", resource.PathPattern)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("SmoothStreaming", resource.SmoothStreaming)...)
 	if resource.TargetOriginId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TargetOriginId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TargetOriginId", resource.TargetOriginId)...)
 	if resource.ViewerProtocolPolicy == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ViewerProtocolPolicy'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ViewerProtocolPolicy", resource.ViewerProtocolPolicy)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AllowedMethods", resource.AllowedMethods)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("CachedMethods", resource.CachedMethods)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Distribution_LambdaFunctionAssociation{} }))("LambdaFunctionAssociations", resource.LambdaFunctionAssociations)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("TrustedSigners", resource.TrustedSigners)...)
 	if resource.ForwardedValues == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ForwardedValues'"))
-	} else {
-		errs = append(errs, resource.ForwardedValues.Validate()...)
+	}
+	if resource.ForwardedValues != nil {
+		errs = append(errs, types.PrefixErrors("ForwardedValues", resource.ForwardedValues.Validate())...)
 	}
 	return errs
 }
@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Distribution_Cookies struct {
 	
 	
 	Forward interface{} `yaml:"Forward"`
 	WhitelistedNames interface{} `yaml:"WhitelistedNames,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Distribution_Cookies) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Distribution_Cookies
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Distribution_Cookies) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Distribution_Cookies
+	return plain(resource), nil
 }
 
 func (resource Distribution_Cookies) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Forward == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Forward'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Forward", resource.Forward)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("WhitelistedNames", resource.WhitelistedNames)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Distribution_CustomErrorResponse struct {
 	
@@ -11,16 +15,47 @@ type Distribution_CustomErrorResponse struct {
 	ErrorCode interface{} `yaml:"ErrorCode"`
 	ResponseCode interface{} `yaml:"ResponseCode,omitempty"`
 	ResponsePagePath interface{} `yaml:"ResponsePagePath,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Distribution_CustomErrorResponse) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Distribution_CustomErrorResponse
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Distribution_CustomErrorResponse) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Distribution_CustomErrorResponse
+	return plain(resource), nil
 }
 
 func (resource Distribution_CustomErrorResponse) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("Double")("ErrorCachingMinTTL", resource.ErrorCachingMinTTL)...)
 	if resource.ErrorCode == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ErrorCode'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("ErrorCode", resource.ErrorCode)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ResponseCode", resource.ResponseCode)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ResponsePagePath", resource.ResponsePagePath)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Distribution_CustomOriginConfig struct {
 	
@@ -15,18 +19,53 @@ type Distribution_CustomOriginConfig struct {
 	OriginProtocolPolicy interface{} `yaml:"OriginProtocolPolicy"`
 	OriginReadTimeout interface{} `yaml:"OriginReadTimeout,omitempty"`
 	OriginSSLProtocols interface{} `yaml:"OriginSSLProtocols,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Distribution_CustomOriginConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Distribution_CustomOriginConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Distribution_CustomOriginConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Distribution_CustomOriginConfig
+	return plain(resource), nil
 }
 
 func (resource Distribution_CustomOriginConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
 	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("HTTPPort", resource.HTTPPort)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("HTTPSPort", resource.HTTPSPort)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("OriginKeepaliveTimeout", resource.OriginKeepaliveTimeout)...)
 	if resource.OriginProtocolPolicy == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'OriginProtocolPolicy'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("OriginProtocolPolicy", resource.OriginProtocolPolicy)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("OriginReadTimeout", resource.OriginReadTimeout)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("OriginSSLProtocols", resource.OriginSSLProtocols)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Distribution_DistributionConfig struct {
 	
@@ -28,15 +32,40 @@ type Distribution_DistributionConfig struct {
 	ViewerCertificate *Distribution_ViewerCertificate `yaml:"ViewerCertificate,omitempty"`
 	Restrictions *Distribution_Restrictions `yaml:"Restrictions,omitempty"`
 	Logging *Distribution_Logging `yaml:"Logging,omitempty"`
-	CustomErrorResponses interface{} `yaml:"CustomErrorResponses,omitempty"`
-	Origins interface{} `yaml:"Origins,omitempty"`
 	Aliases interface{} `yaml:"Aliases,omitempty"`
 	CacheBehaviors interface{} `yaml:"CacheBehaviors,omitempty"`
+	CustomErrorResponses interface{} `yaml:"CustomErrorResponses,omitempty"`
+	Origins interface{} `yaml:"Origins,omitempty"`
 	DefaultCacheBehavior *Distribution_DefaultCacheBehavior `yaml:"DefaultCacheBehavior,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Distribution_DistributionConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Distribution_DistributionConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Distribution_DistributionConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Distribution_DistributionConfig
+	return plain(resource), nil
 }
 
 func (resource Distribution_DistributionConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
@@ -52,8 +81,44 @@ func (resource Distribution_DistributionConfig) Validate() []error {
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Comment", resource.Comment)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DefaultRootObject", resource.DefaultRootObject)...)
 	if resource.Enabled == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Enabled'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HttpVersion", resource.HttpVersion)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("IPV6Enabled", resource.IPV6Enabled)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PriceClass", resource.PriceClass)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("WebACLId", resource.WebACLId)...)
+	
+	if resource.ViewerCertificate != nil {
+		errs = append(errs, types.PrefixErrors("ViewerCertificate", resource.ViewerCertificate.Validate())...)
+	}
+	
+	if resource.Restrictions != nil {
+		errs = append(errs, types.PrefixErrors("Restrictions", resource.Restrictions.Validate())...)
+	}
+	
+	if resource.Logging != nil {
+		errs = append(errs, types.PrefixErrors("Logging", resource.Logging.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Aliases", resource.Aliases)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Distribution_CacheBehavior{} }))("CacheBehaviors", resource.CacheBehaviors)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Distribution_CustomErrorResponse{} }))("CustomErrorResponses", resource.CustomErrorResponses)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Distribution_Origin{} }))("Origins", resource.Origins)...)
+	
+	if resource.DefaultCacheBehavior != nil {
+		errs = append(errs, types.PrefixErrors("DefaultCacheBehavior", resource.DefaultCacheBehavior.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Distribution_ForwardedValues struct {
 	
@@ -11,10 +15,34 @@ type Distribution_ForwardedValues struct {
 	Headers interface{} `yaml:"Headers,omitempty"`
 	QueryStringCacheKeys interface{} `yaml:"QueryStringCacheKeys,omitempty"`
 	Cookies *Distribution_Cookies `yaml:"Cookies,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Distribution_ForwardedValues) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Distribution_ForwardedValues
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Distribution_ForwardedValues) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Distribution_ForwardedValues
+	return plain(resource), nil
 }
 
 func (resource Distribution_ForwardedValues) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -22,5 +50,14 @@ func (resource Distribution_ForwardedValues) Validate() []error {
 	if resource.QueryString == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'QueryString'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("QueryString", resource.QueryString)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Headers", resource.Headers)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("QueryStringCacheKeys", resource.QueryStringCacheKeys)...)
+	
+	if resource.Cookies != nil {
+		errs = append(errs, types.PrefixErrors("Cookies", resource.Cookies.Validate())...)
+	}
 	return errs
 }
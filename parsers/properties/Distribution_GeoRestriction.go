@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Distribution_GeoRestriction struct {
 	
 	
 	RestrictionType interface{} `yaml:"RestrictionType"`
 	Locations interface{} `yaml:"Locations,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Distribution_GeoRestriction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Distribution_GeoRestriction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Distribution_GeoRestriction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Distribution_GeoRestriction
+	return plain(resource), nil
 }
 
 func (resource Distribution_GeoRestriction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.RestrictionType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RestrictionType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RestrictionType", resource.RestrictionType)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Locations", resource.Locations)...)
 	return errs
 }
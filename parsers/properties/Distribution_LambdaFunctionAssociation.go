@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Distribution_LambdaFunctionAssociation struct {
 	
 	
 	EventType interface{} `yaml:"EventType,omitempty"`
 	LambdaFunctionARN interface{} `yaml:"LambdaFunctionARN,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Distribution_LambdaFunctionAssociation) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Distribution_LambdaFunctionAssociation
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Distribution_LambdaFunctionAssociation) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Distribution_LambdaFunctionAssociation
+	return plain(resource), nil
 }
 
 func (resource Distribution_LambdaFunctionAssociation) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("EventType", resource.EventType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("LambdaFunctionARN", resource.LambdaFunctionARN)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Distribution_Logging struct {
 	
@@ -9,15 +13,44 @@ type Distribution_Logging struct {
 	Bucket interface{} `yaml:"Bucket"`
 	IncludeCookies interface{} `yaml:"IncludeCookies,omitempty"`
 	Prefix interface{} `yaml:"Prefix,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Distribution_Logging) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Distribution_Logging
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Distribution_Logging) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Distribution_Logging
+	return plain(resource), nil
 }
 
 func (resource Distribution_Logging) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Bucket == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Bucket'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Bucket", resource.Bucket)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("IncludeCookies", resource.IncludeCookies)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Prefix", resource.Prefix)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Distribution_Origin struct {
 	
@@ -15,10 +19,34 @@ type Distribution_Origin struct {
 	S3OriginConfig *Distribution_S3OriginConfig `yaml:"S3OriginConfig,omitempty"`
 	OriginCustomHeaders interface{} `yaml:"OriginCustomHeaders,omitempty"`
 	CustomOriginConfig *Distribution_CustomOriginConfig `yaml:"CustomOriginConfig,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Distribution_Origin) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Distribution_Origin
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Distribution_Origin) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Distribution_Origin
+	return plain(resource), nil
 }
 
 func (resource Distribution_Origin) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -28,8 +56,22 @@ func (resource Distribution_Origin) Validate() []error {
 	if resource.DomainName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DomainName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DomainName", resource.DomainName)...)
 	if resource.Id == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Id'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Id", resource.Id)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("OriginPath", resource.OriginPath)...)
+	
+	if resource.S3OriginConfig != nil {
+		errs = append(errs, types.PrefixErrors("S3OriginConfig", resource.S3OriginConfig.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Distribution_OriginCustomHeader{} }))("OriginCustomHeaders", resource.OriginCustomHeaders)...)
+	
+	if resource.CustomOriginConfig != nil {
+		errs = append(errs, types.PrefixErrors("CustomOriginConfig", resource.CustomOriginConfig.Validate())...)
+	}
 	return errs
 }
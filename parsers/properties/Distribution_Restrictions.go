@@ -1,19 +1,48 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Distribution_Restrictions struct {
 	
 	GeoRestriction *Distribution_GeoRestriction `yaml:"GeoRestriction"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Distribution_Restrictions) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Distribution_Restrictions
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Distribution_Restrictions) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Distribution_Restrictions
+	return plain(resource), nil
 }
 
 func (resource Distribution_Restrictions) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.GeoRestriction == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'GeoRestriction'"))
-	} else {
-		errs = append(errs, resource.GeoRestriction.Validate()...)
+	}
+	if resource.GeoRestriction != nil {
+		errs = append(errs, types.PrefixErrors("GeoRestriction", resource.GeoRestriction.Validate())...)
 	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Distribution_ViewerCertificate struct {
 	
 	
@@ -12,14 +16,48 @@ type Distribution_ViewerCertificate struct {
 	IamCertificateId interface{} `yaml:"IamCertificateId,omitempty"`
 	MinimumProtocolVersion interface{} `yaml:"MinimumProtocolVersion,omitempty"`
 	SslSupportMethod interface{} `yaml:"SslSupportMethod,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Distribution_ViewerCertificate) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Distribution_ViewerCertificate
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Distribution_ViewerCertificate) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Distribution_ViewerCertificate
+	return plain(resource), nil
 }
 
 func (resource Distribution_ViewerCertificate) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AcmCertificateArn", resource.AcmCertificateArn)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("CloudFrontDefaultCertificate", resource.CloudFrontDefaultCertificate)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("IamCertificateId", resource.IamCertificateId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("MinimumProtocolVersion", resource.MinimumProtocolVersion)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SslSupportMethod", resource.SslSupportMethod)...)
 	return errs
 }
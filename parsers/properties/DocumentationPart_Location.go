@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DocumentationPart_Location struct {
 	
 	
@@ -12,14 +16,48 @@ type DocumentationPart_Location struct {
 	Path interface{} `yaml:"Path,omitempty"`
 	StatusCode interface{} `yaml:"StatusCode,omitempty"`
 	Type interface{} `yaml:"Type,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DocumentationPart_Location) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DocumentationPart_Location
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DocumentationPart_Location) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DocumentationPart_Location
+	return plain(resource), nil
 }
 
 func (resource DocumentationPart_Location) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Method", resource.Method)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Path", resource.Path)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("StatusCode", resource.StatusCode)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	return errs
 }
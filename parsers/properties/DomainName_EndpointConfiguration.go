@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type DomainName_EndpointConfiguration struct {
 	
 	Types interface{} `yaml:"Types,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *DomainName_EndpointConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain DomainName_EndpointConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource DomainName_EndpointConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain DomainName_EndpointConfiguration
+	return plain(resource), nil
 }
 
 func (resource DomainName_EndpointConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Types", resource.Types)...)
 	return errs
 }
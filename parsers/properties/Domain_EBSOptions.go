@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Domain_EBSOptions struct {
 	
 	
@@ -10,13 +14,45 @@ type Domain_EBSOptions struct {
 	Iops interface{} `yaml:"Iops,omitempty"`
 	VolumeSize interface{} `yaml:"VolumeSize,omitempty"`
 	VolumeType interface{} `yaml:"VolumeType,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Domain_EBSOptions) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Domain_EBSOptions
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Domain_EBSOptions) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Domain_EBSOptions
+	return plain(resource), nil
 }
 
 func (resource Domain_EBSOptions) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EBSEnabled", resource.EBSEnabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Iops", resource.Iops)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("VolumeSize", resource.VolumeSize)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("VolumeType", resource.VolumeType)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Domain_ElasticsearchClusterConfig struct {
 	
 	
@@ -14,15 +18,51 @@ type Domain_ElasticsearchClusterConfig struct {
 	InstanceCount interface{} `yaml:"InstanceCount,omitempty"`
 	InstanceType interface{} `yaml:"InstanceType,omitempty"`
 	ZoneAwarenessEnabled interface{} `yaml:"ZoneAwarenessEnabled,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Domain_ElasticsearchClusterConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Domain_ElasticsearchClusterConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Domain_ElasticsearchClusterConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Domain_ElasticsearchClusterConfig
+	return plain(resource), nil
 }
 
 func (resource Domain_ElasticsearchClusterConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("DedicatedMasterCount", resource.DedicatedMasterCount)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DedicatedMasterEnabled", resource.DedicatedMasterEnabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DedicatedMasterType", resource.DedicatedMasterType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("InstanceCount", resource.InstanceCount)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceType", resource.InstanceType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ZoneAwarenessEnabled", resource.ZoneAwarenessEnabled)...)
 	return errs
 }
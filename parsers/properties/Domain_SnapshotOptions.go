@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Domain_SnapshotOptions struct {
 	
 	AutomatedSnapshotStartHour interface{} `yaml:"AutomatedSnapshotStartHour,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Domain_SnapshotOptions) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Domain_SnapshotOptions
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Domain_SnapshotOptions) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Domain_SnapshotOptions
+	return plain(resource), nil
 }
 
 func (resource Domain_SnapshotOptions) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("AutomatedSnapshotStartHour", resource.AutomatedSnapshotStartHour)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Domain_VPCOptions struct {
 	
 	
 	SecurityGroupIds interface{} `yaml:"SecurityGroupIds,omitempty"`
 	SubnetIds interface{} `yaml:"SubnetIds,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Domain_VPCOptions) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Domain_VPCOptions
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Domain_VPCOptions) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Domain_VPCOptions
+	return plain(resource), nil
 }
 
 func (resource Domain_VPCOptions) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroupIds", resource.SecurityGroupIds)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SubnetIds", resource.SubnetIds)...)
 	return errs
 }
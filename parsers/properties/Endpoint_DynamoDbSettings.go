@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Endpoint_DynamoDbSettings struct {
 	
 	ServiceAccessRoleArn interface{} `yaml:"ServiceAccessRoleArn,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Endpoint_DynamoDbSettings) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Endpoint_DynamoDbSettings
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Endpoint_DynamoDbSettings) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Endpoint_DynamoDbSettings
+	return plain(resource), nil
 }
 
 func (resource Endpoint_DynamoDbSettings) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceAccessRoleArn", resource.ServiceAccessRoleArn)...)
 	return errs
 }
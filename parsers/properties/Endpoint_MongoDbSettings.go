@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Endpoint_MongoDbSettings struct {
 	
 	
@@ -24,20 +28,66 @@ type Endpoint_MongoDbSettings struct {
 	Port interface{} `yaml:"Port,omitempty"`
 	ServerName interface{} `yaml:"ServerName,omitempty"`
 	Username interface{} `yaml:"Username,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Endpoint_MongoDbSettings) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Endpoint_MongoDbSettings
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Endpoint_MongoDbSettings) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Endpoint_MongoDbSettings
+	return plain(resource), nil
 }
 
 func (resource Endpoint_MongoDbSettings) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AuthMechanism", resource.AuthMechanism)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AuthSource", resource.AuthSource)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AuthType", resource.AuthType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DatabaseName", resource.DatabaseName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DocsToInvestigate", resource.DocsToInvestigate)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ExtractDocId", resource.ExtractDocId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("NestingLevel", resource.NestingLevel)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Password", resource.Password)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Port", resource.Port)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ServerName", resource.ServerName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Username", resource.Username)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Endpoint_S3Settings struct {
 	
 	
@@ -16,16 +20,54 @@ type Endpoint_S3Settings struct {
 	CsvRowDelimiter interface{} `yaml:"CsvRowDelimiter,omitempty"`
 	ExternalTableDefinition interface{} `yaml:"ExternalTableDefinition,omitempty"`
 	ServiceAccessRoleArn interface{} `yaml:"ServiceAccessRoleArn,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Endpoint_S3Settings) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Endpoint_S3Settings
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Endpoint_S3Settings) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Endpoint_S3Settings
+	return plain(resource), nil
 }
 
 func (resource Endpoint_S3Settings) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("BucketFolder", resource.BucketFolder)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("BucketName", resource.BucketName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CompressionType", resource.CompressionType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CsvDelimiter", resource.CsvDelimiter)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CsvRowDelimiter", resource.CsvRowDelimiter)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ExternalTableDefinition", resource.ExternalTableDefinition)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceAccessRoleArn", resource.ServiceAccessRoleArn)...)
 	return errs
 }
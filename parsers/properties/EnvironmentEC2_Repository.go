@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type EnvironmentEC2_Repository struct {
 	
 	
 	PathComponent interface{} `yaml:"PathComponent"`
 	RepositoryUrl interface{} `yaml:"RepositoryUrl"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *EnvironmentEC2_Repository) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain EnvironmentEC2_Repository
+	return unmarshal((*plain)(resource))
+}
+
+func (resource EnvironmentEC2_Repository) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain EnvironmentEC2_Repository
+	return plain(resource), nil
 }
 
 func (resource EnvironmentEC2_Repository) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.PathComponent == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PathComponent'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PathComponent", resource.PathComponent)...)
 	if resource.RepositoryUrl == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RepositoryUrl'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RepositoryUrl", resource.RepositoryUrl)...)
 	return errs
 }
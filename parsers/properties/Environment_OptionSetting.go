@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Environment_OptionSetting struct {
 	
@@ -11,10 +15,34 @@ type Environment_OptionSetting struct {
 	OptionName interface{} `yaml:"OptionName"`
 	ResourceName interface{} `yaml:"ResourceName,omitempty"`
 	Value interface{} `yaml:"Value,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Environment_OptionSetting) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Environment_OptionSetting
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Environment_OptionSetting) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Environment_OptionSetting
+	return plain(resource), nil
 }
 
 func (resource Environment_OptionSetting) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -22,8 +50,14 @@ func (resource Environment_OptionSetting) Validate() []error {
 	if resource.Namespace == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Namespace'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Namespace", resource.Namespace)...)
 	if resource.OptionName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'OptionName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("OptionName", resource.OptionName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ResourceName", resource.ResourceName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Value", resource.Value)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Environment_Tier struct {
 	
 	
@@ -8,12 +12,42 @@ type Environment_Tier struct {
 	Name interface{} `yaml:"Name,omitempty"`
 	Type interface{} `yaml:"Type,omitempty"`
 	Version interface{} `yaml:"Version,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Environment_Tier) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Environment_Tier
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Environment_Tier) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Environment_Tier
+	return plain(resource), nil
 }
 
 func (resource Environment_Tier) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Version", resource.Version)...)
 	return errs
 }
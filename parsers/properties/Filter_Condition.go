@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Filter_Condition struct {
 	
 	
@@ -12,14 +16,48 @@ type Filter_Condition struct {
 	Lte interface{} `yaml:"Lte,omitempty"`
 	Eq interface{} `yaml:"Eq,omitempty"`
 	Neq interface{} `yaml:"Neq,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Filter_Condition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Filter_Condition
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Filter_Condition) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Filter_Condition
+	return plain(resource), nil
 }
 
 func (resource Filter_Condition) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Gte", resource.Gte)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Lt", resource.Lt)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Lte", resource.Lte)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Eq", resource.Eq)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Neq", resource.Neq)...)
 	return errs
 }
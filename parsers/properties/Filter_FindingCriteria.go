@@ -1,16 +1,50 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Filter_FindingCriteria struct {
 	
 	
 	Criterion interface{} `yaml:"Criterion,omitempty"`
 	ItemType *Filter_Condition `yaml:"ItemType,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Filter_FindingCriteria) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Filter_FindingCriteria
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Filter_FindingCriteria) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Filter_FindingCriteria
+	return plain(resource), nil
 }
 
 func (resource Filter_FindingCriteria) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Json")("Criterion", resource.Criterion)...)
 	
+	if resource.ItemType != nil {
+		errs = append(errs, types.PrefixErrors("ItemType", resource.ItemType.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Fleet_IpPermission struct {
 	
@@ -11,10 +15,34 @@ type Fleet_IpPermission struct {
 	IpRange interface{} `yaml:"IpRange"`
 	Protocol interface{} `yaml:"Protocol"`
 	ToPort interface{} `yaml:"ToPort"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Fleet_IpPermission) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Fleet_IpPermission
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Fleet_IpPermission) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Fleet_IpPermission
+	return plain(resource), nil
 }
 
 func (resource Fleet_IpPermission) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -22,14 +50,18 @@ func (resource Fleet_IpPermission) Validate() []error {
 	if resource.FromPort == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FromPort'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("FromPort", resource.FromPort)...)
 	if resource.IpRange == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IpRange'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IpRange", resource.IpRange)...)
 	if resource.Protocol == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Protocol'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Protocol", resource.Protocol)...)
 	if resource.ToPort == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ToPort'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("ToPort", resource.ToPort)...)
 	return errs
 }
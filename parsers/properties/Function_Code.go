@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Function_Code struct {
 	
 	
@@ -10,13 +14,45 @@ type Function_Code struct {
 	S3Key interface{} `yaml:"S3Key,omitempty"`
 	S3ObjectVersion interface{} `yaml:"S3ObjectVersion,omitempty"`
 	ZipFile interface{} `yaml:"ZipFile,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Function_Code) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Function_Code
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Function_Code) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Function_Code
+	return plain(resource), nil
 }
 
 func (resource Function_Code) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("S3Bucket", resource.S3Bucket)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("S3Key", resource.S3Key)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("S3ObjectVersion", resource.S3ObjectVersion)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ZipFile", resource.ZipFile)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Function_VpcConfig struct {
 	
 	
 	SecurityGroupIds interface{} `yaml:"SecurityGroupIds"`
 	SubnetIds interface{} `yaml:"SubnetIds"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Function_VpcConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Function_VpcConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Function_VpcConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Function_VpcConfig
+	return plain(resource), nil
 }
 
 func (resource Function_VpcConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.SecurityGroupIds == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SecurityGroupIds'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroupIds", resource.SecurityGroupIds)...)
 	if resource.SubnetIds == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SubnetIds'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SubnetIds", resource.SubnetIds)...)
 	return errs
 }
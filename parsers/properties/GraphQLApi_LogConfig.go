@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type GraphQLApi_LogConfig struct {
 	
 	
 	CloudWatchLogsRoleArn interface{} `yaml:"CloudWatchLogsRoleArn,omitempty"`
 	FieldLogLevel interface{} `yaml:"FieldLogLevel,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *GraphQLApi_LogConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain GraphQLApi_LogConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource GraphQLApi_LogConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain GraphQLApi_LogConfig
+	return plain(resource), nil
 }
 
 func (resource GraphQLApi_LogConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CloudWatchLogsRoleArn", resource.CloudWatchLogsRoleArn)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("FieldLogLevel", resource.FieldLogLevel)...)
 	return errs
 }
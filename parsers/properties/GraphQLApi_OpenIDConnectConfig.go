@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type GraphQLApi_OpenIDConnectConfig struct {
 	
 	
@@ -10,13 +14,45 @@ type GraphQLApi_OpenIDConnectConfig struct {
 	ClientId interface{} `yaml:"ClientId,omitempty"`
 	IatTTL interface{} `yaml:"IatTTL,omitempty"`
 	Issuer interface{} `yaml:"Issuer,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *GraphQLApi_OpenIDConnectConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain GraphQLApi_OpenIDConnectConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource GraphQLApi_OpenIDConnectConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain GraphQLApi_OpenIDConnectConfig
+	return plain(resource), nil
 }
 
 func (resource GraphQLApi_OpenIDConnectConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("AuthTTL", resource.AuthTTL)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ClientId", resource.ClientId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("IatTTL", resource.IatTTL)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Issuer", resource.Issuer)...)
 	return errs
 }
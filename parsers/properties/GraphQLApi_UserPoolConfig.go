@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type GraphQLApi_UserPoolConfig struct {
 	
 	
@@ -10,13 +14,45 @@ type GraphQLApi_UserPoolConfig struct {
 	AwsRegion interface{} `yaml:"AwsRegion,omitempty"`
 	DefaultAction interface{} `yaml:"DefaultAction,omitempty"`
 	UserPoolId interface{} `yaml:"UserPoolId,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *GraphQLApi_UserPoolConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain GraphQLApi_UserPoolConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource GraphQLApi_UserPoolConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain GraphQLApi_UserPoolConfig
+	return plain(resource), nil
 }
 
 func (resource GraphQLApi_UserPoolConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AppIdClientRegex", resource.AppIdClientRegex)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AwsRegion", resource.AwsRegion)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DefaultAction", resource.DefaultAction)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("UserPoolId", resource.UserPoolId)...)
 	return errs
 }
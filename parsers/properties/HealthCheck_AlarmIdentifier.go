@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type HealthCheck_AlarmIdentifier struct {
 	
 	
 	Name interface{} `yaml:"Name"`
 	Region interface{} `yaml:"Region"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *HealthCheck_AlarmIdentifier) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain HealthCheck_AlarmIdentifier
+	return unmarshal((*plain)(resource))
+}
+
+func (resource HealthCheck_AlarmIdentifier) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain HealthCheck_AlarmIdentifier
+	return plain(resource), nil
 }
 
 func (resource HealthCheck_AlarmIdentifier) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Region == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Region'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Region", resource.Region)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type HealthCheck_HealthCheckConfig struct {
 	
@@ -35,10 +39,38 @@ type HealthCheck_HealthCheckConfig struct {
 	ChildHealthChecks interface{} `yaml:"ChildHealthChecks,omitempty"`
 	Regions interface{} `yaml:"Regions,omitempty"`
 	AlarmIdentifier *HealthCheck_AlarmIdentifier `yaml:"AlarmIdentifier,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *HealthCheck_HealthCheckConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain HealthCheck_HealthCheckConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource HealthCheck_HealthCheckConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain HealthCheck_HealthCheckConfig
+	return plain(resource), nil
 }
 
 func (resource HealthCheck_HealthCheckConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
 	
 	
@@ -52,11 +84,40 @@ func (resource HealthCheck_HealthCheckConfig) Validate() []error {
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EnableSNI", resource.EnableSNI)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("FailureThreshold", resource.FailureThreshold)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("FullyQualifiedDomainName", resource.FullyQualifiedDomainName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("HealthThreshold", resource.HealthThreshold)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("IPAddress", resource.IPAddress)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InsufficientDataHealthStatus", resource.InsufficientDataHealthStatus)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Inverted", resource.Inverted)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("MeasureLatency", resource.MeasureLatency)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Port", resource.Port)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("RequestInterval", resource.RequestInterval)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ResourcePath", resource.ResourcePath)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SearchString", resource.SearchString)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ChildHealthChecks", resource.ChildHealthChecks)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Regions", resource.Regions)...)
+	
+	if resource.AlarmIdentifier != nil {
+		errs = append(errs, types.PrefixErrors("AlarmIdentifier", resource.AlarmIdentifier.Validate())...)
+	}
 	return errs
 }
@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type HostedZone_HostedZoneConfig struct {
 	
 	Comment interface{} `yaml:"Comment,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *HostedZone_HostedZoneConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain HostedZone_HostedZoneConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource HostedZone_HostedZoneConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain HostedZone_HostedZoneConfig
+	return plain(resource), nil
 }
 
 func (resource HostedZone_HostedZoneConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Comment", resource.Comment)...)
 	return errs
 }
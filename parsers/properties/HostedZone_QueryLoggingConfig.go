@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type HostedZone_QueryLoggingConfig struct {
 	
 	CloudWatchLogsLogGroupArn interface{} `yaml:"CloudWatchLogsLogGroupArn"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *HostedZone_QueryLoggingConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain HostedZone_QueryLoggingConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource HostedZone_QueryLoggingConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain HostedZone_QueryLoggingConfig
+	return plain(resource), nil
 }
 
 func (resource HostedZone_QueryLoggingConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.CloudWatchLogsLogGroupArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CloudWatchLogsLogGroupArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CloudWatchLogsLogGroupArn", resource.CloudWatchLogsLogGroupArn)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type HostedZone_VPC struct {
 	
 	
 	VPCId interface{} `yaml:"VPCId"`
 	VPCRegion interface{} `yaml:"VPCRegion"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *HostedZone_VPC) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain HostedZone_VPC
+	return unmarshal((*plain)(resource))
+}
+
+func (resource HostedZone_VPC) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain HostedZone_VPC
+	return plain(resource), nil
 }
 
 func (resource HostedZone_VPC) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.VPCId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VPCId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VPCId", resource.VPCId)...)
 	if resource.VPCRegion == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VPCRegion'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VPCRegion", resource.VPCRegion)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type IdentityPoolRoleAttachment_MappingRule struct {
 	
@@ -11,10 +15,34 @@ type IdentityPoolRoleAttachment_MappingRule struct {
 	MatchType interface{} `yaml:"MatchType"`
 	RoleARN interface{} `yaml:"RoleARN"`
 	Value interface{} `yaml:"Value"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *IdentityPoolRoleAttachment_MappingRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain IdentityPoolRoleAttachment_MappingRule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource IdentityPoolRoleAttachment_MappingRule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain IdentityPoolRoleAttachment_MappingRule
+	return plain(resource), nil
 }
 
 func (resource IdentityPoolRoleAttachment_MappingRule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -22,14 +50,18 @@ func (resource IdentityPoolRoleAttachment_MappingRule) Validate() []error {
 	if resource.Claim == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Claim'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Claim", resource.Claim)...)
 	if resource.MatchType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MatchType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MatchType", resource.MatchType)...)
 	if resource.RoleARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleARN", resource.RoleARN)...)
 	if resource.Value == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Value'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Value", resource.Value)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type IdentityPoolRoleAttachment_RoleMapping struct {
 	
@@ -9,15 +13,46 @@ type IdentityPoolRoleAttachment_RoleMapping struct {
 	AmbiguousRoleResolution interface{} `yaml:"AmbiguousRoleResolution,omitempty"`
 	Type interface{} `yaml:"Type"`
 	RulesConfiguration *IdentityPoolRoleAttachment_RulesConfigurationType `yaml:"RulesConfiguration,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *IdentityPoolRoleAttachment_RoleMapping) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain IdentityPoolRoleAttachment_RoleMapping
+	return unmarshal((*plain)(resource))
+}
+
+func (resource IdentityPoolRoleAttachment_RoleMapping) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain IdentityPoolRoleAttachment_RoleMapping
+	return plain(resource), nil
 }
 
 func (resource IdentityPoolRoleAttachment_RoleMapping) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AmbiguousRoleResolution", resource.AmbiguousRoleResolution)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	if resource.RulesConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("RulesConfiguration", resource.RulesConfiguration.Validate())...)
+	}
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type IdentityPoolRoleAttachment_RulesConfigurationType struct {
 	
 	Rules interface{} `yaml:"Rules"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *IdentityPoolRoleAttachment_RulesConfigurationType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain IdentityPoolRoleAttachment_RulesConfigurationType
+	return unmarshal((*plain)(resource))
+}
+
+func (resource IdentityPoolRoleAttachment_RulesConfigurationType) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain IdentityPoolRoleAttachment_RulesConfigurationType
+	return plain(resource), nil
 }
 
 func (resource IdentityPoolRoleAttachment_RulesConfigurationType) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.Rules == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Rules'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &IdentityPoolRoleAttachment_MappingRule{} }))("Rules", resource.Rules)...)
 	return errs
 }
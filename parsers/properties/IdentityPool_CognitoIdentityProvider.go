@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type IdentityPool_CognitoIdentityProvider struct {
 	
 	
@@ -8,12 +12,42 @@ type IdentityPool_CognitoIdentityProvider struct {
 	ClientId interface{} `yaml:"ClientId,omitempty"`
 	ProviderName interface{} `yaml:"ProviderName,omitempty"`
 	ServerSideTokenCheck interface{} `yaml:"ServerSideTokenCheck,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *IdentityPool_CognitoIdentityProvider) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain IdentityPool_CognitoIdentityProvider
+	return unmarshal((*plain)(resource))
+}
+
+func (resource IdentityPool_CognitoIdentityProvider) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain IdentityPool_CognitoIdentityProvider
+	return plain(resource), nil
 }
 
 func (resource IdentityPool_CognitoIdentityProvider) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ClientId", resource.ClientId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ProviderName", resource.ProviderName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ServerSideTokenCheck", resource.ServerSideTokenCheck)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type IdentityPool_CognitoStreams struct {
 	
 	
@@ -8,12 +12,42 @@ type IdentityPool_CognitoStreams struct {
 	RoleArn interface{} `yaml:"RoleArn,omitempty"`
 	StreamName interface{} `yaml:"StreamName,omitempty"`
 	StreamingStatus interface{} `yaml:"StreamingStatus,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *IdentityPool_CognitoStreams) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain IdentityPool_CognitoStreams
+	return unmarshal((*plain)(resource))
+}
+
+func (resource IdentityPool_CognitoStreams) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain IdentityPool_CognitoStreams
+	return plain(resource), nil
 }
 
 func (resource IdentityPool_CognitoStreams) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("StreamName", resource.StreamName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("StreamingStatus", resource.StreamingStatus)...)
 	return errs
 }
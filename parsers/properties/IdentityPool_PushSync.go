@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type IdentityPool_PushSync struct {
 	
 	
 	RoleArn interface{} `yaml:"RoleArn,omitempty"`
 	ApplicationArns interface{} `yaml:"ApplicationArns,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *IdentityPool_PushSync) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain IdentityPool_PushSync
+	return unmarshal((*plain)(resource))
+}
+
+func (resource IdentityPool_PushSync) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain IdentityPool_PushSync
+	return plain(resource), nil
 }
 
 func (resource IdentityPool_PushSync) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ApplicationArns", resource.ApplicationArns)...)
 	return errs
 }
@@ -1,19 +1,48 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type InstanceFleetConfig_InstanceFleetProvisioningSpecifications struct {
 	
 	SpotSpecification *InstanceFleetConfig_SpotProvisioningSpecification `yaml:"SpotSpecification"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *InstanceFleetConfig_InstanceFleetProvisioningSpecifications) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain InstanceFleetConfig_InstanceFleetProvisioningSpecifications
+	return unmarshal((*plain)(resource))
+}
+
+func (resource InstanceFleetConfig_InstanceFleetProvisioningSpecifications) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain InstanceFleetConfig_InstanceFleetProvisioningSpecifications
+	return plain(resource), nil
 }
 
 func (resource InstanceFleetConfig_InstanceFleetProvisioningSpecifications) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.SpotSpecification == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SpotSpecification'"))
-	} else {
-		errs = append(errs, resource.SpotSpecification.Validate()...)
+	}
+	if resource.SpotSpecification != nil {
+		errs = append(errs, types.PrefixErrors("SpotSpecification", resource.SpotSpecification.Validate())...)
 	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type InstanceFleetConfig_InstanceTypeConfig struct {
 	
@@ -15,18 +19,55 @@ type InstanceFleetConfig_InstanceTypeConfig struct {
 	WeightedCapacity interface{} `yaml:"WeightedCapacity,omitempty"`
 	Configurations interface{} `yaml:"Configurations,omitempty"`
 	EbsConfiguration *InstanceFleetConfig_EbsConfiguration `yaml:"EbsConfiguration,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *InstanceFleetConfig_InstanceTypeConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain InstanceFleetConfig_InstanceTypeConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource InstanceFleetConfig_InstanceTypeConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain InstanceFleetConfig_InstanceTypeConfig
+	return plain(resource), nil
 }
 
 func (resource InstanceFleetConfig_InstanceTypeConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("BidPrice", resource.BidPrice)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Double")("BidPriceAsPercentageOfOnDemandPrice", resource.BidPriceAsPercentageOfOnDemandPrice)...)
 	if resource.InstanceType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceType", resource.InstanceType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("WeightedCapacity", resource.WeightedCapacity)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &InstanceFleetConfig_Configuration{} }))("Configurations", resource.Configurations)...)
+	
+	if resource.EbsConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("EbsConfiguration", resource.EbsConfiguration.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type InstanceFleetConfig_SpotProvisioningSpecification struct {
 	
@@ -9,18 +13,46 @@ type InstanceFleetConfig_SpotProvisioningSpecification struct {
 	BlockDurationMinutes interface{} `yaml:"BlockDurationMinutes,omitempty"`
 	TimeoutAction interface{} `yaml:"TimeoutAction"`
 	TimeoutDurationMinutes interface{} `yaml:"TimeoutDurationMinutes"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *InstanceFleetConfig_SpotProvisioningSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain InstanceFleetConfig_SpotProvisioningSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource InstanceFleetConfig_SpotProvisioningSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain InstanceFleetConfig_SpotProvisioningSpecification
+	return plain(resource), nil
 }
 
 func (resource InstanceFleetConfig_SpotProvisioningSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("BlockDurationMinutes", resource.BlockDurationMinutes)...)
 	if resource.TimeoutAction == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TimeoutAction'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TimeoutAction", resource.TimeoutAction)...)
 	if resource.TimeoutDurationMinutes == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TimeoutDurationMinutes'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("TimeoutDurationMinutes", resource.TimeoutDurationMinutes)...)
 	return errs
 }
@@ -1,22 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type InstanceGroupConfig_EbsBlockDeviceConfig struct {
 	
 	
 	VolumesPerInstance interface{} `yaml:"VolumesPerInstance,omitempty"`
 	VolumeSpecification *InstanceGroupConfig_VolumeSpecification `yaml:"VolumeSpecification"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *InstanceGroupConfig_EbsBlockDeviceConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain InstanceGroupConfig_EbsBlockDeviceConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource InstanceGroupConfig_EbsBlockDeviceConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain InstanceGroupConfig_EbsBlockDeviceConfig
+	return plain(resource), nil
 }
 
 func (resource InstanceGroupConfig_EbsBlockDeviceConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("VolumesPerInstance", resource.VolumesPerInstance)...)
 	if resource.VolumeSpecification == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VolumeSpecification'"))
-	} else {
-		errs = append(errs, resource.VolumeSpecification.Validate()...)
+	}
+	if resource.VolumeSpecification != nil {
+		errs = append(errs, types.PrefixErrors("VolumeSpecification", resource.VolumeSpecification.Validate())...)
 	}
 	return errs
 }
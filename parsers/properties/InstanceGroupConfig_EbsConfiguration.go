@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type InstanceGroupConfig_EbsConfiguration struct {
 	
 	
 	EbsOptimized interface{} `yaml:"EbsOptimized,omitempty"`
 	EbsBlockDeviceConfigs interface{} `yaml:"EbsBlockDeviceConfigs,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *InstanceGroupConfig_EbsConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain InstanceGroupConfig_EbsConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource InstanceGroupConfig_EbsConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain InstanceGroupConfig_EbsConfiguration
+	return plain(resource), nil
 }
 
 func (resource InstanceGroupConfig_EbsConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EbsOptimized", resource.EbsOptimized)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &InstanceGroupConfig_EbsBlockDeviceConfig{} }))("EbsBlockDeviceConfigs", resource.EbsBlockDeviceConfigs)...)
 	return errs
 }
@@ -1,22 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type InstanceGroupConfig_ScalingAction struct {
 	
 	
 	Market interface{} `yaml:"Market,omitempty"`
 	SimpleScalingPolicyConfiguration *InstanceGroupConfig_SimpleScalingPolicyConfiguration `yaml:"SimpleScalingPolicyConfiguration"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *InstanceGroupConfig_ScalingAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain InstanceGroupConfig_ScalingAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource InstanceGroupConfig_ScalingAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain InstanceGroupConfig_ScalingAction
+	return plain(resource), nil
 }
 
 func (resource InstanceGroupConfig_ScalingAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Market", resource.Market)...)
 	if resource.SimpleScalingPolicyConfiguration == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SimpleScalingPolicyConfiguration'"))
-	} else {
-		errs = append(errs, resource.SimpleScalingPolicyConfiguration.Validate()...)
+	}
+	if resource.SimpleScalingPolicyConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("SimpleScalingPolicyConfiguration", resource.SimpleScalingPolicyConfiguration.Validate())...)
 	}
 	return errs
 }
@@ -1,26 +1,60 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
 
 type Instance_BlockDeviceMapping struct {
 	
 	
 	
 	
-	DeviceName interface{} `yaml:"DeviceName"`
+	DeviceName interface{} `yaml:"DeviceName,omitempty"`
+	NoDevice interface{} `yaml:"NoDevice,omitempty"`
 	VirtualName interface{} `yaml:"VirtualName,omitempty"`
-	NoDevice *Instance_NoDevice `yaml:"NoDevice,omitempty"`
-	Ebs *Instance_Ebs `yaml:"Ebs,omitempty"`
+	Ebs *Instance_EbsBlockDevice `yaml:"Ebs,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Instance_BlockDeviceMapping) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Instance_BlockDeviceMapping
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Instance_BlockDeviceMapping) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Instance_BlockDeviceMapping
+	return plain(resource), nil
 }
 
 func (resource Instance_BlockDeviceMapping) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DeviceName", resource.DeviceName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("NoDevice", resource.NoDevice)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("VirtualName", resource.VirtualName)...)
 	
-	if resource.DeviceName == nil {
-		errs = append(errs, fmt.Errorf("Missing required field 'DeviceName'"))
+	if resource.Ebs != nil {
+		errs = append(errs, types.PrefixErrors("Ebs", resource.Ebs.Validate())...)
 	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Instance_EbsBlockDevice struct {
 	
 	
@@ -12,14 +16,48 @@ type Instance_EbsBlockDevice struct {
 	SnapshotId interface{} `yaml:"SnapshotId,omitempty"`
 	VolumeSize interface{} `yaml:"VolumeSize,omitempty"`
 	VolumeType interface{} `yaml:"VolumeType,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Instance_EbsBlockDevice) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Instance_EbsBlockDevice
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Instance_EbsBlockDevice) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Instance_EbsBlockDevice
+	return plain(resource), nil
 }
 
 func (resource Instance_EbsBlockDevice) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DeleteOnTermination", resource.DeleteOnTermination)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Iops", resource.Iops)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SnapshotId", resource.SnapshotId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("VolumeSize", resource.VolumeSize)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("VolumeType", resource.VolumeType)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Instance_InstanceIpv6Address struct {
 	
 	Ipv6Address interface{} `yaml:"Ipv6Address"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Instance_InstanceIpv6Address) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Instance_InstanceIpv6Address
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Instance_InstanceIpv6Address) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Instance_InstanceIpv6Address
+	return plain(resource), nil
 }
 
 func (resource Instance_InstanceIpv6Address) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.Ipv6Address == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Ipv6Address'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Ipv6Address", resource.Ipv6Address)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Instance_NetworkInterface struct {
 	
@@ -27,10 +31,35 @@ type Instance_NetworkInterface struct {
 	GroupSet interface{} `yaml:"GroupSet,omitempty"`
 	Ipv6Addresses interface{} `yaml:"Ipv6Addresses,omitempty"`
 	PrivateIpAddresses interface{} `yaml:"PrivateIpAddresses,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Instance_NetworkInterface) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Instance_NetworkInterface
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Instance_NetworkInterface) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Instance_NetworkInterface
+	return plain(resource), nil
 }
 
 func (resource Instance_NetworkInterface) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
@@ -43,8 +72,30 @@ func (resource Instance_NetworkInterface) Validate() []error {
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AssociatePublicIpAddress", resource.AssociatePublicIpAddress)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DeleteOnTermination", resource.DeleteOnTermination)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.DeviceIndex == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DeviceIndex'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DeviceIndex", resource.DeviceIndex)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Ipv6AddressCount", resource.Ipv6AddressCount)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NetworkInterfaceId", resource.NetworkInterfaceId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PrivateIpAddress", resource.PrivateIpAddress)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("SecondaryPrivateIpAddressCount", resource.SecondaryPrivateIpAddressCount)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("GroupSet", resource.GroupSet)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Instance_InstanceIpv6Address{} }))("Ipv6Addresses", resource.Ipv6Addresses)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Instance_PrivateIpAddressSpecification{} }))("PrivateIpAddresses", resource.PrivateIpAddresses)...)
 	return errs
 }
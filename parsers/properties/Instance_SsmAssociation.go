@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Instance_SsmAssociation struct {
 	
 	
 	DocumentName interface{} `yaml:"DocumentName"`
 	AssociationParameters interface{} `yaml:"AssociationParameters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Instance_SsmAssociation) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Instance_SsmAssociation
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Instance_SsmAssociation) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Instance_SsmAssociation
+	return plain(resource), nil
 }
 
 func (resource Instance_SsmAssociation) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.DocumentName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DocumentName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DocumentName", resource.DocumentName)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Instance_AssociationParameter{} }))("AssociationParameters", resource.AssociationParameters)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Instance_TimeBasedAutoScaling struct {
 	
 	
@@ -16,16 +20,54 @@ type Instance_TimeBasedAutoScaling struct {
 	Thursday interface{} `yaml:"Thursday,omitempty"`
 	Tuesday interface{} `yaml:"Tuesday,omitempty"`
 	Wednesday interface{} `yaml:"Wednesday,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Instance_TimeBasedAutoScaling) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Instance_TimeBasedAutoScaling
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Instance_TimeBasedAutoScaling) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Instance_TimeBasedAutoScaling
+	return plain(resource), nil
 }
 
 func (resource Instance_TimeBasedAutoScaling) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Friday", resource.Friday)...)
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Monday", resource.Monday)...)
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Saturday", resource.Saturday)...)
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Sunday", resource.Sunday)...)
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Thursday", resource.Thursday)...)
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Tuesday", resource.Tuesday)...)
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Wednesday", resource.Wednesday)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Instance_Volume struct {
 	
 	
 	Device interface{} `yaml:"Device"`
 	VolumeId interface{} `yaml:"VolumeId"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Instance_Volume) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Instance_Volume
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Instance_Volume) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Instance_Volume
+	return plain(resource), nil
 }
 
 func (resource Instance_Volume) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Device == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Device'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Device", resource.Device)...)
 	if resource.VolumeId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VolumeId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VolumeId", resource.VolumeId)...)
 	return errs
 }
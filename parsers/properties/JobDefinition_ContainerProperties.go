@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type JobDefinition_ContainerProperties struct {
 	
@@ -27,10 +31,34 @@ type JobDefinition_ContainerProperties struct {
 	MountPoints interface{} `yaml:"MountPoints,omitempty"`
 	Ulimits interface{} `yaml:"Ulimits,omitempty"`
 	Volumes interface{} `yaml:"Volumes,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *JobDefinition_ContainerProperties) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain JobDefinition_ContainerProperties
+	return unmarshal((*plain)(resource))
+}
+
+func (resource JobDefinition_ContainerProperties) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain JobDefinition_ContainerProperties
+	return plain(resource), nil
 }
 
 func (resource JobDefinition_ContainerProperties) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -46,11 +74,32 @@ func (resource JobDefinition_ContainerProperties) Validate() []error {
 	if resource.Image == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Image'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Image", resource.Image)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("JobRoleArn", resource.JobRoleArn)...)
 	if resource.Memory == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Memory'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Memory", resource.Memory)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Privileged", resource.Privileged)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ReadonlyRootFilesystem", resource.ReadonlyRootFilesystem)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("User", resource.User)...)
 	if resource.Vcpus == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Vcpus'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Vcpus", resource.Vcpus)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Command", resource.Command)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &JobDefinition_Environment{} }))("Environment", resource.Environment)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &JobDefinition_MountPoints{} }))("MountPoints", resource.MountPoints)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &JobDefinition_Ulimit{} }))("Ulimits", resource.Ulimits)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &JobDefinition_Volumes{} }))("Volumes", resource.Volumes)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type JobDefinition_MountPoints struct {
 	
 	
@@ -8,12 +12,42 @@ type JobDefinition_MountPoints struct {
 	ContainerPath interface{} `yaml:"ContainerPath,omitempty"`
 	ReadOnly interface{} `yaml:"ReadOnly,omitempty"`
 	SourceVolume interface{} `yaml:"SourceVolume,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *JobDefinition_MountPoints) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain JobDefinition_MountPoints
+	return unmarshal((*plain)(resource))
+}
+
+func (resource JobDefinition_MountPoints) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain JobDefinition_MountPoints
+	return plain(resource), nil
 }
 
 func (resource JobDefinition_MountPoints) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ContainerPath", resource.ContainerPath)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ReadOnly", resource.ReadOnly)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceVolume", resource.SourceVolume)...)
 	return errs
 }
@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type JobDefinition_RetryStrategy struct {
 	
 	Attempts interface{} `yaml:"Attempts,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *JobDefinition_RetryStrategy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain JobDefinition_RetryStrategy
+	return unmarshal((*plain)(resource))
+}
+
+func (resource JobDefinition_RetryStrategy) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain JobDefinition_RetryStrategy
+	return plain(resource), nil
 }
 
 func (resource JobDefinition_RetryStrategy) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Attempts", resource.Attempts)...)
 	return errs
 }
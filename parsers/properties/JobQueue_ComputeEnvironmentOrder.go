@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type JobQueue_ComputeEnvironmentOrder struct {
 	
 	
 	ComputeEnvironment interface{} `yaml:"ComputeEnvironment"`
 	Order interface{} `yaml:"Order"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *JobQueue_ComputeEnvironmentOrder) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain JobQueue_ComputeEnvironmentOrder
+	return unmarshal((*plain)(resource))
+}
+
+func (resource JobQueue_ComputeEnvironmentOrder) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain JobQueue_ComputeEnvironmentOrder
+	return plain(resource), nil
 }
 
 func (resource JobQueue_ComputeEnvironmentOrder) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.ComputeEnvironment == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ComputeEnvironment'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ComputeEnvironment", resource.ComputeEnvironment)...)
 	if resource.Order == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Order'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Order", resource.Order)...)
 	return errs
 }
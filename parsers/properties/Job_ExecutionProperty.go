@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Job_ExecutionProperty struct {
 	
 	MaxConcurrentRuns interface{} `yaml:"MaxConcurrentRuns,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Job_ExecutionProperty) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Job_ExecutionProperty
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Job_ExecutionProperty) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Job_ExecutionProperty
+	return plain(resource), nil
 }
 
 func (resource Job_ExecutionProperty) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("MaxConcurrentRuns", resource.MaxConcurrentRuns)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Job_JobCommand struct {
 	
 	
 	Name interface{} `yaml:"Name,omitempty"`
 	ScriptLocation interface{} `yaml:"ScriptLocation,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Job_JobCommand) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Job_JobCommand
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Job_JobCommand) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Job_JobCommand
+	return plain(resource), nil
 }
 
 func (resource Job_JobCommand) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ScriptLocation", resource.ScriptLocation)...)
 	return errs
 }
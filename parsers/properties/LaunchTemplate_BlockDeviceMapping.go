@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type LaunchTemplate_BlockDeviceMapping struct {
 	
 	
@@ -10,13 +14,47 @@ type LaunchTemplate_BlockDeviceMapping struct {
 	NoDevice interface{} `yaml:"NoDevice,omitempty"`
 	VirtualName interface{} `yaml:"VirtualName,omitempty"`
 	Ebs *LaunchTemplate_Ebs `yaml:"Ebs,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LaunchTemplate_BlockDeviceMapping) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LaunchTemplate_BlockDeviceMapping
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LaunchTemplate_BlockDeviceMapping) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LaunchTemplate_BlockDeviceMapping
+	return plain(resource), nil
 }
 
 func (resource LaunchTemplate_BlockDeviceMapping) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DeviceName", resource.DeviceName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("NoDevice", resource.NoDevice)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("VirtualName", resource.VirtualName)...)
 	
+	if resource.Ebs != nil {
+		errs = append(errs, types.PrefixErrors("Ebs", resource.Ebs.Validate())...)
+	}
 	return errs
 }
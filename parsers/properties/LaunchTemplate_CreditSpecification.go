@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type LaunchTemplate_CreditSpecification struct {
 	
 	CpuCredits interface{} `yaml:"CpuCredits,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LaunchTemplate_CreditSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LaunchTemplate_CreditSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LaunchTemplate_CreditSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LaunchTemplate_CreditSpecification
+	return plain(resource), nil
 }
 
 func (resource LaunchTemplate_CreditSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CpuCredits", resource.CpuCredits)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type LaunchTemplate_Ebs struct {
 	
 	
@@ -16,16 +20,54 @@ type LaunchTemplate_Ebs struct {
 	SnapshotId interface{} `yaml:"SnapshotId,omitempty"`
 	VolumeSize interface{} `yaml:"VolumeSize,omitempty"`
 	VolumeType interface{} `yaml:"VolumeType,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LaunchTemplate_Ebs) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LaunchTemplate_Ebs
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LaunchTemplate_Ebs) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LaunchTemplate_Ebs
+	return plain(resource), nil
 }
 
 func (resource LaunchTemplate_Ebs) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DeleteOnTermination", resource.DeleteOnTermination)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Encrypted", resource.Encrypted)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Iops", resource.Iops)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("KmsKeyId", resource.KmsKeyId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SnapshotId", resource.SnapshotId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("VolumeSize", resource.VolumeSize)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("VolumeType", resource.VolumeType)...)
 	return errs
 }
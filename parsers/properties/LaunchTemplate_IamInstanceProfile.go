@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type LaunchTemplate_IamInstanceProfile struct {
 	
 	
 	Arn interface{} `yaml:"Arn,omitempty"`
 	Name interface{} `yaml:"Name,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LaunchTemplate_IamInstanceProfile) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LaunchTemplate_IamInstanceProfile
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LaunchTemplate_IamInstanceProfile) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LaunchTemplate_IamInstanceProfile
+	return plain(resource), nil
 }
 
 func (resource LaunchTemplate_IamInstanceProfile) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Arn", resource.Arn)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	return errs
 }
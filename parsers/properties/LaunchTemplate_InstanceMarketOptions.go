@@ -1,16 +1,50 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type LaunchTemplate_InstanceMarketOptions struct {
 	
 	
 	MarketType interface{} `yaml:"MarketType,omitempty"`
 	SpotOptions *LaunchTemplate_SpotOptions `yaml:"SpotOptions,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LaunchTemplate_InstanceMarketOptions) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LaunchTemplate_InstanceMarketOptions
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LaunchTemplate_InstanceMarketOptions) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LaunchTemplate_InstanceMarketOptions
+	return plain(resource), nil
 }
 
 func (resource LaunchTemplate_InstanceMarketOptions) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("MarketType", resource.MarketType)...)
 	
+	if resource.SpotOptions != nil {
+		errs = append(errs, types.PrefixErrors("SpotOptions", resource.SpotOptions.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type LaunchTemplate_LaunchTemplateData struct {
 	
 	
@@ -35,36 +39,110 @@ type LaunchTemplate_LaunchTemplateData struct {
 	Monitoring *LaunchTemplate_Monitoring `yaml:"Monitoring,omitempty"`
 	BlockDeviceMappings interface{} `yaml:"BlockDeviceMappings,omitempty"`
 	ElasticGpuSpecifications interface{} `yaml:"ElasticGpuSpecifications,omitempty"`
-	SecurityGroups interface{} `yaml:"SecurityGroups,omitempty"`
-	TagSpecifications interface{} `yaml:"TagSpecifications,omitempty"`
 	NetworkInterfaces interface{} `yaml:"NetworkInterfaces,omitempty"`
 	SecurityGroupIds interface{} `yaml:"SecurityGroupIds,omitempty"`
+	SecurityGroups interface{} `yaml:"SecurityGroups,omitempty"`
+	TagSpecifications interface{} `yaml:"TagSpecifications,omitempty"`
 	InstanceMarketOptions *LaunchTemplate_InstanceMarketOptions `yaml:"InstanceMarketOptions,omitempty"`
 	IamInstanceProfile *LaunchTemplate_IamInstanceProfile `yaml:"IamInstanceProfile,omitempty"`
 	CreditSpecification *LaunchTemplate_CreditSpecification `yaml:"CreditSpecification,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LaunchTemplate_LaunchTemplateData) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LaunchTemplate_LaunchTemplateData
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LaunchTemplate_LaunchTemplateData) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LaunchTemplate_LaunchTemplateData
+	return plain(resource), nil
 }
 
 func (resource LaunchTemplate_LaunchTemplateData) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DisableApiTermination", resource.DisableApiTermination)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EbsOptimized", resource.EbsOptimized)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ImageId", resource.ImageId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceInitiatedShutdownBehavior", resource.InstanceInitiatedShutdownBehavior)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceType", resource.InstanceType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("KernelId", resource.KernelId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("KeyName", resource.KeyName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("RamDiskId", resource.RamDiskId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("UserData", resource.UserData)...)
 	
+	if resource.Placement != nil {
+		errs = append(errs, types.PrefixErrors("Placement", resource.Placement.Validate())...)
+	}
 	
+	if resource.Monitoring != nil {
+		errs = append(errs, types.PrefixErrors("Monitoring", resource.Monitoring.Validate())...)
+	}
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &LaunchTemplate_BlockDeviceMapping{} }))("BlockDeviceMappings", resource.BlockDeviceMappings)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &LaunchTemplate_ElasticGpuSpecification{} }))("ElasticGpuSpecifications", resource.ElasticGpuSpecifications)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &LaunchTemplate_NetworkInterface{} }))("NetworkInterfaces", resource.NetworkInterfaces)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroupIds", resource.SecurityGroupIds)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroups", resource.SecurityGroups)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &LaunchTemplate_TagSpecification{} }))("TagSpecifications", resource.TagSpecifications)...)
 	
+	if resource.InstanceMarketOptions != nil {
+		errs = append(errs, types.PrefixErrors("InstanceMarketOptions", resource.InstanceMarketOptions.Validate())...)
+	}
 	
+	if resource.IamInstanceProfile != nil {
+		errs = append(errs, types.PrefixErrors("IamInstanceProfile", resource.IamInstanceProfile.Validate())...)
+	}
 	
+	if resource.CreditSpecification != nil {
+		errs = append(errs, types.PrefixErrors("CreditSpecification", resource.CreditSpecification.Validate())...)
+	}
 	return errs
 }
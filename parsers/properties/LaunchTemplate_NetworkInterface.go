@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type LaunchTemplate_NetworkInterface struct {
 	
 	
@@ -26,21 +30,69 @@ type LaunchTemplate_NetworkInterface struct {
 	Groups interface{} `yaml:"Groups,omitempty"`
 	Ipv6Addresses interface{} `yaml:"Ipv6Addresses,omitempty"`
 	PrivateIpAddresses interface{} `yaml:"PrivateIpAddresses,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LaunchTemplate_NetworkInterface) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LaunchTemplate_NetworkInterface
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LaunchTemplate_NetworkInterface) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LaunchTemplate_NetworkInterface
+	return plain(resource), nil
 }
 
 func (resource LaunchTemplate_NetworkInterface) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AssociatePublicIpAddress", resource.AssociatePublicIpAddress)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DeleteOnTermination", resource.DeleteOnTermination)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("DeviceIndex", resource.DeviceIndex)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Ipv6AddressCount", resource.Ipv6AddressCount)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("NetworkInterfaceId", resource.NetworkInterfaceId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("PrivateIpAddress", resource.PrivateIpAddress)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("SecondaryPrivateIpAddressCount", resource.SecondaryPrivateIpAddressCount)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Groups", resource.Groups)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &LaunchTemplate_Ipv6Add{} }))("Ipv6Addresses", resource.Ipv6Addresses)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &LaunchTemplate_PrivateIpAdd{} }))("PrivateIpAddresses", resource.PrivateIpAddresses)...)
 	return errs
 }
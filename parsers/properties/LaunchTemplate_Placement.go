@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type LaunchTemplate_Placement struct {
 	
 	
@@ -12,14 +16,48 @@ type LaunchTemplate_Placement struct {
 	GroupName interface{} `yaml:"GroupName,omitempty"`
 	HostId interface{} `yaml:"HostId,omitempty"`
 	Tenancy interface{} `yaml:"Tenancy,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LaunchTemplate_Placement) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LaunchTemplate_Placement
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LaunchTemplate_Placement) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LaunchTemplate_Placement
+	return plain(resource), nil
 }
 
 func (resource LaunchTemplate_Placement) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Affinity", resource.Affinity)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AvailabilityZone", resource.AvailabilityZone)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("GroupName", resource.GroupName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("HostId", resource.HostId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Tenancy", resource.Tenancy)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type LaunchTemplate_PrivateIpAdd struct {
 	
 	
 	Primary interface{} `yaml:"Primary,omitempty"`
 	PrivateIpAddress interface{} `yaml:"PrivateIpAddress,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LaunchTemplate_PrivateIpAdd) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LaunchTemplate_PrivateIpAdd
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LaunchTemplate_PrivateIpAdd) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LaunchTemplate_PrivateIpAdd
+	return plain(resource), nil
 }
 
 func (resource LaunchTemplate_PrivateIpAdd) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Primary", resource.Primary)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("PrivateIpAddress", resource.PrivateIpAddress)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type LaunchTemplate_SpotOptions struct {
 	
 	
@@ -8,12 +12,42 @@ type LaunchTemplate_SpotOptions struct {
 	InstanceInterruptionBehavior interface{} `yaml:"InstanceInterruptionBehavior,omitempty"`
 	MaxPrice interface{} `yaml:"MaxPrice,omitempty"`
 	SpotInstanceType interface{} `yaml:"SpotInstanceType,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LaunchTemplate_SpotOptions) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LaunchTemplate_SpotOptions
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LaunchTemplate_SpotOptions) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LaunchTemplate_SpotOptions
+	return plain(resource), nil
 }
 
 func (resource LaunchTemplate_SpotOptions) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceInterruptionBehavior", resource.InstanceInterruptionBehavior)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("MaxPrice", resource.MaxPrice)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SpotInstanceType", resource.SpotInstanceType)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Layer_AutoScalingThresholds struct {
 	
 	
@@ -14,15 +18,51 @@ type Layer_AutoScalingThresholds struct {
 	LoadThreshold interface{} `yaml:"LoadThreshold,omitempty"`
 	MemoryThreshold interface{} `yaml:"MemoryThreshold,omitempty"`
 	ThresholdsWaitTime interface{} `yaml:"ThresholdsWaitTime,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Layer_AutoScalingThresholds) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Layer_AutoScalingThresholds
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Layer_AutoScalingThresholds) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Layer_AutoScalingThresholds
+	return plain(resource), nil
 }
 
 func (resource Layer_AutoScalingThresholds) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("CpuThreshold", resource.CpuThreshold)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("IgnoreMetricsTime", resource.IgnoreMetricsTime)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("InstanceCount", resource.InstanceCount)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("LoadThreshold", resource.LoadThreshold)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("MemoryThreshold", resource.MemoryThreshold)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ThresholdsWaitTime", resource.ThresholdsWaitTime)...)
 	return errs
 }
@@ -1,13 +1,45 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Layer_LifecycleEventConfiguration struct {
 	
 	ShutdownEventConfiguration *Layer_ShutdownEventConfiguration `yaml:"ShutdownEventConfiguration,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Layer_LifecycleEventConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Layer_LifecycleEventConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Layer_LifecycleEventConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Layer_LifecycleEventConfiguration
+	return plain(resource), nil
 }
 
 func (resource Layer_LifecycleEventConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	if resource.ShutdownEventConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("ShutdownEventConfiguration", resource.ShutdownEventConfiguration.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Layer_LoadBasedAutoScaling struct {
 	
 	
@@ -8,12 +12,46 @@ type Layer_LoadBasedAutoScaling struct {
 	Enable interface{} `yaml:"Enable,omitempty"`
 	DownScaling *Layer_AutoScalingThresholds `yaml:"DownScaling,omitempty"`
 	UpScaling *Layer_AutoScalingThresholds `yaml:"UpScaling,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Layer_LoadBasedAutoScaling) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Layer_LoadBasedAutoScaling
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Layer_LoadBasedAutoScaling) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Layer_LoadBasedAutoScaling
+	return plain(resource), nil
 }
 
 func (resource Layer_LoadBasedAutoScaling) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enable", resource.Enable)...)
 	
+	if resource.DownScaling != nil {
+		errs = append(errs, types.PrefixErrors("DownScaling", resource.DownScaling.Validate())...)
+	}
 	
+	if resource.UpScaling != nil {
+		errs = append(errs, types.PrefixErrors("UpScaling", resource.UpScaling.Validate())...)
+	}
 	return errs
 }
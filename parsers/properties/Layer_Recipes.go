@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Layer_Recipes struct {
 	
 	
@@ -12,14 +16,48 @@ type Layer_Recipes struct {
 	Setup interface{} `yaml:"Setup,omitempty"`
 	Shutdown interface{} `yaml:"Shutdown,omitempty"`
 	Undeploy interface{} `yaml:"Undeploy,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Layer_Recipes) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Layer_Recipes
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Layer_Recipes) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Layer_Recipes
+	return plain(resource), nil
 }
 
 func (resource Layer_Recipes) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Configure", resource.Configure)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Deploy", resource.Deploy)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Setup", resource.Setup)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Shutdown", resource.Shutdown)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Undeploy", resource.Undeploy)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Layer_ShutdownEventConfiguration struct {
 	
 	
 	DelayUntilElbConnectionsDrained interface{} `yaml:"DelayUntilElbConnectionsDrained,omitempty"`
 	ExecutionTimeout interface{} `yaml:"ExecutionTimeout,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Layer_ShutdownEventConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Layer_ShutdownEventConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Layer_ShutdownEventConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Layer_ShutdownEventConfiguration
+	return plain(resource), nil
 }
 
 func (resource Layer_ShutdownEventConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DelayUntilElbConnectionsDrained", resource.DelayUntilElbConnectionsDrained)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ExecutionTimeout", resource.ExecutionTimeout)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Layer_VolumeConfiguration struct {
 	
 	
@@ -14,15 +18,51 @@ type Layer_VolumeConfiguration struct {
 	RaidLevel interface{} `yaml:"RaidLevel,omitempty"`
 	Size interface{} `yaml:"Size,omitempty"`
 	VolumeType interface{} `yaml:"VolumeType,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Layer_VolumeConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Layer_VolumeConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Layer_VolumeConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Layer_VolumeConfiguration
+	return plain(resource), nil
 }
 
 func (resource Layer_VolumeConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Iops", resource.Iops)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("MountPoint", resource.MountPoint)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("NumberOfDisks", resource.NumberOfDisks)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("RaidLevel", resource.RaidLevel)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Size", resource.Size)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("VolumeType", resource.VolumeType)...)
 	return errs
 }
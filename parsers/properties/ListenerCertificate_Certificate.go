@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ListenerCertificate_Certificate struct {
 	
 	CertificateArn interface{} `yaml:"CertificateArn,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ListenerCertificate_Certificate) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ListenerCertificate_Certificate
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ListenerCertificate_Certificate) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ListenerCertificate_Certificate
+	return plain(resource), nil
 }
 
 func (resource ListenerCertificate_Certificate) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CertificateArn", resource.CertificateArn)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ListenerRule_Action struct {
 	
 	
 	TargetGroupArn interface{} `yaml:"TargetGroupArn"`
 	Type interface{} `yaml:"Type"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ListenerRule_Action) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ListenerRule_Action
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ListenerRule_Action) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ListenerRule_Action
+	return plain(resource), nil
 }
 
 func (resource ListenerRule_Action) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.TargetGroupArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TargetGroupArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TargetGroupArn", resource.TargetGroupArn)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ListenerRule_RuleCondition struct {
 	
 	
 	Field interface{} `yaml:"Field,omitempty"`
 	Values interface{} `yaml:"Values,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ListenerRule_RuleCondition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ListenerRule_RuleCondition
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ListenerRule_RuleCondition) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ListenerRule_RuleCondition
+	return plain(resource), nil
 }
 
 func (resource ListenerRule_RuleCondition) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Field", resource.Field)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Values", resource.Values)...)
 	return errs
 }
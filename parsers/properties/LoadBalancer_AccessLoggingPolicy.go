@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type LoadBalancer_AccessLoggingPolicy struct {
 	
@@ -11,19 +15,49 @@ type LoadBalancer_AccessLoggingPolicy struct {
 	Enabled interface{} `yaml:"Enabled"`
 	S3BucketName interface{} `yaml:"S3BucketName"`
 	S3BucketPrefix interface{} `yaml:"S3BucketPrefix,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LoadBalancer_AccessLoggingPolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LoadBalancer_AccessLoggingPolicy
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LoadBalancer_AccessLoggingPolicy) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LoadBalancer_AccessLoggingPolicy
+	return plain(resource), nil
 }
 
 func (resource LoadBalancer_AccessLoggingPolicy) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("EmitInterval", resource.EmitInterval)...)
 	if resource.Enabled == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Enabled'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	if resource.S3BucketName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'S3BucketName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("S3BucketName", resource.S3BucketName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("S3BucketPrefix", resource.S3BucketPrefix)...)
 	return errs
 }
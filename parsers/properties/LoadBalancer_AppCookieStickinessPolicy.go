@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type LoadBalancer_AppCookieStickinessPolicy struct {
 	
 	
 	CookieName interface{} `yaml:"CookieName"`
 	PolicyName interface{} `yaml:"PolicyName"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LoadBalancer_AppCookieStickinessPolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LoadBalancer_AppCookieStickinessPolicy
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LoadBalancer_AppCookieStickinessPolicy) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LoadBalancer_AppCookieStickinessPolicy
+	return plain(resource), nil
 }
 
 func (resource LoadBalancer_AppCookieStickinessPolicy) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.CookieName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CookieName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CookieName", resource.CookieName)...)
 	if resource.PolicyName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PolicyName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PolicyName", resource.PolicyName)...)
 	return errs
 }
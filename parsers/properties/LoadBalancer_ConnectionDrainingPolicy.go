@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type LoadBalancer_ConnectionDrainingPolicy struct {
 	
 	
 	Enabled interface{} `yaml:"Enabled"`
 	Timeout interface{} `yaml:"Timeout,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LoadBalancer_ConnectionDrainingPolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LoadBalancer_ConnectionDrainingPolicy
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LoadBalancer_ConnectionDrainingPolicy) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LoadBalancer_ConnectionDrainingPolicy
+	return plain(resource), nil
 }
 
 func (resource LoadBalancer_ConnectionDrainingPolicy) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Enabled == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Enabled'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Timeout", resource.Timeout)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type LoadBalancer_ConnectionSettings struct {
 	
 	IdleTimeout interface{} `yaml:"IdleTimeout"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LoadBalancer_ConnectionSettings) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LoadBalancer_ConnectionSettings
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LoadBalancer_ConnectionSettings) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LoadBalancer_ConnectionSettings
+	return plain(resource), nil
 }
 
 func (resource LoadBalancer_ConnectionSettings) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.IdleTimeout == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IdleTimeout'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("IdleTimeout", resource.IdleTimeout)...)
 	return errs
 }
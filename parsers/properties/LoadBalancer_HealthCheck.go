@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type LoadBalancer_HealthCheck struct {
 	
@@ -13,10 +17,34 @@ type LoadBalancer_HealthCheck struct {
 	Target interface{} `yaml:"Target"`
 	Timeout interface{} `yaml:"Timeout"`
 	UnhealthyThreshold interface{} `yaml:"UnhealthyThreshold"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LoadBalancer_HealthCheck) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LoadBalancer_HealthCheck
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LoadBalancer_HealthCheck) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LoadBalancer_HealthCheck
+	return plain(resource), nil
 }
 
 func (resource LoadBalancer_HealthCheck) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -25,17 +53,22 @@ func (resource LoadBalancer_HealthCheck) Validate() []error {
 	if resource.HealthyThreshold == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'HealthyThreshold'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("HealthyThreshold", resource.HealthyThreshold)...)
 	if resource.Interval == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Interval'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Interval", resource.Interval)...)
 	if resource.Target == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Target'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Target", resource.Target)...)
 	if resource.Timeout == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Timeout'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Timeout", resource.Timeout)...)
 	if resource.UnhealthyThreshold == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'UnhealthyThreshold'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("UnhealthyThreshold", resource.UnhealthyThreshold)...)
 	return errs
 }
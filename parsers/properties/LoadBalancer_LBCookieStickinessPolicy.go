@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type LoadBalancer_LBCookieStickinessPolicy struct {
 	
 	
 	CookieExpirationPeriod interface{} `yaml:"CookieExpirationPeriod,omitempty"`
 	PolicyName interface{} `yaml:"PolicyName,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LoadBalancer_LBCookieStickinessPolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LoadBalancer_LBCookieStickinessPolicy
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LoadBalancer_LBCookieStickinessPolicy) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LoadBalancer_LBCookieStickinessPolicy
+	return plain(resource), nil
 }
 
 func (resource LoadBalancer_LBCookieStickinessPolicy) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CookieExpirationPeriod", resource.CookieExpirationPeriod)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("PolicyName", resource.PolicyName)...)
 	return errs
 }
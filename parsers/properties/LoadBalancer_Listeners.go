@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type LoadBalancer_Listeners struct {
 	
@@ -15,10 +19,34 @@ type LoadBalancer_Listeners struct {
 	Protocol interface{} `yaml:"Protocol"`
 	SSLCertificateId interface{} `yaml:"SSLCertificateId,omitempty"`
 	PolicyNames interface{} `yaml:"PolicyNames,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LoadBalancer_Listeners) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LoadBalancer_Listeners
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LoadBalancer_Listeners) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LoadBalancer_Listeners
+	return plain(resource), nil
 }
 
 func (resource LoadBalancer_Listeners) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -28,11 +56,20 @@ func (resource LoadBalancer_Listeners) Validate() []error {
 	if resource.InstancePort == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstancePort'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InstancePort", resource.InstancePort)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceProtocol", resource.InstanceProtocol)...)
 	if resource.LoadBalancerPort == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'LoadBalancerPort'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("LoadBalancerPort", resource.LoadBalancerPort)...)
 	if resource.Protocol == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Protocol'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Protocol", resource.Protocol)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SSLCertificateId", resource.SSLCertificateId)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("PolicyNames", resource.PolicyNames)...)
 	return errs
 }
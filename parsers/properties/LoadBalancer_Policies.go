@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type LoadBalancer_Policies struct {
 	
@@ -13,10 +17,34 @@ type LoadBalancer_Policies struct {
 	Attributes interface{} `yaml:"Attributes"`
 	InstancePorts interface{} `yaml:"InstancePorts,omitempty"`
 	LoadBalancerPorts interface{} `yaml:"LoadBalancerPorts,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LoadBalancer_Policies) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LoadBalancer_Policies
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LoadBalancer_Policies) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LoadBalancer_Policies
+	return plain(resource), nil
 }
 
 func (resource LoadBalancer_Policies) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -25,11 +53,18 @@ func (resource LoadBalancer_Policies) Validate() []error {
 	if resource.PolicyName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PolicyName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PolicyName", resource.PolicyName)...)
 	if resource.PolicyType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PolicyType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PolicyType", resource.PolicyType)...)
 	if resource.Attributes == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Attributes'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("Json"))("Attributes", resource.Attributes)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("InstancePorts", resource.InstancePorts)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("LoadBalancerPorts", resource.LoadBalancerPorts)...)
 	return errs
 }
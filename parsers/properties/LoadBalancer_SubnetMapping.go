@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type LoadBalancer_SubnetMapping struct {
 	
 	
 	AllocationId interface{} `yaml:"AllocationId"`
 	SubnetId interface{} `yaml:"SubnetId"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *LoadBalancer_SubnetMapping) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain LoadBalancer_SubnetMapping
+	return unmarshal((*plain)(resource))
+}
+
+func (resource LoadBalancer_SubnetMapping) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain LoadBalancer_SubnetMapping
+	return plain(resource), nil
 }
 
 func (resource LoadBalancer_SubnetMapping) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.AllocationId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AllocationId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AllocationId", resource.AllocationId)...)
 	if resource.SubnetId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SubnetId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
 	return errs
 }
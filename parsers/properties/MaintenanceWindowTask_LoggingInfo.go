@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type MaintenanceWindowTask_LoggingInfo struct {
 	
@@ -9,18 +13,46 @@ type MaintenanceWindowTask_LoggingInfo struct {
 	Region interface{} `yaml:"Region"`
 	S3Bucket interface{} `yaml:"S3Bucket"`
 	S3Prefix interface{} `yaml:"S3Prefix,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *MaintenanceWindowTask_LoggingInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain MaintenanceWindowTask_LoggingInfo
+	return unmarshal((*plain)(resource))
+}
+
+func (resource MaintenanceWindowTask_LoggingInfo) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain MaintenanceWindowTask_LoggingInfo
+	return plain(resource), nil
 }
 
 func (resource MaintenanceWindowTask_LoggingInfo) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Region == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Region'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Region", resource.Region)...)
 	if resource.S3Bucket == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'S3Bucket'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("S3Bucket", resource.S3Bucket)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("S3Prefix", resource.S3Prefix)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type MaintenanceWindowTask_MaintenanceWindowAutomationParameters struct {
 	
 	
 	DocumentVersion interface{} `yaml:"DocumentVersion,omitempty"`
 	Parameters interface{} `yaml:"Parameters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *MaintenanceWindowTask_MaintenanceWindowAutomationParameters) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain MaintenanceWindowTask_MaintenanceWindowAutomationParameters
+	return unmarshal((*plain)(resource))
+}
+
+func (resource MaintenanceWindowTask_MaintenanceWindowAutomationParameters) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain MaintenanceWindowTask_MaintenanceWindowAutomationParameters
+	return plain(resource), nil
 }
 
 func (resource MaintenanceWindowTask_MaintenanceWindowAutomationParameters) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DocumentVersion", resource.DocumentVersion)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Json")("Parameters", resource.Parameters)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type MaintenanceWindowTask_MaintenanceWindowLambdaParameters struct {
 	
 	
@@ -8,12 +12,42 @@ type MaintenanceWindowTask_MaintenanceWindowLambdaParameters struct {
 	ClientContext interface{} `yaml:"ClientContext,omitempty"`
 	Payload interface{} `yaml:"Payload,omitempty"`
 	Qualifier interface{} `yaml:"Qualifier,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *MaintenanceWindowTask_MaintenanceWindowLambdaParameters) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain MaintenanceWindowTask_MaintenanceWindowLambdaParameters
+	return unmarshal((*plain)(resource))
+}
+
+func (resource MaintenanceWindowTask_MaintenanceWindowLambdaParameters) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain MaintenanceWindowTask_MaintenanceWindowLambdaParameters
+	return plain(resource), nil
 }
 
 func (resource MaintenanceWindowTask_MaintenanceWindowLambdaParameters) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ClientContext", resource.ClientContext)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Payload", resource.Payload)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Qualifier", resource.Qualifier)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type MaintenanceWindowTask_MaintenanceWindowRunCommandParameters struct {
 	
 	
@@ -20,18 +24,62 @@ type MaintenanceWindowTask_MaintenanceWindowRunCommandParameters struct {
 	ServiceRoleArn interface{} `yaml:"ServiceRoleArn,omitempty"`
 	TimeoutSeconds interface{} `yaml:"TimeoutSeconds,omitempty"`
 	NotificationConfig *MaintenanceWindowTask_NotificationConfig `yaml:"NotificationConfig,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *MaintenanceWindowTask_MaintenanceWindowRunCommandParameters) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain MaintenanceWindowTask_MaintenanceWindowRunCommandParameters
+	return unmarshal((*plain)(resource))
+}
+
+func (resource MaintenanceWindowTask_MaintenanceWindowRunCommandParameters) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain MaintenanceWindowTask_MaintenanceWindowRunCommandParameters
+	return plain(resource), nil
 }
 
 func (resource MaintenanceWindowTask_MaintenanceWindowRunCommandParameters) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Comment", resource.Comment)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DocumentHash", resource.DocumentHash)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DocumentHashType", resource.DocumentHashType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("OutputS3BucketName", resource.OutputS3BucketName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("OutputS3KeyPrefix", resource.OutputS3KeyPrefix)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Json")("Parameters", resource.Parameters)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceRoleArn", resource.ServiceRoleArn)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("TimeoutSeconds", resource.TimeoutSeconds)...)
 	
+	if resource.NotificationConfig != nil {
+		errs = append(errs, types.PrefixErrors("NotificationConfig", resource.NotificationConfig.Validate())...)
+	}
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type MaintenanceWindowTask_MaintenanceWindowStepFunctionsParameters struct {
 	
 	
 	Input interface{} `yaml:"Input,omitempty"`
 	Name interface{} `yaml:"Name,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *MaintenanceWindowTask_MaintenanceWindowStepFunctionsParameters) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain MaintenanceWindowTask_MaintenanceWindowStepFunctionsParameters
+	return unmarshal((*plain)(resource))
+}
+
+func (resource MaintenanceWindowTask_MaintenanceWindowStepFunctionsParameters) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain MaintenanceWindowTask_MaintenanceWindowStepFunctionsParameters
+	return plain(resource), nil
 }
 
 func (resource MaintenanceWindowTask_MaintenanceWindowStepFunctionsParameters) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Input", resource.Input)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	return errs
 }
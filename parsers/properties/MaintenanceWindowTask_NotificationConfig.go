@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type MaintenanceWindowTask_NotificationConfig struct {
 	
@@ -9,15 +13,44 @@ type MaintenanceWindowTask_NotificationConfig struct {
 	NotificationArn interface{} `yaml:"NotificationArn"`
 	NotificationType interface{} `yaml:"NotificationType,omitempty"`
 	NotificationEvents interface{} `yaml:"NotificationEvents,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *MaintenanceWindowTask_NotificationConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain MaintenanceWindowTask_NotificationConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource MaintenanceWindowTask_NotificationConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain MaintenanceWindowTask_NotificationConfig
+	return plain(resource), nil
 }
 
 func (resource MaintenanceWindowTask_NotificationConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.NotificationArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'NotificationArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("NotificationArn", resource.NotificationArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NotificationType", resource.NotificationType)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("NotificationEvents", resource.NotificationEvents)...)
 	return errs
 }
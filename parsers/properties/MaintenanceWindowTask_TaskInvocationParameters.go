@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type MaintenanceWindowTask_TaskInvocationParameters struct {
 	
 	
@@ -10,13 +14,53 @@ type MaintenanceWindowTask_TaskInvocationParameters struct {
 	MaintenanceWindowRunCommandParameters *MaintenanceWindowTask_MaintenanceWindowRunCommandParameters `yaml:"MaintenanceWindowRunCommandParameters,omitempty"`
 	MaintenanceWindowLambdaParameters *MaintenanceWindowTask_MaintenanceWindowLambdaParameters `yaml:"MaintenanceWindowLambdaParameters,omitempty"`
 	MaintenanceWindowAutomationParameters *MaintenanceWindowTask_MaintenanceWindowAutomationParameters `yaml:"MaintenanceWindowAutomationParameters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *MaintenanceWindowTask_TaskInvocationParameters) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain MaintenanceWindowTask_TaskInvocationParameters
+	return unmarshal((*plain)(resource))
+}
+
+func (resource MaintenanceWindowTask_TaskInvocationParameters) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain MaintenanceWindowTask_TaskInvocationParameters
+	return plain(resource), nil
 }
 
 func (resource MaintenanceWindowTask_TaskInvocationParameters) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	if resource.MaintenanceWindowStepFunctionsParameters != nil {
+		errs = append(errs, types.PrefixErrors("MaintenanceWindowStepFunctionsParameters", resource.MaintenanceWindowStepFunctionsParameters.Validate())...)
+	}
 	
+	if resource.MaintenanceWindowRunCommandParameters != nil {
+		errs = append(errs, types.PrefixErrors("MaintenanceWindowRunCommandParameters", resource.MaintenanceWindowRunCommandParameters.Validate())...)
+	}
 	
+	if resource.MaintenanceWindowLambdaParameters != nil {
+		errs = append(errs, types.PrefixErrors("MaintenanceWindowLambdaParameters", resource.MaintenanceWindowLambdaParameters.Validate())...)
+	}
 	
+	if resource.MaintenanceWindowAutomationParameters != nil {
+		errs = append(errs, types.PrefixErrors("MaintenanceWindowAutomationParameters", resource.MaintenanceWindowAutomationParameters.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Method_Integration struct {
 	
 	
@@ -24,20 +28,66 @@ type Method_Integration struct {
 	RequestTemplates interface{} `yaml:"RequestTemplates,omitempty"`
 	CacheKeyParameters interface{} `yaml:"CacheKeyParameters,omitempty"`
 	IntegrationResponses interface{} `yaml:"IntegrationResponses,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Method_Integration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Method_Integration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Method_Integration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Method_Integration
+	return plain(resource), nil
 }
 
 func (resource Method_Integration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CacheNamespace", resource.CacheNamespace)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ContentHandling", resource.ContentHandling)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Credentials", resource.Credentials)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("IntegrationHttpMethod", resource.IntegrationHttpMethod)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("PassthroughBehavior", resource.PassthroughBehavior)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Uri", resource.Uri)...)
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("RequestParameters", resource.RequestParameters)...)
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("RequestTemplates", resource.RequestTemplates)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("CacheKeyParameters", resource.CacheKeyParameters)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Method_IntegrationResponse{} }))("IntegrationResponses", resource.IntegrationResponses)...)
 	return errs
 }
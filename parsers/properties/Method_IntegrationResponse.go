@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Method_IntegrationResponse struct {
 	
@@ -13,17 +17,50 @@ type Method_IntegrationResponse struct {
 	StatusCode interface{} `yaml:"StatusCode"`
 	ResponseParameters interface{} `yaml:"ResponseParameters,omitempty"`
 	ResponseTemplates interface{} `yaml:"ResponseTemplates,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Method_IntegrationResponse) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Method_IntegrationResponse
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Method_IntegrationResponse) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Method_IntegrationResponse
+	return plain(resource), nil
 }
 
 func (resource Method_IntegrationResponse) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ContentHandling", resource.ContentHandling)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SelectionPattern", resource.SelectionPattern)...)
 	if resource.StatusCode == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StatusCode'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StatusCode", resource.StatusCode)...)
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("ResponseParameters", resource.ResponseParameters)...)
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("ResponseTemplates", resource.ResponseTemplates)...)
 	return errs
 }
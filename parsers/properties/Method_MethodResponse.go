@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Method_MethodResponse struct {
 	
@@ -9,15 +13,44 @@ type Method_MethodResponse struct {
 	StatusCode interface{} `yaml:"StatusCode"`
 	ResponseModels interface{} `yaml:"ResponseModels,omitempty"`
 	ResponseParameters interface{} `yaml:"ResponseParameters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Method_MethodResponse) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Method_MethodResponse
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Method_MethodResponse) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Method_MethodResponse
+	return plain(resource), nil
 }
 
 func (resource Method_MethodResponse) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.StatusCode == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StatusCode'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StatusCode", resource.StatusCode)...)
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("ResponseModels", resource.ResponseModels)...)
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("Boolean"))("ResponseParameters", resource.ResponseParameters)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type MetricFilter_MetricTransformation struct {
 	
@@ -9,21 +13,48 @@ type MetricFilter_MetricTransformation struct {
 	MetricName interface{} `yaml:"MetricName"`
 	MetricNamespace interface{} `yaml:"MetricNamespace"`
 	MetricValue interface{} `yaml:"MetricValue"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *MetricFilter_MetricTransformation) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain MetricFilter_MetricTransformation
+	return unmarshal((*plain)(resource))
+}
+
+func (resource MetricFilter_MetricTransformation) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain MetricFilter_MetricTransformation
+	return plain(resource), nil
 }
 
 func (resource MetricFilter_MetricTransformation) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.MetricName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MetricName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MetricName", resource.MetricName)...)
 	if resource.MetricNamespace == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MetricNamespace'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MetricNamespace", resource.MetricNamespace)...)
 	if resource.MetricValue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MetricValue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MetricValue", resource.MetricValue)...)
 	return errs
 }
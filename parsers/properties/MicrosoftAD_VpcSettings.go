@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type MicrosoftAD_VpcSettings struct {
 	
 	
 	VpcId interface{} `yaml:"VpcId"`
 	SubnetIds interface{} `yaml:"SubnetIds"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *MicrosoftAD_VpcSettings) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain MicrosoftAD_VpcSettings
+	return unmarshal((*plain)(resource))
+}
+
+func (resource MicrosoftAD_VpcSettings) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain MicrosoftAD_VpcSettings
+	return plain(resource), nil
 }
 
 func (resource MicrosoftAD_VpcSettings) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.VpcId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VpcId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VpcId", resource.VpcId)...)
 	if resource.SubnetIds == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SubnetIds'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SubnetIds", resource.SubnetIds)...)
 	return errs
 }
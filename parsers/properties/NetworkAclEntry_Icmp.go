@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type NetworkAclEntry_Icmp struct {
 	
 	
 	Code interface{} `yaml:"Code,omitempty"`
 	Type interface{} `yaml:"Type,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *NetworkAclEntry_Icmp) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain NetworkAclEntry_Icmp
+	return unmarshal((*plain)(resource))
+}
+
+func (resource NetworkAclEntry_Icmp) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain NetworkAclEntry_Icmp
+	return plain(resource), nil
 }
 
 func (resource NetworkAclEntry_Icmp) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Code", resource.Code)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Type", resource.Type)...)
 	return errs
 }
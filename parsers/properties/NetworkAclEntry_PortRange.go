@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type NetworkAclEntry_PortRange struct {
 	
 	
 	From interface{} `yaml:"From,omitempty"`
 	To interface{} `yaml:"To,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *NetworkAclEntry_PortRange) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain NetworkAclEntry_PortRange
+	return unmarshal((*plain)(resource))
+}
+
+func (resource NetworkAclEntry_PortRange) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain NetworkAclEntry_PortRange
+	return plain(resource), nil
 }
 
 func (resource NetworkAclEntry_PortRange) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("From", resource.From)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("To", resource.To)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type NetworkInterface_PrivateIpAddressSpecification struct {
 	
 	
 	Primary interface{} `yaml:"Primary"`
 	PrivateIpAddress interface{} `yaml:"PrivateIpAddress"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *NetworkInterface_PrivateIpAddressSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain NetworkInterface_PrivateIpAddressSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource NetworkInterface_PrivateIpAddressSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain NetworkInterface_PrivateIpAddressSpecification
+	return plain(resource), nil
 }
 
 func (resource NetworkInterface_PrivateIpAddressSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Primary == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Primary'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Primary", resource.Primary)...)
 	if resource.PrivateIpAddress == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PrivateIpAddress'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PrivateIpAddress", resource.PrivateIpAddress)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type OptionGroup_OptionConfiguration struct {
 	
@@ -15,10 +19,34 @@ type OptionGroup_OptionConfiguration struct {
 	OptionSettings *OptionGroup_OptionSetting `yaml:"OptionSettings,omitempty"`
 	DBSecurityGroupMemberships interface{} `yaml:"DBSecurityGroupMemberships,omitempty"`
 	VpcSecurityGroupMemberships interface{} `yaml:"VpcSecurityGroupMemberships,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *OptionGroup_OptionConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain OptionGroup_OptionConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource OptionGroup_OptionConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain OptionGroup_OptionConfiguration
+	return plain(resource), nil
 }
 
 func (resource OptionGroup_OptionConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -28,5 +56,18 @@ func (resource OptionGroup_OptionConfiguration) Validate() []error {
 	if resource.OptionName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'OptionName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("OptionName", resource.OptionName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("OptionVersion", resource.OptionVersion)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Port", resource.Port)...)
+	
+	if resource.OptionSettings != nil {
+		errs = append(errs, types.PrefixErrors("OptionSettings", resource.OptionSettings.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("DBSecurityGroupMemberships", resource.DBSecurityGroupMemberships)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("VpcSecurityGroupMemberships", resource.VpcSecurityGroupMemberships)...)
 	return errs
 }
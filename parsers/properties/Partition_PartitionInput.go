@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Partition_PartitionInput struct {
 	
@@ -9,15 +13,46 @@ type Partition_PartitionInput struct {
 	Parameters interface{} `yaml:"Parameters,omitempty"`
 	StorageDescriptor *Partition_StorageDescriptor `yaml:"StorageDescriptor,omitempty"`
 	Values interface{} `yaml:"Values"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Partition_PartitionInput) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Partition_PartitionInput
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Partition_PartitionInput) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Partition_PartitionInput
+	return plain(resource), nil
 }
 
 func (resource Partition_PartitionInput) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("Parameters", resource.Parameters)...)
+	
+	if resource.StorageDescriptor != nil {
+		errs = append(errs, types.PrefixErrors("StorageDescriptor", resource.StorageDescriptor.Validate())...)
+	}
 	if resource.Values == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Values'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Values", resource.Values)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Partition_SkewedInfo struct {
 	
 	
@@ -8,12 +12,42 @@ type Partition_SkewedInfo struct {
 	SkewedColumnValueLocationMaps interface{} `yaml:"SkewedColumnValueLocationMaps,omitempty"`
 	SkewedColumnNames interface{} `yaml:"SkewedColumnNames,omitempty"`
 	SkewedColumnValues interface{} `yaml:"SkewedColumnValues,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Partition_SkewedInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Partition_SkewedInfo
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Partition_SkewedInfo) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Partition_SkewedInfo
+	return plain(resource), nil
 }
 
 func (resource Partition_SkewedInfo) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Json")("SkewedColumnValueLocationMaps", resource.SkewedColumnValueLocationMaps)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SkewedColumnNames", resource.SkewedColumnNames)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SkewedColumnValues", resource.SkewedColumnValues)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Partition_StorageDescriptor struct {
 	
 	
@@ -26,21 +30,73 @@ type Partition_StorageDescriptor struct {
 	BucketColumns interface{} `yaml:"BucketColumns,omitempty"`
 	Columns interface{} `yaml:"Columns,omitempty"`
 	SortColumns interface{} `yaml:"SortColumns,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Partition_StorageDescriptor) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Partition_StorageDescriptor
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Partition_StorageDescriptor) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Partition_StorageDescriptor
+	return plain(resource), nil
 }
 
 func (resource Partition_StorageDescriptor) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Compressed", resource.Compressed)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("InputFormat", resource.InputFormat)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Location", resource.Location)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("NumberOfBuckets", resource.NumberOfBuckets)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("OutputFormat", resource.OutputFormat)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Json")("Parameters", resource.Parameters)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("StoredAsSubDirectories", resource.StoredAsSubDirectories)...)
 	
+	if resource.SkewedInfo != nil {
+		errs = append(errs, types.PrefixErrors("SkewedInfo", resource.SkewedInfo.Validate())...)
+	}
 	
+	if resource.SerdeInfo != nil {
+		errs = append(errs, types.PrefixErrors("SerdeInfo", resource.SerdeInfo.Validate())...)
+	}
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("BucketColumns", resource.BucketColumns)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Partition_Column{} }))("Columns", resource.Columns)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Partition_Order{} }))("SortColumns", resource.SortColumns)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type PatchBaseline_PatchFilter struct {
 	
 	
 	Key interface{} `yaml:"Key,omitempty"`
 	Values interface{} `yaml:"Values,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *PatchBaseline_PatchFilter) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain PatchBaseline_PatchFilter
+	return unmarshal((*plain)(resource))
+}
+
+func (resource PatchBaseline_PatchFilter) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain PatchBaseline_PatchFilter
+	return plain(resource), nil
 }
 
 func (resource PatchBaseline_PatchFilter) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Key", resource.Key)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Values", resource.Values)...)
 	return errs
 }
@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type PatchBaseline_PatchFilterGroup struct {
 	
 	PatchFilters interface{} `yaml:"PatchFilters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *PatchBaseline_PatchFilterGroup) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain PatchBaseline_PatchFilterGroup
+	return unmarshal((*plain)(resource))
+}
+
+func (resource PatchBaseline_PatchFilterGroup) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain PatchBaseline_PatchFilterGroup
+	return plain(resource), nil
 }
 
 func (resource PatchBaseline_PatchFilterGroup) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &PatchBaseline_PatchFilter{} }))("PatchFilters", resource.PatchFilters)...)
 	return errs
 }
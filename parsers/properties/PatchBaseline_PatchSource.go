@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type PatchBaseline_PatchSource struct {
 	
 	
@@ -8,12 +12,42 @@ type PatchBaseline_PatchSource struct {
 	Configuration interface{} `yaml:"Configuration,omitempty"`
 	Name interface{} `yaml:"Name,omitempty"`
 	Products interface{} `yaml:"Products,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *PatchBaseline_PatchSource) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain PatchBaseline_PatchSource
+	return unmarshal((*plain)(resource))
+}
+
+func (resource PatchBaseline_PatchSource) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain PatchBaseline_PatchSource
+	return plain(resource), nil
 }
 
 func (resource PatchBaseline_PatchSource) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Configuration", resource.Configuration)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Products", resource.Products)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type PatchBaseline_Rule struct {
 	
 	
@@ -10,13 +14,47 @@ type PatchBaseline_Rule struct {
 	ComplianceLevel interface{} `yaml:"ComplianceLevel,omitempty"`
 	EnableNonSecurity interface{} `yaml:"EnableNonSecurity,omitempty"`
 	PatchFilterGroup *PatchBaseline_PatchFilterGroup `yaml:"PatchFilterGroup,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *PatchBaseline_Rule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain PatchBaseline_Rule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource PatchBaseline_Rule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain PatchBaseline_Rule
+	return plain(resource), nil
 }
 
 func (resource PatchBaseline_Rule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ApproveAfterDays", resource.ApproveAfterDays)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ComplianceLevel", resource.ComplianceLevel)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EnableNonSecurity", resource.EnableNonSecurity)...)
 	
+	if resource.PatchFilterGroup != nil {
+		errs = append(errs, types.PrefixErrors("PatchFilterGroup", resource.PatchFilterGroup.Validate())...)
+	}
 	return errs
 }
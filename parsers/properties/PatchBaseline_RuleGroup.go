@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type PatchBaseline_RuleGroup struct {
 	
 	PatchRules interface{} `yaml:"PatchRules,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *PatchBaseline_RuleGroup) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain PatchBaseline_RuleGroup
+	return unmarshal((*plain)(resource))
+}
+
+func (resource PatchBaseline_RuleGroup) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain PatchBaseline_RuleGroup
+	return plain(resource), nil
 }
 
 func (resource PatchBaseline_RuleGroup) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &PatchBaseline_Rule{} }))("PatchRules", resource.PatchRules)...)
 	return errs
 }
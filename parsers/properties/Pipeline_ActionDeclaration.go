@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Pipeline_ActionDeclaration struct {
 	
@@ -17,10 +21,35 @@ type Pipeline_ActionDeclaration struct {
 	InputArtifacts interface{} `yaml:"InputArtifacts,omitempty"`
 	OutputArtifacts interface{} `yaml:"OutputArtifacts,omitempty"`
 	ActionTypeId *Pipeline_ActionTypeId `yaml:"ActionTypeId"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Pipeline_ActionDeclaration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Pipeline_ActionDeclaration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Pipeline_ActionDeclaration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Pipeline_ActionDeclaration
+	return plain(resource), nil
 }
 
 func (resource Pipeline_ActionDeclaration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
@@ -28,13 +57,24 @@ func (resource Pipeline_ActionDeclaration) Validate() []error {
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Json")("Configuration", resource.Configuration)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("RunOrder", resource.RunOrder)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Pipeline_InputArtifact{} }))("InputArtifacts", resource.InputArtifacts)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Pipeline_OutputArtifact{} }))("OutputArtifacts", resource.OutputArtifacts)...)
 	if resource.ActionTypeId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ActionTypeId'"))
-	} else {
-		errs = append(errs, resource.ActionTypeId.Validate()...)
+	}
+	if resource.ActionTypeId != nil {
+		errs = append(errs, types.PrefixErrors("ActionTypeId", resource.ActionTypeId.Validate())...)
 	}
 	return errs
 }
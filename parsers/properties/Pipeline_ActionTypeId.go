@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Pipeline_ActionTypeId struct {
 	
@@ -11,10 +15,34 @@ type Pipeline_ActionTypeId struct {
 	Owner interface{} `yaml:"Owner"`
 	Provider interface{} `yaml:"Provider"`
 	Version interface{} `yaml:"Version"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Pipeline_ActionTypeId) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Pipeline_ActionTypeId
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Pipeline_ActionTypeId) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Pipeline_ActionTypeId
+	return plain(resource), nil
 }
 
 func (resource Pipeline_ActionTypeId) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -22,14 +50,18 @@ func (resource Pipeline_ActionTypeId) Validate() []error {
 	if resource.Category == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Category'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Category", resource.Category)...)
 	if resource.Owner == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Owner'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Owner", resource.Owner)...)
 	if resource.Provider == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Provider'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Provider", resource.Provider)...)
 	if resource.Version == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Version'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Version", resource.Version)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Pipeline_ArtifactStore struct {
 	
@@ -9,18 +13,48 @@ type Pipeline_ArtifactStore struct {
 	Location interface{} `yaml:"Location"`
 	Type interface{} `yaml:"Type"`
 	EncryptionKey *Pipeline_EncryptionKey `yaml:"EncryptionKey,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Pipeline_ArtifactStore) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Pipeline_ArtifactStore
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Pipeline_ArtifactStore) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Pipeline_ArtifactStore
+	return plain(resource), nil
 }
 
 func (resource Pipeline_ArtifactStore) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Location == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Location'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Location", resource.Location)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	if resource.EncryptionKey != nil {
+		errs = append(errs, types.PrefixErrors("EncryptionKey", resource.EncryptionKey.Validate())...)
+	}
 	return errs
 }
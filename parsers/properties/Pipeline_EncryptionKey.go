@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Pipeline_EncryptionKey struct {
 	
 	
 	Id interface{} `yaml:"Id"`
 	Type interface{} `yaml:"Type"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Pipeline_EncryptionKey) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Pipeline_EncryptionKey
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Pipeline_EncryptionKey) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Pipeline_EncryptionKey
+	return plain(resource), nil
 }
 
 func (resource Pipeline_EncryptionKey) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Id == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Id'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Id", resource.Id)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Pipeline_Field struct {
 	
@@ -9,15 +13,44 @@ type Pipeline_Field struct {
 	Key interface{} `yaml:"Key"`
 	RefValue interface{} `yaml:"RefValue,omitempty"`
 	StringValue interface{} `yaml:"StringValue,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Pipeline_Field) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Pipeline_Field
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Pipeline_Field) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Pipeline_Field
+	return plain(resource), nil
 }
 
 func (resource Pipeline_Field) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Key == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Key'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Key", resource.Key)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RefValue", resource.RefValue)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("StringValue", resource.StringValue)...)
 	return errs
 }
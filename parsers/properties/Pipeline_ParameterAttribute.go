@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Pipeline_ParameterAttribute struct {
 	
 	
 	Key interface{} `yaml:"Key"`
 	StringValue interface{} `yaml:"StringValue"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Pipeline_ParameterAttribute) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Pipeline_ParameterAttribute
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Pipeline_ParameterAttribute) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Pipeline_ParameterAttribute
+	return plain(resource), nil
 }
 
 func (resource Pipeline_ParameterAttribute) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Key == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Key'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Key", resource.Key)...)
 	if resource.StringValue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StringValue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StringValue", resource.StringValue)...)
 	return errs
 }
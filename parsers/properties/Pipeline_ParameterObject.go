@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Pipeline_ParameterObject struct {
 	
 	
 	Id interface{} `yaml:"Id"`
 	Attributes interface{} `yaml:"Attributes"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Pipeline_ParameterObject) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Pipeline_ParameterObject
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Pipeline_ParameterObject) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Pipeline_ParameterObject
+	return plain(resource), nil
 }
 
 func (resource Pipeline_ParameterObject) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Id == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Id'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Id", resource.Id)...)
 	if resource.Attributes == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Attributes'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Pipeline_ParameterAttribute{} }))("Attributes", resource.Attributes)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Pipeline_ParameterValue struct {
 	
 	
 	Id interface{} `yaml:"Id"`
 	StringValue interface{} `yaml:"StringValue"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Pipeline_ParameterValue) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Pipeline_ParameterValue
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Pipeline_ParameterValue) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Pipeline_ParameterValue
+	return plain(resource), nil
 }
 
 func (resource Pipeline_ParameterValue) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Id == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Id'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Id", resource.Id)...)
 	if resource.StringValue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StringValue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StringValue", resource.StringValue)...)
 	return errs
 }
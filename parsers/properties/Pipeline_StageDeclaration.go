@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Pipeline_StageDeclaration struct {
 	
@@ -9,18 +13,46 @@ type Pipeline_StageDeclaration struct {
 	Name interface{} `yaml:"Name"`
 	Actions interface{} `yaml:"Actions"`
 	Blockers interface{} `yaml:"Blockers,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Pipeline_StageDeclaration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Pipeline_StageDeclaration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Pipeline_StageDeclaration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Pipeline_StageDeclaration
+	return plain(resource), nil
 }
 
 func (resource Pipeline_StageDeclaration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Actions == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Actions'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Pipeline_ActionDeclaration{} }))("Actions", resource.Actions)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Pipeline_BlockerDeclaration{} }))("Blockers", resource.Blockers)...)
 	return errs
 }
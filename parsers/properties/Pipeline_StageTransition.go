@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Pipeline_StageTransition struct {
 	
 	
 	Reason interface{} `yaml:"Reason"`
 	StageName interface{} `yaml:"StageName"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Pipeline_StageTransition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Pipeline_StageTransition
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Pipeline_StageTransition) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Pipeline_StageTransition
+	return plain(resource), nil
 }
 
 func (resource Pipeline_StageTransition) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Reason == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Reason'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Reason", resource.Reason)...)
 	if resource.StageName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StageName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StageName", resource.StageName)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Project_Artifacts struct {
 	
@@ -15,18 +19,53 @@ type Project_Artifacts struct {
 	Packaging interface{} `yaml:"Packaging,omitempty"`
 	Path interface{} `yaml:"Path,omitempty"`
 	Type interface{} `yaml:"Type"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Project_Artifacts) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Project_Artifacts
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Project_Artifacts) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Project_Artifacts
+	return plain(resource), nil
 }
 
 func (resource Project_Artifacts) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Location", resource.Location)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("NamespaceType", resource.NamespaceType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Packaging", resource.Packaging)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Path", resource.Path)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Project_Environment struct {
 	
@@ -13,10 +17,34 @@ type Project_Environment struct {
 	PrivilegedMode interface{} `yaml:"PrivilegedMode,omitempty"`
 	Type interface{} `yaml:"Type"`
 	EnvironmentVariables interface{} `yaml:"EnvironmentVariables,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Project_Environment) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Project_Environment
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Project_Environment) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Project_Environment
+	return plain(resource), nil
 }
 
 func (resource Project_Environment) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -25,11 +53,18 @@ func (resource Project_Environment) Validate() []error {
 	if resource.ComputeType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ComputeType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ComputeType", resource.ComputeType)...)
 	if resource.Image == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Image'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Image", resource.Image)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("PrivilegedMode", resource.PrivilegedMode)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Project_EnvironmentVariable{} }))("EnvironmentVariables", resource.EnvironmentVariables)...)
 	return errs
 }
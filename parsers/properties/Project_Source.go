@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Project_Source struct {
 	
@@ -15,18 +19,55 @@ type Project_Source struct {
 	Location interface{} `yaml:"Location,omitempty"`
 	Type interface{} `yaml:"Type"`
 	Auth *Project_SourceAuth `yaml:"Auth,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Project_Source) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Project_Source
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Project_Source) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Project_Source
+	return plain(resource), nil
 }
 
 func (resource Project_Source) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("BuildSpec", resource.BuildSpec)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("GitCloneDepth", resource.GitCloneDepth)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("InsecureSsl", resource.InsecureSsl)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Location", resource.Location)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	if resource.Auth != nil {
+		errs = append(errs, types.PrefixErrors("Auth", resource.Auth.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Project_VpcConfig struct {
 	
@@ -9,21 +13,48 @@ type Project_VpcConfig struct {
 	VpcId interface{} `yaml:"VpcId"`
 	SecurityGroupIds interface{} `yaml:"SecurityGroupIds"`
 	Subnets interface{} `yaml:"Subnets"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Project_VpcConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Project_VpcConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Project_VpcConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Project_VpcConfig
+	return plain(resource), nil
 }
 
 func (resource Project_VpcConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.VpcId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VpcId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VpcId", resource.VpcId)...)
 	if resource.SecurityGroupIds == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SecurityGroupIds'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroupIds", resource.SecurityGroupIds)...)
 	if resource.Subnets == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Subnets'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Subnets", resource.Subnets)...)
 	return errs
 }
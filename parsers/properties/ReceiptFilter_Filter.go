@@ -1,22 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ReceiptFilter_Filter struct {
 	
 	
 	Name interface{} `yaml:"Name,omitempty"`
 	IpFilter *ReceiptFilter_IpFilter `yaml:"IpFilter"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ReceiptFilter_Filter) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ReceiptFilter_Filter
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ReceiptFilter_Filter) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ReceiptFilter_Filter
+	return plain(resource), nil
 }
 
 func (resource ReceiptFilter_Filter) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.IpFilter == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IpFilter'"))
-	} else {
-		errs = append(errs, resource.IpFilter.Validate()...)
+	}
+	if resource.IpFilter != nil {
+		errs = append(errs, types.PrefixErrors("IpFilter", resource.IpFilter.Validate())...)
 	}
 	return errs
 }
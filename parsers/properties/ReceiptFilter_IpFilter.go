@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ReceiptFilter_IpFilter struct {
 	
 	
 	Cidr interface{} `yaml:"Cidr"`
 	Policy interface{} `yaml:"Policy"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ReceiptFilter_IpFilter) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ReceiptFilter_IpFilter
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ReceiptFilter_IpFilter) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ReceiptFilter_IpFilter
+	return plain(resource), nil
 }
 
 func (resource ReceiptFilter_IpFilter) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Cidr == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Cidr'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Cidr", resource.Cidr)...)
 	if resource.Policy == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Policy'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Policy", resource.Policy)...)
 	return errs
 }
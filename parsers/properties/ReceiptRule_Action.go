@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ReceiptRule_Action struct {
 	
 	
@@ -16,16 +20,68 @@ type ReceiptRule_Action struct {
 	LambdaAction *ReceiptRule_LambdaAction `yaml:"LambdaAction,omitempty"`
 	BounceAction *ReceiptRule_BounceAction `yaml:"BounceAction,omitempty"`
 	AddHeaderAction *ReceiptRule_AddHeaderAction `yaml:"AddHeaderAction,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ReceiptRule_Action) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ReceiptRule_Action
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ReceiptRule_Action) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ReceiptRule_Action
+	return plain(resource), nil
 }
 
 func (resource ReceiptRule_Action) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
 	
+	if resource.WorkmailAction != nil {
+		errs = append(errs, types.PrefixErrors("WorkmailAction", resource.WorkmailAction.Validate())...)
+	}
 	
+	if resource.StopAction != nil {
+		errs = append(errs, types.PrefixErrors("StopAction", resource.StopAction.Validate())...)
+	}
 	
+	if resource.SNSAction != nil {
+		errs = append(errs, types.PrefixErrors("SNSAction", resource.SNSAction.Validate())...)
+	}
 	
+	if resource.S3Action != nil {
+		errs = append(errs, types.PrefixErrors("S3Action", resource.S3Action.Validate())...)
+	}
 	
+	if resource.LambdaAction != nil {
+		errs = append(errs, types.PrefixErrors("LambdaAction", resource.LambdaAction.Validate())...)
+	}
 	
+	if resource.BounceAction != nil {
+		errs = append(errs, types.PrefixErrors("BounceAction", resource.BounceAction.Validate())...)
+	}
 	
+	if resource.AddHeaderAction != nil {
+		errs = append(errs, types.PrefixErrors("AddHeaderAction", resource.AddHeaderAction.Validate())...)
+	}
 	return errs
 }
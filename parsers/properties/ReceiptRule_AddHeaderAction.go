@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ReceiptRule_AddHeaderAction struct {
 	
 	
 	HeaderName interface{} `yaml:"HeaderName"`
 	HeaderValue interface{} `yaml:"HeaderValue"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ReceiptRule_AddHeaderAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ReceiptRule_AddHeaderAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ReceiptRule_AddHeaderAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ReceiptRule_AddHeaderAction
+	return plain(resource), nil
 }
 
 func (resource ReceiptRule_AddHeaderAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.HeaderName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'HeaderName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("HeaderName", resource.HeaderName)...)
 	if resource.HeaderValue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'HeaderValue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("HeaderValue", resource.HeaderValue)...)
 	return errs
 }
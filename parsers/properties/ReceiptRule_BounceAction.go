@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ReceiptRule_BounceAction struct {
 	
@@ -13,10 +17,34 @@ type ReceiptRule_BounceAction struct {
 	SmtpReplyCode interface{} `yaml:"SmtpReplyCode"`
 	StatusCode interface{} `yaml:"StatusCode,omitempty"`
 	TopicArn interface{} `yaml:"TopicArn,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ReceiptRule_BounceAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ReceiptRule_BounceAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ReceiptRule_BounceAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ReceiptRule_BounceAction
+	return plain(resource), nil
 }
 
 func (resource ReceiptRule_BounceAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -25,11 +53,18 @@ func (resource ReceiptRule_BounceAction) Validate() []error {
 	if resource.Message == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Message'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Message", resource.Message)...)
 	if resource.Sender == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Sender'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Sender", resource.Sender)...)
 	if resource.SmtpReplyCode == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SmtpReplyCode'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("SmtpReplyCode", resource.SmtpReplyCode)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("StatusCode", resource.StatusCode)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TopicArn", resource.TopicArn)...)
 	return errs
 }
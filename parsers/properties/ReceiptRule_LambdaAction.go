@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ReceiptRule_LambdaAction struct {
 	
@@ -9,15 +13,44 @@ type ReceiptRule_LambdaAction struct {
 	FunctionArn interface{} `yaml:"FunctionArn"`
 	InvocationType interface{} `yaml:"InvocationType,omitempty"`
 	TopicArn interface{} `yaml:"TopicArn,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ReceiptRule_LambdaAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ReceiptRule_LambdaAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ReceiptRule_LambdaAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ReceiptRule_LambdaAction
+	return plain(resource), nil
 }
 
 func (resource ReceiptRule_LambdaAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.FunctionArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FunctionArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("FunctionArn", resource.FunctionArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InvocationType", resource.InvocationType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TopicArn", resource.TopicArn)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ReceiptRule_Rule struct {
 	
 	
@@ -14,15 +18,51 @@ type ReceiptRule_Rule struct {
 	TlsPolicy interface{} `yaml:"TlsPolicy,omitempty"`
 	Actions interface{} `yaml:"Actions,omitempty"`
 	Recipients interface{} `yaml:"Recipients,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ReceiptRule_Rule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ReceiptRule_Rule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ReceiptRule_Rule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ReceiptRule_Rule
+	return plain(resource), nil
 }
 
 func (resource ReceiptRule_Rule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ScanEnabled", resource.ScanEnabled)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("TlsPolicy", resource.TlsPolicy)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &ReceiptRule_Action{} }))("Actions", resource.Actions)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Recipients", resource.Recipients)...)
 	return errs
 }
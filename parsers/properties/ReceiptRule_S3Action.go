@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ReceiptRule_S3Action struct {
 	
@@ -11,10 +15,34 @@ type ReceiptRule_S3Action struct {
 	KmsKeyArn interface{} `yaml:"KmsKeyArn,omitempty"`
 	ObjectKeyPrefix interface{} `yaml:"ObjectKeyPrefix,omitempty"`
 	TopicArn interface{} `yaml:"TopicArn,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ReceiptRule_S3Action) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ReceiptRule_S3Action
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ReceiptRule_S3Action) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ReceiptRule_S3Action
+	return plain(resource), nil
 }
 
 func (resource ReceiptRule_S3Action) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -22,5 +50,12 @@ func (resource ReceiptRule_S3Action) Validate() []error {
 	if resource.BucketName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BucketName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("BucketName", resource.BucketName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KmsKeyArn", resource.KmsKeyArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ObjectKeyPrefix", resource.ObjectKeyPrefix)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TopicArn", resource.TopicArn)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ReceiptRule_SNSAction struct {
 	
 	
 	Encoding interface{} `yaml:"Encoding,omitempty"`
 	TopicArn interface{} `yaml:"TopicArn,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ReceiptRule_SNSAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ReceiptRule_SNSAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ReceiptRule_SNSAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ReceiptRule_SNSAction
+	return plain(resource), nil
 }
 
 func (resource ReceiptRule_SNSAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Encoding", resource.Encoding)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("TopicArn", resource.TopicArn)...)
 	return errs
 }
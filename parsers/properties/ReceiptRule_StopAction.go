@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ReceiptRule_StopAction struct {
 	
 	
 	Scope interface{} `yaml:"Scope"`
 	TopicArn interface{} `yaml:"TopicArn,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ReceiptRule_StopAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ReceiptRule_StopAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ReceiptRule_StopAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ReceiptRule_StopAction
+	return plain(resource), nil
 }
 
 func (resource ReceiptRule_StopAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Scope == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Scope'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Scope", resource.Scope)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TopicArn", resource.TopicArn)...)
 	return errs
 }
@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ReceiptRule_WorkmailAction struct {
 	
 	
 	OrganizationArn interface{} `yaml:"OrganizationArn"`
 	TopicArn interface{} `yaml:"TopicArn,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ReceiptRule_WorkmailAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ReceiptRule_WorkmailAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ReceiptRule_WorkmailAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ReceiptRule_WorkmailAction
+	return plain(resource), nil
 }
 
 func (resource ReceiptRule_WorkmailAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.OrganizationArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'OrganizationArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("OrganizationArn", resource.OrganizationArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TopicArn", resource.TopicArn)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type RecordSetGroup_AliasTarget struct {
 	
@@ -9,18 +13,46 @@ type RecordSetGroup_AliasTarget struct {
 	DNSName interface{} `yaml:"DNSName"`
 	EvaluateTargetHealth interface{} `yaml:"EvaluateTargetHealth,omitempty"`
 	HostedZoneId interface{} `yaml:"HostedZoneId"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *RecordSetGroup_AliasTarget) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain RecordSetGroup_AliasTarget
+	return unmarshal((*plain)(resource))
+}
+
+func (resource RecordSetGroup_AliasTarget) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain RecordSetGroup_AliasTarget
+	return plain(resource), nil
 }
 
 func (resource RecordSetGroup_AliasTarget) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.DNSName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DNSName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DNSName", resource.DNSName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EvaluateTargetHealth", resource.EvaluateTargetHealth)...)
 	if resource.HostedZoneId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'HostedZoneId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("HostedZoneId", resource.HostedZoneId)...)
 	return errs
 }
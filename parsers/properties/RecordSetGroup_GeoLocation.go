@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type RecordSetGroup_GeoLocation struct {
 	
 	
@@ -8,12 +12,42 @@ type RecordSetGroup_GeoLocation struct {
 	ContinentCode interface{} `yaml:"ContinentCode,omitempty"`
 	CountryCode interface{} `yaml:"CountryCode,omitempty"`
 	SubdivisionCode interface{} `yaml:"SubdivisionCode,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *RecordSetGroup_GeoLocation) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain RecordSetGroup_GeoLocation
+	return unmarshal((*plain)(resource))
+}
+
+func (resource RecordSetGroup_GeoLocation) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain RecordSetGroup_GeoLocation
+	return plain(resource), nil
 }
 
 func (resource RecordSetGroup_GeoLocation) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ContinentCode", resource.ContinentCode)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CountryCode", resource.CountryCode)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SubdivisionCode", resource.SubdivisionCode)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type RecordSetGroup_RecordSet struct {
 	
@@ -31,10 +35,37 @@ type RecordSetGroup_RecordSet struct {
 	ResourceRecords interface{} `yaml:"ResourceRecords,omitempty"`
 	GeoLocation *RecordSetGroup_GeoLocation `yaml:"GeoLocation,omitempty"`
 	AliasTarget *RecordSetGroup_AliasTarget `yaml:"AliasTarget,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *RecordSetGroup_RecordSet) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain RecordSetGroup_RecordSet
+	return unmarshal((*plain)(resource))
+}
+
+func (resource RecordSetGroup_RecordSet) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain RecordSetGroup_RecordSet
+	return plain(resource), nil
 }
 
 func (resource RecordSetGroup_RecordSet) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
 	
 	
@@ -47,13 +78,40 @@ func (resource RecordSetGroup_RecordSet) Validate() []error {
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Comment", resource.Comment)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Failover", resource.Failover)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("HealthCheckId", resource.HealthCheckId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HostedZoneId", resource.HostedZoneId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HostedZoneName", resource.HostedZoneName)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Region", resource.Region)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SetIdentifier", resource.SetIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TTL", resource.TTL)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Weight", resource.Weight)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ResourceRecords", resource.ResourceRecords)...)
+	
+	if resource.GeoLocation != nil {
+		errs = append(errs, types.PrefixErrors("GeoLocation", resource.GeoLocation.Validate())...)
+	}
+	
+	if resource.AliasTarget != nil {
+		errs = append(errs, types.PrefixErrors("AliasTarget", resource.AliasTarget.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ReplicationGroup_NodeGroupConfiguration struct {
 	
 	
@@ -10,13 +14,45 @@ type ReplicationGroup_NodeGroupConfiguration struct {
 	ReplicaCount interface{} `yaml:"ReplicaCount,omitempty"`
 	Slots interface{} `yaml:"Slots,omitempty"`
 	ReplicaAvailabilityZones interface{} `yaml:"ReplicaAvailabilityZones,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ReplicationGroup_NodeGroupConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ReplicationGroup_NodeGroupConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ReplicationGroup_NodeGroupConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ReplicationGroup_NodeGroupConfiguration
+	return plain(resource), nil
 }
 
 func (resource ReplicationGroup_NodeGroupConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("PrimaryAvailabilityZone", resource.PrimaryAvailabilityZone)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ReplicaCount", resource.ReplicaCount)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Slots", resource.Slots)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ReplicaAvailabilityZones", resource.ReplicaAvailabilityZones)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Repository_LifecyclePolicy struct {
 	
 	
 	LifecyclePolicyText interface{} `yaml:"LifecyclePolicyText,omitempty"`
 	RegistryId interface{} `yaml:"RegistryId,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Repository_LifecyclePolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Repository_LifecyclePolicy
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Repository_LifecyclePolicy) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Repository_LifecyclePolicy
+	return plain(resource), nil
 }
 
 func (resource Repository_LifecyclePolicy) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("LifecyclePolicyText", resource.LifecyclePolicyText)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("RegistryId", resource.RegistryId)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Repository_RepositoryTrigger struct {
 	
 	
@@ -12,14 +16,48 @@ type Repository_RepositoryTrigger struct {
 	Name interface{} `yaml:"Name,omitempty"`
 	Branches interface{} `yaml:"Branches,omitempty"`
 	Events interface{} `yaml:"Events,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Repository_RepositoryTrigger) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Repository_RepositoryTrigger
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Repository_RepositoryTrigger) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Repository_RepositoryTrigger
+	return plain(resource), nil
 }
 
 func (resource Repository_RepositoryTrigger) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CustomData", resource.CustomData)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DestinationArn", resource.DestinationArn)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Branches", resource.Branches)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Events", resource.Events)...)
 	return errs
 }
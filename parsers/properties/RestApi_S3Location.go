@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type RestApi_S3Location struct {
 	
 	
@@ -10,13 +14,45 @@ type RestApi_S3Location struct {
 	ETag interface{} `yaml:"ETag,omitempty"`
 	Key interface{} `yaml:"Key,omitempty"`
 	Version interface{} `yaml:"Version,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *RestApi_S3Location) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain RestApi_S3Location
+	return unmarshal((*plain)(resource))
+}
+
+func (resource RestApi_S3Location) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain RestApi_S3Location
+	return plain(resource), nil
 }
 
 func (resource RestApi_S3Location) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Bucket", resource.Bucket)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ETag", resource.ETag)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Key", resource.Key)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Version", resource.Version)...)
 	return errs
 }
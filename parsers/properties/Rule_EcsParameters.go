@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Rule_EcsParameters struct {
 	
 	
 	TaskCount interface{} `yaml:"TaskCount,omitempty"`
 	TaskDefinitionArn interface{} `yaml:"TaskDefinitionArn"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Rule_EcsParameters) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Rule_EcsParameters
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Rule_EcsParameters) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Rule_EcsParameters
+	return plain(resource), nil
 }
 
 func (resource Rule_EcsParameters) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("TaskCount", resource.TaskCount)...)
 	if resource.TaskDefinitionArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TaskDefinitionArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TaskDefinitionArn", resource.TaskDefinitionArn)...)
 	return errs
 }
@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Rule_InputTransformer struct {
 	
 	
 	InputTemplate interface{} `yaml:"InputTemplate"`
 	InputPathsMap interface{} `yaml:"InputPathsMap,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Rule_InputTransformer) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Rule_InputTransformer
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Rule_InputTransformer) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Rule_InputTransformer
+	return plain(resource), nil
 }
 
 func (resource Rule_InputTransformer) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.InputTemplate == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InputTemplate'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InputTemplate", resource.InputTemplate)...)
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("InputPathsMap", resource.InputPathsMap)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Rule_KinesisParameters struct {
 	
 	PartitionKeyPath interface{} `yaml:"PartitionKeyPath"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Rule_KinesisParameters) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Rule_KinesisParameters
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Rule_KinesisParameters) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Rule_KinesisParameters
+	return plain(resource), nil
 }
 
 func (resource Rule_KinesisParameters) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.PartitionKeyPath == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PartitionKeyPath'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PartitionKeyPath", resource.PartitionKeyPath)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Rule_Predicate struct {
 	
@@ -9,21 +13,48 @@ type Rule_Predicate struct {
 	DataId interface{} `yaml:"DataId"`
 	Negated interface{} `yaml:"Negated"`
 	Type interface{} `yaml:"Type"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Rule_Predicate) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Rule_Predicate
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Rule_Predicate) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Rule_Predicate
+	return plain(resource), nil
 }
 
 func (resource Rule_Predicate) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.DataId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DataId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DataId", resource.DataId)...)
 	if resource.Negated == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Negated'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Negated", resource.Negated)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	return errs
 }
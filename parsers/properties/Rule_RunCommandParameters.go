@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Rule_RunCommandParameters struct {
 	
 	RunCommandTargets interface{} `yaml:"RunCommandTargets"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Rule_RunCommandParameters) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Rule_RunCommandParameters
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Rule_RunCommandParameters) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Rule_RunCommandParameters
+	return plain(resource), nil
 }
 
 func (resource Rule_RunCommandParameters) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.RunCommandTargets == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RunCommandTargets'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Rule_RunCommandTarget{} }))("RunCommandTargets", resource.RunCommandTargets)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Rule_Target struct {
 	
@@ -21,10 +25,34 @@ type Rule_Target struct {
 	KinesisParameters *Rule_KinesisParameters `yaml:"KinesisParameters,omitempty"`
 	InputTransformer *Rule_InputTransformer `yaml:"InputTransformer,omitempty"`
 	EcsParameters *Rule_EcsParameters `yaml:"EcsParameters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Rule_Target) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Rule_Target
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Rule_Target) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Rule_Target
+	return plain(resource), nil
 }
 
 func (resource Rule_Target) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -37,8 +65,32 @@ func (resource Rule_Target) Validate() []error {
 	if resource.Arn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Arn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Arn", resource.Arn)...)
 	if resource.Id == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Id'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Id", resource.Id)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Input", resource.Input)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InputPath", resource.InputPath)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
+	
+	if resource.RunCommandParameters != nil {
+		errs = append(errs, types.PrefixErrors("RunCommandParameters", resource.RunCommandParameters.Validate())...)
+	}
+	
+	if resource.KinesisParameters != nil {
+		errs = append(errs, types.PrefixErrors("KinesisParameters", resource.KinesisParameters.Validate())...)
+	}
+	
+	if resource.InputTransformer != nil {
+		errs = append(errs, types.PrefixErrors("InputTransformer", resource.InputTransformer.Validate())...)
+	}
+	
+	if resource.EcsParameters != nil {
+		errs = append(errs, types.PrefixErrors("EcsParameters", resource.EcsParameters.Validate())...)
+	}
 	return errs
 }
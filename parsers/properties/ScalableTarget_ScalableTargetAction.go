@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ScalableTarget_ScalableTargetAction struct {
 	
 	
 	MaxCapacity interface{} `yaml:"MaxCapacity,omitempty"`
 	MinCapacity interface{} `yaml:"MinCapacity,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ScalableTarget_ScalableTargetAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ScalableTarget_ScalableTargetAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ScalableTarget_ScalableTargetAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ScalableTarget_ScalableTargetAction
+	return plain(resource), nil
 }
 
 func (resource ScalableTarget_ScalableTargetAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MaxCapacity", resource.MaxCapacity)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MinCapacity", resource.MinCapacity)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ScalableTarget_ScheduledAction struct {
 	
@@ -13,20 +17,54 @@ type ScalableTarget_ScheduledAction struct {
 	ScheduledActionName interface{} `yaml:"ScheduledActionName"`
 	StartTime interface{} `yaml:"StartTime,omitempty"`
 	ScalableTargetAction *ScalableTarget_ScalableTargetAction `yaml:"ScalableTargetAction,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ScalableTarget_ScheduledAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ScalableTarget_ScheduledAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ScalableTarget_ScheduledAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ScalableTarget_ScheduledAction
+	return plain(resource), nil
 }
 
 func (resource ScalableTarget_ScheduledAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Timestamp")("EndTime", resource.EndTime)...)
 	if resource.Schedule == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Schedule'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Schedule", resource.Schedule)...)
 	if resource.ScheduledActionName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ScheduledActionName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ScheduledActionName", resource.ScheduledActionName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Timestamp")("StartTime", resource.StartTime)...)
+	
+	if resource.ScalableTargetAction != nil {
+		errs = append(errs, types.PrefixErrors("ScalableTargetAction", resource.ScalableTargetAction.Validate())...)
+	}
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ScalingPlan_ApplicationSource struct {
 	
 	
 	CloudFormationStackARN interface{} `yaml:"CloudFormationStackARN,omitempty"`
 	TagFilters interface{} `yaml:"TagFilters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ScalingPlan_ApplicationSource) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ScalingPlan_ApplicationSource
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ScalingPlan_ApplicationSource) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ScalingPlan_ApplicationSource
+	return plain(resource), nil
 }
 
 func (resource ScalingPlan_ApplicationSource) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CloudFormationStackARN", resource.CloudFormationStackARN)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &ScalingPlan_TagFilter{} }))("TagFilters", resource.TagFilters)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ScalingPlan_CustomizedScalingMetricSpecification struct {
 	
@@ -13,10 +17,34 @@ type ScalingPlan_CustomizedScalingMetricSpecification struct {
 	Statistic interface{} `yaml:"Statistic"`
 	Unit interface{} `yaml:"Unit,omitempty"`
 	Dimensions interface{} `yaml:"Dimensions,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ScalingPlan_CustomizedScalingMetricSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ScalingPlan_CustomizedScalingMetricSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ScalingPlan_CustomizedScalingMetricSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ScalingPlan_CustomizedScalingMetricSpecification
+	return plain(resource), nil
 }
 
 func (resource ScalingPlan_CustomizedScalingMetricSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -25,11 +53,18 @@ func (resource ScalingPlan_CustomizedScalingMetricSpecification) Validate() []er
 	if resource.MetricName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MetricName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MetricName", resource.MetricName)...)
 	if resource.Namespace == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Namespace'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Namespace", resource.Namespace)...)
 	if resource.Statistic == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Statistic'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Statistic", resource.Statistic)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Unit", resource.Unit)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &ScalingPlan_MetricDimension{} }))("Dimensions", resource.Dimensions)...)
 	return errs
 }
@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ScalingPlan_PredefinedScalingMetricSpecification struct {
 	
 	
 	PredefinedScalingMetricType interface{} `yaml:"PredefinedScalingMetricType"`
 	ResourceLabel interface{} `yaml:"ResourceLabel,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ScalingPlan_PredefinedScalingMetricSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ScalingPlan_PredefinedScalingMetricSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ScalingPlan_PredefinedScalingMetricSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ScalingPlan_PredefinedScalingMetricSpecification
+	return plain(resource), nil
 }
 
 func (resource ScalingPlan_PredefinedScalingMetricSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.PredefinedScalingMetricType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PredefinedScalingMetricType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PredefinedScalingMetricType", resource.PredefinedScalingMetricType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ResourceLabel", resource.ResourceLabel)...)
 	return errs
 }
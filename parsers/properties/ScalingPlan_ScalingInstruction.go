@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ScalingPlan_ScalingInstruction struct {
 	
@@ -15,10 +19,34 @@ type ScalingPlan_ScalingInstruction struct {
 	ScalableDimension interface{} `yaml:"ScalableDimension"`
 	ServiceNamespace interface{} `yaml:"ServiceNamespace"`
 	TargetTrackingConfigurations interface{} `yaml:"TargetTrackingConfigurations"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ScalingPlan_ScalingInstruction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ScalingPlan_ScalingInstruction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ScalingPlan_ScalingInstruction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ScalingPlan_ScalingInstruction
+	return plain(resource), nil
 }
 
 func (resource ScalingPlan_ScalingInstruction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -28,20 +56,26 @@ func (resource ScalingPlan_ScalingInstruction) Validate() []error {
 	if resource.MaxCapacity == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MaxCapacity'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("MaxCapacity", resource.MaxCapacity)...)
 	if resource.MinCapacity == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MinCapacity'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("MinCapacity", resource.MinCapacity)...)
 	if resource.ResourceId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ResourceId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ResourceId", resource.ResourceId)...)
 	if resource.ScalableDimension == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ScalableDimension'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ScalableDimension", resource.ScalableDimension)...)
 	if resource.ServiceNamespace == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ServiceNamespace'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceNamespace", resource.ServiceNamespace)...)
 	if resource.TargetTrackingConfigurations == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TargetTrackingConfigurations'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &ScalingPlan_TargetTrackingConfiguration{} }))("TargetTrackingConfigurations", resource.TargetTrackingConfigurations)...)
 	return errs
 }
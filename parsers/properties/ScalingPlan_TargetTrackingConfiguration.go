@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ScalingPlan_TargetTrackingConfiguration struct {
 	
@@ -17,10 +21,34 @@ type ScalingPlan_TargetTrackingConfiguration struct {
 	TargetValue interface{} `yaml:"TargetValue"`
 	PredefinedScalingMetricSpecification *ScalingPlan_PredefinedScalingMetricSpecification `yaml:"PredefinedScalingMetricSpecification,omitempty"`
 	CustomizedScalingMetricSpecification *ScalingPlan_CustomizedScalingMetricSpecification `yaml:"CustomizedScalingMetricSpecification,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ScalingPlan_TargetTrackingConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ScalingPlan_TargetTrackingConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ScalingPlan_TargetTrackingConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ScalingPlan_TargetTrackingConfiguration
+	return plain(resource), nil
 }
 
 func (resource ScalingPlan_TargetTrackingConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -28,8 +56,25 @@ func (resource ScalingPlan_TargetTrackingConfiguration) Validate() []error {
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DisableScaleIn", resource.DisableScaleIn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("EstimatedInstanceWarmup", resource.EstimatedInstanceWarmup)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ScaleInCooldown", resource.ScaleInCooldown)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ScaleOutCooldown", resource.ScaleOutCooldown)...)
 	if resource.TargetValue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TargetValue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Double")("TargetValue", resource.TargetValue)...)
+	
+	if resource.PredefinedScalingMetricSpecification != nil {
+		errs = append(errs, types.PrefixErrors("PredefinedScalingMetricSpecification", resource.PredefinedScalingMetricSpecification.Validate())...)
+	}
+	
+	if resource.CustomizedScalingMetricSpecification != nil {
+		errs = append(errs, types.PrefixErrors("CustomizedScalingMetricSpecification", resource.CustomizedScalingMetricSpecification.Validate())...)
+	}
 	return errs
 }
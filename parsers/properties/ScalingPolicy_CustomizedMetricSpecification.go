@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ScalingPolicy_CustomizedMetricSpecification struct {
 	
@@ -13,10 +17,34 @@ type ScalingPolicy_CustomizedMetricSpecification struct {
 	Statistic interface{} `yaml:"Statistic"`
 	Unit interface{} `yaml:"Unit,omitempty"`
 	Dimensions interface{} `yaml:"Dimensions,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ScalingPolicy_CustomizedMetricSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ScalingPolicy_CustomizedMetricSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ScalingPolicy_CustomizedMetricSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ScalingPolicy_CustomizedMetricSpecification
+	return plain(resource), nil
 }
 
 func (resource ScalingPolicy_CustomizedMetricSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -25,11 +53,18 @@ func (resource ScalingPolicy_CustomizedMetricSpecification) Validate() []error {
 	if resource.MetricName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MetricName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MetricName", resource.MetricName)...)
 	if resource.Namespace == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Namespace'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Namespace", resource.Namespace)...)
 	if resource.Statistic == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Statistic'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Statistic", resource.Statistic)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Unit", resource.Unit)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &ScalingPolicy_MetricDimension{} }))("Dimensions", resource.Dimensions)...)
 	return errs
 }
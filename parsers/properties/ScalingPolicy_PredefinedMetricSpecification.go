@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ScalingPolicy_PredefinedMetricSpecification struct {
 	
 	
 	PredefinedMetricType interface{} `yaml:"PredefinedMetricType"`
 	ResourceLabel interface{} `yaml:"ResourceLabel,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ScalingPolicy_PredefinedMetricSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ScalingPolicy_PredefinedMetricSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ScalingPolicy_PredefinedMetricSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ScalingPolicy_PredefinedMetricSpecification
+	return plain(resource), nil
 }
 
 func (resource ScalingPolicy_PredefinedMetricSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.PredefinedMetricType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PredefinedMetricType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PredefinedMetricType", resource.PredefinedMetricType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ResourceLabel", resource.ResourceLabel)...)
 	return errs
 }
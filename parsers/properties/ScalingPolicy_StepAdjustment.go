@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ScalingPolicy_StepAdjustment struct {
 	
@@ -9,15 +13,44 @@ type ScalingPolicy_StepAdjustment struct {
 	MetricIntervalLowerBound interface{} `yaml:"MetricIntervalLowerBound,omitempty"`
 	MetricIntervalUpperBound interface{} `yaml:"MetricIntervalUpperBound,omitempty"`
 	ScalingAdjustment interface{} `yaml:"ScalingAdjustment"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ScalingPolicy_StepAdjustment) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ScalingPolicy_StepAdjustment
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ScalingPolicy_StepAdjustment) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ScalingPolicy_StepAdjustment
+	return plain(resource), nil
 }
 
 func (resource ScalingPolicy_StepAdjustment) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("MetricIntervalLowerBound", resource.MetricIntervalLowerBound)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("MetricIntervalUpperBound", resource.MetricIntervalUpperBound)...)
 	if resource.ScalingAdjustment == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ScalingAdjustment'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("ScalingAdjustment", resource.ScalingAdjustment)...)
 	return errs
 }
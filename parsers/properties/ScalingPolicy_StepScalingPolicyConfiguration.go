@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type ScalingPolicy_StepScalingPolicyConfiguration struct {
 	
 	
@@ -12,14 +16,48 @@ type ScalingPolicy_StepScalingPolicyConfiguration struct {
 	MetricAggregationType interface{} `yaml:"MetricAggregationType,omitempty"`
 	MinAdjustmentMagnitude interface{} `yaml:"MinAdjustmentMagnitude,omitempty"`
 	StepAdjustments interface{} `yaml:"StepAdjustments,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ScalingPolicy_StepScalingPolicyConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ScalingPolicy_StepScalingPolicyConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ScalingPolicy_StepScalingPolicyConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ScalingPolicy_StepScalingPolicyConfiguration
+	return plain(resource), nil
 }
 
 func (resource ScalingPolicy_StepScalingPolicyConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AdjustmentType", resource.AdjustmentType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Cooldown", resource.Cooldown)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("MetricAggregationType", resource.MetricAggregationType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MinAdjustmentMagnitude", resource.MinAdjustmentMagnitude)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &ScalingPolicy_StepAdjustment{} }))("StepAdjustments", resource.StepAdjustments)...)
 	return errs
 }
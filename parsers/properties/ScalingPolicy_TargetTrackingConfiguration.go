@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ScalingPolicy_TargetTrackingConfiguration struct {
 	
@@ -11,16 +15,51 @@ type ScalingPolicy_TargetTrackingConfiguration struct {
 	TargetValue interface{} `yaml:"TargetValue"`
 	PredefinedMetricSpecification *ScalingPolicy_PredefinedMetricSpecification `yaml:"PredefinedMetricSpecification,omitempty"`
 	CustomizedMetricSpecification *ScalingPolicy_CustomizedMetricSpecification `yaml:"CustomizedMetricSpecification,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ScalingPolicy_TargetTrackingConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ScalingPolicy_TargetTrackingConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ScalingPolicy_TargetTrackingConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ScalingPolicy_TargetTrackingConfiguration
+	return plain(resource), nil
 }
 
 func (resource ScalingPolicy_TargetTrackingConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DisableScaleIn", resource.DisableScaleIn)...)
 	if resource.TargetValue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TargetValue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Double")("TargetValue", resource.TargetValue)...)
+	
+	if resource.PredefinedMetricSpecification != nil {
+		errs = append(errs, types.PrefixErrors("PredefinedMetricSpecification", resource.PredefinedMetricSpecification.Validate())...)
+	}
+	
+	if resource.CustomizedMetricSpecification != nil {
+		errs = append(errs, types.PrefixErrors("CustomizedMetricSpecification", resource.CustomizedMetricSpecification.Validate())...)
+	}
 	return errs
 }
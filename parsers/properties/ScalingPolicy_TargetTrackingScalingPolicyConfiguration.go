@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type ScalingPolicy_TargetTrackingScalingPolicyConfiguration struct {
 	
@@ -15,18 +19,57 @@ type ScalingPolicy_TargetTrackingScalingPolicyConfiguration struct {
 	TargetValue interface{} `yaml:"TargetValue"`
 	PredefinedMetricSpecification *ScalingPolicy_PredefinedMetricSpecification `yaml:"PredefinedMetricSpecification,omitempty"`
 	CustomizedMetricSpecification *ScalingPolicy_CustomizedMetricSpecification `yaml:"CustomizedMetricSpecification,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *ScalingPolicy_TargetTrackingScalingPolicyConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain ScalingPolicy_TargetTrackingScalingPolicyConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource ScalingPolicy_TargetTrackingScalingPolicyConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain ScalingPolicy_TargetTrackingScalingPolicyConfiguration
+	return plain(resource), nil
 }
 
 func (resource ScalingPolicy_TargetTrackingScalingPolicyConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DisableScaleIn", resource.DisableScaleIn)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ScaleInCooldown", resource.ScaleInCooldown)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ScaleOutCooldown", resource.ScaleOutCooldown)...)
 	if resource.TargetValue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TargetValue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Double")("TargetValue", resource.TargetValue)...)
+	
+	if resource.PredefinedMetricSpecification != nil {
+		errs = append(errs, types.PrefixErrors("PredefinedMetricSpecification", resource.PredefinedMetricSpecification.Validate())...)
+	}
+	
+	if resource.CustomizedMetricSpecification != nil {
+		errs = append(errs, types.PrefixErrors("CustomizedMetricSpecification", resource.CustomizedMetricSpecification.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type SecurityGroup_Egress struct {
 	
@@ -19,20 +23,59 @@ type SecurityGroup_Egress struct {
 	FromPort interface{} `yaml:"FromPort,omitempty"`
 	IpProtocol interface{} `yaml:"IpProtocol"`
 	ToPort interface{} `yaml:"ToPort,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SecurityGroup_Egress) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SecurityGroup_Egress
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SecurityGroup_Egress) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SecurityGroup_Egress
+	return plain(resource), nil
 }
 
 func (resource SecurityGroup_Egress) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
 	
 	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CidrIp", resource.CidrIp)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CidrIpv6", resource.CidrIpv6)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DestinationPrefixListId", resource.DestinationPrefixListId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DestinationSecurityGroupId", resource.DestinationSecurityGroupId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("FromPort", resource.FromPort)...)
 	if resource.IpProtocol == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IpProtocol'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IpProtocol", resource.IpProtocol)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ToPort", resource.ToPort)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type SecurityGroup_Ingress struct {
 	
@@ -21,10 +25,34 @@ type SecurityGroup_Ingress struct {
 	SourceSecurityGroupName interface{} `yaml:"SourceSecurityGroupName,omitempty"`
 	SourceSecurityGroupOwnerId interface{} `yaml:"SourceSecurityGroupOwnerId,omitempty"`
 	ToPort interface{} `yaml:"ToPort,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SecurityGroup_Ingress) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SecurityGroup_Ingress
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SecurityGroup_Ingress) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SecurityGroup_Ingress
+	return plain(resource), nil
 }
 
 func (resource SecurityGroup_Ingress) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -34,8 +62,25 @@ func (resource SecurityGroup_Ingress) Validate() []error {
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CidrIp", resource.CidrIp)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CidrIpv6", resource.CidrIpv6)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("FromPort", resource.FromPort)...)
 	if resource.IpProtocol == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IpProtocol'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IpProtocol", resource.IpProtocol)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceSecurityGroupId", resource.SourceSecurityGroupId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceSecurityGroupName", resource.SourceSecurityGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceSecurityGroupOwnerId", resource.SourceSecurityGroupOwnerId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ToPort", resource.ToPort)...)
 	return errs
 }
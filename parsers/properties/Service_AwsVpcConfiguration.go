@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Service_AwsVpcConfiguration struct {
 	
@@ -9,15 +13,44 @@ type Service_AwsVpcConfiguration struct {
 	AssignPublicIp interface{} `yaml:"AssignPublicIp,omitempty"`
 	SecurityGroups interface{} `yaml:"SecurityGroups,omitempty"`
 	Subnets interface{} `yaml:"Subnets"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Service_AwsVpcConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Service_AwsVpcConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Service_AwsVpcConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Service_AwsVpcConfiguration
+	return plain(resource), nil
 }
 
 func (resource Service_AwsVpcConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AssignPublicIp", resource.AssignPublicIp)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroups", resource.SecurityGroups)...)
 	if resource.Subnets == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Subnets'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Subnets", resource.Subnets)...)
 	return errs
 }
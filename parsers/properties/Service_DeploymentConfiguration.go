@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Service_DeploymentConfiguration struct {
 	
 	
 	MaximumPercent interface{} `yaml:"MaximumPercent,omitempty"`
 	MinimumHealthyPercent interface{} `yaml:"MinimumHealthyPercent,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Service_DeploymentConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Service_DeploymentConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Service_DeploymentConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Service_DeploymentConfiguration
+	return plain(resource), nil
 }
 
 func (resource Service_DeploymentConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MaximumPercent", resource.MaximumPercent)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MinimumHealthyPercent", resource.MinimumHealthyPercent)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Service_DnsConfig struct {
 	
 	
 	NamespaceId interface{} `yaml:"NamespaceId"`
 	DnsRecords interface{} `yaml:"DnsRecords"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Service_DnsConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Service_DnsConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Service_DnsConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Service_DnsConfig
+	return plain(resource), nil
 }
 
 func (resource Service_DnsConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.NamespaceId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'NamespaceId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("NamespaceId", resource.NamespaceId)...)
 	if resource.DnsRecords == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DnsRecords'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Service_DnsRecord{} }))("DnsRecords", resource.DnsRecords)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Service_HealthCheckConfig struct {
 	
@@ -9,15 +13,44 @@ type Service_HealthCheckConfig struct {
 	FailureThreshold interface{} `yaml:"FailureThreshold,omitempty"`
 	ResourcePath interface{} `yaml:"ResourcePath,omitempty"`
 	Type interface{} `yaml:"Type"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Service_HealthCheckConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Service_HealthCheckConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Service_HealthCheckConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Service_HealthCheckConfig
+	return plain(resource), nil
 }
 
 func (resource Service_HealthCheckConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("FailureThreshold", resource.FailureThreshold)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ResourcePath", resource.ResourcePath)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	return errs
 }
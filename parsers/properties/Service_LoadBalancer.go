@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Service_LoadBalancer struct {
 	
@@ -11,16 +15,47 @@ type Service_LoadBalancer struct {
 	ContainerPort interface{} `yaml:"ContainerPort"`
 	LoadBalancerName interface{} `yaml:"LoadBalancerName,omitempty"`
 	TargetGroupArn interface{} `yaml:"TargetGroupArn,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Service_LoadBalancer) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Service_LoadBalancer
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Service_LoadBalancer) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Service_LoadBalancer
+	return plain(resource), nil
 }
 
 func (resource Service_LoadBalancer) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ContainerName", resource.ContainerName)...)
 	if resource.ContainerPort == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ContainerPort'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("ContainerPort", resource.ContainerPort)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("LoadBalancerName", resource.LoadBalancerName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TargetGroupArn", resource.TargetGroupArn)...)
 	return errs
 }
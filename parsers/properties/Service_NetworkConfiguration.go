@@ -1,13 +1,45 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Service_NetworkConfiguration struct {
 	
 	AwsvpcConfiguration *Service_AwsVpcConfiguration `yaml:"AwsvpcConfiguration,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Service_NetworkConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Service_NetworkConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Service_NetworkConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Service_NetworkConfiguration
+	return plain(resource), nil
 }
 
 func (resource Service_NetworkConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	if resource.AwsvpcConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("AwsvpcConfiguration", resource.AwsvpcConfiguration.Validate())...)
+	}
 	return errs
 }
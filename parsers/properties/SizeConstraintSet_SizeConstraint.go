@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type SizeConstraintSet_SizeConstraint struct {
 	
@@ -11,10 +15,34 @@ type SizeConstraintSet_SizeConstraint struct {
 	Size interface{} `yaml:"Size"`
 	TextTransformation interface{} `yaml:"TextTransformation"`
 	FieldToMatch *SizeConstraintSet_FieldToMatch `yaml:"FieldToMatch"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SizeConstraintSet_SizeConstraint) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SizeConstraintSet_SizeConstraint
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SizeConstraintSet_SizeConstraint) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SizeConstraintSet_SizeConstraint
+	return plain(resource), nil
 }
 
 func (resource SizeConstraintSet_SizeConstraint) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -22,16 +50,20 @@ func (resource SizeConstraintSet_SizeConstraint) Validate() []error {
 	if resource.ComparisonOperator == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ComparisonOperator'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ComparisonOperator", resource.ComparisonOperator)...)
 	if resource.Size == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Size'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Size", resource.Size)...)
 	if resource.TextTransformation == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TextTransformation'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TextTransformation", resource.TextTransformation)...)
 	if resource.FieldToMatch == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FieldToMatch'"))
-	} else {
-		errs = append(errs, resource.FieldToMatch.Validate()...)
+	}
+	if resource.FieldToMatch != nil {
+		errs = append(errs, types.PrefixErrors("FieldToMatch", resource.FieldToMatch.Validate())...)
 	}
 	return errs
 }
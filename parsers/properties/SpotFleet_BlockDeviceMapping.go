@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type SpotFleet_BlockDeviceMapping struct {
 	
@@ -11,10 +15,34 @@ type SpotFleet_BlockDeviceMapping struct {
 	NoDevice interface{} `yaml:"NoDevice,omitempty"`
 	VirtualName interface{} `yaml:"VirtualName,omitempty"`
 	Ebs *SpotFleet_EbsBlockDevice `yaml:"Ebs,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SpotFleet_BlockDeviceMapping) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SpotFleet_BlockDeviceMapping
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SpotFleet_BlockDeviceMapping) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SpotFleet_BlockDeviceMapping
+	return plain(resource), nil
 }
 
 func (resource SpotFleet_BlockDeviceMapping) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -22,5 +50,14 @@ func (resource SpotFleet_BlockDeviceMapping) Validate() []error {
 	if resource.DeviceName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DeviceName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DeviceName", resource.DeviceName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NoDevice", resource.NoDevice)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("VirtualName", resource.VirtualName)...)
+	
+	if resource.Ebs != nil {
+		errs = append(errs, types.PrefixErrors("Ebs", resource.Ebs.Validate())...)
+	}
 	return errs
 }
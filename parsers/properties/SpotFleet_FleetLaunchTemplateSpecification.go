@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type SpotFleet_FleetLaunchTemplateSpecification struct {
 	
 	
@@ -8,12 +12,42 @@ type SpotFleet_FleetLaunchTemplateSpecification struct {
 	LaunchTemplateId interface{} `yaml:"LaunchTemplateId,omitempty"`
 	LaunchTemplateName interface{} `yaml:"LaunchTemplateName,omitempty"`
 	Version interface{} `yaml:"Version,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SpotFleet_FleetLaunchTemplateSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SpotFleet_FleetLaunchTemplateSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SpotFleet_FleetLaunchTemplateSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SpotFleet_FleetLaunchTemplateSpecification
+	return plain(resource), nil
 }
 
 func (resource SpotFleet_FleetLaunchTemplateSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("LaunchTemplateId", resource.LaunchTemplateId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("LaunchTemplateName", resource.LaunchTemplateName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Version", resource.Version)...)
 	return errs
 }
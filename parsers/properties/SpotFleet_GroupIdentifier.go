@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type SpotFleet_GroupIdentifier struct {
 	
 	GroupId interface{} `yaml:"GroupId"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SpotFleet_GroupIdentifier) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SpotFleet_GroupIdentifier
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SpotFleet_GroupIdentifier) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SpotFleet_GroupIdentifier
+	return plain(resource), nil
 }
 
 func (resource SpotFleet_GroupIdentifier) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.GroupId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'GroupId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("GroupId", resource.GroupId)...)
 	return errs
 }
@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type SpotFleet_IamInstanceProfileSpecification struct {
 	
 	Arn interface{} `yaml:"Arn,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SpotFleet_IamInstanceProfileSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SpotFleet_IamInstanceProfileSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SpotFleet_IamInstanceProfileSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SpotFleet_IamInstanceProfileSpecification
+	return plain(resource), nil
 }
 
 func (resource SpotFleet_IamInstanceProfileSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Arn", resource.Arn)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type SpotFleet_InstanceNetworkInterfaceSpecification struct {
 	
 	
@@ -24,20 +28,66 @@ type SpotFleet_InstanceNetworkInterfaceSpecification struct {
 	Groups interface{} `yaml:"Groups,omitempty"`
 	Ipv6Addresses interface{} `yaml:"Ipv6Addresses,omitempty"`
 	PrivateIpAddresses interface{} `yaml:"PrivateIpAddresses,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SpotFleet_InstanceNetworkInterfaceSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SpotFleet_InstanceNetworkInterfaceSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SpotFleet_InstanceNetworkInterfaceSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SpotFleet_InstanceNetworkInterfaceSpecification
+	return plain(resource), nil
 }
 
 func (resource SpotFleet_InstanceNetworkInterfaceSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AssociatePublicIpAddress", resource.AssociatePublicIpAddress)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DeleteOnTermination", resource.DeleteOnTermination)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("DeviceIndex", resource.DeviceIndex)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Ipv6AddressCount", resource.Ipv6AddressCount)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("NetworkInterfaceId", resource.NetworkInterfaceId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("SecondaryPrivateIpAddressCount", resource.SecondaryPrivateIpAddressCount)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Groups", resource.Groups)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &SpotFleet_InstanceIpv6Address{} }))("Ipv6Addresses", resource.Ipv6Addresses)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &SpotFleet_PrivateIpAddressSpecification{} }))("PrivateIpAddresses", resource.PrivateIpAddresses)...)
 	return errs
 }
@@ -1,16 +1,50 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type SpotFleet_LaunchTemplateConfig struct {
 	
 	
 	Overrides interface{} `yaml:"Overrides,omitempty"`
 	LaunchTemplateSpecification *SpotFleet_FleetLaunchTemplateSpecification `yaml:"LaunchTemplateSpecification,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SpotFleet_LaunchTemplateConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SpotFleet_LaunchTemplateConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SpotFleet_LaunchTemplateConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SpotFleet_LaunchTemplateConfig
+	return plain(resource), nil
 }
 
 func (resource SpotFleet_LaunchTemplateConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &SpotFleet_LaunchTemplateOverrides{} }))("Overrides", resource.Overrides)...)
 	
+	if resource.LaunchTemplateSpecification != nil {
+		errs = append(errs, types.PrefixErrors("LaunchTemplateSpecification", resource.LaunchTemplateSpecification.Validate())...)
+	}
 	return errs
 }
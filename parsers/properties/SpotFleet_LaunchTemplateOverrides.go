@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type SpotFleet_LaunchTemplateOverrides struct {
 	
 	
@@ -12,14 +16,48 @@ type SpotFleet_LaunchTemplateOverrides struct {
 	SpotPrice interface{} `yaml:"SpotPrice,omitempty"`
 	SubnetId interface{} `yaml:"SubnetId,omitempty"`
 	WeightedCapacity interface{} `yaml:"WeightedCapacity,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SpotFleet_LaunchTemplateOverrides) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SpotFleet_LaunchTemplateOverrides
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SpotFleet_LaunchTemplateOverrides) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SpotFleet_LaunchTemplateOverrides
+	return plain(resource), nil
 }
 
 func (resource SpotFleet_LaunchTemplateOverrides) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AvailabilityZone", resource.AvailabilityZone)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceType", resource.InstanceType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SpotPrice", resource.SpotPrice)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("WeightedCapacity", resource.WeightedCapacity)...)
 	return errs
 }
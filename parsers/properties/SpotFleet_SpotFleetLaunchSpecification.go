@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type SpotFleet_SpotFleetLaunchSpecification struct {
 	
@@ -37,10 +41,34 @@ type SpotFleet_SpotFleetLaunchSpecification struct {
 	SecurityGroups interface{} `yaml:"SecurityGroups,omitempty"`
 	TagSpecifications interface{} `yaml:"TagSpecifications,omitempty"`
 	IamInstanceProfile *SpotFleet_IamInstanceProfileSpecification `yaml:"IamInstanceProfile,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SpotFleet_SpotFleetLaunchSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SpotFleet_SpotFleetLaunchSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SpotFleet_SpotFleetLaunchSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SpotFleet_SpotFleetLaunchSpecification
+	return plain(resource), nil
 }
 
 func (resource SpotFleet_SpotFleetLaunchSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -58,11 +86,49 @@ func (resource SpotFleet_SpotFleetLaunchSpecification) Validate() []error {
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EbsOptimized", resource.EbsOptimized)...)
 	if resource.ImageId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ImageId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ImageId", resource.ImageId)...)
 	if resource.InstanceType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceType", resource.InstanceType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KernelId", resource.KernelId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KeyName", resource.KeyName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RamdiskId", resource.RamdiskId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SpotPrice", resource.SpotPrice)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("UserData", resource.UserData)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Double")("WeightedCapacity", resource.WeightedCapacity)...)
+	
+	if resource.Placement != nil {
+		errs = append(errs, types.PrefixErrors("Placement", resource.Placement.Validate())...)
+	}
+	
+	if resource.Monitoring != nil {
+		errs = append(errs, types.PrefixErrors("Monitoring", resource.Monitoring.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &SpotFleet_BlockDeviceMapping{} }))("BlockDeviceMappings", resource.BlockDeviceMappings)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &SpotFleet_InstanceNetworkInterfaceSpecification{} }))("NetworkInterfaces", resource.NetworkInterfaces)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &SpotFleet_GroupIdentifier{} }))("SecurityGroups", resource.SecurityGroups)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &SpotFleet_SpotFleetTagSpecification{} }))("TagSpecifications", resource.TagSpecifications)...)
+	
+	if resource.IamInstanceProfile != nil {
+		errs = append(errs, types.PrefixErrors("IamInstanceProfile", resource.IamInstanceProfile.Validate())...)
+	}
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type SpotFleet_SpotFleetRequestConfigData struct {
 	
@@ -27,10 +31,35 @@ type SpotFleet_SpotFleetRequestConfigData struct {
 	ValidUntil interface{} `yaml:"ValidUntil,omitempty"`
 	LaunchSpecifications interface{} `yaml:"LaunchSpecifications,omitempty"`
 	LaunchTemplateConfigs interface{} `yaml:"LaunchTemplateConfigs,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SpotFleet_SpotFleetRequestConfigData) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SpotFleet_SpotFleetRequestConfigData
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SpotFleet_SpotFleetRequestConfigData) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SpotFleet_SpotFleetRequestConfigData
+	return plain(resource), nil
 }
 
 func (resource SpotFleet_SpotFleetRequestConfigData) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
@@ -43,11 +72,32 @@ func (resource SpotFleet_SpotFleetRequestConfigData) Validate() []error {
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AllocationStrategy", resource.AllocationStrategy)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ExcessCapacityTerminationPolicy", resource.ExcessCapacityTerminationPolicy)...)
 	if resource.IamFleetRole == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IamFleetRole'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IamFleetRole", resource.IamFleetRole)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ReplaceUnhealthyInstances", resource.ReplaceUnhealthyInstances)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SpotPrice", resource.SpotPrice)...)
 	if resource.TargetCapacity == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TargetCapacity'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("TargetCapacity", resource.TargetCapacity)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("TerminateInstancesWithExpiration", resource.TerminateInstancesWithExpiration)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ValidFrom", resource.ValidFrom)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ValidUntil", resource.ValidUntil)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &SpotFleet_SpotFleetLaunchSpecification{} }))("LaunchSpecifications", resource.LaunchSpecifications)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &SpotFleet_LaunchTemplateConfig{} }))("LaunchTemplateConfigs", resource.LaunchTemplateConfigs)...)
 	return errs
 }
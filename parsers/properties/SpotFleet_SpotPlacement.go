@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type SpotFleet_SpotPlacement struct {
 	
 	
 	AvailabilityZone interface{} `yaml:"AvailabilityZone,omitempty"`
 	GroupName interface{} `yaml:"GroupName,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SpotFleet_SpotPlacement) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SpotFleet_SpotPlacement
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SpotFleet_SpotPlacement) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SpotFleet_SpotPlacement
+	return plain(resource), nil
 }
 
 func (resource SpotFleet_SpotPlacement) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AvailabilityZone", resource.AvailabilityZone)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("GroupName", resource.GroupName)...)
 	return errs
 }
@@ -1,25 +1,55 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type SqlInjectionMatchSet_SqlInjectionMatchTuple struct {
 	
 	
 	TextTransformation interface{} `yaml:"TextTransformation"`
 	FieldToMatch *SqlInjectionMatchSet_FieldToMatch `yaml:"FieldToMatch"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *SqlInjectionMatchSet_SqlInjectionMatchTuple) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain SqlInjectionMatchSet_SqlInjectionMatchTuple
+	return unmarshal((*plain)(resource))
+}
+
+func (resource SqlInjectionMatchSet_SqlInjectionMatchTuple) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain SqlInjectionMatchSet_SqlInjectionMatchTuple
+	return plain(resource), nil
 }
 
 func (resource SqlInjectionMatchSet_SqlInjectionMatchTuple) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.TextTransformation == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TextTransformation'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TextTransformation", resource.TextTransformation)...)
 	if resource.FieldToMatch == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FieldToMatch'"))
-	} else {
-		errs = append(errs, resource.FieldToMatch.Validate()...)
+	}
+	if resource.FieldToMatch != nil {
+		errs = append(errs, types.PrefixErrors("FieldToMatch", resource.FieldToMatch.Validate())...)
 	}
 	return errs
 }
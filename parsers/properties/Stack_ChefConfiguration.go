@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Stack_ChefConfiguration struct {
 	
 	
 	BerkshelfVersion interface{} `yaml:"BerkshelfVersion,omitempty"`
 	ManageBerkshelf interface{} `yaml:"ManageBerkshelf,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Stack_ChefConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Stack_ChefConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Stack_ChefConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Stack_ChefConfiguration
+	return plain(resource), nil
 }
 
 func (resource Stack_ChefConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("BerkshelfVersion", resource.BerkshelfVersion)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ManageBerkshelf", resource.ManageBerkshelf)...)
 	return errs
 }
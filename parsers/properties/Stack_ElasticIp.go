@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Stack_ElasticIp struct {
 	
 	
 	Ip interface{} `yaml:"Ip"`
 	Name interface{} `yaml:"Name,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Stack_ElasticIp) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Stack_ElasticIp
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Stack_ElasticIp) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Stack_ElasticIp
+	return plain(resource), nil
 }
 
 func (resource Stack_ElasticIp) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Ip == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Ip'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Ip", resource.Ip)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Stack_RdsDbInstance struct {
 	
@@ -9,21 +13,48 @@ type Stack_RdsDbInstance struct {
 	DbPassword interface{} `yaml:"DbPassword"`
 	DbUser interface{} `yaml:"DbUser"`
 	RdsDbInstanceArn interface{} `yaml:"RdsDbInstanceArn"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Stack_RdsDbInstance) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Stack_RdsDbInstance
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Stack_RdsDbInstance) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Stack_RdsDbInstance
+	return plain(resource), nil
 }
 
 func (resource Stack_RdsDbInstance) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.DbPassword == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DbPassword'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DbPassword", resource.DbPassword)...)
 	if resource.DbUser == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DbUser'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DbUser", resource.DbUser)...)
 	if resource.RdsDbInstanceArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RdsDbInstanceArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RdsDbInstanceArn", resource.RdsDbInstanceArn)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Stack_Source struct {
 	
 	
@@ -14,15 +18,51 @@ type Stack_Source struct {
 	Type interface{} `yaml:"Type,omitempty"`
 	Url interface{} `yaml:"Url,omitempty"`
 	Username interface{} `yaml:"Username,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Stack_Source) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Stack_Source
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Stack_Source) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Stack_Source
+	return plain(resource), nil
 }
 
 func (resource Stack_Source) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Password", resource.Password)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Revision", resource.Revision)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SshKey", resource.SshKey)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Url", resource.Url)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Username", resource.Username)...)
 	return errs
 }
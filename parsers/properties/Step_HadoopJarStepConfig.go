@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Step_HadoopJarStepConfig struct {
 	
@@ -11,10 +15,34 @@ type Step_HadoopJarStepConfig struct {
 	MainClass interface{} `yaml:"MainClass,omitempty"`
 	Args interface{} `yaml:"Args,omitempty"`
 	StepProperties interface{} `yaml:"StepProperties,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Step_HadoopJarStepConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Step_HadoopJarStepConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Step_HadoopJarStepConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Step_HadoopJarStepConfig
+	return plain(resource), nil
 }
 
 func (resource Step_HadoopJarStepConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -22,5 +50,12 @@ func (resource Step_HadoopJarStepConfig) Validate() []error {
 	if resource.Jar == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Jar'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Jar", resource.Jar)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("MainClass", resource.MainClass)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Args", resource.Args)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Step_KeyValue{} }))("StepProperties", resource.StepProperties)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Stream_StreamEncryption struct {
 	
 	
 	EncryptionType interface{} `yaml:"EncryptionType"`
 	KeyId interface{} `yaml:"KeyId"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Stream_StreamEncryption) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Stream_StreamEncryption
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Stream_StreamEncryption) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Stream_StreamEncryption
+	return plain(resource), nil
 }
 
 func (resource Stream_StreamEncryption) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.EncryptionType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'EncryptionType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("EncryptionType", resource.EncryptionType)...)
 	if resource.KeyId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'KeyId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("KeyId", resource.KeyId)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type StreamingDistribution_Logging struct {
 	
@@ -9,21 +13,48 @@ type StreamingDistribution_Logging struct {
 	Bucket interface{} `yaml:"Bucket"`
 	Enabled interface{} `yaml:"Enabled"`
 	Prefix interface{} `yaml:"Prefix"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *StreamingDistribution_Logging) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain StreamingDistribution_Logging
+	return unmarshal((*plain)(resource))
+}
+
+func (resource StreamingDistribution_Logging) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain StreamingDistribution_Logging
+	return plain(resource), nil
 }
 
 func (resource StreamingDistribution_Logging) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Bucket == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Bucket'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Bucket", resource.Bucket)...)
 	if resource.Enabled == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Enabled'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	if resource.Prefix == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Prefix'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Prefix", resource.Prefix)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type StreamingDistribution_S3Origin struct {
 	
 	
 	DomainName interface{} `yaml:"DomainName"`
 	OriginAccessIdentity interface{} `yaml:"OriginAccessIdentity"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *StreamingDistribution_S3Origin) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain StreamingDistribution_S3Origin
+	return unmarshal((*plain)(resource))
+}
+
+func (resource StreamingDistribution_S3Origin) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain StreamingDistribution_S3Origin
+	return plain(resource), nil
 }
 
 func (resource StreamingDistribution_S3Origin) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.DomainName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DomainName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DomainName", resource.DomainName)...)
 	if resource.OriginAccessIdentity == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'OriginAccessIdentity'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("OriginAccessIdentity", resource.OriginAccessIdentity)...)
 	return errs
 }
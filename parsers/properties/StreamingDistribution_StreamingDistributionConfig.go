@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type StreamingDistribution_StreamingDistributionConfig struct {
 	
@@ -17,10 +21,34 @@ type StreamingDistribution_StreamingDistributionConfig struct {
 	S3Origin *StreamingDistribution_S3Origin `yaml:"S3Origin"`
 	Logging *StreamingDistribution_Logging `yaml:"Logging,omitempty"`
 	Aliases interface{} `yaml:"Aliases,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *StreamingDistribution_StreamingDistributionConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain StreamingDistribution_StreamingDistributionConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource StreamingDistribution_StreamingDistributionConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain StreamingDistribution_StreamingDistributionConfig
+	return plain(resource), nil
 }
 
 func (resource StreamingDistribution_StreamingDistributionConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -31,18 +59,30 @@ func (resource StreamingDistribution_StreamingDistributionConfig) Validate() []e
 	if resource.Comment == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Comment'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Comment", resource.Comment)...)
 	if resource.Enabled == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Enabled'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PriceClass", resource.PriceClass)...)
 	if resource.TrustedSigners == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TrustedSigners'"))
-	} else {
-		errs = append(errs, resource.TrustedSigners.Validate()...)
+	}
+	if resource.TrustedSigners != nil {
+		errs = append(errs, types.PrefixErrors("TrustedSigners", resource.TrustedSigners.Validate())...)
 	}
 	if resource.S3Origin == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'S3Origin'"))
-	} else {
-		errs = append(errs, resource.S3Origin.Validate()...)
 	}
+	if resource.S3Origin != nil {
+		errs = append(errs, types.PrefixErrors("S3Origin", resource.S3Origin.Validate())...)
+	}
+	
+	if resource.Logging != nil {
+		errs = append(errs, types.PrefixErrors("Logging", resource.Logging.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Aliases", resource.Aliases)...)
 	return errs
 }
@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type StreamingDistribution_TrustedSigners struct {
 	
 	
 	Enabled interface{} `yaml:"Enabled"`
 	AwsAccountNumbers interface{} `yaml:"AwsAccountNumbers,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *StreamingDistribution_TrustedSigners) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain StreamingDistribution_TrustedSigners
+	return unmarshal((*plain)(resource))
+}
+
+func (resource StreamingDistribution_TrustedSigners) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain StreamingDistribution_TrustedSigners
+	return plain(resource), nil
 }
 
 func (resource StreamingDistribution_TrustedSigners) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Enabled == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Enabled'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AwsAccountNumbers", resource.AwsAccountNumbers)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Table_AttributeDefinition struct {
 	
 	
 	AttributeName interface{} `yaml:"AttributeName"`
 	AttributeType interface{} `yaml:"AttributeType"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Table_AttributeDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Table_AttributeDefinition
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Table_AttributeDefinition) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Table_AttributeDefinition
+	return plain(resource), nil
 }
 
 func (resource Table_AttributeDefinition) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.AttributeName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AttributeName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AttributeName", resource.AttributeName)...)
 	if resource.AttributeType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AttributeType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AttributeType", resource.AttributeType)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Table_GlobalSecondaryIndex struct {
 	
@@ -11,10 +15,34 @@ type Table_GlobalSecondaryIndex struct {
 	ProvisionedThroughput *Table_ProvisionedThroughput `yaml:"ProvisionedThroughput"`
 	Projection *Table_Projection `yaml:"Projection"`
 	KeySchema interface{} `yaml:"KeySchema"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Table_GlobalSecondaryIndex) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Table_GlobalSecondaryIndex
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Table_GlobalSecondaryIndex) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Table_GlobalSecondaryIndex
+	return plain(resource), nil
 }
 
 func (resource Table_GlobalSecondaryIndex) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -22,18 +50,22 @@ func (resource Table_GlobalSecondaryIndex) Validate() []error {
 	if resource.IndexName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IndexName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IndexName", resource.IndexName)...)
 	if resource.ProvisionedThroughput == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ProvisionedThroughput'"))
-	} else {
-		errs = append(errs, resource.ProvisionedThroughput.Validate()...)
+	}
+	if resource.ProvisionedThroughput != nil {
+		errs = append(errs, types.PrefixErrors("ProvisionedThroughput", resource.ProvisionedThroughput.Validate())...)
 	}
 	if resource.Projection == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Projection'"))
-	} else {
-		errs = append(errs, resource.Projection.Validate()...)
+	}
+	if resource.Projection != nil {
+		errs = append(errs, types.PrefixErrors("Projection", resource.Projection.Validate())...)
 	}
 	if resource.KeySchema == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'KeySchema'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Table_KeySchema{} }))("KeySchema", resource.KeySchema)...)
 	return errs
 }
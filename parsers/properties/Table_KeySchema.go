@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Table_KeySchema struct {
 	
 	
 	AttributeName interface{} `yaml:"AttributeName"`
 	KeyType interface{} `yaml:"KeyType"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Table_KeySchema) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Table_KeySchema
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Table_KeySchema) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Table_KeySchema
+	return plain(resource), nil
 }
 
 func (resource Table_KeySchema) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.AttributeName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AttributeName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AttributeName", resource.AttributeName)...)
 	if resource.KeyType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'KeyType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("KeyType", resource.KeyType)...)
 	return errs
 }
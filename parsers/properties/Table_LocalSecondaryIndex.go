@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Table_LocalSecondaryIndex struct {
 	
@@ -9,23 +13,50 @@ type Table_LocalSecondaryIndex struct {
 	IndexName interface{} `yaml:"IndexName"`
 	Projection *Table_Projection `yaml:"Projection"`
 	KeySchema interface{} `yaml:"KeySchema"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Table_LocalSecondaryIndex) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Table_LocalSecondaryIndex
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Table_LocalSecondaryIndex) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Table_LocalSecondaryIndex
+	return plain(resource), nil
 }
 
 func (resource Table_LocalSecondaryIndex) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.IndexName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IndexName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IndexName", resource.IndexName)...)
 	if resource.Projection == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Projection'"))
-	} else {
-		errs = append(errs, resource.Projection.Validate()...)
+	}
+	if resource.Projection != nil {
+		errs = append(errs, types.PrefixErrors("Projection", resource.Projection.Validate())...)
 	}
 	if resource.KeySchema == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'KeySchema'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Table_KeySchema{} }))("KeySchema", resource.KeySchema)...)
 	return errs
 }
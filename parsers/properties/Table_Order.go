@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Table_Order struct {
 	
 	
 	Column interface{} `yaml:"Column"`
 	SortOrder interface{} `yaml:"SortOrder"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Table_Order) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Table_Order
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Table_Order) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Table_Order
+	return plain(resource), nil
 }
 
 func (resource Table_Order) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Column == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Column'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Column", resource.Column)...)
 	if resource.SortOrder == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SortOrder'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("SortOrder", resource.SortOrder)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Table_Projection struct {
 	
 	
 	ProjectionType interface{} `yaml:"ProjectionType,omitempty"`
 	NonKeyAttributes interface{} `yaml:"NonKeyAttributes,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Table_Projection) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Table_Projection
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Table_Projection) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Table_Projection
+	return plain(resource), nil
 }
 
 func (resource Table_Projection) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ProjectionType", resource.ProjectionType)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("NonKeyAttributes", resource.NonKeyAttributes)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Table_ProvisionedThroughput struct {
 	
 	
 	ReadCapacityUnits interface{} `yaml:"ReadCapacityUnits"`
 	WriteCapacityUnits interface{} `yaml:"WriteCapacityUnits"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Table_ProvisionedThroughput) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Table_ProvisionedThroughput
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Table_ProvisionedThroughput) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Table_ProvisionedThroughput
+	return plain(resource), nil
 }
 
 func (resource Table_ProvisionedThroughput) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.ReadCapacityUnits == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ReadCapacityUnits'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Long")("ReadCapacityUnits", resource.ReadCapacityUnits)...)
 	if resource.WriteCapacityUnits == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'WriteCapacityUnits'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Long")("WriteCapacityUnits", resource.WriteCapacityUnits)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Table_SSESpecification struct {
 	
 	SSEEnabled interface{} `yaml:"SSEEnabled"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Table_SSESpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Table_SSESpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Table_SSESpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Table_SSESpecification
+	return plain(resource), nil
 }
 
 func (resource Table_SSESpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.SSEEnabled == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SSEEnabled'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("SSEEnabled", resource.SSEEnabled)...)
 	return errs
 }
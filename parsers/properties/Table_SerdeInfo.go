@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Table_SerdeInfo struct {
 	
 	
@@ -8,12 +12,42 @@ type Table_SerdeInfo struct {
 	Name interface{} `yaml:"Name,omitempty"`
 	Parameters interface{} `yaml:"Parameters,omitempty"`
 	SerializationLibrary interface{} `yaml:"SerializationLibrary,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Table_SerdeInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Table_SerdeInfo
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Table_SerdeInfo) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Table_SerdeInfo
+	return plain(resource), nil
 }
 
 func (resource Table_SerdeInfo) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Json")("Parameters", resource.Parameters)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SerializationLibrary", resource.SerializationLibrary)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Table_StreamSpecification struct {
 	
 	StreamViewType interface{} `yaml:"StreamViewType"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Table_StreamSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Table_StreamSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Table_StreamSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Table_StreamSpecification
+	return plain(resource), nil
 }
 
 func (resource Table_StreamSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.StreamViewType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StreamViewType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StreamViewType", resource.StreamViewType)...)
 	return errs
 }
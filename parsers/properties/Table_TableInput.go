@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Table_TableInput struct {
 	
 	
@@ -22,19 +26,65 @@ type Table_TableInput struct {
 	ViewOriginalText interface{} `yaml:"ViewOriginalText,omitempty"`
 	StorageDescriptor *Table_StorageDescriptor `yaml:"StorageDescriptor,omitempty"`
 	PartitionKeys interface{} `yaml:"PartitionKeys,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Table_TableInput) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Table_TableInput
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Table_TableInput) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Table_TableInput
+	return plain(resource), nil
 }
 
 func (resource Table_TableInput) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Owner", resource.Owner)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Json")("Parameters", resource.Parameters)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Retention", resource.Retention)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("TableType", resource.TableType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ViewExpandedText", resource.ViewExpandedText)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ViewOriginalText", resource.ViewOriginalText)...)
 	
+	if resource.StorageDescriptor != nil {
+		errs = append(errs, types.PrefixErrors("StorageDescriptor", resource.StorageDescriptor.Validate())...)
+	}
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Table_Column{} }))("PartitionKeys", resource.PartitionKeys)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Table_TimeToLiveSpecification struct {
 	
 	
 	AttributeName interface{} `yaml:"AttributeName"`
 	Enabled interface{} `yaml:"Enabled"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Table_TimeToLiveSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Table_TimeToLiveSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Table_TimeToLiveSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Table_TimeToLiveSpecification
+	return plain(resource), nil
 }
 
 func (resource Table_TimeToLiveSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.AttributeName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AttributeName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AttributeName", resource.AttributeName)...)
 	if resource.Enabled == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Enabled'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TargetGroup_Matcher struct {
 	
 	HttpCode interface{} `yaml:"HttpCode"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TargetGroup_Matcher) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TargetGroup_Matcher
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TargetGroup_Matcher) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TargetGroup_Matcher
+	return plain(resource), nil
 }
 
 func (resource TargetGroup_Matcher) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.HttpCode == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'HttpCode'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("HttpCode", resource.HttpCode)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TargetGroup_TargetDescription struct {
 	
@@ -9,15 +13,44 @@ type TargetGroup_TargetDescription struct {
 	AvailabilityZone interface{} `yaml:"AvailabilityZone,omitempty"`
 	Id interface{} `yaml:"Id"`
 	Port interface{} `yaml:"Port,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TargetGroup_TargetDescription) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TargetGroup_TargetDescription
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TargetGroup_TargetDescription) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TargetGroup_TargetDescription
+	return plain(resource), nil
 }
 
 func (resource TargetGroup_TargetDescription) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AvailabilityZone", resource.AvailabilityZone)...)
 	if resource.Id == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Id'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Id", resource.Id)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Port", resource.Port)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type TaskDefinition_ContainerDefinition struct {
 	
 	
@@ -43,49 +47,131 @@ type TaskDefinition_ContainerDefinition struct {
 	WorkingDirectory interface{} `yaml:"WorkingDirectory,omitempty"`
 	DockerLabels interface{} `yaml:"DockerLabels,omitempty"`
 	LogConfiguration *TaskDefinition_LogConfiguration `yaml:"LogConfiguration,omitempty"`
-	Command interface{} `yaml:"Command,omitempty"`
+	Environment interface{} `yaml:"Environment,omitempty"`
 	DnsServers interface{} `yaml:"DnsServers,omitempty"`
 	DockerSecurityOptions interface{} `yaml:"DockerSecurityOptions,omitempty"`
 	EntryPoint interface{} `yaml:"EntryPoint,omitempty"`
-	Environment interface{} `yaml:"Environment,omitempty"`
+	Command interface{} `yaml:"Command,omitempty"`
 	ExtraHosts interface{} `yaml:"ExtraHosts,omitempty"`
 	Links interface{} `yaml:"Links,omitempty"`
-	Ulimits interface{} `yaml:"Ulimits,omitempty"`
 	DnsSearchDomains interface{} `yaml:"DnsSearchDomains,omitempty"`
 	MountPoints interface{} `yaml:"MountPoints,omitempty"`
 	PortMappings interface{} `yaml:"PortMappings,omitempty"`
+	Ulimits interface{} `yaml:"Ulimits,omitempty"`
 	VolumesFrom interface{} `yaml:"VolumesFrom,omitempty"`
 	LinuxParameters *TaskDefinition_LinuxParameters `yaml:"LinuxParameters,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TaskDefinition_ContainerDefinition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TaskDefinition_ContainerDefinition
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TaskDefinition_ContainerDefinition) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TaskDefinition_ContainerDefinition
+	return plain(resource), nil
 }
 
 func (resource TaskDefinition_ContainerDefinition) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Cpu", resource.Cpu)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DisableNetworking", resource.DisableNetworking)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Essential", resource.Essential)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Hostname", resource.Hostname)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Image", resource.Image)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Memory", resource.Memory)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MemoryReservation", resource.MemoryReservation)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Privileged", resource.Privileged)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ReadonlyRootFilesystem", resource.ReadonlyRootFilesystem)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("User", resource.User)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("WorkingDirectory", resource.WorkingDirectory)...)
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("DockerLabels", resource.DockerLabels)...)
 	
+	if resource.LogConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("LogConfiguration", resource.LogConfiguration.Validate())...)
+	}
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &TaskDefinition_KeyValuePair{} }))("Environment", resource.Environment)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("DnsServers", resource.DnsServers)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("DockerSecurityOptions", resource.DockerSecurityOptions)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("EntryPoint", resource.EntryPoint)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Command", resource.Command)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &TaskDefinition_HostEntry{} }))("ExtraHosts", resource.ExtraHosts)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Links", resource.Links)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("DnsSearchDomains", resource.DnsSearchDomains)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &TaskDefinition_MountPoint{} }))("MountPoints", resource.MountPoints)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &TaskDefinition_PortMapping{} }))("PortMappings", resource.PortMappings)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &TaskDefinition_Ulimit{} }))("Ulimits", resource.Ulimits)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &TaskDefinition_VolumeFrom{} }))("VolumesFrom", resource.VolumesFrom)...)
 	
+	if resource.LinuxParameters != nil {
+		errs = append(errs, types.PrefixErrors("LinuxParameters", resource.LinuxParameters.Validate())...)
+	}
 	return errs
 }
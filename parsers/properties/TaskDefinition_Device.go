@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TaskDefinition_Device struct {
 	
@@ -9,15 +13,44 @@ type TaskDefinition_Device struct {
 	ContainerPath interface{} `yaml:"ContainerPath,omitempty"`
 	HostPath interface{} `yaml:"HostPath"`
 	Permissions interface{} `yaml:"Permissions,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TaskDefinition_Device) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TaskDefinition_Device
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TaskDefinition_Device) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TaskDefinition_Device
+	return plain(resource), nil
 }
 
 func (resource TaskDefinition_Device) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ContainerPath", resource.ContainerPath)...)
 	if resource.HostPath == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'HostPath'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("HostPath", resource.HostPath)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Permissions", resource.Permissions)...)
 	return errs
 }
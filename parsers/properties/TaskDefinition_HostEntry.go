@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TaskDefinition_HostEntry struct {
 	
 	
 	Hostname interface{} `yaml:"Hostname"`
 	IpAddress interface{} `yaml:"IpAddress"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TaskDefinition_HostEntry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TaskDefinition_HostEntry
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TaskDefinition_HostEntry) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TaskDefinition_HostEntry
+	return plain(resource), nil
 }
 
 func (resource TaskDefinition_HostEntry) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Hostname == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Hostname'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Hostname", resource.Hostname)...)
 	if resource.IpAddress == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IpAddress'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IpAddress", resource.IpAddress)...)
 	return errs
 }
@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type TaskDefinition_HostVolumeProperties struct {
 	
 	SourcePath interface{} `yaml:"SourcePath,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TaskDefinition_HostVolumeProperties) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TaskDefinition_HostVolumeProperties
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TaskDefinition_HostVolumeProperties) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TaskDefinition_HostVolumeProperties
+	return plain(resource), nil
 }
 
 func (resource TaskDefinition_HostVolumeProperties) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SourcePath", resource.SourcePath)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type TaskDefinition_KernelCapabilities struct {
 	
 	
 	Add interface{} `yaml:"Add,omitempty"`
 	Drop interface{} `yaml:"Drop,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TaskDefinition_KernelCapabilities) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TaskDefinition_KernelCapabilities
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TaskDefinition_KernelCapabilities) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TaskDefinition_KernelCapabilities
+	return plain(resource), nil
 }
 
 func (resource TaskDefinition_KernelCapabilities) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Add", resource.Add)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Drop", resource.Drop)...)
 	return errs
 }
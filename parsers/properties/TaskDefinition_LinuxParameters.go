@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type TaskDefinition_LinuxParameters struct {
 	
 	
@@ -8,12 +12,44 @@ type TaskDefinition_LinuxParameters struct {
 	InitProcessEnabled interface{} `yaml:"InitProcessEnabled,omitempty"`
 	Devices interface{} `yaml:"Devices,omitempty"`
 	Capabilities *TaskDefinition_KernelCapabilities `yaml:"Capabilities,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TaskDefinition_LinuxParameters) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TaskDefinition_LinuxParameters
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TaskDefinition_LinuxParameters) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TaskDefinition_LinuxParameters
+	return plain(resource), nil
 }
 
 func (resource TaskDefinition_LinuxParameters) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("InitProcessEnabled", resource.InitProcessEnabled)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &TaskDefinition_Device{} }))("Devices", resource.Devices)...)
 	
+	if resource.Capabilities != nil {
+		errs = append(errs, types.PrefixErrors("Capabilities", resource.Capabilities.Validate())...)
+	}
 	return errs
 }
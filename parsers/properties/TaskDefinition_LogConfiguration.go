@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TaskDefinition_LogConfiguration struct {
 	
 	
 	LogDriver interface{} `yaml:"LogDriver"`
 	Options interface{} `yaml:"Options,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TaskDefinition_LogConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TaskDefinition_LogConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TaskDefinition_LogConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TaskDefinition_LogConfiguration
+	return plain(resource), nil
 }
 
 func (resource TaskDefinition_LogConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.LogDriver == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'LogDriver'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("LogDriver", resource.LogDriver)...)
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Options", resource.Options)...)
 	return errs
 }
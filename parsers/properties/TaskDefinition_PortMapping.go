@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type TaskDefinition_PortMapping struct {
 	
 	
@@ -8,12 +12,42 @@ type TaskDefinition_PortMapping struct {
 	ContainerPort interface{} `yaml:"ContainerPort,omitempty"`
 	HostPort interface{} `yaml:"HostPort,omitempty"`
 	Protocol interface{} `yaml:"Protocol,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TaskDefinition_PortMapping) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TaskDefinition_PortMapping
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TaskDefinition_PortMapping) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TaskDefinition_PortMapping
+	return plain(resource), nil
 }
 
 func (resource TaskDefinition_PortMapping) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ContainerPort", resource.ContainerPort)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("HostPort", resource.HostPort)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Protocol", resource.Protocol)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TaskDefinition_Ulimit struct {
 	
@@ -9,21 +13,48 @@ type TaskDefinition_Ulimit struct {
 	HardLimit interface{} `yaml:"HardLimit"`
 	Name interface{} `yaml:"Name"`
 	SoftLimit interface{} `yaml:"SoftLimit"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TaskDefinition_Ulimit) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TaskDefinition_Ulimit
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TaskDefinition_Ulimit) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TaskDefinition_Ulimit
+	return plain(resource), nil
 }
 
 func (resource TaskDefinition_Ulimit) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.HardLimit == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'HardLimit'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("HardLimit", resource.HardLimit)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.SoftLimit == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SoftLimit'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("SoftLimit", resource.SoftLimit)...)
 	return errs
 }
@@ -1,16 +1,50 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type TaskDefinition_Volume struct {
 	
 	
 	Name interface{} `yaml:"Name,omitempty"`
 	Host *TaskDefinition_HostVolumeProperties `yaml:"Host,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TaskDefinition_Volume) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TaskDefinition_Volume
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TaskDefinition_Volume) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TaskDefinition_Volume
+	return plain(resource), nil
 }
 
 func (resource TaskDefinition_Volume) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	if resource.Host != nil {
+		errs = append(errs, types.PrefixErrors("Host", resource.Host.Validate())...)
+	}
 	return errs
 }
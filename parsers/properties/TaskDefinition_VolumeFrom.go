@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type TaskDefinition_VolumeFrom struct {
 	
 	
 	ReadOnly interface{} `yaml:"ReadOnly,omitempty"`
 	SourceContainer interface{} `yaml:"SourceContainer,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TaskDefinition_VolumeFrom) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TaskDefinition_VolumeFrom
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TaskDefinition_VolumeFrom) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TaskDefinition_VolumeFrom
+	return plain(resource), nil
 }
 
 func (resource TaskDefinition_VolumeFrom) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ReadOnly", resource.ReadOnly)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceContainer", resource.SourceContainer)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Template_Template struct {
 	
 	
@@ -10,13 +14,45 @@ type Template_Template struct {
 	SubjectPart interface{} `yaml:"SubjectPart,omitempty"`
 	TemplateName interface{} `yaml:"TemplateName,omitempty"`
 	TextPart interface{} `yaml:"TextPart,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Template_Template) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Template_Template
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Template_Template) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Template_Template
+	return plain(resource), nil
 }
 
 func (resource Template_Template) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("HtmlPart", resource.HtmlPart)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SubjectPart", resource.SubjectPart)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("TemplateName", resource.TemplateName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("TextPart", resource.TextPart)...)
 	return errs
 }
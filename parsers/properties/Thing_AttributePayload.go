@@ -1,13 +1,43 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Thing_AttributePayload struct {
 	
 	Attributes interface{} `yaml:"Attributes,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Thing_AttributePayload) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Thing_AttributePayload
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Thing_AttributePayload) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Thing_AttributePayload
+	return plain(resource), nil
 }
 
 func (resource Thing_AttributePayload) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Attributes", resource.Attributes)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type TopicRule_Action struct {
 	
 	
@@ -26,21 +30,93 @@ type TopicRule_Action struct {
 	DynamoDB *TopicRule_DynamoDBAction `yaml:"DynamoDB,omitempty"`
 	CloudwatchMetric *TopicRule_CloudwatchMetricAction `yaml:"CloudwatchMetric,omitempty"`
 	CloudwatchAlarm *TopicRule_CloudwatchAlarmAction `yaml:"CloudwatchAlarm,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_Action) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_Action
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_Action) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_Action
+	return plain(resource), nil
 }
 
 func (resource TopicRule_Action) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	if resource.Sqs != nil {
+		errs = append(errs, types.PrefixErrors("Sqs", resource.Sqs.Validate())...)
+	}
 	
+	if resource.Sns != nil {
+		errs = append(errs, types.PrefixErrors("Sns", resource.Sns.Validate())...)
+	}
 	
+	if resource.S3 != nil {
+		errs = append(errs, types.PrefixErrors("S3", resource.S3.Validate())...)
+	}
 	
+	if resource.Republish != nil {
+		errs = append(errs, types.PrefixErrors("Republish", resource.Republish.Validate())...)
+	}
 	
+	if resource.Lambda != nil {
+		errs = append(errs, types.PrefixErrors("Lambda", resource.Lambda.Validate())...)
+	}
 	
+	if resource.Kinesis != nil {
+		errs = append(errs, types.PrefixErrors("Kinesis", resource.Kinesis.Validate())...)
+	}
 	
+	if resource.Firehose != nil {
+		errs = append(errs, types.PrefixErrors("Firehose", resource.Firehose.Validate())...)
+	}
 	
+	if resource.Elasticsearch != nil {
+		errs = append(errs, types.PrefixErrors("Elasticsearch", resource.Elasticsearch.Validate())...)
+	}
 	
+	if resource.DynamoDBv2 != nil {
+		errs = append(errs, types.PrefixErrors("DynamoDBv2", resource.DynamoDBv2.Validate())...)
+	}
 	
+	if resource.DynamoDB != nil {
+		errs = append(errs, types.PrefixErrors("DynamoDB", resource.DynamoDB.Validate())...)
+	}
 	
+	if resource.CloudwatchMetric != nil {
+		errs = append(errs, types.PrefixErrors("CloudwatchMetric", resource.CloudwatchMetric.Validate())...)
+	}
 	
+	if resource.CloudwatchAlarm != nil {
+		errs = append(errs, types.PrefixErrors("CloudwatchAlarm", resource.CloudwatchAlarm.Validate())...)
+	}
 	return errs
 }
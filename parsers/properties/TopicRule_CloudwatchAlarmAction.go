@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TopicRule_CloudwatchAlarmAction struct {
 	
@@ -11,10 +15,34 @@ type TopicRule_CloudwatchAlarmAction struct {
 	RoleArn interface{} `yaml:"RoleArn"`
 	StateReason interface{} `yaml:"StateReason"`
 	StateValue interface{} `yaml:"StateValue"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_CloudwatchAlarmAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_CloudwatchAlarmAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_CloudwatchAlarmAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_CloudwatchAlarmAction
+	return plain(resource), nil
 }
 
 func (resource TopicRule_CloudwatchAlarmAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -22,14 +50,18 @@ func (resource TopicRule_CloudwatchAlarmAction) Validate() []error {
 	if resource.AlarmName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AlarmName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AlarmName", resource.AlarmName)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	if resource.StateReason == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StateReason'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StateReason", resource.StateReason)...)
 	if resource.StateValue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StateValue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StateValue", resource.StateValue)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TopicRule_CloudwatchMetricAction struct {
 	
@@ -15,10 +19,34 @@ type TopicRule_CloudwatchMetricAction struct {
 	MetricUnit interface{} `yaml:"MetricUnit"`
 	MetricValue interface{} `yaml:"MetricValue"`
 	RoleArn interface{} `yaml:"RoleArn"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_CloudwatchMetricAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_CloudwatchMetricAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_CloudwatchMetricAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_CloudwatchMetricAction
+	return plain(resource), nil
 }
 
 func (resource TopicRule_CloudwatchMetricAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -28,17 +56,24 @@ func (resource TopicRule_CloudwatchMetricAction) Validate() []error {
 	if resource.MetricName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MetricName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MetricName", resource.MetricName)...)
 	if resource.MetricNamespace == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MetricNamespace'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MetricNamespace", resource.MetricNamespace)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("MetricTimestamp", resource.MetricTimestamp)...)
 	if resource.MetricUnit == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MetricUnit'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MetricUnit", resource.MetricUnit)...)
 	if resource.MetricValue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MetricValue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MetricValue", resource.MetricValue)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TopicRule_DynamoDBAction struct {
 	
@@ -21,10 +25,34 @@ type TopicRule_DynamoDBAction struct {
 	RangeKeyValue interface{} `yaml:"RangeKeyValue,omitempty"`
 	RoleArn interface{} `yaml:"RoleArn"`
 	TableName interface{} `yaml:"TableName"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_DynamoDBAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_DynamoDBAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_DynamoDBAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_DynamoDBAction
+	return plain(resource), nil
 }
 
 func (resource TopicRule_DynamoDBAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -37,14 +65,28 @@ func (resource TopicRule_DynamoDBAction) Validate() []error {
 	if resource.HashKeyField == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'HashKeyField'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("HashKeyField", resource.HashKeyField)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HashKeyType", resource.HashKeyType)...)
 	if resource.HashKeyValue == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'HashKeyValue'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("HashKeyValue", resource.HashKeyValue)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PayloadField", resource.PayloadField)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RangeKeyField", resource.RangeKeyField)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RangeKeyType", resource.RangeKeyType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RangeKeyValue", resource.RangeKeyValue)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	if resource.TableName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TableName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TableName", resource.TableName)...)
 	return errs
 }
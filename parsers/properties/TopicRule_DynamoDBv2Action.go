@@ -1,16 +1,50 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type TopicRule_DynamoDBv2Action struct {
 	
 	
 	RoleArn interface{} `yaml:"RoleArn,omitempty"`
 	PutItem *TopicRule_PutItemInput `yaml:"PutItem,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_DynamoDBv2Action) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_DynamoDBv2Action
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_DynamoDBv2Action) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_DynamoDBv2Action
+	return plain(resource), nil
 }
 
 func (resource TopicRule_DynamoDBv2Action) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	
+	if resource.PutItem != nil {
+		errs = append(errs, types.PrefixErrors("PutItem", resource.PutItem.Validate())...)
+	}
 	return errs
 }
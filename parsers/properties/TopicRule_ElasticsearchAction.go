@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TopicRule_ElasticsearchAction struct {
 	
@@ -13,10 +17,34 @@ type TopicRule_ElasticsearchAction struct {
 	Index interface{} `yaml:"Index"`
 	RoleArn interface{} `yaml:"RoleArn"`
 	Type interface{} `yaml:"Type"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_ElasticsearchAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_ElasticsearchAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_ElasticsearchAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_ElasticsearchAction
+	return plain(resource), nil
 }
 
 func (resource TopicRule_ElasticsearchAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
@@ -25,17 +53,22 @@ func (resource TopicRule_ElasticsearchAction) Validate() []error {
 	if resource.Endpoint == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Endpoint'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Endpoint", resource.Endpoint)...)
 	if resource.Id == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Id'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Id", resource.Id)...)
 	if resource.Index == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Index'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Index", resource.Index)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TopicRule_FirehoseAction struct {
 	
@@ -9,18 +13,46 @@ type TopicRule_FirehoseAction struct {
 	DeliveryStreamName interface{} `yaml:"DeliveryStreamName"`
 	RoleArn interface{} `yaml:"RoleArn"`
 	Separator interface{} `yaml:"Separator,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_FirehoseAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_FirehoseAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_FirehoseAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_FirehoseAction
+	return plain(resource), nil
 }
 
 func (resource TopicRule_FirehoseAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.DeliveryStreamName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DeliveryStreamName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DeliveryStreamName", resource.DeliveryStreamName)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Separator", resource.Separator)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TopicRule_KinesisAction struct {
 	
@@ -9,18 +13,46 @@ type TopicRule_KinesisAction struct {
 	PartitionKey interface{} `yaml:"PartitionKey,omitempty"`
 	RoleArn interface{} `yaml:"RoleArn"`
 	StreamName interface{} `yaml:"StreamName"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_KinesisAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_KinesisAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_KinesisAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_KinesisAction
+	return plain(resource), nil
 }
 
 func (resource TopicRule_KinesisAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("PartitionKey", resource.PartitionKey)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	if resource.StreamName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StreamName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StreamName", resource.StreamName)...)
 	return errs
 }
@@ -1,17 +1,46 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TopicRule_PutItemInput struct {
 	
 	TableName interface{} `yaml:"TableName"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_PutItemInput) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_PutItemInput
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_PutItemInput) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_PutItemInput
+	return plain(resource), nil
 }
 
 func (resource TopicRule_PutItemInput) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	if resource.TableName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TableName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TableName", resource.TableName)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TopicRule_RepublishAction struct {
 	
 	
 	RoleArn interface{} `yaml:"RoleArn"`
 	Topic interface{} `yaml:"Topic"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_RepublishAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_RepublishAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_RepublishAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_RepublishAction
+	return plain(resource), nil
 }
 
 func (resource TopicRule_RepublishAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	if resource.Topic == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Topic'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Topic", resource.Topic)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TopicRule_S3Action struct {
 	
@@ -9,21 +13,48 @@ type TopicRule_S3Action struct {
 	BucketName interface{} `yaml:"BucketName"`
 	Key interface{} `yaml:"Key"`
 	RoleArn interface{} `yaml:"RoleArn"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_S3Action) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_S3Action
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_S3Action) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_S3Action
+	return plain(resource), nil
 }
 
 func (resource TopicRule_S3Action) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.BucketName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BucketName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("BucketName", resource.BucketName)...)
 	if resource.Key == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Key'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Key", resource.Key)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TopicRule_SnsAction struct {
 	
@@ -9,18 +13,46 @@ type TopicRule_SnsAction struct {
 	MessageFormat interface{} `yaml:"MessageFormat,omitempty"`
 	RoleArn interface{} `yaml:"RoleArn"`
 	TargetArn interface{} `yaml:"TargetArn"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_SnsAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_SnsAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_SnsAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_SnsAction
+	return plain(resource), nil
 }
 
 func (resource TopicRule_SnsAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("MessageFormat", resource.MessageFormat)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	if resource.TargetArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TargetArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TargetArn", resource.TargetArn)...)
 	return errs
 }
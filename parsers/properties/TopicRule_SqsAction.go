@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TopicRule_SqsAction struct {
 	
@@ -9,18 +13,46 @@ type TopicRule_SqsAction struct {
 	QueueUrl interface{} `yaml:"QueueUrl"`
 	RoleArn interface{} `yaml:"RoleArn"`
 	UseBase64 interface{} `yaml:"UseBase64,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_SqsAction) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_SqsAction
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_SqsAction) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_SqsAction
+	return plain(resource), nil
 }
 
 func (resource TopicRule_SqsAction) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.QueueUrl == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'QueueUrl'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("QueueUrl", resource.QueueUrl)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("UseBase64", resource.UseBase64)...)
 	return errs
 }
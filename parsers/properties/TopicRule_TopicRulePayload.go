@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type TopicRule_TopicRulePayload struct {
 	
@@ -13,23 +17,54 @@ type TopicRule_TopicRulePayload struct {
 	RuleDisabled interface{} `yaml:"RuleDisabled"`
 	Sql interface{} `yaml:"Sql"`
 	Actions interface{} `yaml:"Actions"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *TopicRule_TopicRulePayload) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain TopicRule_TopicRulePayload
+	return unmarshal((*plain)(resource))
+}
+
+func (resource TopicRule_TopicRulePayload) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain TopicRule_TopicRulePayload
+	return plain(resource), nil
 }
 
 func (resource TopicRule_TopicRulePayload) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
 	
 	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AwsIotSqlVersion", resource.AwsIotSqlVersion)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.RuleDisabled == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RuleDisabled'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("RuleDisabled", resource.RuleDisabled)...)
 	if resource.Sql == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Sql'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Sql", resource.Sql)...)
 	if resource.Actions == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Actions'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &TopicRule_Action{} }))("Actions", resource.Actions)...)
 	return errs
 }
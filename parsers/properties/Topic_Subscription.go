@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type Topic_Subscription struct {
 	
 	
 	Endpoint interface{} `yaml:"Endpoint"`
 	Protocol interface{} `yaml:"Protocol"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Topic_Subscription) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Topic_Subscription
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Topic_Subscription) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Topic_Subscription
+	return plain(resource), nil
 }
 
 func (resource Topic_Subscription) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Endpoint == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Endpoint'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Endpoint", resource.Endpoint)...)
 	if resource.Protocol == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Protocol'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Protocol", resource.Protocol)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Trail_EventSelector struct {
 	
 	
@@ -8,12 +12,42 @@ type Trail_EventSelector struct {
 	IncludeManagementEvents interface{} `yaml:"IncludeManagementEvents,omitempty"`
 	ReadWriteType interface{} `yaml:"ReadWriteType,omitempty"`
 	DataResources interface{} `yaml:"DataResources,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Trail_EventSelector) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Trail_EventSelector
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Trail_EventSelector) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Trail_EventSelector
+	return plain(resource), nil
 }
 
 func (resource Trail_EventSelector) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("IncludeManagementEvents", resource.IncludeManagementEvents)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ReadWriteType", resource.ReadWriteType)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Trail_DataResource{} }))("DataResources", resource.DataResources)...)
 	return errs
 }
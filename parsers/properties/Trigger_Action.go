@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Trigger_Action struct {
 	
 	
 	Arguments interface{} `yaml:"Arguments,omitempty"`
 	JobName interface{} `yaml:"JobName,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Trigger_Action) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Trigger_Action
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Trigger_Action) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Trigger_Action
+	return plain(resource), nil
 }
 
 func (resource Trigger_Action) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Json")("Arguments", resource.Arguments)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("JobName", resource.JobName)...)
 	return errs
 }
@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Trigger_Condition struct {
 	
 	
@@ -8,12 +12,42 @@ type Trigger_Condition struct {
 	JobName interface{} `yaml:"JobName,omitempty"`
 	LogicalOperator interface{} `yaml:"LogicalOperator,omitempty"`
 	State interface{} `yaml:"State,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Trigger_Condition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Trigger_Condition
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Trigger_Condition) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Trigger_Condition
+	return plain(resource), nil
 }
 
 func (resource Trigger_Condition) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("JobName", resource.JobName)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("LogicalOperator", resource.LogicalOperator)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("State", resource.State)...)
 	return errs
 }
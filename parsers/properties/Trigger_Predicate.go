@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type Trigger_Predicate struct {
 	
 	
 	Logical interface{} `yaml:"Logical,omitempty"`
 	Conditions interface{} `yaml:"Conditions,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *Trigger_Predicate) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain Trigger_Predicate
+	return unmarshal((*plain)(resource))
+}
+
+func (resource Trigger_Predicate) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain Trigger_Predicate
+	return plain(resource), nil
 }
 
 func (resource Trigger_Predicate) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Logical", resource.Logical)...)
 	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &Trigger_Condition{} }))("Conditions", resource.Conditions)...)
 	return errs
 }
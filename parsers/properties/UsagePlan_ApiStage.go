@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UsagePlan_ApiStage struct {
 	
 	
 	ApiId interface{} `yaml:"ApiId,omitempty"`
 	Stage interface{} `yaml:"Stage,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UsagePlan_ApiStage) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UsagePlan_ApiStage
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UsagePlan_ApiStage) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UsagePlan_ApiStage
+	return plain(resource), nil
 }
 
 func (resource UsagePlan_ApiStage) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ApiId", resource.ApiId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Stage", resource.Stage)...)
 	return errs
 }
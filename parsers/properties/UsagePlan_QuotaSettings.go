@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UsagePlan_QuotaSettings struct {
 	
 	
@@ -8,12 +12,42 @@ type UsagePlan_QuotaSettings struct {
 	Limit interface{} `yaml:"Limit,omitempty"`
 	Offset interface{} `yaml:"Offset,omitempty"`
 	Period interface{} `yaml:"Period,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UsagePlan_QuotaSettings) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UsagePlan_QuotaSettings
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UsagePlan_QuotaSettings) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UsagePlan_QuotaSettings
+	return plain(resource), nil
 }
 
 func (resource UsagePlan_QuotaSettings) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Limit", resource.Limit)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Offset", resource.Offset)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Period", resource.Period)...)
 	return errs
 }
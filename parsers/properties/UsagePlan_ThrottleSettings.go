@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UsagePlan_ThrottleSettings struct {
 	
 	
 	BurstLimit interface{} `yaml:"BurstLimit,omitempty"`
 	RateLimit interface{} `yaml:"RateLimit,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UsagePlan_ThrottleSettings) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UsagePlan_ThrottleSettings
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UsagePlan_ThrottleSettings) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UsagePlan_ThrottleSettings
+	return plain(resource), nil
 }
 
 func (resource UsagePlan_ThrottleSettings) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("BurstLimit", resource.BurstLimit)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("RateLimit", resource.RateLimit)...)
 	return errs
 }
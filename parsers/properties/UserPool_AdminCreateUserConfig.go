@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UserPool_AdminCreateUserConfig struct {
 	
 	
@@ -8,12 +12,44 @@ type UserPool_AdminCreateUserConfig struct {
 	AllowAdminCreateUserOnly interface{} `yaml:"AllowAdminCreateUserOnly,omitempty"`
 	UnusedAccountValidityDays interface{} `yaml:"UnusedAccountValidityDays,omitempty"`
 	InviteMessageTemplate *UserPool_InviteMessageTemplate `yaml:"InviteMessageTemplate,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UserPool_AdminCreateUserConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UserPool_AdminCreateUserConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UserPool_AdminCreateUserConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UserPool_AdminCreateUserConfig
+	return plain(resource), nil
 }
 
 func (resource UserPool_AdminCreateUserConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AllowAdminCreateUserOnly", resource.AllowAdminCreateUserOnly)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Double")("UnusedAccountValidityDays", resource.UnusedAccountValidityDays)...)
 	
+	if resource.InviteMessageTemplate != nil {
+		errs = append(errs, types.PrefixErrors("InviteMessageTemplate", resource.InviteMessageTemplate.Validate())...)
+	}
 	return errs
 }
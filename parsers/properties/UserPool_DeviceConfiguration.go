@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UserPool_DeviceConfiguration struct {
 	
 	
 	ChallengeRequiredOnNewDevice interface{} `yaml:"ChallengeRequiredOnNewDevice,omitempty"`
 	DeviceOnlyRememberedOnUserPrompt interface{} `yaml:"DeviceOnlyRememberedOnUserPrompt,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UserPool_DeviceConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UserPool_DeviceConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UserPool_DeviceConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UserPool_DeviceConfiguration
+	return plain(resource), nil
 }
 
 func (resource UserPool_DeviceConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ChallengeRequiredOnNewDevice", resource.ChallengeRequiredOnNewDevice)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DeviceOnlyRememberedOnUserPrompt", resource.DeviceOnlyRememberedOnUserPrompt)...)
 	return errs
 }
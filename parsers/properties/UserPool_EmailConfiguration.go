@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UserPool_EmailConfiguration struct {
 	
 	
 	ReplyToEmailAddress interface{} `yaml:"ReplyToEmailAddress,omitempty"`
 	SourceArn interface{} `yaml:"SourceArn,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UserPool_EmailConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UserPool_EmailConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UserPool_EmailConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UserPool_EmailConfiguration
+	return plain(resource), nil
 }
 
 func (resource UserPool_EmailConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ReplyToEmailAddress", resource.ReplyToEmailAddress)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceArn", resource.SourceArn)...)
 	return errs
 }
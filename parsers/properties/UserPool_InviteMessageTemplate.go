@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UserPool_InviteMessageTemplate struct {
 	
 	
@@ -8,12 +12,42 @@ type UserPool_InviteMessageTemplate struct {
 	EmailMessage interface{} `yaml:"EmailMessage,omitempty"`
 	EmailSubject interface{} `yaml:"EmailSubject,omitempty"`
 	SMSMessage interface{} `yaml:"SMSMessage,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UserPool_InviteMessageTemplate) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UserPool_InviteMessageTemplate
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UserPool_InviteMessageTemplate) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UserPool_InviteMessageTemplate
+	return plain(resource), nil
 }
 
 func (resource UserPool_InviteMessageTemplate) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("EmailMessage", resource.EmailMessage)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("EmailSubject", resource.EmailSubject)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SMSMessage", resource.SMSMessage)...)
 	return errs
 }
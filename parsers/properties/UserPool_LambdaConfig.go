@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UserPool_LambdaConfig struct {
 	
 	
@@ -18,17 +22,57 @@ type UserPool_LambdaConfig struct {
 	PreAuthentication interface{} `yaml:"PreAuthentication,omitempty"`
 	PreSignUp interface{} `yaml:"PreSignUp,omitempty"`
 	VerifyAuthChallengeResponse interface{} `yaml:"VerifyAuthChallengeResponse,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UserPool_LambdaConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UserPool_LambdaConfig
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UserPool_LambdaConfig) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UserPool_LambdaConfig
+	return plain(resource), nil
 }
 
 func (resource UserPool_LambdaConfig) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CreateAuthChallenge", resource.CreateAuthChallenge)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("CustomMessage", resource.CustomMessage)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("DefineAuthChallenge", resource.DefineAuthChallenge)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("PostAuthentication", resource.PostAuthentication)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("PostConfirmation", resource.PostConfirmation)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("PreAuthentication", resource.PreAuthentication)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("PreSignUp", resource.PreSignUp)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("VerifyAuthChallengeResponse", resource.VerifyAuthChallengeResponse)...)
 	return errs
 }
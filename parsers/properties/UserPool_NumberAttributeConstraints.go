@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UserPool_NumberAttributeConstraints struct {
 	
 	
 	MaxValue interface{} `yaml:"MaxValue,omitempty"`
 	MinValue interface{} `yaml:"MinValue,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UserPool_NumberAttributeConstraints) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UserPool_NumberAttributeConstraints
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UserPool_NumberAttributeConstraints) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UserPool_NumberAttributeConstraints
+	return plain(resource), nil
 }
 
 func (resource UserPool_NumberAttributeConstraints) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("MaxValue", resource.MaxValue)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("MinValue", resource.MinValue)...)
 	return errs
 }
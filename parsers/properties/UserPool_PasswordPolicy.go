@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UserPool_PasswordPolicy struct {
 	
 	
@@ -12,14 +16,48 @@ type UserPool_PasswordPolicy struct {
 	RequireNumbers interface{} `yaml:"RequireNumbers,omitempty"`
 	RequireSymbols interface{} `yaml:"RequireSymbols,omitempty"`
 	RequireUppercase interface{} `yaml:"RequireUppercase,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UserPool_PasswordPolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UserPool_PasswordPolicy
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UserPool_PasswordPolicy) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UserPool_PasswordPolicy
+	return plain(resource), nil
 }
 
 func (resource UserPool_PasswordPolicy) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MinimumLength", resource.MinimumLength)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("RequireLowercase", resource.RequireLowercase)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("RequireNumbers", resource.RequireNumbers)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("RequireSymbols", resource.RequireSymbols)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("RequireUppercase", resource.RequireUppercase)...)
 	return errs
 }
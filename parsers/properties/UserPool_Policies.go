@@ -1,13 +1,45 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UserPool_Policies struct {
 	
 	PasswordPolicy *UserPool_PasswordPolicy `yaml:"PasswordPolicy,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UserPool_Policies) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UserPool_Policies
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UserPool_Policies) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UserPool_Policies
+	return plain(resource), nil
 }
 
 func (resource UserPool_Policies) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
 	
+	if resource.PasswordPolicy != nil {
+		errs = append(errs, types.PrefixErrors("PasswordPolicy", resource.PasswordPolicy.Validate())...)
+	}
 	return errs
 }
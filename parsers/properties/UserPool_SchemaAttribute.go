@@ -1,6 +1,10 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UserPool_SchemaAttribute struct {
 	
 	
@@ -16,16 +20,58 @@ type UserPool_SchemaAttribute struct {
 	Required interface{} `yaml:"Required,omitempty"`
 	StringAttributeConstraints *UserPool_StringAttributeConstraints `yaml:"StringAttributeConstraints,omitempty"`
 	NumberAttributeConstraints *UserPool_NumberAttributeConstraints `yaml:"NumberAttributeConstraints,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UserPool_SchemaAttribute) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UserPool_SchemaAttribute
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UserPool_SchemaAttribute) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UserPool_SchemaAttribute
+	return plain(resource), nil
 }
 
 func (resource UserPool_SchemaAttribute) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
+	
+	
+	
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("AttributeDataType", resource.AttributeDataType)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DeveloperOnlyAttribute", resource.DeveloperOnlyAttribute)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Mutable", resource.Mutable)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Required", resource.Required)...)
 	
+	if resource.StringAttributeConstraints != nil {
+		errs = append(errs, types.PrefixErrors("StringAttributeConstraints", resource.StringAttributeConstraints.Validate())...)
+	}
 	
+	if resource.NumberAttributeConstraints != nil {
+		errs = append(errs, types.PrefixErrors("NumberAttributeConstraints", resource.NumberAttributeConstraints.Validate())...)
+	}
 	return errs
 }
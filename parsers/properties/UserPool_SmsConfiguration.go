@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UserPool_SmsConfiguration struct {
 	
 	
 	ExternalId interface{} `yaml:"ExternalId,omitempty"`
 	SnsCallerArn interface{} `yaml:"SnsCallerArn,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UserPool_SmsConfiguration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UserPool_SmsConfiguration
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UserPool_SmsConfiguration) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UserPool_SmsConfiguration
+	return plain(resource), nil
 }
 
 func (resource UserPool_SmsConfiguration) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("ExternalId", resource.ExternalId)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("SnsCallerArn", resource.SnsCallerArn)...)
 	return errs
 }
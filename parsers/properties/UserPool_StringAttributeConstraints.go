@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type UserPool_StringAttributeConstraints struct {
 	
 	
 	MaxLength interface{} `yaml:"MaxLength,omitempty"`
 	MinLength interface{} `yaml:"MinLength,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *UserPool_StringAttributeConstraints) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain UserPool_StringAttributeConstraints
+	return unmarshal((*plain)(resource))
+}
+
+func (resource UserPool_StringAttributeConstraints) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain UserPool_StringAttributeConstraints
+	return plain(resource), nil
 }
 
 func (resource UserPool_StringAttributeConstraints) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("MaxLength", resource.MaxLength)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("MinLength", resource.MinLength)...)
 	return errs
 }
@@ -1,20 +1,51 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type User_LoginProfile struct {
 	
 	
 	Password interface{} `yaml:"Password"`
 	PasswordResetRequired interface{} `yaml:"PasswordResetRequired,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *User_LoginProfile) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain User_LoginProfile
+	return unmarshal((*plain)(resource))
+}
+
+func (resource User_LoginProfile) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain User_LoginProfile
+	return plain(resource), nil
 }
 
 func (resource User_LoginProfile) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.Password == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Password'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Password", resource.Password)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("PasswordResetRequired", resource.PasswordResetRequired)...)
 	return errs
 }
@@ -1,23 +1,53 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type User_Policy struct {
 	
 	
 	PolicyDocument interface{} `yaml:"PolicyDocument"`
 	PolicyName interface{} `yaml:"PolicyName"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *User_Policy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain User_Policy
+	return unmarshal((*plain)(resource))
+}
+
+func (resource User_Policy) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain User_Policy
+	return plain(resource), nil
 }
 
 func (resource User_Policy) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	if resource.PolicyDocument == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PolicyDocument'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Json")("PolicyDocument", resource.PolicyDocument)...)
 	if resource.PolicyName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PolicyName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PolicyName", resource.PolicyName)...)
 	return errs
 }
@@ -1,16 +1,48 @@
 package properties
 
 
+import (
+	"github.com/KablamoOSS/kombustion/types"
+)
+
 type VPNConnection_VpnTunnelOptionsSpecification struct {
 	
 	
 	PreSharedKey interface{} `yaml:"PreSharedKey,omitempty"`
 	TunnelInsideCidr interface{} `yaml:"TunnelInsideCidr,omitempty"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *VPNConnection_VpnTunnelOptionsSpecification) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain VPNConnection_VpnTunnelOptionsSpecification
+	return unmarshal((*plain)(resource))
+}
+
+func (resource VPNConnection_VpnTunnelOptionsSpecification) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain VPNConnection_VpnTunnelOptionsSpecification
+	return plain(resource), nil
 }
 
 func (resource VPNConnection_VpnTunnelOptionsSpecification) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
+	
+	
 	
+	errs = append(errs, types.ValidatePrimitive("String")("PreSharedKey", resource.PreSharedKey)...)
 	
+	errs = append(errs, types.ValidatePrimitive("String")("TunnelInsideCidr", resource.TunnelInsideCidr)...)
 	return errs
 }
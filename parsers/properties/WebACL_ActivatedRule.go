@@ -1,6 +1,10 @@
 package properties
 
-	import "fmt"
+
+import (
+	"github.com/KablamoOSS/kombustion/types"
+	"fmt"
+)
 
 type WebACL_ActivatedRule struct {
 	
@@ -9,23 +13,50 @@ type WebACL_ActivatedRule struct {
 	Priority interface{} `yaml:"Priority"`
 	RuleId interface{} `yaml:"RuleId"`
 	Action *WebACL_WafAction `yaml:"Action"`
+
+	// Intrinsic - an intrinsic function (eg. Fn::If) used in place of the property object
+	Intrinsic interface{} `yaml:"-"`
+}
+
+func (resource *WebACL_ActivatedRule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if intrinsic, ok := types.UnmarshalIntrinsic(unmarshal); ok {
+		resource.Intrinsic = intrinsic
+		return nil
+	}
+	type plain WebACL_ActivatedRule
+	return unmarshal((*plain)(resource))
+}
+
+func (resource WebACL_ActivatedRule) MarshalYAML() (interface{}, error) {
+	if resource.Intrinsic != nil {
+		return resource.Intrinsic, nil
+	}
+	type plain WebACL_ActivatedRule
+	return plain(resource), nil
 }
 
 func (resource WebACL_ActivatedRule) Validate() []error {
 	errs := []error{}
+	if resource.Intrinsic != nil {
+		// intrinsic functions can't be evaluated until deployment
+		return errs
+	}
 	
 	
 	
 	if resource.Priority == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Priority'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Priority", resource.Priority)...)
 	if resource.RuleId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RuleId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RuleId", resource.RuleId)...)
 	if resource.Action == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Action'"))
-	} else {
-		errs = append(errs, resource.Action.Validate()...)
+	}
+	if resource.Action != nil {
+		errs = append(errs, types.PrefixErrors("Action", resource.Action.Validate())...)
 	}
 	return errs
 }
@@ -0,0 +1,1637 @@
+package parsers
+
+import "github.com/KablamoOSS/kombustion/types"
+
+func GetPropertySpecs_resources() map[string]map[string]types.PropertySpec {
+	return map[string]map[string]types.PropertySpec{
+		
+		"AWS::ApiGateway::Account": map[string]types.PropertySpec{"CloudWatchRoleArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::ApiKey": map[string]types.PropertySpec{"CustomerId": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, "GenerateDistinctId": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "StageKeys": {Container: "List", PropertyType: "AWS::ApiGateway::ApiKey.StageKey", UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::Authorizer": map[string]types.PropertySpec{"AuthType": {UpdateType: "Mutable"}, "AuthorizerCredentials": {UpdateType: "Mutable"}, "AuthorizerResultTtlInSeconds": {UpdateType: "Mutable"}, "AuthorizerUri": {UpdateType: "Mutable"}, "IdentitySource": {UpdateType: "Mutable"}, "IdentityValidationExpression": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "ProviderARNs": {Container: "List", UpdateType: "Mutable"}, "RestApiId": {UpdateType: "Immutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::BasePathMapping": map[string]types.PropertySpec{"BasePath": {UpdateType: "Immutable"}, "DomainName": {UpdateType: "Immutable"}, "RestApiId": {UpdateType: "Mutable"}, "Stage": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::ClientCertificate": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::Deployment": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "RestApiId": {UpdateType: "Immutable"}, "StageDescription": {PropertyType: "AWS::ApiGateway::Deployment.StageDescription", UpdateType: "Mutable"}, "StageName": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::DocumentationPart": map[string]types.PropertySpec{"Location": {PropertyType: "AWS::ApiGateway::DocumentationPart.Location", UpdateType: "Immutable"}, "Properties": {UpdateType: "Mutable"}, "RestApiId": {UpdateType: "Immutable"}, },
+		
+		"AWS::ApiGateway::DocumentationVersion": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "DocumentationVersion": {UpdateType: "Immutable"}, "RestApiId": {UpdateType: "Immutable"}, },
+		
+		"AWS::ApiGateway::DomainName": map[string]types.PropertySpec{"CertificateArn": {UpdateType: "Mutable"}, "DomainName": {UpdateType: "Immutable"}, "EndpointConfiguration": {PropertyType: "AWS::ApiGateway::DomainName.EndpointConfiguration", UpdateType: "Mutable"}, "RegionalCertificateArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::GatewayResponse": map[string]types.PropertySpec{"ResponseParameters": {Container: "Map", UpdateType: "Mutable"}, "ResponseTemplates": {Container: "Map", UpdateType: "Mutable"}, "ResponseType": {UpdateType: "Immutable"}, "RestApiId": {UpdateType: "Immutable"}, "StatusCode": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::Method": map[string]types.PropertySpec{"ApiKeyRequired": {UpdateType: "Mutable"}, "AuthorizationType": {UpdateType: "Mutable"}, "AuthorizerId": {UpdateType: "Mutable"}, "HttpMethod": {UpdateType: "Mutable"}, "Integration": {PropertyType: "AWS::ApiGateway::Method.Integration", UpdateType: "Mutable"}, "MethodResponses": {Container: "List", PropertyType: "AWS::ApiGateway::Method.MethodResponse", UpdateType: "Mutable"}, "OperationName": {UpdateType: "Mutable"}, "RequestModels": {Container: "Map", UpdateType: "Mutable"}, "RequestParameters": {Container: "Map", UpdateType: "Mutable"}, "RequestValidatorId": {UpdateType: "Mutable"}, "ResourceId": {UpdateType: "Mutable"}, "RestApiId": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::Model": map[string]types.PropertySpec{"ContentType": {UpdateType: "Immutable"}, "Description": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "RestApiId": {UpdateType: "Immutable"}, "Schema": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::RequestValidator": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, "RestApiId": {UpdateType: "Immutable"}, "ValidateRequestBody": {UpdateType: "Mutable"}, "ValidateRequestParameters": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::Resource": map[string]types.PropertySpec{"ParentId": {UpdateType: "Immutable"}, "PathPart": {UpdateType: "Immutable"}, "RestApiId": {UpdateType: "Immutable"}, },
+		
+		"AWS::ApiGateway::RestApi": map[string]types.PropertySpec{"ApiKeySourceType": {UpdateType: "Mutable"}, "BinaryMediaTypes": {Container: "List", UpdateType: "Mutable"}, "Body": {UpdateType: "Mutable"}, "BodyS3Location": {PropertyType: "AWS::ApiGateway::RestApi.S3Location", UpdateType: "Mutable"}, "CloneFrom": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "EndpointConfiguration": {PropertyType: "AWS::ApiGateway::RestApi.EndpointConfiguration", UpdateType: "Mutable"}, "FailOnWarnings": {UpdateType: "Mutable"}, "MinimumCompressionSize": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "Parameters": {Container: "Map", UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::Stage": map[string]types.PropertySpec{"CacheClusterEnabled": {UpdateType: "Mutable"}, "CacheClusterSize": {UpdateType: "Mutable"}, "ClientCertificateId": {UpdateType: "Mutable"}, "DeploymentId": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "DocumentationVersion": {UpdateType: "Mutable"}, "MethodSettings": {Container: "List", PropertyType: "AWS::ApiGateway::Stage.MethodSetting", UpdateType: "Mutable"}, "RestApiId": {UpdateType: "Immutable"}, "StageName": {UpdateType: "Immutable"}, "Variables": {Container: "Map", UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::UsagePlan": map[string]types.PropertySpec{"ApiStages": {Container: "List", PropertyType: "AWS::ApiGateway::UsagePlan.ApiStage", UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "Quota": {PropertyType: "AWS::ApiGateway::UsagePlan.QuotaSettings", UpdateType: "Mutable"}, "Throttle": {PropertyType: "AWS::ApiGateway::UsagePlan.ThrottleSettings", UpdateType: "Mutable"}, "UsagePlanName": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::UsagePlanKey": map[string]types.PropertySpec{"KeyId": {UpdateType: "Immutable"}, "KeyType": {UpdateType: "Immutable"}, "UsagePlanId": {UpdateType: "Immutable"}, },
+		
+		"AWS::ApiGateway::VpcLink": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "TargetArns": {Container: "List", UpdateType: "Immutable"}, },
+		
+		"AWS::AppSync::ApiKey": map[string]types.PropertySpec{"ApiId": {UpdateType: "Immutable"}, "Description": {UpdateType: "Mutable"}, "Expires": {UpdateType: "Mutable"}, },
+		
+		"AWS::AppSync::DataSource": map[string]types.PropertySpec{"ApiId": {UpdateType: "Immutable"}, "Description": {UpdateType: "Mutable"}, "DynamoDBConfig": {PropertyType: "AWS::AppSync::DataSource.DynamoDBConfig", UpdateType: "Mutable"}, "ElasticsearchConfig": {PropertyType: "AWS::AppSync::DataSource.ElasticsearchConfig", UpdateType: "Mutable"}, "LambdaConfig": {PropertyType: "AWS::AppSync::DataSource.LambdaConfig", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "ServiceRoleArn": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::AppSync::GraphQLApi": map[string]types.PropertySpec{"AuthenticationType": {UpdateType: "Mutable"}, "LogConfig": {PropertyType: "AWS::AppSync::GraphQLApi.LogConfig", UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "OpenIDConnectConfig": {PropertyType: "AWS::AppSync::GraphQLApi.OpenIDConnectConfig", UpdateType: "Mutable"}, "UserPoolConfig": {PropertyType: "AWS::AppSync::GraphQLApi.UserPoolConfig", UpdateType: "Mutable"}, },
+		
+		"AWS::AppSync::GraphQLSchema": map[string]types.PropertySpec{"ApiId": {UpdateType: "Immutable"}, "Definition": {UpdateType: "Mutable"}, "DefinitionS3Location": {UpdateType: "Mutable"}, },
+		
+		"AWS::AppSync::Resolver": map[string]types.PropertySpec{"ApiId": {UpdateType: "Immutable"}, "DataSourceName": {UpdateType: "Mutable"}, "FieldName": {UpdateType: "Immutable"}, "RequestMappingTemplate": {UpdateType: "Mutable"}, "RequestMappingTemplateS3Location": {UpdateType: "Mutable"}, "ResponseMappingTemplate": {UpdateType: "Mutable"}, "ResponseMappingTemplateS3Location": {UpdateType: "Mutable"}, "TypeName": {UpdateType: "Immutable"}, },
+		
+		"AWS::ApplicationAutoScaling::ScalableTarget": map[string]types.PropertySpec{"MaxCapacity": {UpdateType: "Mutable"}, "MinCapacity": {UpdateType: "Mutable"}, "ResourceId": {UpdateType: "Immutable"}, "RoleARN": {UpdateType: "Mutable"}, "ScalableDimension": {UpdateType: "Immutable"}, "ScheduledActions": {Container: "List", PropertyType: "AWS::ApplicationAutoScaling::ScalableTarget.ScheduledAction", UpdateType: "Mutable"}, "ServiceNamespace": {UpdateType: "Immutable"}, },
+		
+		"AWS::ApplicationAutoScaling::ScalingPolicy": map[string]types.PropertySpec{"PolicyName": {UpdateType: "Immutable"}, "PolicyType": {UpdateType: "Mutable"}, "ResourceId": {UpdateType: "Immutable"}, "ScalableDimension": {UpdateType: "Immutable"}, "ScalingTargetId": {UpdateType: "Immutable"}, "ServiceNamespace": {UpdateType: "Immutable"}, "StepScalingPolicyConfiguration": {PropertyType: "AWS::ApplicationAutoScaling::ScalingPolicy.StepScalingPolicyConfiguration", UpdateType: "Mutable"}, "TargetTrackingScalingPolicyConfiguration": {PropertyType: "AWS::ApplicationAutoScaling::ScalingPolicy.TargetTrackingScalingPolicyConfiguration", UpdateType: "Mutable"}, },
+		
+		"AWS::Athena::NamedQuery": map[string]types.PropertySpec{"Database": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "QueryString": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::AutoScalingGroup": map[string]types.PropertySpec{"AutoScalingGroupName": {UpdateType: "Immutable"}, "AvailabilityZones": {Container: "List", UpdateType: "Mutable"}, "Cooldown": {UpdateType: "Mutable"}, "DesiredCapacity": {UpdateType: "Mutable"}, "HealthCheckGracePeriod": {UpdateType: "Mutable"}, "HealthCheckType": {UpdateType: "Mutable"}, "InstanceId": {UpdateType: "Immutable"}, "LaunchConfigurationName": {UpdateType: "Mutable"}, "LifecycleHookSpecificationList": {Container: "List", PropertyType: "AWS::AutoScaling::AutoScalingGroup.LifecycleHookSpecification", UpdateType: "Mutable"}, "LoadBalancerNames": {Container: "List", UpdateType: "Mutable"}, "MaxSize": {UpdateType: "Mutable"}, "MetricsCollection": {Container: "List", PropertyType: "AWS::AutoScaling::AutoScalingGroup.MetricsCollection", UpdateType: "Mutable"}, "MinSize": {UpdateType: "Mutable"}, "NotificationConfigurations": {Container: "List", PropertyType: "AWS::AutoScaling::AutoScalingGroup.NotificationConfiguration", UpdateType: "Mutable"}, "PlacementGroup": {UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "AWS::AutoScaling::AutoScalingGroup.TagProperty", UpdateType: "Mutable"}, "TargetGroupARNs": {Container: "List", UpdateType: "Mutable"}, "TerminationPolicies": {Container: "List", UpdateType: "Mutable"}, "VPCZoneIdentifier": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::LaunchConfiguration": map[string]types.PropertySpec{"AssociatePublicIpAddress": {UpdateType: "Immutable"}, "BlockDeviceMappings": {Container: "List", PropertyType: "AWS::AutoScaling::LaunchConfiguration.BlockDeviceMapping", UpdateType: "Immutable"}, "ClassicLinkVPCId": {UpdateType: "Immutable"}, "ClassicLinkVPCSecurityGroups": {Container: "List", UpdateType: "Immutable"}, "EbsOptimized": {UpdateType: "Immutable"}, "IamInstanceProfile": {UpdateType: "Immutable"}, "ImageId": {UpdateType: "Immutable"}, "InstanceId": {UpdateType: "Immutable"}, "InstanceMonitoring": {UpdateType: "Immutable"}, "InstanceType": {UpdateType: "Immutable"}, "KernelId": {UpdateType: "Immutable"}, "KeyName": {UpdateType: "Immutable"}, "PlacementTenancy": {UpdateType: "Immutable"}, "RamDiskId": {UpdateType: "Immutable"}, "SecurityGroups": {Container: "List", UpdateType: "Immutable"}, "SpotPrice": {UpdateType: "Immutable"}, "UserData": {UpdateType: "Immutable"}, },
+		
+		"AWS::AutoScaling::LifecycleHook": map[string]types.PropertySpec{"AutoScalingGroupName": {UpdateType: "Immutable"}, "DefaultResult": {UpdateType: "Mutable"}, "HeartbeatTimeout": {UpdateType: "Mutable"}, "LifecycleHookName": {UpdateType: "Immutable"}, "LifecycleTransition": {UpdateType: "Mutable"}, "NotificationMetadata": {UpdateType: "Mutable"}, "NotificationTargetARN": {UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::ScalingPolicy": map[string]types.PropertySpec{"AdjustmentType": {UpdateType: "Mutable"}, "AutoScalingGroupName": {UpdateType: "Mutable"}, "Cooldown": {UpdateType: "Mutable"}, "EstimatedInstanceWarmup": {UpdateType: "Mutable"}, "MetricAggregationType": {UpdateType: "Mutable"}, "MinAdjustmentMagnitude": {UpdateType: "Mutable"}, "PolicyType": {UpdateType: "Mutable"}, "ScalingAdjustment": {UpdateType: "Mutable"}, "StepAdjustments": {Container: "List", PropertyType: "AWS::AutoScaling::ScalingPolicy.StepAdjustment", UpdateType: "Mutable"}, "TargetTrackingConfiguration": {PropertyType: "AWS::AutoScaling::ScalingPolicy.TargetTrackingConfiguration", UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::ScheduledAction": map[string]types.PropertySpec{"AutoScalingGroupName": {UpdateType: "Immutable"}, "DesiredCapacity": {UpdateType: "Mutable"}, "EndTime": {UpdateType: "Mutable"}, "MaxSize": {UpdateType: "Mutable"}, "MinSize": {UpdateType: "Mutable"}, "Recurrence": {UpdateType: "Mutable"}, "StartTime": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScalingPlans::ScalingPlan": map[string]types.PropertySpec{"ApplicationSource": {PropertyType: "AWS::AutoScalingPlans::ScalingPlan.ApplicationSource", UpdateType: "Mutable"}, "ScalingInstructions": {Container: "List", PropertyType: "AWS::AutoScalingPlans::ScalingPlan.ScalingInstruction", UpdateType: "Mutable"}, },
+		
+		"AWS::Batch::ComputeEnvironment": map[string]types.PropertySpec{"ComputeEnvironmentName": {UpdateType: "Immutable"}, "ComputeResources": {PropertyType: "AWS::Batch::ComputeEnvironment.ComputeResources", UpdateType: "Mutable"}, "ServiceRole": {UpdateType: "Mutable"}, "State": {UpdateType: "Mutable"}, "Type": {UpdateType: "Immutable"}, },
+		
+		"AWS::Batch::JobDefinition": map[string]types.PropertySpec{"ContainerProperties": {PropertyType: "AWS::Batch::JobDefinition.ContainerProperties", UpdateType: "Mutable"}, "JobDefinitionName": {UpdateType: "Immutable"}, "Parameters": {UpdateType: "Mutable"}, "RetryStrategy": {PropertyType: "AWS::Batch::JobDefinition.RetryStrategy", UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::Batch::JobQueue": map[string]types.PropertySpec{"ComputeEnvironmentOrder": {Container: "List", PropertyType: "AWS::Batch::JobQueue.ComputeEnvironmentOrder", UpdateType: "Mutable"}, "JobQueueName": {UpdateType: "Immutable"}, "Priority": {UpdateType: "Mutable"}, "State": {UpdateType: "Mutable"}, },
+		
+		"AWS::CertificateManager::Certificate": map[string]types.PropertySpec{"DomainName": {UpdateType: "Immutable"}, "DomainValidationOptions": {Container: "List", PropertyType: "AWS::CertificateManager::Certificate.DomainValidationOption", UpdateType: "Immutable"}, "SubjectAlternativeNames": {Container: "List", UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::Cloud9::EnvironmentEC2": map[string]types.PropertySpec{"AutomaticStopTimeMinutes": {UpdateType: "Immutable"}, "Description": {UpdateType: "Immutable"}, "InstanceType": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "OwnerArn": {UpdateType: "Immutable"}, "Repositories": {Container: "List", PropertyType: "AWS::Cloud9::EnvironmentEC2.Repository", UpdateType: "Immutable"}, "SubnetId": {UpdateType: "Immutable"}, },
+		
+		"AWS::CloudFormation::CustomResource": map[string]types.PropertySpec{"ServiceToken": {UpdateType: "Immutable"}, },
+		
+		"AWS::CloudFormation::Stack": map[string]types.PropertySpec{"NotificationARNs": {Container: "List", UpdateType: "Mutable"}, "Parameters": {Container: "Map", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "TemplateURL": {UpdateType: "Mutable"}, "TimeoutInMinutes": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFormation::WaitCondition": map[string]types.PropertySpec{"Count": {UpdateType: "Mutable"}, "Handle": {UpdateType: "Mutable"}, "Timeout": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFormation::WaitConditionHandle": map[string]types.PropertySpec{},
+		
+		"AWS::CloudFront::CloudFrontOriginAccessIdentity": map[string]types.PropertySpec{"CloudFrontOriginAccessIdentityConfig": {PropertyType: "AWS::CloudFront::CloudFrontOriginAccessIdentity.CloudFrontOriginAccessIdentityConfig", UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution": map[string]types.PropertySpec{"DistributionConfig": {PropertyType: "AWS::CloudFront::Distribution.DistributionConfig", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::StreamingDistribution": map[string]types.PropertySpec{"StreamingDistributionConfig": {PropertyType: "AWS::CloudFront::StreamingDistribution.StreamingDistributionConfig", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::CloudTrail::Trail": map[string]types.PropertySpec{"CloudWatchLogsLogGroupArn": {UpdateType: "Mutable"}, "CloudWatchLogsRoleArn": {UpdateType: "Mutable"}, "EnableLogFileValidation": {UpdateType: "Mutable"}, "EventSelectors": {Container: "List", PropertyType: "AWS::CloudTrail::Trail.EventSelector", UpdateType: "Mutable"}, "IncludeGlobalServiceEvents": {UpdateType: "Mutable"}, "IsLogging": {UpdateType: "Mutable"}, "IsMultiRegionTrail": {UpdateType: "Mutable"}, "KMSKeyId": {UpdateType: "Mutable"}, "S3BucketName": {UpdateType: "Mutable"}, "S3KeyPrefix": {UpdateType: "Mutable"}, "SnsTopicName": {UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "TrailName": {UpdateType: "Immutable"}, },
+		
+		"AWS::CloudWatch::Alarm": map[string]types.PropertySpec{"ActionsEnabled": {UpdateType: "Mutable"}, "AlarmActions": {Container: "List", UpdateType: "Mutable"}, "AlarmDescription": {UpdateType: "Mutable"}, "AlarmName": {UpdateType: "Immutable"}, "ComparisonOperator": {UpdateType: "Mutable"}, "Dimensions": {Container: "List", PropertyType: "AWS::CloudWatch::Alarm.Dimension", UpdateType: "Mutable"}, "EvaluateLowSampleCountPercentile": {UpdateType: "Mutable"}, "EvaluationPeriods": {UpdateType: "Mutable"}, "ExtendedStatistic": {UpdateType: "Mutable"}, "InsufficientDataActions": {Container: "List", UpdateType: "Mutable"}, "MetricName": {UpdateType: "Mutable"}, "Namespace": {UpdateType: "Mutable"}, "OKActions": {Container: "List", UpdateType: "Mutable"}, "Period": {UpdateType: "Mutable"}, "Statistic": {UpdateType: "Mutable"}, "Threshold": {UpdateType: "Mutable"}, "TreatMissingData": {UpdateType: "Mutable"}, "Unit": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudWatch::Dashboard": map[string]types.PropertySpec{"DashboardBody": {UpdateType: "Mutable"}, "DashboardName": {UpdateType: "Immutable"}, },
+		
+		"AWS::CodeBuild::Project": map[string]types.PropertySpec{"Artifacts": {PropertyType: "AWS::CodeBuild::Project.Artifacts", UpdateType: "Mutable"}, "BadgeEnabled": {UpdateType: "Mutable"}, "Cache": {PropertyType: "AWS::CodeBuild::Project.ProjectCache", UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "EncryptionKey": {UpdateType: "Mutable"}, "Environment": {PropertyType: "AWS::CodeBuild::Project.Environment", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "ServiceRole": {UpdateType: "Mutable"}, "Source": {PropertyType: "AWS::CodeBuild::Project.Source", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "TimeoutInMinutes": {UpdateType: "Mutable"}, "Triggers": {PropertyType: "AWS::CodeBuild::Project.ProjectTriggers", UpdateType: "Mutable"}, "VpcConfig": {PropertyType: "AWS::CodeBuild::Project.VpcConfig", UpdateType: "Mutable"}, },
+		
+		"AWS::CodeCommit::Repository": map[string]types.PropertySpec{"RepositoryDescription": {UpdateType: "Mutable"}, "RepositoryName": {UpdateType: "Mutable"}, "Triggers": {Container: "List", PropertyType: "AWS::CodeCommit::Repository.RepositoryTrigger", UpdateType: "Conditional"}, },
+		
+		"AWS::CodeDeploy::Application": map[string]types.PropertySpec{"ApplicationName": {UpdateType: "Immutable"}, "ComputePlatform": {UpdateType: "Immutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentConfig": map[string]types.PropertySpec{"DeploymentConfigName": {UpdateType: "Immutable"}, "MinimumHealthyHosts": {PropertyType: "AWS::CodeDeploy::DeploymentConfig.MinimumHealthyHosts", UpdateType: "Immutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup": map[string]types.PropertySpec{"AlarmConfiguration": {PropertyType: "AWS::CodeDeploy::DeploymentGroup.AlarmConfiguration", UpdateType: "Mutable"}, "ApplicationName": {UpdateType: "Immutable"}, "AutoRollbackConfiguration": {PropertyType: "AWS::CodeDeploy::DeploymentGroup.AutoRollbackConfiguration", UpdateType: "Mutable"}, "AutoScalingGroups": {Container: "List", UpdateType: "Mutable"}, "Deployment": {PropertyType: "AWS::CodeDeploy::DeploymentGroup.Deployment", UpdateType: "Mutable"}, "DeploymentConfigName": {UpdateType: "Mutable"}, "DeploymentGroupName": {UpdateType: "Immutable"}, "DeploymentStyle": {PropertyType: "AWS::CodeDeploy::DeploymentGroup.DeploymentStyle", UpdateType: "Mutable"}, "Ec2TagFilters": {Container: "List", PropertyType: "AWS::CodeDeploy::DeploymentGroup.EC2TagFilter", UpdateType: "Mutable"}, "LoadBalancerInfo": {PropertyType: "AWS::CodeDeploy::DeploymentGroup.LoadBalancerInfo", UpdateType: "Mutable"}, "OnPremisesInstanceTagFilters": {Container: "List", PropertyType: "AWS::CodeDeploy::DeploymentGroup.TagFilter", UpdateType: "Mutable"}, "ServiceRoleArn": {UpdateType: "Mutable"}, "TriggerConfigurations": {Container: "List", PropertyType: "AWS::CodeDeploy::DeploymentGroup.TriggerConfig", UpdateType: "Mutable"}, },
+		
+		"AWS::CodePipeline::CustomActionType": map[string]types.PropertySpec{"Category": {UpdateType: "Immutable"}, "ConfigurationProperties": {Container: "List", PropertyType: "AWS::CodePipeline::CustomActionType.ConfigurationProperties", UpdateType: "Immutable"}, "InputArtifactDetails": {PropertyType: "AWS::CodePipeline::CustomActionType.ArtifactDetails", UpdateType: "Immutable"}, "OutputArtifactDetails": {PropertyType: "AWS::CodePipeline::CustomActionType.ArtifactDetails", UpdateType: "Immutable"}, "Provider": {UpdateType: "Immutable"}, "Settings": {PropertyType: "AWS::CodePipeline::CustomActionType.Settings", UpdateType: "Immutable"}, "Version": {UpdateType: "Immutable"}, },
+		
+		"AWS::CodePipeline::Pipeline": map[string]types.PropertySpec{"ArtifactStore": {PropertyType: "AWS::CodePipeline::Pipeline.ArtifactStore", UpdateType: "Mutable"}, "DisableInboundStageTransitions": {Container: "List", PropertyType: "AWS::CodePipeline::Pipeline.StageTransition", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "RestartExecutionOnUpdate": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "Stages": {Container: "List", PropertyType: "AWS::CodePipeline::Pipeline.StageDeclaration", UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::IdentityPool": map[string]types.PropertySpec{"AllowUnauthenticatedIdentities": {UpdateType: "Mutable"}, "CognitoEvents": {UpdateType: "Mutable"}, "CognitoIdentityProviders": {Container: "List", PropertyType: "AWS::Cognito::IdentityPool.CognitoIdentityProvider", UpdateType: "Mutable"}, "CognitoStreams": {PropertyType: "AWS::Cognito::IdentityPool.CognitoStreams", UpdateType: "Mutable"}, "DeveloperProviderName": {UpdateType: "Mutable"}, "IdentityPoolName": {UpdateType: "Mutable"}, "OpenIdConnectProviderARNs": {Container: "List", UpdateType: "Mutable"}, "PushSync": {PropertyType: "AWS::Cognito::IdentityPool.PushSync", UpdateType: "Mutable"}, "SamlProviderARNs": {Container: "List", UpdateType: "Mutable"}, "SupportedLoginProviders": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::IdentityPoolRoleAttachment": map[string]types.PropertySpec{"IdentityPoolId": {UpdateType: "Immutable"}, "RoleMappings": {UpdateType: "Mutable"}, "Roles": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPool": map[string]types.PropertySpec{"AdminCreateUserConfig": {PropertyType: "AWS::Cognito::UserPool.AdminCreateUserConfig", UpdateType: "Mutable"}, "AliasAttributes": {Container: "List", UpdateType: "Immutable"}, "AutoVerifiedAttributes": {Container: "List", UpdateType: "Mutable"}, "DeviceConfiguration": {PropertyType: "AWS::Cognito::UserPool.DeviceConfiguration", UpdateType: "Mutable"}, "EmailConfiguration": {PropertyType: "AWS::Cognito::UserPool.EmailConfiguration", UpdateType: "Mutable"}, "EmailVerificationMessage": {UpdateType: "Mutable"}, "EmailVerificationSubject": {UpdateType: "Mutable"}, "LambdaConfig": {PropertyType: "AWS::Cognito::UserPool.LambdaConfig", UpdateType: "Mutable"}, "MfaConfiguration": {UpdateType: "Mutable"}, "Policies": {PropertyType: "AWS::Cognito::UserPool.Policies", UpdateType: "Mutable"}, "Schema": {Container: "List", PropertyType: "AWS::Cognito::UserPool.SchemaAttribute", UpdateType: "Immutable"}, "SmsAuthenticationMessage": {UpdateType: "Mutable"}, "SmsConfiguration": {PropertyType: "AWS::Cognito::UserPool.SmsConfiguration", UpdateType: "Mutable"}, "SmsVerificationMessage": {UpdateType: "Mutable"}, "UserPoolName": {UpdateType: "Immutable"}, "UserPoolTags": {UpdateType: "Mutable"}, "UsernameAttributes": {Container: "List", UpdateType: "Immutable"}, },
+		
+		"AWS::Cognito::UserPoolClient": map[string]types.PropertySpec{"ClientName": {UpdateType: "Mutable"}, "ExplicitAuthFlows": {Container: "List", UpdateType: "Mutable"}, "GenerateSecret": {UpdateType: "Immutable"}, "ReadAttributes": {Container: "List", UpdateType: "Mutable"}, "RefreshTokenValidity": {UpdateType: "Mutable"}, "UserPoolId": {UpdateType: "Immutable"}, "WriteAttributes": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPoolGroup": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "GroupName": {UpdateType: "Immutable"}, "Precedence": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "UserPoolId": {UpdateType: "Immutable"}, },
+		
+		"AWS::Cognito::UserPoolUser": map[string]types.PropertySpec{"DesiredDeliveryMediums": {Container: "List", UpdateType: "Immutable"}, "ForceAliasCreation": {UpdateType: "Immutable"}, "MessageAction": {UpdateType: "Immutable"}, "UserAttributes": {Container: "List", PropertyType: "AWS::Cognito::UserPoolUser.AttributeType", UpdateType: "Immutable"}, "UserPoolId": {UpdateType: "Immutable"}, "Username": {UpdateType: "Immutable"}, "ValidationData": {Container: "List", PropertyType: "AWS::Cognito::UserPoolUser.AttributeType", UpdateType: "Immutable"}, },
+		
+		"AWS::Cognito::UserPoolUserToGroupAttachment": map[string]types.PropertySpec{"GroupName": {UpdateType: "Immutable"}, "UserPoolId": {UpdateType: "Immutable"}, "Username": {UpdateType: "Immutable"}, },
+		
+		"AWS::Config::ConfigRule": map[string]types.PropertySpec{"ConfigRuleName": {UpdateType: "Immutable"}, "Description": {UpdateType: "Mutable"}, "InputParameters": {UpdateType: "Mutable"}, "MaximumExecutionFrequency": {UpdateType: "Mutable"}, "Scope": {PropertyType: "AWS::Config::ConfigRule.Scope", UpdateType: "Mutable"}, "Source": {PropertyType: "AWS::Config::ConfigRule.Source", UpdateType: "Mutable"}, },
+		
+		"AWS::Config::ConfigurationRecorder": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, "RecordingGroup": {PropertyType: "AWS::Config::ConfigurationRecorder.RecordingGroup", UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::Config::DeliveryChannel": map[string]types.PropertySpec{"ConfigSnapshotDeliveryProperties": {PropertyType: "AWS::Config::DeliveryChannel.ConfigSnapshotDeliveryProperties", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "S3BucketName": {UpdateType: "Mutable"}, "S3KeyPrefix": {UpdateType: "Mutable"}, "SnsTopicARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::DAX::Cluster": map[string]types.PropertySpec{"AvailabilityZones": {Container: "List", UpdateType: "Mutable"}, "ClusterName": {UpdateType: "Immutable"}, "Description": {UpdateType: "Mutable"}, "IAMRoleARN": {UpdateType: "Immutable"}, "NodeType": {UpdateType: "Immutable"}, "NotificationTopicARN": {UpdateType: "Mutable"}, "ParameterGroupName": {UpdateType: "Mutable"}, "PreferredMaintenanceWindow": {UpdateType: "Mutable"}, "ReplicationFactor": {UpdateType: "Mutable"}, "SecurityGroupIds": {Container: "List", UpdateType: "Mutable"}, "SubnetGroupName": {UpdateType: "Immutable"}, "Tags": {UpdateType: "Mutable"}, },
+		
+		"AWS::DAX::ParameterGroup": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "ParameterGroupName": {UpdateType: "Immutable"}, "ParameterNameValues": {UpdateType: "Mutable"}, },
+		
+		"AWS::DAX::SubnetGroup": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "SubnetGroupName": {UpdateType: "Immutable"}, "SubnetIds": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::DMS::Certificate": map[string]types.PropertySpec{"CertificateIdentifier": {UpdateType: "Immutable"}, "CertificatePem": {UpdateType: "Immutable"}, "CertificateWallet": {UpdateType: "Immutable"}, },
+		
+		"AWS::DMS::Endpoint": map[string]types.PropertySpec{"CertificateArn": {UpdateType: "Mutable"}, "DatabaseName": {UpdateType: "Mutable"}, "DynamoDbSettings": {PropertyType: "AWS::DMS::Endpoint.DynamoDbSettings", UpdateType: "Mutable"}, "EndpointIdentifier": {UpdateType: "Mutable"}, "EndpointType": {UpdateType: "Mutable"}, "EngineName": {UpdateType: "Mutable"}, "ExtraConnectionAttributes": {UpdateType: "Mutable"}, "KmsKeyId": {UpdateType: "Immutable"}, "MongoDbSettings": {PropertyType: "AWS::DMS::Endpoint.MongoDbSettings", UpdateType: "Mutable"}, "Password": {UpdateType: "Mutable"}, "Port": {UpdateType: "Mutable"}, "S3Settings": {PropertyType: "AWS::DMS::Endpoint.S3Settings", UpdateType: "Mutable"}, "ServerName": {UpdateType: "Mutable"}, "SslMode": {UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Immutable"}, "Username": {UpdateType: "Mutable"}, },
+		
+		"AWS::DMS::EventSubscription": map[string]types.PropertySpec{"Enabled": {UpdateType: "Mutable"}, "EventCategories": {Container: "List", UpdateType: "Mutable"}, "SnsTopicArn": {UpdateType: "Mutable"}, "SourceIds": {Container: "List", UpdateType: "Immutable"}, "SourceType": {UpdateType: "Mutable"}, "SubscriptionName": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Immutable"}, },
+		
+		"AWS::DMS::ReplicationInstance": map[string]types.PropertySpec{"AllocatedStorage": {UpdateType: "Mutable"}, "AllowMajorVersionUpgrade": {UpdateType: "Mutable"}, "AutoMinorVersionUpgrade": {UpdateType: "Mutable"}, "AvailabilityZone": {UpdateType: "Immutable"}, "EngineVersion": {UpdateType: "Mutable"}, "KmsKeyId": {UpdateType: "Immutable"}, "MultiAZ": {UpdateType: "Mutable"}, "PreferredMaintenanceWindow": {UpdateType: "Mutable"}, "PubliclyAccessible": {UpdateType: "Immutable"}, "ReplicationInstanceClass": {UpdateType: "Mutable"}, "ReplicationInstanceIdentifier": {UpdateType: "Mutable"}, "ReplicationSubnetGroupIdentifier": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Immutable"}, "VpcSecurityGroupIds": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::DMS::ReplicationSubnetGroup": map[string]types.PropertySpec{"ReplicationSubnetGroupDescription": {UpdateType: "Mutable"}, "ReplicationSubnetGroupIdentifier": {UpdateType: "Immutable"}, "SubnetIds": {Container: "List", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Immutable"}, },
+		
+		"AWS::DMS::ReplicationTask": map[string]types.PropertySpec{"CdcStartTime": {UpdateType: "Mutable"}, "MigrationType": {UpdateType: "Mutable"}, "ReplicationInstanceArn": {UpdateType: "Immutable"}, "ReplicationTaskIdentifier": {UpdateType: "Mutable"}, "ReplicationTaskSettings": {UpdateType: "Mutable"}, "SourceEndpointArn": {UpdateType: "Immutable"}, "TableMappings": {UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Immutable"}, "TargetEndpointArn": {UpdateType: "Immutable"}, },
+		
+		"AWS::DataPipeline::Pipeline": map[string]types.PropertySpec{"Activate": {UpdateType: "Mutable"}, "Description": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "ParameterObjects": {Container: "List", PropertyType: "AWS::DataPipeline::Pipeline.ParameterObject", UpdateType: "Mutable"}, "ParameterValues": {Container: "List", PropertyType: "AWS::DataPipeline::Pipeline.ParameterValue", UpdateType: "Mutable"}, "PipelineObjects": {Container: "List", PropertyType: "AWS::DataPipeline::Pipeline.PipelineObject", UpdateType: "Mutable"}, "PipelineTags": {Container: "List", PropertyType: "AWS::DataPipeline::Pipeline.PipelineTag", UpdateType: "Mutable"}, },
+		
+		"AWS::DirectoryService::MicrosoftAD": map[string]types.PropertySpec{"CreateAlias": {UpdateType: "Immutable"}, "EnableSso": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "Password": {UpdateType: "Immutable"}, "ShortName": {UpdateType: "Immutable"}, "VpcSettings": {PropertyType: "AWS::DirectoryService::MicrosoftAD.VpcSettings", UpdateType: "Immutable"}, },
+		
+		"AWS::DirectoryService::SimpleAD": map[string]types.PropertySpec{"CreateAlias": {UpdateType: "Immutable"}, "Description": {UpdateType: "Immutable"}, "EnableSso": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "Password": {UpdateType: "Immutable"}, "ShortName": {UpdateType: "Immutable"}, "Size": {UpdateType: "Immutable"}, "VpcSettings": {PropertyType: "AWS::DirectoryService::SimpleAD.VpcSettings", UpdateType: "Immutable"}, },
+		
+		"AWS::DynamoDB::Table": map[string]types.PropertySpec{"AttributeDefinitions": {Container: "List", PropertyType: "AWS::DynamoDB::Table.AttributeDefinition", UpdateType: "Conditional"}, "GlobalSecondaryIndexes": {Container: "List", PropertyType: "AWS::DynamoDB::Table.GlobalSecondaryIndex", UpdateType: "Mutable"}, "KeySchema": {Container: "List", PropertyType: "AWS::DynamoDB::Table.KeySchema", UpdateType: "Immutable"}, "LocalSecondaryIndexes": {Container: "List", PropertyType: "AWS::DynamoDB::Table.LocalSecondaryIndex", UpdateType: "Immutable"}, "ProvisionedThroughput": {PropertyType: "AWS::DynamoDB::Table.ProvisionedThroughput", UpdateType: "Mutable"}, "SSESpecification": {PropertyType: "AWS::DynamoDB::Table.SSESpecification", UpdateType: "Conditional"}, "StreamSpecification": {PropertyType: "AWS::DynamoDB::Table.StreamSpecification", UpdateType: "Mutable"}, "TableName": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "TimeToLiveSpecification": {PropertyType: "AWS::DynamoDB::Table.TimeToLiveSpecification", UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::CustomerGateway": map[string]types.PropertySpec{"BgpAsn": {UpdateType: "Immutable"}, "IpAddress": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "Type": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::DHCPOptions": map[string]types.PropertySpec{"DomainName": {UpdateType: "Immutable"}, "DomainNameServers": {Container: "List", UpdateType: "Immutable"}, "NetbiosNameServers": {Container: "List", UpdateType: "Immutable"}, "NetbiosNodeType": {UpdateType: "Immutable"}, "NtpServers": {Container: "List", UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::EIP": map[string]types.PropertySpec{"Domain": {UpdateType: "Immutable"}, "InstanceId": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::EIPAssociation": map[string]types.PropertySpec{"AllocationId": {UpdateType: "Conditional"}, "EIP": {UpdateType: "Conditional"}, "InstanceId": {UpdateType: "Conditional"}, "NetworkInterfaceId": {UpdateType: "Conditional"}, "PrivateIpAddress": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::EgressOnlyInternetGateway": map[string]types.PropertySpec{"VpcId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::FlowLog": map[string]types.PropertySpec{"DeliverLogsPermissionArn": {UpdateType: "Immutable"}, "LogGroupName": {UpdateType: "Immutable"}, "ResourceId": {UpdateType: "Immutable"}, "ResourceType": {UpdateType: "Immutable"}, "TrafficType": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::Host": map[string]types.PropertySpec{"AutoPlacement": {UpdateType: "Mutable"}, "AvailabilityZone": {UpdateType: "Immutable"}, "InstanceType": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::Instance": map[string]types.PropertySpec{"AdditionalInfo": {UpdateType: "Conditional"}, "Affinity": {UpdateType: "Conditional"}, "AvailabilityZone": {UpdateType: "Immutable"}, "BlockDeviceMappings": {Container: "List", PropertyType: "AWS::EC2::Instance.BlockDeviceMapping", UpdateType: "Conditional"}, "CreditSpecification": {PropertyType: "AWS::EC2::Instance.CreditSpecification", UpdateType: "Mutable"}, "DisableApiTermination": {UpdateType: "Mutable"}, "EbsOptimized": {UpdateType: "Conditional"}, "ElasticGpuSpecifications": {Container: "List", PropertyType: "AWS::EC2::Instance.ElasticGpuSpecification", UpdateType: "Immutable"}, "HostId": {UpdateType: "Conditional"}, "IamInstanceProfile": {UpdateType: "Mutable"}, "ImageId": {UpdateType: "Immutable"}, "InstanceInitiatedShutdownBehavior": {UpdateType: "Mutable"}, "InstanceType": {UpdateType: "Conditional"}, "Ipv6AddressCount": {UpdateType: "Immutable"}, "Ipv6Addresses": {Container: "List", PropertyType: "AWS::EC2::Instance.InstanceIpv6Address", UpdateType: "Immutable"}, "KernelId": {UpdateType: "Conditional"}, "KeyName": {UpdateType: "Immutable"}, "Monitoring": {UpdateType: "Mutable"}, "NetworkInterfaces": {Container: "List", PropertyType: "AWS::EC2::Instance.NetworkInterface", UpdateType: "Immutable"}, "PlacementGroupName": {UpdateType: "Immutable"}, "PrivateIpAddress": {UpdateType: "Immutable"}, "RamdiskId": {UpdateType: "Conditional"}, "SecurityGroupIds": {Container: "List", UpdateType: "Conditional"}, "SecurityGroups": {Container: "List", UpdateType: "Immutable"}, "SourceDestCheck": {UpdateType: "Mutable"}, "SsmAssociations": {Container: "List", PropertyType: "AWS::EC2::Instance.SsmAssociation", UpdateType: "Mutable"}, "SubnetId": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "Tenancy": {UpdateType: "Conditional"}, "UserData": {UpdateType: "Conditional"}, "Volumes": {Container: "List", PropertyType: "AWS::EC2::Instance.Volume", UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::InternetGateway": map[string]types.PropertySpec{"Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate": map[string]types.PropertySpec{"LaunchTemplateData": {PropertyType: "AWS::EC2::LaunchTemplate.LaunchTemplateData", UpdateType: "Mutable"}, "LaunchTemplateName": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::NatGateway": map[string]types.PropertySpec{"AllocationId": {UpdateType: "Immutable"}, "SubnetId": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::NetworkAcl": map[string]types.PropertySpec{"Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "VpcId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::NetworkAclEntry": map[string]types.PropertySpec{"CidrBlock": {UpdateType: "Mutable"}, "Egress": {UpdateType: "Immutable"}, "Icmp": {PropertyType: "AWS::EC2::NetworkAclEntry.Icmp", UpdateType: "Mutable"}, "Ipv6CidrBlock": {UpdateType: "Mutable"}, "NetworkAclId": {UpdateType: "Immutable"}, "PortRange": {PropertyType: "AWS::EC2::NetworkAclEntry.PortRange", UpdateType: "Mutable"}, "Protocol": {UpdateType: "Mutable"}, "RuleAction": {UpdateType: "Mutable"}, "RuleNumber": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::NetworkInterface": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "GroupSet": {Container: "List", UpdateType: "Mutable"}, "InterfaceType": {UpdateType: "Immutable"}, "Ipv6AddressCount": {UpdateType: "Mutable"}, "Ipv6Addresses": {PropertyType: "AWS::EC2::NetworkInterface.InstanceIpv6Address", UpdateType: "Mutable"}, "PrivateIpAddress": {UpdateType: "Immutable"}, "PrivateIpAddresses": {Container: "List", PropertyType: "AWS::EC2::NetworkInterface.PrivateIpAddressSpecification", UpdateType: "Conditional"}, "SecondaryPrivateIpAddressCount": {UpdateType: "Mutable"}, "SourceDestCheck": {UpdateType: "Mutable"}, "SubnetId": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::NetworkInterfaceAttachment": map[string]types.PropertySpec{"DeleteOnTermination": {UpdateType: "Mutable"}, "DeviceIndex": {UpdateType: "Mutable"}, "InstanceId": {UpdateType: "Mutable"}, "NetworkInterfaceId": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::NetworkInterfacePermission": map[string]types.PropertySpec{"AwsAccountId": {UpdateType: "Immutable"}, "NetworkInterfaceId": {UpdateType: "Immutable"}, "Permission": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::PlacementGroup": map[string]types.PropertySpec{"Strategy": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::Route": map[string]types.PropertySpec{"DestinationCidrBlock": {UpdateType: "Immutable"}, "DestinationIpv6CidrBlock": {UpdateType: "Mutable"}, "EgressOnlyInternetGatewayId": {UpdateType: "Mutable"}, "GatewayId": {UpdateType: "Mutable"}, "InstanceId": {UpdateType: "Mutable"}, "NatGatewayId": {UpdateType: "Mutable"}, "NetworkInterfaceId": {UpdateType: "Mutable"}, "RouteTableId": {UpdateType: "Immutable"}, "VpcPeeringConnectionId": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::RouteTable": map[string]types.PropertySpec{"Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "VpcId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::SecurityGroup": map[string]types.PropertySpec{"GroupDescription": {UpdateType: "Immutable"}, "GroupName": {UpdateType: "Immutable"}, "SecurityGroupEgress": {Container: "List", PropertyType: "AWS::EC2::SecurityGroup.Egress", UpdateType: "Mutable"}, "SecurityGroupIngress": {Container: "List", PropertyType: "AWS::EC2::SecurityGroup.Ingress", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "VpcId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::SecurityGroupEgress": map[string]types.PropertySpec{"CidrIp": {UpdateType: "Immutable"}, "CidrIpv6": {UpdateType: "Immutable"}, "Description": {UpdateType: "Mutable"}, "DestinationPrefixListId": {UpdateType: "Immutable"}, "DestinationSecurityGroupId": {UpdateType: "Immutable"}, "FromPort": {UpdateType: "Immutable"}, "GroupId": {UpdateType: "Immutable"}, "IpProtocol": {UpdateType: "Immutable"}, "ToPort": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::SecurityGroupIngress": map[string]types.PropertySpec{"CidrIp": {UpdateType: "Immutable"}, "CidrIpv6": {UpdateType: "Immutable"}, "Description": {UpdateType: "Mutable"}, "FromPort": {UpdateType: "Immutable"}, "GroupId": {UpdateType: "Immutable"}, "GroupName": {UpdateType: "Immutable"}, "IpProtocol": {UpdateType: "Immutable"}, "SourceSecurityGroupId": {UpdateType: "Immutable"}, "SourceSecurityGroupName": {UpdateType: "Immutable"}, "SourceSecurityGroupOwnerId": {UpdateType: "Immutable"}, "ToPort": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::SpotFleet": map[string]types.PropertySpec{"SpotFleetRequestConfigData": {PropertyType: "AWS::EC2::SpotFleet.SpotFleetRequestConfigData", UpdateType: "Conditional"}, },
+		
+		"AWS::EC2::Subnet": map[string]types.PropertySpec{"AssignIpv6AddressOnCreation": {UpdateType: "Mutable"}, "AvailabilityZone": {UpdateType: "Immutable"}, "CidrBlock": {UpdateType: "Immutable"}, "Ipv6CidrBlock": {UpdateType: "Mutable"}, "MapPublicIpOnLaunch": {UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "VpcId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::SubnetCidrBlock": map[string]types.PropertySpec{"Ipv6CidrBlock": {UpdateType: "Immutable"}, "SubnetId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::SubnetNetworkAclAssociation": map[string]types.PropertySpec{"NetworkAclId": {UpdateType: "Immutable"}, "SubnetId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::SubnetRouteTableAssociation": map[string]types.PropertySpec{"RouteTableId": {UpdateType: "Mutable"}, "SubnetId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::TrunkInterfaceAssociation": map[string]types.PropertySpec{"BranchInterfaceId": {UpdateType: "Immutable"}, "GREKey": {UpdateType: "Immutable"}, "TrunkInterfaceId": {UpdateType: "Immutable"}, "VLANId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::VPC": map[string]types.PropertySpec{"CidrBlock": {UpdateType: "Immutable"}, "EnableDnsHostnames": {UpdateType: "Mutable"}, "EnableDnsSupport": {UpdateType: "Mutable"}, "InstanceTenancy": {UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::VPCCidrBlock": map[string]types.PropertySpec{"AmazonProvidedIpv6CidrBlock": {UpdateType: "Immutable"}, "CidrBlock": {UpdateType: "Immutable"}, "VpcId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::VPCDHCPOptionsAssociation": map[string]types.PropertySpec{"DhcpOptionsId": {UpdateType: "Mutable"}, "VpcId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::VPCEndpoint": map[string]types.PropertySpec{"PolicyDocument": {UpdateType: "Mutable"}, "RouteTableIds": {Container: "List", UpdateType: "Mutable"}, "ServiceName": {UpdateType: "Immutable"}, "VpcId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::VPCGatewayAttachment": map[string]types.PropertySpec{"InternetGatewayId": {UpdateType: "Mutable"}, "VpcId": {UpdateType: "Mutable"}, "VpnGatewayId": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::VPCPeeringConnection": map[string]types.PropertySpec{"PeerOwnerId": {UpdateType: "Immutable"}, "PeerRoleArn": {UpdateType: "Immutable"}, "PeerVpcId": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "VpcId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::VPNConnection": map[string]types.PropertySpec{"CustomerGatewayId": {UpdateType: "Immutable"}, "StaticRoutesOnly": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "Type": {UpdateType: "Immutable"}, "VpnGatewayId": {UpdateType: "Immutable"}, "VpnTunnelOptionsSpecifications": {Container: "List", PropertyType: "AWS::EC2::VPNConnection.VpnTunnelOptionsSpecification", UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::VPNConnectionRoute": map[string]types.PropertySpec{"DestinationCidrBlock": {UpdateType: "Immutable"}, "VpnConnectionId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::VPNGateway": map[string]types.PropertySpec{"AmazonSideAsn": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "Type": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::VPNGatewayRoutePropagation": map[string]types.PropertySpec{"RouteTableIds": {Container: "List", UpdateType: "Mutable"}, "VpnGatewayId": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::Volume": map[string]types.PropertySpec{"AutoEnableIO": {UpdateType: "Mutable"}, "AvailabilityZone": {UpdateType: "Mutable"}, "Encrypted": {UpdateType: "Mutable"}, "Iops": {UpdateType: "Mutable"}, "KmsKeyId": {UpdateType: "Mutable"}, "Size": {UpdateType: "Mutable"}, "SnapshotId": {UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "VolumeType": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::VolumeAttachment": map[string]types.PropertySpec{"Device": {UpdateType: "Immutable"}, "InstanceId": {UpdateType: "Immutable"}, "VolumeId": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECR::Repository": map[string]types.PropertySpec{"LifecyclePolicy": {PropertyType: "AWS::ECR::Repository.LifecyclePolicy", UpdateType: "Mutable"}, "RepositoryName": {UpdateType: "Immutable"}, "RepositoryPolicyText": {UpdateType: "Mutable"}, },
+		
+		"AWS::ECS::Cluster": map[string]types.PropertySpec{"ClusterName": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::Service": map[string]types.PropertySpec{"Cluster": {UpdateType: "Immutable"}, "DeploymentConfiguration": {PropertyType: "AWS::ECS::Service.DeploymentConfiguration", UpdateType: "Mutable"}, "DesiredCount": {UpdateType: "Mutable"}, "HealthCheckGracePeriodSeconds": {UpdateType: "Mutable"}, "LaunchType": {UpdateType: "Immutable"}, "LoadBalancers": {Container: "List", PropertyType: "AWS::ECS::Service.LoadBalancer", UpdateType: "Immutable"}, "NetworkConfiguration": {PropertyType: "AWS::ECS::Service.NetworkConfiguration", UpdateType: "Mutable"}, "PlacementConstraints": {Container: "List", PropertyType: "AWS::ECS::Service.PlacementConstraint", UpdateType: "Immutable"}, "PlacementStrategies": {Container: "List", PropertyType: "AWS::ECS::Service.PlacementStrategy", UpdateType: "Immutable"}, "PlatformVersion": {UpdateType: "Immutable"}, "Role": {UpdateType: "Immutable"}, "ServiceName": {UpdateType: "Immutable"}, "TaskDefinition": {UpdateType: "Mutable"}, },
+		
+		"AWS::ECS::TaskDefinition": map[string]types.PropertySpec{"ContainerDefinitions": {Container: "List", PropertyType: "AWS::ECS::TaskDefinition.ContainerDefinition", UpdateType: "Immutable"}, "Cpu": {UpdateType: "Immutable"}, "ExecutionRoleArn": {UpdateType: "Immutable"}, "Family": {UpdateType: "Immutable"}, "Memory": {UpdateType: "Immutable"}, "NetworkMode": {UpdateType: "Immutable"}, "PlacementConstraints": {Container: "List", PropertyType: "AWS::ECS::TaskDefinition.TaskDefinitionPlacementConstraint", UpdateType: "Immutable"}, "RequiresCompatibilities": {Container: "List", UpdateType: "Immutable"}, "TaskRoleArn": {UpdateType: "Immutable"}, "Volumes": {Container: "List", PropertyType: "AWS::ECS::TaskDefinition.Volume", UpdateType: "Mutable"}, },
+		
+		"AWS::EFS::FileSystem": map[string]types.PropertySpec{"Encrypted": {UpdateType: "Immutable"}, "FileSystemTags": {Container: "List", PropertyType: "AWS::EFS::FileSystem.ElasticFileSystemTag", UpdateType: "Mutable"}, "KmsKeyId": {UpdateType: "Immutable"}, "PerformanceMode": {UpdateType: "Immutable"}, },
+		
+		"AWS::EFS::MountTarget": map[string]types.PropertySpec{"FileSystemId": {UpdateType: "Immutable"}, "IpAddress": {UpdateType: "Immutable"}, "SecurityGroups": {Container: "List", UpdateType: "Mutable"}, "SubnetId": {UpdateType: "Immutable"}, },
+		
+		"AWS::EMR::Cluster": map[string]types.PropertySpec{"AdditionalInfo": {UpdateType: "Immutable"}, "Applications": {Container: "List", PropertyType: "AWS::EMR::Cluster.Application", UpdateType: "Immutable"}, "AutoScalingRole": {UpdateType: "Immutable"}, "BootstrapActions": {Container: "List", PropertyType: "AWS::EMR::Cluster.BootstrapActionConfig", UpdateType: "Immutable"}, "Configurations": {Container: "List", PropertyType: "AWS::EMR::Cluster.Configuration", UpdateType: "Immutable"}, "CustomAmiId": {UpdateType: "Immutable"}, "EbsRootVolumeSize": {UpdateType: "Immutable"}, "Instances": {PropertyType: "AWS::EMR::Cluster.JobFlowInstancesConfig", UpdateType: "Conditional"}, "JobFlowRole": {UpdateType: "Immutable"}, "LogUri": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "ReleaseLabel": {UpdateType: "Immutable"}, "ScaleDownBehavior": {UpdateType: "Immutable"}, "SecurityConfiguration": {UpdateType: "Immutable"}, "ServiceRole": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "VisibleToAllUsers": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceFleetConfig": map[string]types.PropertySpec{"ClusterId": {UpdateType: "Immutable"}, "InstanceFleetType": {UpdateType: "Immutable"}, "InstanceTypeConfigs": {Container: "List", PropertyType: "AWS::EMR::InstanceFleetConfig.InstanceTypeConfig", UpdateType: "Immutable"}, "LaunchSpecifications": {PropertyType: "AWS::EMR::InstanceFleetConfig.InstanceFleetProvisioningSpecifications", UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "TargetOnDemandCapacity": {UpdateType: "Mutable"}, "TargetSpotCapacity": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceGroupConfig": map[string]types.PropertySpec{"AutoScalingPolicy": {PropertyType: "AWS::EMR::InstanceGroupConfig.AutoScalingPolicy", UpdateType: "Mutable"}, "BidPrice": {UpdateType: "Immutable"}, "Configurations": {Container: "List", PropertyType: "AWS::EMR::InstanceGroupConfig.Configuration", UpdateType: "Immutable"}, "EbsConfiguration": {PropertyType: "AWS::EMR::InstanceGroupConfig.EbsConfiguration", UpdateType: "Immutable"}, "InstanceCount": {UpdateType: "Mutable"}, "InstanceRole": {UpdateType: "Immutable"}, "InstanceType": {UpdateType: "Immutable"}, "JobFlowId": {UpdateType: "Immutable"}, "Market": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::EMR::SecurityConfiguration": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, "SecurityConfiguration": {UpdateType: "Immutable"}, },
+		
+		"AWS::EMR::Step": map[string]types.PropertySpec{"ActionOnFailure": {UpdateType: "Immutable"}, "HadoopJarStep": {PropertyType: "AWS::EMR::Step.HadoopJarStepConfig", UpdateType: "Immutable"}, "JobFlowId": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::ElastiCache::CacheCluster": map[string]types.PropertySpec{"AZMode": {UpdateType: "Conditional"}, "AutoMinorVersionUpgrade": {UpdateType: "Mutable"}, "CacheNodeType": {UpdateType: "Immutable"}, "CacheParameterGroupName": {UpdateType: "Mutable"}, "CacheSecurityGroupNames": {Container: "List", UpdateType: "Mutable"}, "CacheSubnetGroupName": {UpdateType: "Immutable"}, "ClusterName": {UpdateType: "Immutable"}, "Engine": {UpdateType: "Immutable"}, "EngineVersion": {UpdateType: "Mutable"}, "NotificationTopicArn": {UpdateType: "Mutable"}, "NumCacheNodes": {UpdateType: "Conditional"}, "Port": {UpdateType: "Immutable"}, "PreferredAvailabilityZone": {UpdateType: "Conditional"}, "PreferredAvailabilityZones": {Container: "List", UpdateType: "Conditional"}, "PreferredMaintenanceWindow": {UpdateType: "Mutable"}, "SnapshotArns": {Container: "List", UpdateType: "Immutable"}, "SnapshotName": {UpdateType: "Immutable"}, "SnapshotRetentionLimit": {UpdateType: "Mutable"}, "SnapshotWindow": {UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "VpcSecurityGroupIds": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::ElastiCache::ParameterGroup": map[string]types.PropertySpec{"CacheParameterGroupFamily": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "Properties": {Container: "Map", UpdateType: "Mutable"}, },
+		
+		"AWS::ElastiCache::ReplicationGroup": map[string]types.PropertySpec{"AtRestEncryptionEnabled": {UpdateType: "Immutable"}, "AuthToken": {UpdateType: "Immutable"}, "AutoMinorVersionUpgrade": {UpdateType: "Mutable"}, "AutomaticFailoverEnabled": {UpdateType: "Mutable"}, "CacheNodeType": {UpdateType: "Mutable"}, "CacheParameterGroupName": {UpdateType: "Mutable"}, "CacheSecurityGroupNames": {Container: "List", UpdateType: "Mutable"}, "CacheSubnetGroupName": {UpdateType: "Immutable"}, "Engine": {UpdateType: "Immutable"}, "EngineVersion": {UpdateType: "Mutable"}, "NodeGroupConfiguration": {Container: "List", PropertyType: "AWS::ElastiCache::ReplicationGroup.NodeGroupConfiguration", UpdateType: "Immutable"}, "NotificationTopicArn": {UpdateType: "Mutable"}, "NumCacheClusters": {UpdateType: "Mutable"}, "NumNodeGroups": {UpdateType: "Immutable"}, "Port": {UpdateType: "Immutable"}, "PreferredCacheClusterAZs": {Container: "List", UpdateType: "Immutable"}, "PreferredMaintenanceWindow": {UpdateType: "Mutable"}, "PrimaryClusterId": {UpdateType: "Mutable"}, "ReplicasPerNodeGroup": {UpdateType: "Immutable"}, "ReplicationGroupDescription": {UpdateType: "Mutable"}, "ReplicationGroupId": {UpdateType: "Immutable"}, "SecurityGroupIds": {Container: "List", UpdateType: "Mutable"}, "SnapshotArns": {Container: "List", UpdateType: "Immutable"}, "SnapshotName": {UpdateType: "Immutable"}, "SnapshotRetentionLimit": {UpdateType: "Mutable"}, "SnapshotWindow": {UpdateType: "Mutable"}, "SnapshottingClusterId": {UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "TransitEncryptionEnabled": {UpdateType: "Immutable"}, },
+		
+		"AWS::ElastiCache::SecurityGroup": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElastiCache::SecurityGroupIngress": map[string]types.PropertySpec{"CacheSecurityGroupName": {UpdateType: "Mutable"}, "EC2SecurityGroupName": {UpdateType: "Mutable"}, "EC2SecurityGroupOwnerId": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElastiCache::SubnetGroup": map[string]types.PropertySpec{"CacheSubnetGroupName": {UpdateType: "Immutable"}, "Description": {UpdateType: "Mutable"}, "SubnetIds": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticBeanstalk::Application": map[string]types.PropertySpec{"ApplicationName": {UpdateType: "Immutable"}, "Description": {UpdateType: "Mutable"}, "ResourceLifecycleConfig": {PropertyType: "AWS::ElasticBeanstalk::Application.ApplicationResourceLifecycleConfig", UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticBeanstalk::ApplicationVersion": map[string]types.PropertySpec{"ApplicationName": {UpdateType: "Immutable"}, "Description": {UpdateType: "Mutable"}, "SourceBundle": {PropertyType: "AWS::ElasticBeanstalk::ApplicationVersion.SourceBundle", UpdateType: "Immutable"}, },
+		
+		"AWS::ElasticBeanstalk::ConfigurationTemplate": map[string]types.PropertySpec{"ApplicationName": {UpdateType: "Immutable"}, "Description": {UpdateType: "Mutable"}, "EnvironmentId": {UpdateType: "Immutable"}, "OptionSettings": {Container: "List", PropertyType: "AWS::ElasticBeanstalk::ConfigurationTemplate.ConfigurationOptionSetting", UpdateType: "Mutable"}, "PlatformArn": {UpdateType: "Immutable"}, "SolutionStackName": {UpdateType: "Immutable"}, "SourceConfiguration": {PropertyType: "AWS::ElasticBeanstalk::ConfigurationTemplate.SourceConfiguration", UpdateType: "Immutable"}, },
+		
+		"AWS::ElasticBeanstalk::Environment": map[string]types.PropertySpec{"ApplicationName": {UpdateType: "Immutable"}, "CNAMEPrefix": {UpdateType: "Immutable"}, "Description": {UpdateType: "Mutable"}, "EnvironmentName": {UpdateType: "Immutable"}, "OptionSettings": {Container: "List", PropertyType: "AWS::ElasticBeanstalk::Environment.OptionSetting", UpdateType: "Mutable"}, "PlatformArn": {UpdateType: "Mutable"}, "SolutionStackName": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "TemplateName": {UpdateType: "Mutable"}, "Tier": {PropertyType: "AWS::ElasticBeanstalk::Environment.Tier", UpdateType: "Conditional"}, "VersionLabel": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancing::LoadBalancer": map[string]types.PropertySpec{"AccessLoggingPolicy": {PropertyType: "AWS::ElasticLoadBalancing::LoadBalancer.AccessLoggingPolicy", UpdateType: "Mutable"}, "AppCookieStickinessPolicy": {Container: "List", PropertyType: "AWS::ElasticLoadBalancing::LoadBalancer.AppCookieStickinessPolicy", UpdateType: "Mutable"}, "AvailabilityZones": {Container: "List", UpdateType: "Conditional"}, "ConnectionDrainingPolicy": {PropertyType: "AWS::ElasticLoadBalancing::LoadBalancer.ConnectionDrainingPolicy", UpdateType: "Mutable"}, "ConnectionSettings": {PropertyType: "AWS::ElasticLoadBalancing::LoadBalancer.ConnectionSettings", UpdateType: "Mutable"}, "CrossZone": {UpdateType: "Mutable"}, "HealthCheck": {PropertyType: "AWS::ElasticLoadBalancing::LoadBalancer.HealthCheck", UpdateType: "Conditional"}, "Instances": {Container: "List", UpdateType: "Mutable"}, "LBCookieStickinessPolicy": {Container: "List", PropertyType: "AWS::ElasticLoadBalancing::LoadBalancer.LBCookieStickinessPolicy", UpdateType: "Mutable"}, "Listeners": {Container: "List", PropertyType: "AWS::ElasticLoadBalancing::LoadBalancer.Listeners", UpdateType: "Mutable"}, "LoadBalancerName": {UpdateType: "Immutable"}, "Policies": {Container: "List", PropertyType: "AWS::ElasticLoadBalancing::LoadBalancer.Policies", UpdateType: "Mutable"}, "Scheme": {UpdateType: "Immutable"}, "SecurityGroups": {Container: "List", UpdateType: "Mutable"}, "Subnets": {Container: "List", UpdateType: "Conditional"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::Listener": map[string]types.PropertySpec{"Certificates": {Container: "List", PropertyType: "AWS::ElasticLoadBalancingV2::Listener.Certificate", UpdateType: "Mutable"}, "DefaultActions": {Container: "List", PropertyType: "AWS::ElasticLoadBalancingV2::Listener.Action", UpdateType: "Mutable"}, "LoadBalancerArn": {UpdateType: "Immutable"}, "Port": {UpdateType: "Mutable"}, "Protocol": {UpdateType: "Mutable"}, "SslPolicy": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::ListenerCertificate": map[string]types.PropertySpec{"Certificates": {Container: "List", PropertyType: "AWS::ElasticLoadBalancingV2::ListenerCertificate.Certificate", UpdateType: "Immutable"}, "ListenerArn": {UpdateType: "Immutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::ListenerRule": map[string]types.PropertySpec{"Actions": {Container: "List", PropertyType: "AWS::ElasticLoadBalancingV2::ListenerRule.Action", UpdateType: "Mutable"}, "Conditions": {Container: "List", PropertyType: "AWS::ElasticLoadBalancingV2::ListenerRule.RuleCondition", UpdateType: "Mutable"}, "ListenerArn": {UpdateType: "Immutable"}, "Priority": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::LoadBalancer": map[string]types.PropertySpec{"IpAddressType": {UpdateType: "Mutable"}, "LoadBalancerAttributes": {Container: "List", PropertyType: "AWS::ElasticLoadBalancingV2::LoadBalancer.LoadBalancerAttribute", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "Scheme": {UpdateType: "Immutable"}, "SecurityGroups": {Container: "List", UpdateType: "Mutable"}, "SubnetMappings": {Container: "List", PropertyType: "AWS::ElasticLoadBalancingV2::LoadBalancer.SubnetMapping", UpdateType: "Immutable"}, "Subnets": {Container: "List", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "Type": {UpdateType: "Immutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::TargetGroup": map[string]types.PropertySpec{"HealthCheckIntervalSeconds": {UpdateType: "Mutable"}, "HealthCheckPath": {UpdateType: "Mutable"}, "HealthCheckPort": {UpdateType: "Mutable"}, "HealthCheckProtocol": {UpdateType: "Mutable"}, "HealthCheckTimeoutSeconds": {UpdateType: "Mutable"}, "HealthyThresholdCount": {UpdateType: "Mutable"}, "Matcher": {PropertyType: "AWS::ElasticLoadBalancingV2::TargetGroup.Matcher", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "Port": {UpdateType: "Immutable"}, "Protocol": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "TargetGroupAttributes": {Container: "List", PropertyType: "AWS::ElasticLoadBalancingV2::TargetGroup.TargetGroupAttribute", UpdateType: "Mutable"}, "TargetType": {UpdateType: "Immutable"}, "Targets": {Container: "List", PropertyType: "AWS::ElasticLoadBalancingV2::TargetGroup.TargetDescription", UpdateType: "Mutable"}, "UnhealthyThresholdCount": {UpdateType: "Mutable"}, "VpcId": {UpdateType: "Immutable"}, },
+		
+		"AWS::Elasticsearch::Domain": map[string]types.PropertySpec{"AccessPolicies": {UpdateType: "Mutable"}, "AdvancedOptions": {Container: "Map", UpdateType: "Mutable"}, "DomainName": {UpdateType: "Immutable"}, "EBSOptions": {PropertyType: "AWS::Elasticsearch::Domain.EBSOptions", UpdateType: "Mutable"}, "ElasticsearchClusterConfig": {PropertyType: "AWS::Elasticsearch::Domain.ElasticsearchClusterConfig", UpdateType: "Mutable"}, "ElasticsearchVersion": {UpdateType: "Immutable"}, "SnapshotOptions": {PropertyType: "AWS::Elasticsearch::Domain.SnapshotOptions", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "VPCOptions": {PropertyType: "AWS::Elasticsearch::Domain.VPCOptions", UpdateType: "Mutable"}, },
+		
+		"AWS::Events::Rule": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "EventPattern": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "RoleArn": {UpdateType: "Mutable"}, "ScheduleExpression": {UpdateType: "Mutable"}, "State": {UpdateType: "Mutable"}, "Targets": {Container: "List", PropertyType: "AWS::Events::Rule.Target", UpdateType: "Mutable"}, },
+		
+		"AWS::GameLift::Alias": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "RoutingStrategy": {PropertyType: "AWS::GameLift::Alias.RoutingStrategy", UpdateType: "Mutable"}, },
+		
+		"AWS::GameLift::Build": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "StorageLocation": {PropertyType: "AWS::GameLift::Build.S3Location", UpdateType: "Immutable"}, "Version": {UpdateType: "Mutable"}, },
+		
+		"AWS::GameLift::Fleet": map[string]types.PropertySpec{"BuildId": {UpdateType: "Immutable"}, "Description": {UpdateType: "Mutable"}, "DesiredEC2Instances": {UpdateType: "Mutable"}, "EC2InboundPermissions": {Container: "List", PropertyType: "AWS::GameLift::Fleet.IpPermission", UpdateType: "Mutable"}, "EC2InstanceType": {UpdateType: "Immutable"}, "LogPaths": {Container: "List", UpdateType: "Immutable"}, "MaxSize": {UpdateType: "Mutable"}, "MinSize": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "ServerLaunchParameters": {UpdateType: "Immutable"}, "ServerLaunchPath": {UpdateType: "Immutable"}, },
+		
+		"AWS::Glue::Classifier": map[string]types.PropertySpec{"GrokClassifier": {PropertyType: "AWS::Glue::Classifier.GrokClassifier", UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Connection": map[string]types.PropertySpec{"CatalogId": {UpdateType: "Immutable"}, "ConnectionInput": {PropertyType: "AWS::Glue::Connection.ConnectionInput", UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Crawler": map[string]types.PropertySpec{"Classifiers": {Container: "List", UpdateType: "Mutable"}, "DatabaseName": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "Role": {UpdateType: "Mutable"}, "Schedule": {PropertyType: "AWS::Glue::Crawler.Schedule", UpdateType: "Mutable"}, "SchemaChangePolicy": {PropertyType: "AWS::Glue::Crawler.SchemaChangePolicy", UpdateType: "Mutable"}, "TablePrefix": {UpdateType: "Mutable"}, "Targets": {PropertyType: "AWS::Glue::Crawler.Targets", UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Database": map[string]types.PropertySpec{"CatalogId": {UpdateType: "Immutable"}, "DatabaseInput": {PropertyType: "AWS::Glue::Database.DatabaseInput", UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::DevEndpoint": map[string]types.PropertySpec{"EndpointName": {UpdateType: "Immutable"}, "ExtraJarsS3Path": {UpdateType: "Mutable"}, "ExtraPythonLibsS3Path": {UpdateType: "Mutable"}, "NumberOfNodes": {UpdateType: "Mutable"}, "PublicKey": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "SecurityGroupIds": {Container: "List", UpdateType: "Mutable"}, "SubnetId": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Job": map[string]types.PropertySpec{"AllocatedCapacity": {UpdateType: "Mutable"}, "Command": {PropertyType: "AWS::Glue::Job.JobCommand", UpdateType: "Mutable"}, "Connections": {PropertyType: "AWS::Glue::Job.ConnectionsList", UpdateType: "Mutable"}, "DefaultArguments": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "ExecutionProperty": {PropertyType: "AWS::Glue::Job.ExecutionProperty", UpdateType: "Mutable"}, "LogUri": {UpdateType: "Mutable"}, "MaxRetries": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "Role": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Partition": map[string]types.PropertySpec{"CatalogId": {UpdateType: "Immutable"}, "DatabaseName": {UpdateType: "Immutable"}, "PartitionInput": {PropertyType: "AWS::Glue::Partition.PartitionInput", UpdateType: "Mutable"}, "TableName": {UpdateType: "Immutable"}, },
+		
+		"AWS::Glue::Table": map[string]types.PropertySpec{"CatalogId": {UpdateType: "Immutable"}, "DatabaseName": {UpdateType: "Immutable"}, "TableInput": {PropertyType: "AWS::Glue::Table.TableInput", UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Trigger": map[string]types.PropertySpec{"Actions": {Container: "List", PropertyType: "AWS::Glue::Trigger.Action", UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "Predicate": {PropertyType: "AWS::Glue::Trigger.Predicate", UpdateType: "Mutable"}, "Schedule": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::GuardDuty::Detector": map[string]types.PropertySpec{"Enable": {UpdateType: "Mutable"}, },
+		
+		"AWS::GuardDuty::Filter": map[string]types.PropertySpec{"Action": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "DetectorId": {UpdateType: "Immutable"}, "FindingCriteria": {PropertyType: "AWS::GuardDuty::Filter.FindingCriteria", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "Rank": {UpdateType: "Mutable"}, },
+		
+		"AWS::GuardDuty::IPSet": map[string]types.PropertySpec{"Activate": {UpdateType: "Mutable"}, "DetectorId": {UpdateType: "Immutable"}, "Format": {UpdateType: "Immutable"}, "Location": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::GuardDuty::Master": map[string]types.PropertySpec{"DetectorId": {UpdateType: "Immutable"}, "InvitationId": {UpdateType: "Immutable"}, "MasterId": {UpdateType: "Immutable"}, },
+		
+		"AWS::GuardDuty::Member": map[string]types.PropertySpec{"DetectorId": {UpdateType: "Immutable"}, "DisableEmailNotification": {UpdateType: "Mutable"}, "Email": {UpdateType: "Immutable"}, "MemberId": {UpdateType: "Immutable"}, "Message": {UpdateType: "Mutable"}, "Status": {UpdateType: "Mutable"}, },
+		
+		"AWS::GuardDuty::ThreatIntelSet": map[string]types.PropertySpec{"Activate": {UpdateType: "Mutable"}, "DetectorId": {UpdateType: "Immutable"}, "Format": {UpdateType: "Immutable"}, "Location": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::IAM::AccessKey": map[string]types.PropertySpec{"Serial": {UpdateType: "Immutable"}, "Status": {UpdateType: "Mutable"}, "UserName": {UpdateType: "Immutable"}, },
+		
+		"AWS::IAM::Group": map[string]types.PropertySpec{"GroupName": {UpdateType: "Immutable"}, "ManagedPolicyArns": {Container: "List", UpdateType: "Mutable"}, "Path": {UpdateType: "Mutable"}, "Policies": {Container: "List", PropertyType: "AWS::IAM::Group.Policy", UpdateType: "Mutable"}, },
+		
+		"AWS::IAM::InstanceProfile": map[string]types.PropertySpec{"InstanceProfileName": {UpdateType: "Immutable"}, "Path": {UpdateType: "Immutable"}, "Roles": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::IAM::ManagedPolicy": map[string]types.PropertySpec{"Description": {UpdateType: "Immutable"}, "Groups": {Container: "List", UpdateType: "Mutable"}, "ManagedPolicyName": {UpdateType: "Immutable"}, "Path": {UpdateType: "Immutable"}, "PolicyDocument": {UpdateType: "Mutable"}, "Roles": {Container: "List", UpdateType: "Mutable"}, "Users": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::IAM::Policy": map[string]types.PropertySpec{"Groups": {Container: "List", UpdateType: "Mutable"}, "PolicyDocument": {UpdateType: "Mutable"}, "PolicyName": {UpdateType: "Mutable"}, "Roles": {Container: "List", UpdateType: "Mutable"}, "Users": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::IAM::Role": map[string]types.PropertySpec{"AssumeRolePolicyDocument": {UpdateType: "Mutable"}, "ManagedPolicyArns": {Container: "List", UpdateType: "Mutable"}, "Path": {UpdateType: "Immutable"}, "Policies": {Container: "List", PropertyType: "AWS::IAM::Role.Policy", UpdateType: "Mutable"}, "RoleName": {UpdateType: "Immutable"}, },
+		
+		"AWS::IAM::User": map[string]types.PropertySpec{"Groups": {Container: "List", UpdateType: "Mutable"}, "LoginProfile": {PropertyType: "AWS::IAM::User.LoginProfile", UpdateType: "Mutable"}, "ManagedPolicyArns": {Container: "List", UpdateType: "Mutable"}, "Path": {UpdateType: "Mutable"}, "Policies": {Container: "List", PropertyType: "AWS::IAM::User.Policy", UpdateType: "Mutable"}, "UserName": {UpdateType: "Immutable"}, },
+		
+		"AWS::IAM::UserToGroupAddition": map[string]types.PropertySpec{"GroupName": {UpdateType: "Mutable"}, "Users": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::Inspector::AssessmentTarget": map[string]types.PropertySpec{"AssessmentTargetName": {UpdateType: "Immutable"}, "ResourceGroupArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::Inspector::AssessmentTemplate": map[string]types.PropertySpec{"AssessmentTargetArn": {UpdateType: "Immutable"}, "AssessmentTemplateName": {UpdateType: "Immutable"}, "DurationInSeconds": {UpdateType: "Immutable"}, "RulesPackageArns": {Container: "List", UpdateType: "Immutable"}, "UserAttributesForFindings": {Container: "List", PropertyType: "Tag", UpdateType: "Immutable"}, },
+		
+		"AWS::Inspector::ResourceGroup": map[string]types.PropertySpec{"ResourceGroupTags": {Container: "List", PropertyType: "Tag", UpdateType: "Immutable"}, },
+		
+		"AWS::IoT::Certificate": map[string]types.PropertySpec{"CertificateSigningRequest": {UpdateType: "Immutable"}, "Status": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::Policy": map[string]types.PropertySpec{"PolicyDocument": {UpdateType: "Immutable"}, "PolicyName": {UpdateType: "Immutable"}, },
+		
+		"AWS::IoT::PolicyPrincipalAttachment": map[string]types.PropertySpec{"PolicyName": {UpdateType: "Immutable"}, "Principal": {UpdateType: "Immutable"}, },
+		
+		"AWS::IoT::Thing": map[string]types.PropertySpec{"AttributePayload": {PropertyType: "AWS::IoT::Thing.AttributePayload", UpdateType: "Mutable"}, "ThingName": {UpdateType: "Immutable"}, },
+		
+		"AWS::IoT::ThingPrincipalAttachment": map[string]types.PropertySpec{"Principal": {UpdateType: "Immutable"}, "ThingName": {UpdateType: "Immutable"}, },
+		
+		"AWS::IoT::TopicRule": map[string]types.PropertySpec{"RuleName": {UpdateType: "Immutable"}, "TopicRulePayload": {PropertyType: "AWS::IoT::TopicRule.TopicRulePayload", UpdateType: "Mutable"}, },
+		
+		"AWS::KMS::Alias": map[string]types.PropertySpec{"AliasName": {UpdateType: "Immutable"}, "TargetKeyId": {UpdateType: "Mutable"}, },
+		
+		"AWS::KMS::Key": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "EnableKeyRotation": {UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, "KeyPolicy": {UpdateType: "Mutable"}, "KeyUsage": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::Kinesis::Stream": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, "RetentionPeriodHours": {UpdateType: "Mutable"}, "ShardCount": {UpdateType: "Mutable"}, "StreamEncryption": {PropertyType: "AWS::Kinesis::Stream.StreamEncryption", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::Application": map[string]types.PropertySpec{"ApplicationCode": {UpdateType: "Mutable"}, "ApplicationDescription": {UpdateType: "Mutable"}, "ApplicationName": {UpdateType: "Immutable"}, "Inputs": {Container: "List", PropertyType: "AWS::KinesisAnalytics::Application.Input", UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationOutput": map[string]types.PropertySpec{"ApplicationName": {UpdateType: "Immutable"}, "Output": {PropertyType: "AWS::KinesisAnalytics::ApplicationOutput.Output", UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationReferenceDataSource": map[string]types.PropertySpec{"ApplicationName": {UpdateType: "Immutable"}, "ReferenceDataSource": {PropertyType: "AWS::KinesisAnalytics::ApplicationReferenceDataSource.ReferenceDataSource", UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream": map[string]types.PropertySpec{"DeliveryStreamName": {UpdateType: "Immutable"}, "DeliveryStreamType": {UpdateType: "Immutable"}, "ElasticsearchDestinationConfiguration": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.ElasticsearchDestinationConfiguration", UpdateType: "Mutable"}, "ExtendedS3DestinationConfiguration": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.ExtendedS3DestinationConfiguration", UpdateType: "Mutable"}, "KinesisStreamSourceConfiguration": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.KinesisStreamSourceConfiguration", UpdateType: "Mutable"}, "RedshiftDestinationConfiguration": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.RedshiftDestinationConfiguration", UpdateType: "Mutable"}, "S3DestinationConfiguration": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.S3DestinationConfiguration", UpdateType: "Mutable"}, },
+		
+		"AWS::Lambda::Alias": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "FunctionName": {UpdateType: "Immutable"}, "FunctionVersion": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "RoutingConfig": {PropertyType: "AWS::Lambda::Alias.AliasRoutingConfiguration", UpdateType: "Mutable"}, },
+		
+		"AWS::Lambda::EventSourceMapping": map[string]types.PropertySpec{"BatchSize": {UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, "EventSourceArn": {UpdateType: "Immutable"}, "FunctionName": {UpdateType: "Mutable"}, "StartingPosition": {UpdateType: "Immutable"}, },
+		
+		"AWS::Lambda::Function": map[string]types.PropertySpec{"Code": {PropertyType: "AWS::Lambda::Function.Code", UpdateType: "Mutable"}, "DeadLetterConfig": {PropertyType: "AWS::Lambda::Function.DeadLetterConfig", UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "Environment": {PropertyType: "AWS::Lambda::Function.Environment", UpdateType: "Mutable"}, "FunctionName": {UpdateType: "Immutable"}, "Handler": {UpdateType: "Mutable"}, "KmsKeyArn": {UpdateType: "Mutable"}, "MemorySize": {UpdateType: "Mutable"}, "ReservedConcurrentExecutions": {UpdateType: "Mutable"}, "Role": {UpdateType: "Mutable"}, "Runtime": {UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "Timeout": {UpdateType: "Mutable"}, "TracingConfig": {PropertyType: "AWS::Lambda::Function.TracingConfig", UpdateType: "Mutable"}, "VpcConfig": {PropertyType: "AWS::Lambda::Function.VpcConfig", UpdateType: "Mutable"}, },
+		
+		"AWS::Lambda::Permission": map[string]types.PropertySpec{"Action": {UpdateType: "Immutable"}, "EventSourceToken": {UpdateType: "Immutable"}, "FunctionName": {UpdateType: "Immutable"}, "Principal": {UpdateType: "Immutable"}, "SourceAccount": {UpdateType: "Immutable"}, "SourceArn": {UpdateType: "Immutable"}, },
+		
+		"AWS::Lambda::Version": map[string]types.PropertySpec{"CodeSha256": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "FunctionName": {UpdateType: "Immutable"}, },
+		
+		"AWS::Logs::Destination": map[string]types.PropertySpec{"DestinationName": {UpdateType: "Immutable"}, "DestinationPolicy": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "TargetArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::Logs::LogGroup": map[string]types.PropertySpec{"LogGroupName": {UpdateType: "Immutable"}, "RetentionInDays": {UpdateType: "Mutable"}, },
+		
+		"AWS::Logs::LogStream": map[string]types.PropertySpec{"LogGroupName": {UpdateType: "Immutable"}, "LogStreamName": {UpdateType: "Immutable"}, },
+		
+		"AWS::Logs::MetricFilter": map[string]types.PropertySpec{"FilterPattern": {UpdateType: "Mutable"}, "LogGroupName": {UpdateType: "Immutable"}, "MetricTransformations": {Container: "List", PropertyType: "AWS::Logs::MetricFilter.MetricTransformation", UpdateType: "Mutable"}, },
+		
+		"AWS::Logs::SubscriptionFilter": map[string]types.PropertySpec{"DestinationArn": {UpdateType: "Immutable"}, "FilterPattern": {UpdateType: "Immutable"}, "LogGroupName": {UpdateType: "Immutable"}, "RoleArn": {UpdateType: "Immutable"}, },
+		
+		"AWS::OpsWorks::App": map[string]types.PropertySpec{"AppSource": {PropertyType: "AWS::OpsWorks::App.Source", UpdateType: "Mutable"}, "Attributes": {Container: "Map", UpdateType: "Mutable"}, "DataSources": {Container: "List", PropertyType: "AWS::OpsWorks::App.DataSource", UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "Domains": {Container: "List", UpdateType: "Mutable"}, "EnableSsl": {UpdateType: "Mutable"}, "Environment": {Container: "List", PropertyType: "AWS::OpsWorks::App.EnvironmentVariable", UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "Shortname": {UpdateType: "Immutable"}, "SslConfiguration": {PropertyType: "AWS::OpsWorks::App.SslConfiguration", UpdateType: "Mutable"}, "StackId": {UpdateType: "Immutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::ElasticLoadBalancerAttachment": map[string]types.PropertySpec{"ElasticLoadBalancerName": {UpdateType: "Mutable"}, "LayerId": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Instance": map[string]types.PropertySpec{"AgentVersion": {UpdateType: "Mutable"}, "AmiId": {UpdateType: "Mutable"}, "Architecture": {UpdateType: "Mutable"}, "AutoScalingType": {UpdateType: "Immutable"}, "AvailabilityZone": {UpdateType: "Immutable"}, "BlockDeviceMappings": {Container: "List", PropertyType: "AWS::OpsWorks::Instance.BlockDeviceMapping", UpdateType: "Immutable"}, "EbsOptimized": {UpdateType: "Immutable"}, "ElasticIps": {Container: "List", UpdateType: "Mutable"}, "Hostname": {UpdateType: "Mutable"}, "InstallUpdatesOnBoot": {UpdateType: "Mutable"}, "InstanceType": {UpdateType: "Mutable"}, "LayerIds": {Container: "List", UpdateType: "Mutable"}, "Os": {UpdateType: "Mutable"}, "RootDeviceType": {UpdateType: "Immutable"}, "SshKeyName": {UpdateType: "Mutable"}, "StackId": {UpdateType: "Immutable"}, "SubnetId": {UpdateType: "Immutable"}, "Tenancy": {UpdateType: "Immutable"}, "TimeBasedAutoScaling": {PropertyType: "AWS::OpsWorks::Instance.TimeBasedAutoScaling", UpdateType: "Immutable"}, "VirtualizationType": {UpdateType: "Immutable"}, "Volumes": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Layer": map[string]types.PropertySpec{"Attributes": {Container: "Map", UpdateType: "Mutable"}, "AutoAssignElasticIps": {UpdateType: "Mutable"}, "AutoAssignPublicIps": {UpdateType: "Mutable"}, "CustomInstanceProfileArn": {UpdateType: "Mutable"}, "CustomJson": {UpdateType: "Mutable"}, "CustomRecipes": {PropertyType: "AWS::OpsWorks::Layer.Recipes", UpdateType: "Mutable"}, "CustomSecurityGroupIds": {Container: "List", UpdateType: "Mutable"}, "EnableAutoHealing": {UpdateType: "Mutable"}, "InstallUpdatesOnBoot": {UpdateType: "Mutable"}, "LifecycleEventConfiguration": {PropertyType: "AWS::OpsWorks::Layer.LifecycleEventConfiguration", UpdateType: "Mutable"}, "LoadBasedAutoScaling": {PropertyType: "AWS::OpsWorks::Layer.LoadBasedAutoScaling", UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "Packages": {Container: "List", UpdateType: "Mutable"}, "Shortname": {UpdateType: "Mutable"}, "StackId": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "Type": {UpdateType: "Immutable"}, "UseEbsOptimizedInstances": {UpdateType: "Mutable"}, "VolumeConfigurations": {Container: "List", PropertyType: "AWS::OpsWorks::Layer.VolumeConfiguration", UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Stack": map[string]types.PropertySpec{"AgentVersion": {UpdateType: "Mutable"}, "Attributes": {Container: "Map", UpdateType: "Mutable"}, "ChefConfiguration": {PropertyType: "AWS::OpsWorks::Stack.ChefConfiguration", UpdateType: "Mutable"}, "CloneAppIds": {Container: "List", UpdateType: "Immutable"}, "ClonePermissions": {UpdateType: "Immutable"}, "ConfigurationManager": {PropertyType: "AWS::OpsWorks::Stack.StackConfigurationManager", UpdateType: "Mutable"}, "CustomCookbooksSource": {PropertyType: "AWS::OpsWorks::Stack.Source", UpdateType: "Mutable"}, "CustomJson": {UpdateType: "Mutable"}, "DefaultAvailabilityZone": {UpdateType: "Mutable"}, "DefaultInstanceProfileArn": {UpdateType: "Mutable"}, "DefaultOs": {UpdateType: "Mutable"}, "DefaultRootDeviceType": {UpdateType: "Mutable"}, "DefaultSshKeyName": {UpdateType: "Mutable"}, "DefaultSubnetId": {UpdateType: "Mutable"}, "EcsClusterArn": {UpdateType: "Mutable"}, "ElasticIps": {Container: "List", PropertyType: "AWS::OpsWorks::Stack.ElasticIp", UpdateType: "Mutable"}, "HostnameTheme": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "RdsDbInstances": {Container: "List", PropertyType: "AWS::OpsWorks::Stack.RdsDbInstance", UpdateType: "Mutable"}, "ServiceRoleArn": {UpdateType: "Immutable"}, "SourceStackId": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "UseCustomCookbooks": {UpdateType: "Mutable"}, "UseOpsworksSecurityGroups": {UpdateType: "Mutable"}, "VpcId": {UpdateType: "Immutable"}, },
+		
+		"AWS::OpsWorks::UserProfile": map[string]types.PropertySpec{"AllowSelfManagement": {UpdateType: "Mutable"}, "IamUserArn": {UpdateType: "Immutable"}, "SshPublicKey": {UpdateType: "Mutable"}, "SshUsername": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Volume": map[string]types.PropertySpec{"Ec2VolumeId": {UpdateType: "Immutable"}, "MountPoint": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "StackId": {UpdateType: "Immutable"}, },
+		
+		"AWS::RDS::DBCluster": map[string]types.PropertySpec{"AvailabilityZones": {Container: "List", UpdateType: "Immutable"}, "BackupRetentionPeriod": {UpdateType: "Mutable"}, "DBClusterIdentifier": {UpdateType: "Immutable"}, "DBClusterParameterGroupName": {UpdateType: "Mutable"}, "DBSubnetGroupName": {UpdateType: "Immutable"}, "DatabaseName": {UpdateType: "Immutable"}, "Engine": {UpdateType: "Immutable"}, "EngineVersion": {UpdateType: "Immutable"}, "KmsKeyId": {UpdateType: "Immutable"}, "MasterUserPassword": {UpdateType: "Mutable"}, "MasterUsername": {UpdateType: "Immutable"}, "Port": {UpdateType: "Mutable"}, "PreferredBackupWindow": {UpdateType: "Mutable"}, "PreferredMaintenanceWindow": {UpdateType: "Mutable"}, "ReplicationSourceIdentifier": {UpdateType: "Mutable"}, "SnapshotIdentifier": {UpdateType: "Immutable"}, "StorageEncrypted": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "VpcSecurityGroupIds": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::RDS::DBClusterParameterGroup": map[string]types.PropertySpec{"Description": {UpdateType: "Immutable"}, "Family": {UpdateType: "Immutable"}, "Parameters": {UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::RDS::DBInstance": map[string]types.PropertySpec{"AllocatedStorage": {UpdateType: "Mutable"}, "AllowMajorVersionUpgrade": {UpdateType: "Mutable"}, "AutoMinorVersionUpgrade": {UpdateType: "Conditional"}, "AvailabilityZone": {UpdateType: "Immutable"}, "BackupRetentionPeriod": {UpdateType: "Conditional"}, "CharacterSetName": {UpdateType: "Immutable"}, "CopyTagsToSnapshot": {UpdateType: "Mutable"}, "DBClusterIdentifier": {UpdateType: "Immutable"}, "DBInstanceClass": {UpdateType: "Mutable"}, "DBInstanceIdentifier": {UpdateType: "Immutable"}, "DBName": {UpdateType: "Immutable"}, "DBParameterGroupName": {UpdateType: "Conditional"}, "DBSecurityGroups": {Container: "List", UpdateType: "Mutable"}, "DBSnapshotIdentifier": {UpdateType: "Immutable"}, "DBSubnetGroupName": {UpdateType: "Immutable"}, "Domain": {UpdateType: "Mutable"}, "DomainIAMRoleName": {UpdateType: "Mutable"}, "Engine": {UpdateType: "Immutable"}, "EngineVersion": {UpdateType: "Conditional"}, "Iops": {UpdateType: "Mutable"}, "KmsKeyId": {UpdateType: "Immutable"}, "LicenseModel": {UpdateType: "Mutable"}, "MasterUserPassword": {UpdateType: "Mutable"}, "MasterUsername": {UpdateType: "Immutable"}, "MonitoringInterval": {UpdateType: "Conditional"}, "MonitoringRoleArn": {UpdateType: "Mutable"}, "MultiAZ": {UpdateType: "Mutable"}, "OptionGroupName": {UpdateType: "Mutable"}, "Port": {UpdateType: "Immutable"}, "PreferredBackupWindow": {UpdateType: "Mutable"}, "PreferredMaintenanceWindow": {UpdateType: "Conditional"}, "PubliclyAccessible": {UpdateType: "Immutable"}, "SourceDBInstanceIdentifier": {UpdateType: "Immutable"}, "SourceRegion": {UpdateType: "Immutable"}, "StorageEncrypted": {UpdateType: "Immutable"}, "StorageType": {UpdateType: "Conditional"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "Timezone": {UpdateType: "Immutable"}, "VPCSecurityGroups": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::RDS::DBParameterGroup": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "Family": {UpdateType: "Mutable"}, "Parameters": {Container: "Map", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::RDS::DBSecurityGroup": map[string]types.PropertySpec{"DBSecurityGroupIngress": {Container: "List", PropertyType: "AWS::RDS::DBSecurityGroup.Ingress", UpdateType: "Mutable"}, "EC2VpcId": {UpdateType: "Immutable"}, "GroupDescription": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::RDS::DBSecurityGroupIngress": map[string]types.PropertySpec{"CIDRIP": {UpdateType: "Mutable"}, "DBSecurityGroupName": {UpdateType: "Mutable"}, "EC2SecurityGroupId": {UpdateType: "Mutable"}, "EC2SecurityGroupName": {UpdateType: "Mutable"}, "EC2SecurityGroupOwnerId": {UpdateType: "Mutable"}, },
+		
+		"AWS::RDS::DBSubnetGroup": map[string]types.PropertySpec{"DBSubnetGroupDescription": {UpdateType: "Mutable"}, "DBSubnetGroupName": {UpdateType: "Immutable"}, "SubnetIds": {Container: "List", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::RDS::EventSubscription": map[string]types.PropertySpec{"Enabled": {UpdateType: "Mutable"}, "EventCategories": {Container: "List", UpdateType: "Mutable"}, "SnsTopicArn": {UpdateType: "Immutable"}, "SourceIds": {Container: "List", UpdateType: "Mutable"}, "SourceType": {UpdateType: "Conditional"}, },
+		
+		"AWS::RDS::OptionGroup": map[string]types.PropertySpec{"EngineName": {UpdateType: "Immutable"}, "MajorEngineVersion": {UpdateType: "Immutable"}, "OptionConfigurations": {Container: "List", PropertyType: "AWS::RDS::OptionGroup.OptionConfiguration", UpdateType: "Immutable"}, "OptionGroupDescription": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::Redshift::Cluster": map[string]types.PropertySpec{"AllowVersionUpgrade": {UpdateType: "Mutable"}, "AutomatedSnapshotRetentionPeriod": {UpdateType: "Mutable"}, "AvailabilityZone": {UpdateType: "Immutable"}, "ClusterIdentifier": {UpdateType: "Immutable"}, "ClusterParameterGroupName": {UpdateType: "Mutable"}, "ClusterSecurityGroups": {Container: "List", UpdateType: "Mutable"}, "ClusterSubnetGroupName": {UpdateType: "Immutable"}, "ClusterType": {UpdateType: "Mutable"}, "ClusterVersion": {UpdateType: "Mutable"}, "DBName": {UpdateType: "Immutable"}, "ElasticIp": {UpdateType: "Immutable"}, "Encrypted": {UpdateType: "Immutable"}, "HsmClientCertificateIdentifier": {UpdateType: "Mutable"}, "HsmConfigurationIdentifier": {UpdateType: "Mutable"}, "IamRoles": {Container: "List", UpdateType: "Mutable"}, "KmsKeyId": {UpdateType: "Immutable"}, "LoggingProperties": {PropertyType: "AWS::Redshift::Cluster.LoggingProperties", UpdateType: "Mutable"}, "MasterUserPassword": {UpdateType: "Mutable"}, "MasterUsername": {UpdateType: "Immutable"}, "NodeType": {UpdateType: "Mutable"}, "NumberOfNodes": {UpdateType: "Mutable"}, "OwnerAccount": {UpdateType: "Immutable"}, "Port": {UpdateType: "Immutable"}, "PreferredMaintenanceWindow": {UpdateType: "Mutable"}, "PubliclyAccessible": {UpdateType: "Mutable"}, "SnapshotClusterIdentifier": {UpdateType: "Immutable"}, "SnapshotIdentifier": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "VpcSecurityGroupIds": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::Redshift::ClusterParameterGroup": map[string]types.PropertySpec{"Description": {UpdateType: "Immutable"}, "ParameterGroupFamily": {UpdateType: "Immutable"}, "Parameters": {Container: "List", PropertyType: "AWS::Redshift::ClusterParameterGroup.Parameter", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::Redshift::ClusterSecurityGroup": map[string]types.PropertySpec{"Description": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::Redshift::ClusterSecurityGroupIngress": map[string]types.PropertySpec{"CIDRIP": {UpdateType: "Immutable"}, "ClusterSecurityGroupName": {UpdateType: "Immutable"}, "EC2SecurityGroupName": {UpdateType: "Immutable"}, "EC2SecurityGroupOwnerId": {UpdateType: "Immutable"}, },
+		
+		"AWS::Redshift::ClusterSubnetGroup": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "SubnetIds": {Container: "List", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::HealthCheck": map[string]types.PropertySpec{"HealthCheckConfig": {PropertyType: "AWS::Route53::HealthCheck.HealthCheckConfig", UpdateType: "Mutable"}, "HealthCheckTags": {Container: "List", PropertyType: "AWS::Route53::HealthCheck.HealthCheckTag", UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::HostedZone": map[string]types.PropertySpec{"HostedZoneConfig": {PropertyType: "AWS::Route53::HostedZone.HostedZoneConfig", UpdateType: "Mutable"}, "HostedZoneTags": {Container: "List", PropertyType: "AWS::Route53::HostedZone.HostedZoneTag", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "QueryLoggingConfig": {PropertyType: "AWS::Route53::HostedZone.QueryLoggingConfig", UpdateType: "Mutable"}, "VPCs": {Container: "List", PropertyType: "AWS::Route53::HostedZone.VPC", UpdateType: "Conditional"}, },
+		
+		"AWS::Route53::RecordSet": map[string]types.PropertySpec{"AliasTarget": {PropertyType: "AWS::Route53::RecordSet.AliasTarget", UpdateType: "Mutable"}, "Comment": {UpdateType: "Mutable"}, "Failover": {UpdateType: "Mutable"}, "GeoLocation": {PropertyType: "AWS::Route53::RecordSet.GeoLocation", UpdateType: "Mutable"}, "HealthCheckId": {UpdateType: "Mutable"}, "HostedZoneId": {UpdateType: "Immutable"}, "HostedZoneName": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "Region": {UpdateType: "Mutable"}, "ResourceRecords": {Container: "List", UpdateType: "Mutable"}, "SetIdentifier": {UpdateType: "Mutable"}, "TTL": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, "Weight": {UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::RecordSetGroup": map[string]types.PropertySpec{"Comment": {UpdateType: "Mutable"}, "HostedZoneId": {UpdateType: "Immutable"}, "HostedZoneName": {UpdateType: "Immutable"}, "RecordSets": {Container: "List", PropertyType: "AWS::Route53::RecordSetGroup.RecordSet", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket": map[string]types.PropertySpec{"AccelerateConfiguration": {PropertyType: "AWS::S3::Bucket.AccelerateConfiguration", UpdateType: "Mutable"}, "AccessControl": {UpdateType: "Mutable"}, "AnalyticsConfigurations": {Container: "List", PropertyType: "AWS::S3::Bucket.AnalyticsConfiguration", UpdateType: "Mutable"}, "BucketEncryption": {PropertyType: "AWS::S3::Bucket.BucketEncryption", UpdateType: "Mutable"}, "BucketName": {UpdateType: "Immutable"}, "CorsConfiguration": {PropertyType: "AWS::S3::Bucket.CorsConfiguration", UpdateType: "Mutable"}, "InventoryConfigurations": {Container: "List", PropertyType: "AWS::S3::Bucket.InventoryConfiguration", UpdateType: "Mutable"}, "LifecycleConfiguration": {PropertyType: "AWS::S3::Bucket.LifecycleConfiguration", UpdateType: "Mutable"}, "LoggingConfiguration": {PropertyType: "AWS::S3::Bucket.LoggingConfiguration", UpdateType: "Mutable"}, "MetricsConfigurations": {Container: "List", PropertyType: "AWS::S3::Bucket.MetricsConfiguration", UpdateType: "Mutable"}, "NotificationConfiguration": {PropertyType: "AWS::S3::Bucket.NotificationConfiguration", UpdateType: "Mutable"}, "ReplicationConfiguration": {PropertyType: "AWS::S3::Bucket.ReplicationConfiguration", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, "VersioningConfiguration": {PropertyType: "AWS::S3::Bucket.VersioningConfiguration", UpdateType: "Mutable"}, "WebsiteConfiguration": {PropertyType: "AWS::S3::Bucket.WebsiteConfiguration", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::BucketPolicy": map[string]types.PropertySpec{"Bucket": {UpdateType: "Immutable"}, "PolicyDocument": {UpdateType: "Mutable"}, },
+		
+		"AWS::SDB::Domain": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ConfigurationSet": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::SES::ConfigurationSetEventDestination": map[string]types.PropertySpec{"ConfigurationSetName": {UpdateType: "Immutable"}, "EventDestination": {PropertyType: "AWS::SES::ConfigurationSetEventDestination.EventDestination", UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ReceiptFilter": map[string]types.PropertySpec{"Filter": {PropertyType: "AWS::SES::ReceiptFilter.Filter", UpdateType: "Immutable"}, },
+		
+		"AWS::SES::ReceiptRule": map[string]types.PropertySpec{"After": {UpdateType: "Mutable"}, "Rule": {PropertyType: "AWS::SES::ReceiptRule.Rule", UpdateType: "Mutable"}, "RuleSetName": {UpdateType: "Immutable"}, },
+		
+		"AWS::SES::ReceiptRuleSet": map[string]types.PropertySpec{"RuleSetName": {UpdateType: "Immutable"}, },
+		
+		"AWS::SES::Template": map[string]types.PropertySpec{"Template": {PropertyType: "AWS::SES::Template.Template", UpdateType: "Mutable"}, },
+		
+		"AWS::SNS::Subscription": map[string]types.PropertySpec{"Endpoint": {UpdateType: "Immutable"}, "Protocol": {UpdateType: "Immutable"}, "TopicArn": {UpdateType: "Immutable"}, },
+		
+		"AWS::SNS::Topic": map[string]types.PropertySpec{"DisplayName": {UpdateType: "Mutable"}, "Subscription": {Container: "List", PropertyType: "AWS::SNS::Topic.Subscription", UpdateType: "Mutable"}, "TopicName": {UpdateType: "Immutable"}, },
+		
+		"AWS::SNS::TopicPolicy": map[string]types.PropertySpec{"PolicyDocument": {UpdateType: "Mutable"}, "Topics": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::SQS::Queue": map[string]types.PropertySpec{"ContentBasedDeduplication": {UpdateType: "Mutable"}, "DelaySeconds": {UpdateType: "Mutable"}, "FifoQueue": {UpdateType: "Immutable"}, "KmsDataKeyReusePeriodSeconds": {UpdateType: "Mutable"}, "KmsMasterKeyId": {UpdateType: "Mutable"}, "MaximumMessageSize": {UpdateType: "Mutable"}, "MessageRetentionPeriod": {UpdateType: "Mutable"}, "QueueName": {UpdateType: "Immutable"}, "ReceiveMessageWaitTimeSeconds": {UpdateType: "Mutable"}, "RedrivePolicy": {UpdateType: "Mutable"}, "VisibilityTimeout": {UpdateType: "Mutable"}, },
+		
+		"AWS::SQS::QueuePolicy": map[string]types.PropertySpec{"PolicyDocument": {UpdateType: "Mutable"}, "Queues": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::Association": map[string]types.PropertySpec{"AssociationName": {UpdateType: "Mutable"}, "DocumentVersion": {UpdateType: "Mutable"}, "InstanceId": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "Parameters": {Container: "Map", PropertyType: "AWS::SSM::Association.ParameterValues", UpdateType: "Mutable"}, "ScheduleExpression": {UpdateType: "Mutable"}, "Targets": {Container: "List", PropertyType: "AWS::SSM::Association.Target", UpdateType: "Immutable"}, },
+		
+		"AWS::SSM::Document": map[string]types.PropertySpec{"Content": {UpdateType: "Immutable"}, "DocumentType": {UpdateType: "Immutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::MaintenanceWindowTask": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "LoggingInfo": {PropertyType: "AWS::SSM::MaintenanceWindowTask.LoggingInfo", UpdateType: "Mutable"}, "MaxConcurrency": {UpdateType: "Mutable"}, "MaxErrors": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "Priority": {UpdateType: "Mutable"}, "ServiceRoleArn": {UpdateType: "Mutable"}, "Targets": {Container: "List", PropertyType: "AWS::SSM::MaintenanceWindowTask.Target", UpdateType: "Mutable"}, "TaskArn": {UpdateType: "Mutable"}, "TaskInvocationParameters": {PropertyType: "AWS::SSM::MaintenanceWindowTask.TaskInvocationParameters", UpdateType: "Mutable"}, "TaskParameters": {UpdateType: "Mutable"}, "TaskType": {UpdateType: "Mutable"}, "WindowId": {UpdateType: "Immutable"}, },
+		
+		"AWS::SSM::Parameter": map[string]types.PropertySpec{"AllowedPattern": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "Type": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::PatchBaseline": map[string]types.PropertySpec{"ApprovalRules": {PropertyType: "AWS::SSM::PatchBaseline.RuleGroup", UpdateType: "Mutable"}, "ApprovedPatches": {Container: "List", UpdateType: "Mutable"}, "ApprovedPatchesComplianceLevel": {UpdateType: "Mutable"}, "ApprovedPatchesEnableNonSecurity": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "GlobalFilters": {PropertyType: "AWS::SSM::PatchBaseline.PatchFilterGroup", UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "OperatingSystem": {UpdateType: "Immutable"}, "PatchGroups": {Container: "List", UpdateType: "Mutable"}, "RejectedPatches": {Container: "List", UpdateType: "Mutable"}, "Sources": {Container: "List", PropertyType: "AWS::SSM::PatchBaseline.PatchSource", UpdateType: "Mutable"}, },
+		
+		"AWS::ServiceCatalog::CloudFormationProvisionedProduct": map[string]types.PropertySpec{"AcceptLanguage": {UpdateType: "Mutable"}, "NotificationArns": {Container: "List", UpdateType: "Immutable"}, "PathId": {UpdateType: "Mutable"}, "ProductId": {UpdateType: "Immutable"}, "ProductName": {UpdateType: "Immutable"}, "ProvisionedProductName": {UpdateType: "Immutable"}, "ProvisioningArtifactId": {UpdateType: "Mutable"}, "ProvisioningArtifactName": {UpdateType: "Mutable"}, "ProvisioningParameters": {Container: "List", PropertyType: "AWS::ServiceCatalog::CloudFormationProvisionedProduct.ProvisioningParameter", UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Immutable"}, },
+		
+		"AWS::ServiceDiscovery::Instance": map[string]types.PropertySpec{"InstanceAttributes": {UpdateType: "Mutable"}, "InstanceId": {UpdateType: "Immutable"}, "ServiceId": {UpdateType: "Immutable"}, },
+		
+		"AWS::ServiceDiscovery::PrivateDnsNamespace": map[string]types.PropertySpec{"Description": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "Vpc": {UpdateType: "Immutable"}, },
+		
+		"AWS::ServiceDiscovery::PublicDnsNamespace": map[string]types.PropertySpec{"Description": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::ServiceDiscovery::Service": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "DnsConfig": {PropertyType: "AWS::ServiceDiscovery::Service.DnsConfig", UpdateType: "Mutable"}, "HealthCheckConfig": {PropertyType: "AWS::ServiceDiscovery::Service.HealthCheckConfig", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::StepFunctions::Activity": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::StepFunctions::StateMachine": map[string]types.PropertySpec{"DefinitionString": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "StateMachineName": {UpdateType: "Immutable"}, },
+		
+		"AWS::WAF::ByteMatchSet": map[string]types.PropertySpec{"ByteMatchTuples": {Container: "List", PropertyType: "AWS::WAF::ByteMatchSet.ByteMatchTuple", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::WAF::IPSet": map[string]types.PropertySpec{"IPSetDescriptors": {Container: "List", PropertyType: "AWS::WAF::IPSet.IPSetDescriptor", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::WAF::Rule": map[string]types.PropertySpec{"MetricName": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "Predicates": {Container: "List", PropertyType: "AWS::WAF::Rule.Predicate", UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::SizeConstraintSet": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, "SizeConstraints": {Container: "List", PropertyType: "AWS::WAF::SizeConstraintSet.SizeConstraint", UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::SqlInjectionMatchSet": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, "SqlInjectionMatchTuples": {Container: "List", PropertyType: "AWS::WAF::SqlInjectionMatchSet.SqlInjectionMatchTuple", UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::WebACL": map[string]types.PropertySpec{"DefaultAction": {PropertyType: "AWS::WAF::WebACL.WafAction", UpdateType: "Mutable"}, "MetricName": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "Rules": {Container: "List", PropertyType: "AWS::WAF::WebACL.ActivatedRule", UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::XssMatchSet": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, "XssMatchTuples": {Container: "List", PropertyType: "AWS::WAF::XssMatchSet.XssMatchTuple", UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::ByteMatchSet": map[string]types.PropertySpec{"ByteMatchTuples": {Container: "List", PropertyType: "AWS::WAFRegional::ByteMatchSet.ByteMatchTuple", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::WAFRegional::IPSet": map[string]types.PropertySpec{"IPSetDescriptors": {Container: "List", PropertyType: "AWS::WAFRegional::IPSet.IPSetDescriptor", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::WAFRegional::Rule": map[string]types.PropertySpec{"MetricName": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "Predicates": {Container: "List", PropertyType: "AWS::WAFRegional::Rule.Predicate", UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::SizeConstraintSet": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, "SizeConstraints": {Container: "List", PropertyType: "AWS::WAFRegional::SizeConstraintSet.SizeConstraint", UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::SqlInjectionMatchSet": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, "SqlInjectionMatchTuples": {Container: "List", PropertyType: "AWS::WAFRegional::SqlInjectionMatchSet.SqlInjectionMatchTuple", UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::WebACL": map[string]types.PropertySpec{"DefaultAction": {PropertyType: "AWS::WAFRegional::WebACL.Action", UpdateType: "Mutable"}, "MetricName": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "Rules": {Container: "List", PropertyType: "AWS::WAFRegional::WebACL.Rule", UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::WebACLAssociation": map[string]types.PropertySpec{"ResourceArn": {UpdateType: "Immutable"}, "WebACLId": {UpdateType: "Immutable"}, },
+		
+		"AWS::WAFRegional::XssMatchSet": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, "XssMatchTuples": {Container: "List", PropertyType: "AWS::WAFRegional::XssMatchSet.XssMatchTuple", UpdateType: "Mutable"}, },
+		
+		"AWS::WorkSpaces::Workspace": map[string]types.PropertySpec{"BundleId": {UpdateType: "Conditional"}, "DirectoryId": {UpdateType: "Conditional"}, "RootVolumeEncryptionEnabled": {UpdateType: "Conditional"}, "UserName": {UpdateType: "Immutable"}, "UserVolumeEncryptionEnabled": {UpdateType: "Conditional"}, "VolumeEncryptionKey": {UpdateType: "Conditional"}, },
+		
+	}
+}
+
+func GetPropertySpecs_properties() map[string]map[string]types.PropertySpec {
+	return map[string]map[string]types.PropertySpec{
+		
+		"AWS::ApiGateway::ApiKey.StageKey": map[string]types.PropertySpec{"RestApiId": {UpdateType: "Mutable"}, "StageName": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::Deployment.MethodSetting": map[string]types.PropertySpec{"CacheDataEncrypted": {UpdateType: "Mutable"}, "CacheTtlInSeconds": {UpdateType: "Mutable"}, "CachingEnabled": {UpdateType: "Mutable"}, "DataTraceEnabled": {UpdateType: "Mutable"}, "HttpMethod": {UpdateType: "Mutable"}, "LoggingLevel": {UpdateType: "Mutable"}, "MetricsEnabled": {UpdateType: "Mutable"}, "ResourcePath": {UpdateType: "Mutable"}, "ThrottlingBurstLimit": {UpdateType: "Mutable"}, "ThrottlingRateLimit": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::Deployment.StageDescription": map[string]types.PropertySpec{"CacheClusterEnabled": {UpdateType: "Mutable"}, "CacheClusterSize": {UpdateType: "Mutable"}, "CacheDataEncrypted": {UpdateType: "Mutable"}, "CacheTtlInSeconds": {UpdateType: "Mutable"}, "CachingEnabled": {UpdateType: "Mutable"}, "ClientCertificateId": {UpdateType: "Mutable"}, "DataTraceEnabled": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "DocumentationVersion": {UpdateType: "Mutable"}, "LoggingLevel": {UpdateType: "Mutable"}, "MethodSettings": {Container: "List", PropertyType: "AWS::ApiGateway::Deployment.MethodSetting", UpdateType: "Mutable"}, "MetricsEnabled": {UpdateType: "Mutable"}, "ThrottlingBurstLimit": {UpdateType: "Mutable"}, "ThrottlingRateLimit": {UpdateType: "Mutable"}, "Variables": {Container: "Map", UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::DocumentationPart.Location": map[string]types.PropertySpec{"Method": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "Path": {UpdateType: "Immutable"}, "StatusCode": {UpdateType: "Immutable"}, "Type": {UpdateType: "Immutable"}, },
+		
+		"AWS::ApiGateway::DomainName.EndpointConfiguration": map[string]types.PropertySpec{"Types": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::Method.Integration": map[string]types.PropertySpec{"CacheKeyParameters": {Container: "List", UpdateType: "Mutable"}, "CacheNamespace": {UpdateType: "Mutable"}, "ContentHandling": {UpdateType: "Mutable"}, "Credentials": {UpdateType: "Mutable"}, "IntegrationHttpMethod": {UpdateType: "Mutable"}, "IntegrationResponses": {Container: "List", PropertyType: "AWS::ApiGateway::Method.IntegrationResponse", UpdateType: "Mutable"}, "PassthroughBehavior": {UpdateType: "Mutable"}, "RequestParameters": {Container: "Map", UpdateType: "Mutable"}, "RequestTemplates": {Container: "Map", UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, "Uri": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::Method.IntegrationResponse": map[string]types.PropertySpec{"ContentHandling": {UpdateType: "Mutable"}, "ResponseParameters": {Container: "Map", UpdateType: "Mutable"}, "ResponseTemplates": {Container: "Map", UpdateType: "Mutable"}, "SelectionPattern": {UpdateType: "Mutable"}, "StatusCode": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::Method.MethodResponse": map[string]types.PropertySpec{"ResponseModels": {Container: "Map", UpdateType: "Mutable"}, "ResponseParameters": {Container: "Map", UpdateType: "Mutable"}, "StatusCode": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::RestApi.EndpointConfiguration": map[string]types.PropertySpec{"Types": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::RestApi.S3Location": map[string]types.PropertySpec{"Bucket": {UpdateType: "Mutable"}, "ETag": {UpdateType: "Mutable"}, "Key": {UpdateType: "Mutable"}, "Version": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::Stage.MethodSetting": map[string]types.PropertySpec{"CacheDataEncrypted": {UpdateType: "Mutable"}, "CacheTtlInSeconds": {UpdateType: "Mutable"}, "CachingEnabled": {UpdateType: "Mutable"}, "DataTraceEnabled": {UpdateType: "Mutable"}, "HttpMethod": {UpdateType: "Mutable"}, "LoggingLevel": {UpdateType: "Mutable"}, "MetricsEnabled": {UpdateType: "Mutable"}, "ResourcePath": {UpdateType: "Mutable"}, "ThrottlingBurstLimit": {UpdateType: "Mutable"}, "ThrottlingRateLimit": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::UsagePlan.ApiStage": map[string]types.PropertySpec{"ApiId": {UpdateType: "Mutable"}, "Stage": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::UsagePlan.QuotaSettings": map[string]types.PropertySpec{"Limit": {UpdateType: "Mutable"}, "Offset": {UpdateType: "Mutable"}, "Period": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApiGateway::UsagePlan.ThrottleSettings": map[string]types.PropertySpec{"BurstLimit": {UpdateType: "Mutable"}, "RateLimit": {UpdateType: "Mutable"}, },
+		
+		"AWS::AppSync::DataSource.DynamoDBConfig": map[string]types.PropertySpec{"AwsRegion": {UpdateType: "Mutable"}, "TableName": {UpdateType: "Mutable"}, "UseCallerCredentials": {UpdateType: "Mutable"}, },
+		
+		"AWS::AppSync::DataSource.ElasticsearchConfig": map[string]types.PropertySpec{"AwsRegion": {UpdateType: "Mutable"}, "Endpoint": {UpdateType: "Mutable"}, },
+		
+		"AWS::AppSync::DataSource.LambdaConfig": map[string]types.PropertySpec{"LambdaFunctionArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::AppSync::GraphQLApi.LogConfig": map[string]types.PropertySpec{"CloudWatchLogsRoleArn": {UpdateType: "Mutable"}, "FieldLogLevel": {UpdateType: "Mutable"}, },
+		
+		"AWS::AppSync::GraphQLApi.OpenIDConnectConfig": map[string]types.PropertySpec{"AuthTTL": {UpdateType: "Mutable"}, "ClientId": {UpdateType: "Mutable"}, "IatTTL": {UpdateType: "Mutable"}, "Issuer": {UpdateType: "Mutable"}, },
+		
+		"AWS::AppSync::GraphQLApi.UserPoolConfig": map[string]types.PropertySpec{"AppIdClientRegex": {UpdateType: "Mutable"}, "AwsRegion": {UpdateType: "Mutable"}, "DefaultAction": {UpdateType: "Mutable"}, "UserPoolId": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApplicationAutoScaling::ScalableTarget.ScalableTargetAction": map[string]types.PropertySpec{"MaxCapacity": {UpdateType: "Mutable"}, "MinCapacity": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApplicationAutoScaling::ScalableTarget.ScheduledAction": map[string]types.PropertySpec{"EndTime": {UpdateType: "Mutable"}, "ScalableTargetAction": {PropertyType: "AWS::ApplicationAutoScaling::ScalableTarget.ScalableTargetAction", UpdateType: "Mutable"}, "Schedule": {UpdateType: "Mutable"}, "ScheduledActionName": {UpdateType: "Mutable"}, "StartTime": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApplicationAutoScaling::ScalingPolicy.CustomizedMetricSpecification": map[string]types.PropertySpec{"Dimensions": {Container: "List", PropertyType: "AWS::ApplicationAutoScaling::ScalingPolicy.MetricDimension", UpdateType: "Mutable"}, "MetricName": {UpdateType: "Mutable"}, "Namespace": {UpdateType: "Mutable"}, "Statistic": {UpdateType: "Mutable"}, "Unit": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApplicationAutoScaling::ScalingPolicy.MetricDimension": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApplicationAutoScaling::ScalingPolicy.PredefinedMetricSpecification": map[string]types.PropertySpec{"PredefinedMetricType": {UpdateType: "Mutable"}, "ResourceLabel": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApplicationAutoScaling::ScalingPolicy.StepAdjustment": map[string]types.PropertySpec{"MetricIntervalLowerBound": {UpdateType: "Mutable"}, "MetricIntervalUpperBound": {UpdateType: "Mutable"}, "ScalingAdjustment": {UpdateType: "Mutable"}, },
+		
+		"AWS::ApplicationAutoScaling::ScalingPolicy.StepScalingPolicyConfiguration": map[string]types.PropertySpec{"AdjustmentType": {UpdateType: "Mutable"}, "Cooldown": {UpdateType: "Mutable"}, "MetricAggregationType": {UpdateType: "Mutable"}, "MinAdjustmentMagnitude": {UpdateType: "Mutable"}, "StepAdjustments": {Container: "List", PropertyType: "AWS::ApplicationAutoScaling::ScalingPolicy.StepAdjustment", UpdateType: "Mutable"}, },
+		
+		"AWS::ApplicationAutoScaling::ScalingPolicy.TargetTrackingScalingPolicyConfiguration": map[string]types.PropertySpec{"CustomizedMetricSpecification": {PropertyType: "AWS::ApplicationAutoScaling::ScalingPolicy.CustomizedMetricSpecification", UpdateType: "Mutable"}, "DisableScaleIn": {UpdateType: "Mutable"}, "PredefinedMetricSpecification": {PropertyType: "AWS::ApplicationAutoScaling::ScalingPolicy.PredefinedMetricSpecification", UpdateType: "Mutable"}, "ScaleInCooldown": {UpdateType: "Mutable"}, "ScaleOutCooldown": {UpdateType: "Mutable"}, "TargetValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::AutoScalingGroup.LifecycleHookSpecification": map[string]types.PropertySpec{"DefaultResult": {UpdateType: "Mutable"}, "HeartbeatTimeout": {UpdateType: "Mutable"}, "LifecycleHookName": {UpdateType: "Mutable"}, "LifecycleTransition": {UpdateType: "Mutable"}, "NotificationMetadata": {UpdateType: "Mutable"}, "NotificationTargetARN": {UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::AutoScalingGroup.MetricsCollection": map[string]types.PropertySpec{"Granularity": {UpdateType: "Mutable"}, "Metrics": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::AutoScalingGroup.NotificationConfiguration": map[string]types.PropertySpec{"NotificationTypes": {Container: "List", UpdateType: "Mutable"}, "TopicARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::AutoScalingGroup.TagProperty": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "PropagateAtLaunch": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::LaunchConfiguration.BlockDevice": map[string]types.PropertySpec{"DeleteOnTermination": {UpdateType: "Mutable"}, "Encrypted": {UpdateType: "Mutable"}, "Iops": {UpdateType: "Mutable"}, "SnapshotId": {UpdateType: "Mutable"}, "VolumeSize": {UpdateType: "Mutable"}, "VolumeType": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::LaunchConfiguration.BlockDeviceMapping": map[string]types.PropertySpec{"DeviceName": {UpdateType: "Mutable"}, "Ebs": {PropertyType: "AWS::AutoScaling::LaunchConfiguration.BlockDevice", UpdateType: "Mutable"}, "NoDevice": {UpdateType: "Mutable"}, "VirtualName": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::ScalingPolicy.CustomizedMetricSpecification": map[string]types.PropertySpec{"Dimensions": {Container: "List", PropertyType: "AWS::AutoScaling::ScalingPolicy.MetricDimension", UpdateType: "Mutable"}, "MetricName": {UpdateType: "Mutable"}, "Namespace": {UpdateType: "Mutable"}, "Statistic": {UpdateType: "Mutable"}, "Unit": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::ScalingPolicy.MetricDimension": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::ScalingPolicy.PredefinedMetricSpecification": map[string]types.PropertySpec{"PredefinedMetricType": {UpdateType: "Mutable"}, "ResourceLabel": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::ScalingPolicy.StepAdjustment": map[string]types.PropertySpec{"MetricIntervalLowerBound": {UpdateType: "Mutable"}, "MetricIntervalUpperBound": {UpdateType: "Mutable"}, "ScalingAdjustment": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScaling::ScalingPolicy.TargetTrackingConfiguration": map[string]types.PropertySpec{"CustomizedMetricSpecification": {PropertyType: "AWS::AutoScaling::ScalingPolicy.CustomizedMetricSpecification", UpdateType: "Mutable"}, "DisableScaleIn": {UpdateType: "Mutable"}, "PredefinedMetricSpecification": {PropertyType: "AWS::AutoScaling::ScalingPolicy.PredefinedMetricSpecification", UpdateType: "Mutable"}, "TargetValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScalingPlans::ScalingPlan.ApplicationSource": map[string]types.PropertySpec{"CloudFormationStackARN": {UpdateType: "Mutable"}, "TagFilters": {Container: "List", PropertyType: "AWS::AutoScalingPlans::ScalingPlan.TagFilter", UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScalingPlans::ScalingPlan.CustomizedScalingMetricSpecification": map[string]types.PropertySpec{"Dimensions": {Container: "List", PropertyType: "AWS::AutoScalingPlans::ScalingPlan.MetricDimension", UpdateType: "Mutable"}, "MetricName": {UpdateType: "Mutable"}, "Namespace": {UpdateType: "Mutable"}, "Statistic": {UpdateType: "Mutable"}, "Unit": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScalingPlans::ScalingPlan.MetricDimension": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScalingPlans::ScalingPlan.PredefinedScalingMetricSpecification": map[string]types.PropertySpec{"PredefinedScalingMetricType": {UpdateType: "Mutable"}, "ResourceLabel": {UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScalingPlans::ScalingPlan.ScalingInstruction": map[string]types.PropertySpec{"MaxCapacity": {UpdateType: "Mutable"}, "MinCapacity": {UpdateType: "Mutable"}, "ResourceId": {UpdateType: "Mutable"}, "ScalableDimension": {UpdateType: "Mutable"}, "ServiceNamespace": {UpdateType: "Mutable"}, "TargetTrackingConfigurations": {Container: "List", PropertyType: "AWS::AutoScalingPlans::ScalingPlan.TargetTrackingConfiguration", UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScalingPlans::ScalingPlan.TagFilter": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Values": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::AutoScalingPlans::ScalingPlan.TargetTrackingConfiguration": map[string]types.PropertySpec{"CustomizedScalingMetricSpecification": {PropertyType: "AWS::AutoScalingPlans::ScalingPlan.CustomizedScalingMetricSpecification", UpdateType: "Mutable"}, "DisableScaleIn": {UpdateType: "Mutable"}, "EstimatedInstanceWarmup": {UpdateType: "Mutable"}, "PredefinedScalingMetricSpecification": {PropertyType: "AWS::AutoScalingPlans::ScalingPlan.PredefinedScalingMetricSpecification", UpdateType: "Mutable"}, "ScaleInCooldown": {UpdateType: "Mutable"}, "ScaleOutCooldown": {UpdateType: "Mutable"}, "TargetValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::Batch::ComputeEnvironment.ComputeResources": map[string]types.PropertySpec{"BidPercentage": {UpdateType: "Immutable"}, "DesiredvCpus": {UpdateType: "Mutable"}, "Ec2KeyPair": {UpdateType: "Immutable"}, "ImageId": {UpdateType: "Immutable"}, "InstanceRole": {UpdateType: "Immutable"}, "InstanceTypes": {Container: "List", UpdateType: "Immutable"}, "MaxvCpus": {UpdateType: "Mutable"}, "MinvCpus": {UpdateType: "Mutable"}, "SecurityGroupIds": {Container: "List", UpdateType: "Immutable"}, "SpotIamFleetRole": {UpdateType: "Immutable"}, "Subnets": {Container: "List", UpdateType: "Immutable"}, "Tags": {UpdateType: "Immutable"}, "Type": {UpdateType: "Immutable"}, },
+		
+		"AWS::Batch::JobDefinition.ContainerProperties": map[string]types.PropertySpec{"Command": {Container: "List", UpdateType: "Mutable"}, "Environment": {Container: "List", PropertyType: "AWS::Batch::JobDefinition.Environment", UpdateType: "Mutable"}, "Image": {UpdateType: "Mutable"}, "JobRoleArn": {UpdateType: "Mutable"}, "Memory": {UpdateType: "Mutable"}, "MountPoints": {Container: "List", PropertyType: "AWS::Batch::JobDefinition.MountPoints", UpdateType: "Mutable"}, "Privileged": {UpdateType: "Mutable"}, "ReadonlyRootFilesystem": {UpdateType: "Mutable"}, "Ulimits": {Container: "List", PropertyType: "AWS::Batch::JobDefinition.Ulimit", UpdateType: "Mutable"}, "User": {UpdateType: "Mutable"}, "Vcpus": {UpdateType: "Mutable"}, "Volumes": {Container: "List", PropertyType: "AWS::Batch::JobDefinition.Volumes", UpdateType: "Mutable"}, },
+		
+		"AWS::Batch::JobDefinition.Environment": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::Batch::JobDefinition.MountPoints": map[string]types.PropertySpec{"ContainerPath": {UpdateType: "Mutable"}, "ReadOnly": {UpdateType: "Mutable"}, "SourceVolume": {UpdateType: "Mutable"}, },
+		
+		"AWS::Batch::JobDefinition.RetryStrategy": map[string]types.PropertySpec{"Attempts": {UpdateType: "Mutable"}, },
+		
+		"AWS::Batch::JobDefinition.Ulimit": map[string]types.PropertySpec{"HardLimit": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "SoftLimit": {UpdateType: "Mutable"}, },
+		
+		"AWS::Batch::JobDefinition.Volumes": map[string]types.PropertySpec{"Host": {PropertyType: "AWS::Batch::JobDefinition.VolumesHost", UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::Batch::JobDefinition.VolumesHost": map[string]types.PropertySpec{"SourcePath": {UpdateType: "Mutable"}, },
+		
+		"AWS::Batch::JobQueue.ComputeEnvironmentOrder": map[string]types.PropertySpec{"ComputeEnvironment": {UpdateType: "Mutable"}, "Order": {UpdateType: "Mutable"}, },
+		
+		"AWS::CertificateManager::Certificate.DomainValidationOption": map[string]types.PropertySpec{"DomainName": {UpdateType: "Mutable"}, "ValidationDomain": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cloud9::EnvironmentEC2.Repository": map[string]types.PropertySpec{"PathComponent": {UpdateType: "Mutable"}, "RepositoryUrl": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::CloudFrontOriginAccessIdentity.CloudFrontOriginAccessIdentityConfig": map[string]types.PropertySpec{"Comment": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.CacheBehavior": map[string]types.PropertySpec{"AllowedMethods": {Container: "List", UpdateType: "Mutable"}, "CachedMethods": {Container: "List", UpdateType: "Mutable"}, "Compress": {UpdateType: "Mutable"}, "DefaultTTL": {UpdateType: "Mutable"}, "ForwardedValues": {PropertyType: "AWS::CloudFront::Distribution.ForwardedValues", UpdateType: "Mutable"}, "LambdaFunctionAssociations": {Container: "List", PropertyType: "AWS::CloudFront::Distribution.LambdaFunctionAssociation", UpdateType: "Mutable"}, "MaxTTL": {UpdateType: "Mutable"}, "MinTTL": {UpdateType: "Mutable"}, "PathPattern": {UpdateType: "Mutable"}, "SmoothStreaming": {UpdateType: "Mutable"}, "TargetOriginId": {UpdateType: "Mutable"}, "TrustedSigners": {Container: "List", UpdateType: "Mutable"}, "ViewerProtocolPolicy": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.Cookies": map[string]types.PropertySpec{"Forward": {UpdateType: "Mutable"}, "WhitelistedNames": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.CustomErrorResponse": map[string]types.PropertySpec{"ErrorCachingMinTTL": {UpdateType: "Mutable"}, "ErrorCode": {UpdateType: "Mutable"}, "ResponseCode": {UpdateType: "Mutable"}, "ResponsePagePath": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.CustomOriginConfig": map[string]types.PropertySpec{"HTTPPort": {UpdateType: "Mutable"}, "HTTPSPort": {UpdateType: "Mutable"}, "OriginKeepaliveTimeout": {UpdateType: "Mutable"}, "OriginProtocolPolicy": {UpdateType: "Mutable"}, "OriginReadTimeout": {UpdateType: "Mutable"}, "OriginSSLProtocols": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.DefaultCacheBehavior": map[string]types.PropertySpec{"AllowedMethods": {Container: "List", UpdateType: "Mutable"}, "CachedMethods": {Container: "List", UpdateType: "Mutable"}, "Compress": {UpdateType: "Mutable"}, "DefaultTTL": {UpdateType: "Mutable"}, "ForwardedValues": {PropertyType: "AWS::CloudFront::Distribution.ForwardedValues", UpdateType: "Mutable"}, "LambdaFunctionAssociations": {Container: "List", PropertyType: "AWS::CloudFront::Distribution.LambdaFunctionAssociation", UpdateType: "Mutable"}, "MaxTTL": {UpdateType: "Mutable"}, "MinTTL": {UpdateType: "Mutable"}, "SmoothStreaming": {UpdateType: "Mutable"}, "TargetOriginId": {UpdateType: "Mutable"}, "TrustedSigners": {Container: "List", UpdateType: "Mutable"}, "ViewerProtocolPolicy": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.DistributionConfig": map[string]types.PropertySpec{"Aliases": {Container: "List", UpdateType: "Mutable"}, "CacheBehaviors": {Container: "List", PropertyType: "AWS::CloudFront::Distribution.CacheBehavior", UpdateType: "Mutable"}, "Comment": {UpdateType: "Mutable"}, "CustomErrorResponses": {Container: "List", PropertyType: "AWS::CloudFront::Distribution.CustomErrorResponse", UpdateType: "Mutable"}, "DefaultCacheBehavior": {PropertyType: "AWS::CloudFront::Distribution.DefaultCacheBehavior", UpdateType: "Mutable"}, "DefaultRootObject": {UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, "HttpVersion": {UpdateType: "Mutable"}, "IPV6Enabled": {UpdateType: "Mutable"}, "Logging": {PropertyType: "AWS::CloudFront::Distribution.Logging", UpdateType: "Mutable"}, "Origins": {Container: "List", PropertyType: "AWS::CloudFront::Distribution.Origin", UpdateType: "Mutable"}, "PriceClass": {UpdateType: "Mutable"}, "Restrictions": {PropertyType: "AWS::CloudFront::Distribution.Restrictions", UpdateType: "Mutable"}, "ViewerCertificate": {PropertyType: "AWS::CloudFront::Distribution.ViewerCertificate", UpdateType: "Mutable"}, "WebACLId": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.ForwardedValues": map[string]types.PropertySpec{"Cookies": {PropertyType: "AWS::CloudFront::Distribution.Cookies", UpdateType: "Mutable"}, "Headers": {Container: "List", UpdateType: "Mutable"}, "QueryString": {UpdateType: "Mutable"}, "QueryStringCacheKeys": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.GeoRestriction": map[string]types.PropertySpec{"Locations": {Container: "List", UpdateType: "Mutable"}, "RestrictionType": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.LambdaFunctionAssociation": map[string]types.PropertySpec{"EventType": {UpdateType: "Mutable"}, "LambdaFunctionARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.Logging": map[string]types.PropertySpec{"Bucket": {UpdateType: "Mutable"}, "IncludeCookies": {UpdateType: "Mutable"}, "Prefix": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.Origin": map[string]types.PropertySpec{"CustomOriginConfig": {PropertyType: "AWS::CloudFront::Distribution.CustomOriginConfig", UpdateType: "Mutable"}, "DomainName": {UpdateType: "Mutable"}, "Id": {UpdateType: "Mutable"}, "OriginCustomHeaders": {Container: "List", PropertyType: "AWS::CloudFront::Distribution.OriginCustomHeader", UpdateType: "Mutable"}, "OriginPath": {UpdateType: "Mutable"}, "S3OriginConfig": {PropertyType: "AWS::CloudFront::Distribution.S3OriginConfig", UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.OriginCustomHeader": map[string]types.PropertySpec{"HeaderName": {UpdateType: "Mutable"}, "HeaderValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.Restrictions": map[string]types.PropertySpec{"GeoRestriction": {PropertyType: "AWS::CloudFront::Distribution.GeoRestriction", UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.S3OriginConfig": map[string]types.PropertySpec{"OriginAccessIdentity": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::Distribution.ViewerCertificate": map[string]types.PropertySpec{"AcmCertificateArn": {UpdateType: "Mutable"}, "CloudFrontDefaultCertificate": {UpdateType: "Mutable"}, "IamCertificateId": {UpdateType: "Mutable"}, "MinimumProtocolVersion": {UpdateType: "Mutable"}, "SslSupportMethod": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::StreamingDistribution.Logging": map[string]types.PropertySpec{"Bucket": {UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, "Prefix": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::StreamingDistribution.S3Origin": map[string]types.PropertySpec{"DomainName": {UpdateType: "Mutable"}, "OriginAccessIdentity": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::StreamingDistribution.StreamingDistributionConfig": map[string]types.PropertySpec{"Aliases": {Container: "List", UpdateType: "Mutable"}, "Comment": {UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, "Logging": {PropertyType: "AWS::CloudFront::StreamingDistribution.Logging", UpdateType: "Mutable"}, "PriceClass": {UpdateType: "Mutable"}, "S3Origin": {PropertyType: "AWS::CloudFront::StreamingDistribution.S3Origin", UpdateType: "Mutable"}, "TrustedSigners": {PropertyType: "AWS::CloudFront::StreamingDistribution.TrustedSigners", UpdateType: "Mutable"}, },
+		
+		"AWS::CloudFront::StreamingDistribution.TrustedSigners": map[string]types.PropertySpec{"AwsAccountNumbers": {Container: "List", UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudTrail::Trail.DataResource": map[string]types.PropertySpec{"Type": {UpdateType: "Mutable"}, "Values": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::CloudTrail::Trail.EventSelector": map[string]types.PropertySpec{"DataResources": {Container: "List", PropertyType: "AWS::CloudTrail::Trail.DataResource", UpdateType: "Mutable"}, "IncludeManagementEvents": {UpdateType: "Mutable"}, "ReadWriteType": {UpdateType: "Mutable"}, },
+		
+		"AWS::CloudWatch::Alarm.Dimension": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeBuild::Project.Artifacts": map[string]types.PropertySpec{"Location": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "NamespaceType": {UpdateType: "Mutable"}, "Packaging": {UpdateType: "Mutable"}, "Path": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeBuild::Project.Environment": map[string]types.PropertySpec{"ComputeType": {UpdateType: "Mutable"}, "EnvironmentVariables": {Container: "List", PropertyType: "AWS::CodeBuild::Project.EnvironmentVariable", UpdateType: "Mutable"}, "Image": {UpdateType: "Mutable"}, "PrivilegedMode": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeBuild::Project.EnvironmentVariable": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeBuild::Project.ProjectCache": map[string]types.PropertySpec{"Location": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeBuild::Project.ProjectTriggers": map[string]types.PropertySpec{"Webhook": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeBuild::Project.Source": map[string]types.PropertySpec{"Auth": {PropertyType: "AWS::CodeBuild::Project.SourceAuth", UpdateType: "Mutable"}, "BuildSpec": {UpdateType: "Mutable"}, "GitCloneDepth": {UpdateType: "Mutable"}, "InsecureSsl": {UpdateType: "Mutable"}, "Location": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeBuild::Project.SourceAuth": map[string]types.PropertySpec{"Resource": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeBuild::Project.VpcConfig": map[string]types.PropertySpec{"SecurityGroupIds": {Container: "List", UpdateType: "Mutable"}, "Subnets": {Container: "List", UpdateType: "Mutable"}, "VpcId": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeCommit::Repository.RepositoryTrigger": map[string]types.PropertySpec{"Branches": {Container: "List", UpdateType: "Mutable"}, "CustomData": {UpdateType: "Mutable"}, "DestinationArn": {UpdateType: "Mutable"}, "Events": {Container: "List", UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentConfig.MinimumHealthyHosts": map[string]types.PropertySpec{"Type": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.Alarm": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.AlarmConfiguration": map[string]types.PropertySpec{"Alarms": {Container: "List", PropertyType: "AWS::CodeDeploy::DeploymentGroup.Alarm", UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, "IgnorePollAlarmFailure": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.AutoRollbackConfiguration": map[string]types.PropertySpec{"Enabled": {UpdateType: "Mutable"}, "Events": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.Deployment": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "IgnoreApplicationStopFailures": {UpdateType: "Mutable"}, "Revision": {PropertyType: "AWS::CodeDeploy::DeploymentGroup.RevisionLocation", UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.DeploymentStyle": map[string]types.PropertySpec{"DeploymentOption": {UpdateType: "Mutable"}, "DeploymentType": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.EC2TagFilter": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.ELBInfo": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.GitHubLocation": map[string]types.PropertySpec{"CommitId": {UpdateType: "Mutable"}, "Repository": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.LoadBalancerInfo": map[string]types.PropertySpec{"ElbInfoList": {Container: "List", PropertyType: "AWS::CodeDeploy::DeploymentGroup.ELBInfo", UpdateType: "Mutable"}, "TargetGroupInfoList": {Container: "List", PropertyType: "AWS::CodeDeploy::DeploymentGroup.TargetGroupInfo", UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.RevisionLocation": map[string]types.PropertySpec{"GitHubLocation": {PropertyType: "AWS::CodeDeploy::DeploymentGroup.GitHubLocation", UpdateType: "Mutable"}, "RevisionType": {UpdateType: "Mutable"}, "S3Location": {PropertyType: "AWS::CodeDeploy::DeploymentGroup.S3Location", UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.S3Location": map[string]types.PropertySpec{"Bucket": {UpdateType: "Mutable"}, "BundleType": {UpdateType: "Mutable"}, "ETag": {UpdateType: "Mutable"}, "Key": {UpdateType: "Mutable"}, "Version": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.TagFilter": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.TargetGroupInfo": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodeDeploy::DeploymentGroup.TriggerConfig": map[string]types.PropertySpec{"TriggerEvents": {Container: "List", UpdateType: "Mutable"}, "TriggerName": {UpdateType: "Mutable"}, "TriggerTargetArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodePipeline::CustomActionType.ArtifactDetails": map[string]types.PropertySpec{"MaximumCount": {UpdateType: "Mutable"}, "MinimumCount": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodePipeline::CustomActionType.ConfigurationProperties": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "Key": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "Queryable": {UpdateType: "Mutable"}, "Required": {UpdateType: "Mutable"}, "Secret": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodePipeline::CustomActionType.Settings": map[string]types.PropertySpec{"EntityUrlTemplate": {UpdateType: "Mutable"}, "ExecutionUrlTemplate": {UpdateType: "Mutable"}, "RevisionUrlTemplate": {UpdateType: "Mutable"}, "ThirdPartyConfigurationUrl": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodePipeline::Pipeline.ActionDeclaration": map[string]types.PropertySpec{"ActionTypeId": {PropertyType: "AWS::CodePipeline::Pipeline.ActionTypeId", UpdateType: "Mutable"}, "Configuration": {UpdateType: "Mutable"}, "InputArtifacts": {Container: "List", PropertyType: "AWS::CodePipeline::Pipeline.InputArtifact", UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "OutputArtifacts": {Container: "List", PropertyType: "AWS::CodePipeline::Pipeline.OutputArtifact", UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "RunOrder": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodePipeline::Pipeline.ActionTypeId": map[string]types.PropertySpec{"Category": {UpdateType: "Mutable"}, "Owner": {UpdateType: "Mutable"}, "Provider": {UpdateType: "Mutable"}, "Version": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodePipeline::Pipeline.ArtifactStore": map[string]types.PropertySpec{"EncryptionKey": {PropertyType: "AWS::CodePipeline::Pipeline.EncryptionKey", UpdateType: "Mutable"}, "Location": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodePipeline::Pipeline.BlockerDeclaration": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodePipeline::Pipeline.EncryptionKey": map[string]types.PropertySpec{"Id": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodePipeline::Pipeline.InputArtifact": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodePipeline::Pipeline.OutputArtifact": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodePipeline::Pipeline.StageDeclaration": map[string]types.PropertySpec{"Actions": {Container: "List", PropertyType: "AWS::CodePipeline::Pipeline.ActionDeclaration", UpdateType: "Mutable"}, "Blockers": {Container: "List", PropertyType: "AWS::CodePipeline::Pipeline.BlockerDeclaration", UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::CodePipeline::Pipeline.StageTransition": map[string]types.PropertySpec{"Reason": {UpdateType: "Mutable"}, "StageName": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::IdentityPool.CognitoIdentityProvider": map[string]types.PropertySpec{"ClientId": {UpdateType: "Mutable"}, "ProviderName": {UpdateType: "Mutable"}, "ServerSideTokenCheck": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::IdentityPool.CognitoStreams": map[string]types.PropertySpec{"RoleArn": {UpdateType: "Mutable"}, "StreamName": {UpdateType: "Mutable"}, "StreamingStatus": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::IdentityPool.PushSync": map[string]types.PropertySpec{"ApplicationArns": {Container: "List", UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::IdentityPoolRoleAttachment.MappingRule": map[string]types.PropertySpec{"Claim": {UpdateType: "Mutable"}, "MatchType": {UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::IdentityPoolRoleAttachment.RoleMapping": map[string]types.PropertySpec{"AmbiguousRoleResolution": {UpdateType: "Mutable"}, "RulesConfiguration": {PropertyType: "AWS::Cognito::IdentityPoolRoleAttachment.RulesConfigurationType", UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::IdentityPoolRoleAttachment.RulesConfigurationType": map[string]types.PropertySpec{"Rules": {Container: "List", PropertyType: "AWS::Cognito::IdentityPoolRoleAttachment.MappingRule", UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPool.AdminCreateUserConfig": map[string]types.PropertySpec{"AllowAdminCreateUserOnly": {UpdateType: "Mutable"}, "InviteMessageTemplate": {PropertyType: "AWS::Cognito::UserPool.InviteMessageTemplate", UpdateType: "Mutable"}, "UnusedAccountValidityDays": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPool.DeviceConfiguration": map[string]types.PropertySpec{"ChallengeRequiredOnNewDevice": {UpdateType: "Mutable"}, "DeviceOnlyRememberedOnUserPrompt": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPool.EmailConfiguration": map[string]types.PropertySpec{"ReplyToEmailAddress": {UpdateType: "Mutable"}, "SourceArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPool.InviteMessageTemplate": map[string]types.PropertySpec{"EmailMessage": {UpdateType: "Mutable"}, "EmailSubject": {UpdateType: "Mutable"}, "SMSMessage": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPool.LambdaConfig": map[string]types.PropertySpec{"CreateAuthChallenge": {UpdateType: "Mutable"}, "CustomMessage": {UpdateType: "Mutable"}, "DefineAuthChallenge": {UpdateType: "Mutable"}, "PostAuthentication": {UpdateType: "Mutable"}, "PostConfirmation": {UpdateType: "Mutable"}, "PreAuthentication": {UpdateType: "Mutable"}, "PreSignUp": {UpdateType: "Mutable"}, "VerifyAuthChallengeResponse": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPool.NumberAttributeConstraints": map[string]types.PropertySpec{"MaxValue": {UpdateType: "Mutable"}, "MinValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPool.PasswordPolicy": map[string]types.PropertySpec{"MinimumLength": {UpdateType: "Mutable"}, "RequireLowercase": {UpdateType: "Mutable"}, "RequireNumbers": {UpdateType: "Mutable"}, "RequireSymbols": {UpdateType: "Mutable"}, "RequireUppercase": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPool.Policies": map[string]types.PropertySpec{"PasswordPolicy": {PropertyType: "AWS::Cognito::UserPool.PasswordPolicy", UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPool.SchemaAttribute": map[string]types.PropertySpec{"AttributeDataType": {UpdateType: "Mutable"}, "DeveloperOnlyAttribute": {UpdateType: "Mutable"}, "Mutable": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "NumberAttributeConstraints": {PropertyType: "AWS::Cognito::UserPool.NumberAttributeConstraints", UpdateType: "Mutable"}, "Required": {UpdateType: "Mutable"}, "StringAttributeConstraints": {PropertyType: "AWS::Cognito::UserPool.StringAttributeConstraints", UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPool.SmsConfiguration": map[string]types.PropertySpec{"ExternalId": {UpdateType: "Mutable"}, "SnsCallerArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPool.StringAttributeConstraints": map[string]types.PropertySpec{"MaxLength": {UpdateType: "Mutable"}, "MinLength": {UpdateType: "Mutable"}, },
+		
+		"AWS::Cognito::UserPoolUser.AttributeType": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::Config::ConfigRule.Scope": map[string]types.PropertySpec{"ComplianceResourceId": {UpdateType: "Mutable"}, "ComplianceResourceTypes": {Container: "List", UpdateType: "Mutable"}, "TagKey": {UpdateType: "Mutable"}, "TagValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::Config::ConfigRule.Source": map[string]types.PropertySpec{"Owner": {UpdateType: "Mutable"}, "SourceDetails": {Container: "List", PropertyType: "AWS::Config::ConfigRule.SourceDetail", UpdateType: "Mutable"}, "SourceIdentifier": {UpdateType: "Mutable"}, },
+		
+		"AWS::Config::ConfigRule.SourceDetail": map[string]types.PropertySpec{"EventSource": {UpdateType: "Mutable"}, "MaximumExecutionFrequency": {UpdateType: "Mutable"}, "MessageType": {UpdateType: "Mutable"}, },
+		
+		"AWS::Config::ConfigurationRecorder.RecordingGroup": map[string]types.PropertySpec{"AllSupported": {UpdateType: "Mutable"}, "IncludeGlobalResourceTypes": {UpdateType: "Mutable"}, "ResourceTypes": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::Config::DeliveryChannel.ConfigSnapshotDeliveryProperties": map[string]types.PropertySpec{"DeliveryFrequency": {UpdateType: "Mutable"}, },
+		
+		"AWS::DMS::Endpoint.DynamoDbSettings": map[string]types.PropertySpec{"ServiceAccessRoleArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::DMS::Endpoint.MongoDbSettings": map[string]types.PropertySpec{"AuthMechanism": {UpdateType: "Mutable"}, "AuthSource": {UpdateType: "Mutable"}, "AuthType": {UpdateType: "Mutable"}, "DatabaseName": {UpdateType: "Mutable"}, "DocsToInvestigate": {UpdateType: "Mutable"}, "ExtractDocId": {UpdateType: "Mutable"}, "NestingLevel": {UpdateType: "Mutable"}, "Password": {UpdateType: "Mutable"}, "Port": {UpdateType: "Mutable"}, "ServerName": {UpdateType: "Mutable"}, "Username": {UpdateType: "Mutable"}, },
+		
+		"AWS::DMS::Endpoint.S3Settings": map[string]types.PropertySpec{"BucketFolder": {UpdateType: "Mutable"}, "BucketName": {UpdateType: "Mutable"}, "CompressionType": {UpdateType: "Mutable"}, "CsvDelimiter": {UpdateType: "Mutable"}, "CsvRowDelimiter": {UpdateType: "Mutable"}, "ExternalTableDefinition": {UpdateType: "Mutable"}, "ServiceAccessRoleArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::DataPipeline::Pipeline.Field": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "RefValue": {UpdateType: "Mutable"}, "StringValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::DataPipeline::Pipeline.ParameterAttribute": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "StringValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::DataPipeline::Pipeline.ParameterObject": map[string]types.PropertySpec{"Attributes": {Container: "List", PropertyType: "AWS::DataPipeline::Pipeline.ParameterAttribute", UpdateType: "Mutable"}, "Id": {UpdateType: "Mutable"}, },
+		
+		"AWS::DataPipeline::Pipeline.ParameterValue": map[string]types.PropertySpec{"Id": {UpdateType: "Mutable"}, "StringValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::DataPipeline::Pipeline.PipelineObject": map[string]types.PropertySpec{"Fields": {Container: "List", PropertyType: "AWS::DataPipeline::Pipeline.Field", UpdateType: "Mutable"}, "Id": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::DataPipeline::Pipeline.PipelineTag": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::DirectoryService::MicrosoftAD.VpcSettings": map[string]types.PropertySpec{"SubnetIds": {Container: "List", UpdateType: "Mutable"}, "VpcId": {UpdateType: "Mutable"}, },
+		
+		"AWS::DirectoryService::SimpleAD.VpcSettings": map[string]types.PropertySpec{"SubnetIds": {Container: "List", UpdateType: "Mutable"}, "VpcId": {UpdateType: "Mutable"}, },
+		
+		"AWS::DynamoDB::Table.AttributeDefinition": map[string]types.PropertySpec{"AttributeName": {UpdateType: "Mutable"}, "AttributeType": {UpdateType: "Mutable"}, },
+		
+		"AWS::DynamoDB::Table.GlobalSecondaryIndex": map[string]types.PropertySpec{"IndexName": {UpdateType: "Mutable"}, "KeySchema": {Container: "List", PropertyType: "AWS::DynamoDB::Table.KeySchema", UpdateType: "Mutable"}, "Projection": {PropertyType: "AWS::DynamoDB::Table.Projection", UpdateType: "Mutable"}, "ProvisionedThroughput": {PropertyType: "AWS::DynamoDB::Table.ProvisionedThroughput", UpdateType: "Mutable"}, },
+		
+		"AWS::DynamoDB::Table.KeySchema": map[string]types.PropertySpec{"AttributeName": {UpdateType: "Mutable"}, "KeyType": {UpdateType: "Mutable"}, },
+		
+		"AWS::DynamoDB::Table.LocalSecondaryIndex": map[string]types.PropertySpec{"IndexName": {UpdateType: "Mutable"}, "KeySchema": {Container: "List", PropertyType: "AWS::DynamoDB::Table.KeySchema", UpdateType: "Mutable"}, "Projection": {PropertyType: "AWS::DynamoDB::Table.Projection", UpdateType: "Mutable"}, },
+		
+		"AWS::DynamoDB::Table.Projection": map[string]types.PropertySpec{"NonKeyAttributes": {Container: "List", UpdateType: "Mutable"}, "ProjectionType": {UpdateType: "Mutable"}, },
+		
+		"AWS::DynamoDB::Table.ProvisionedThroughput": map[string]types.PropertySpec{"ReadCapacityUnits": {UpdateType: "Mutable"}, "WriteCapacityUnits": {UpdateType: "Mutable"}, },
+		
+		"AWS::DynamoDB::Table.SSESpecification": map[string]types.PropertySpec{"SSEEnabled": {UpdateType: "Mutable"}, },
+		
+		"AWS::DynamoDB::Table.StreamSpecification": map[string]types.PropertySpec{"StreamViewType": {UpdateType: "Mutable"}, },
+		
+		"AWS::DynamoDB::Table.TimeToLiveSpecification": map[string]types.PropertySpec{"AttributeName": {UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::Instance.AssociationParameter": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Value": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::Instance.BlockDeviceMapping": map[string]types.PropertySpec{"DeviceName": {UpdateType: "Mutable"}, "Ebs": {PropertyType: "AWS::EC2::Instance.Ebs", UpdateType: "Mutable"}, "NoDevice": {PropertyType: "AWS::EC2::Instance.NoDevice", UpdateType: "Mutable"}, "VirtualName": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::Instance.CreditSpecification": map[string]types.PropertySpec{"CPUCredits": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::Instance.Ebs": map[string]types.PropertySpec{"DeleteOnTermination": {UpdateType: "Mutable"}, "Encrypted": {UpdateType: "Mutable"}, "Iops": {UpdateType: "Mutable"}, "SnapshotId": {UpdateType: "Mutable"}, "VolumeSize": {UpdateType: "Mutable"}, "VolumeType": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::Instance.ElasticGpuSpecification": map[string]types.PropertySpec{"Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::Instance.InstanceIpv6Address": map[string]types.PropertySpec{"Ipv6Address": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::Instance.NetworkInterface": map[string]types.PropertySpec{"AssociatePublicIpAddress": {UpdateType: "Mutable"}, "DeleteOnTermination": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "DeviceIndex": {UpdateType: "Mutable"}, "GroupSet": {Container: "List", UpdateType: "Mutable"}, "Ipv6AddressCount": {UpdateType: "Mutable"}, "Ipv6Addresses": {Container: "List", PropertyType: "AWS::EC2::Instance.InstanceIpv6Address", UpdateType: "Mutable"}, "NetworkInterfaceId": {UpdateType: "Mutable"}, "PrivateIpAddress": {UpdateType: "Mutable"}, "PrivateIpAddresses": {Container: "List", PropertyType: "AWS::EC2::Instance.PrivateIpAddressSpecification", UpdateType: "Mutable"}, "SecondaryPrivateIpAddressCount": {UpdateType: "Mutable"}, "SubnetId": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::Instance.NoDevice": map[string]types.PropertySpec{},
+		
+		"AWS::EC2::Instance.PrivateIpAddressSpecification": map[string]types.PropertySpec{"Primary": {UpdateType: "Mutable"}, "PrivateIpAddress": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::Instance.SsmAssociation": map[string]types.PropertySpec{"AssociationParameters": {Container: "List", PropertyType: "AWS::EC2::Instance.AssociationParameter", UpdateType: "Mutable"}, "DocumentName": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::Instance.Volume": map[string]types.PropertySpec{"Device": {UpdateType: "Mutable"}, "VolumeId": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.BlockDeviceMapping": map[string]types.PropertySpec{"DeviceName": {UpdateType: "Mutable"}, "Ebs": {PropertyType: "AWS::EC2::LaunchTemplate.Ebs", UpdateType: "Mutable"}, "NoDevice": {UpdateType: "Mutable"}, "VirtualName": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.CreditSpecification": map[string]types.PropertySpec{"CpuCredits": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.Ebs": map[string]types.PropertySpec{"DeleteOnTermination": {UpdateType: "Mutable"}, "Encrypted": {UpdateType: "Mutable"}, "Iops": {UpdateType: "Mutable"}, "KmsKeyId": {UpdateType: "Mutable"}, "SnapshotId": {UpdateType: "Mutable"}, "VolumeSize": {UpdateType: "Mutable"}, "VolumeType": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.ElasticGpuSpecification": map[string]types.PropertySpec{"Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.IamInstanceProfile": map[string]types.PropertySpec{"Arn": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.InstanceMarketOptions": map[string]types.PropertySpec{"MarketType": {UpdateType: "Mutable"}, "SpotOptions": {PropertyType: "AWS::EC2::LaunchTemplate.SpotOptions", UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.Ipv6Add": map[string]types.PropertySpec{"Ipv6Address": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.LaunchTemplateData": map[string]types.PropertySpec{"BlockDeviceMappings": {Container: "List", PropertyType: "AWS::EC2::LaunchTemplate.BlockDeviceMapping", UpdateType: "Mutable"}, "CreditSpecification": {PropertyType: "AWS::EC2::LaunchTemplate.CreditSpecification", UpdateType: "Mutable"}, "DisableApiTermination": {UpdateType: "Mutable"}, "EbsOptimized": {UpdateType: "Mutable"}, "ElasticGpuSpecifications": {Container: "List", PropertyType: "AWS::EC2::LaunchTemplate.ElasticGpuSpecification", UpdateType: "Mutable"}, "IamInstanceProfile": {PropertyType: "AWS::EC2::LaunchTemplate.IamInstanceProfile", UpdateType: "Mutable"}, "ImageId": {UpdateType: "Mutable"}, "InstanceInitiatedShutdownBehavior": {UpdateType: "Mutable"}, "InstanceMarketOptions": {PropertyType: "AWS::EC2::LaunchTemplate.InstanceMarketOptions", UpdateType: "Mutable"}, "InstanceType": {UpdateType: "Mutable"}, "KernelId": {UpdateType: "Mutable"}, "KeyName": {UpdateType: "Mutable"}, "Monitoring": {PropertyType: "AWS::EC2::LaunchTemplate.Monitoring", UpdateType: "Mutable"}, "NetworkInterfaces": {Container: "List", PropertyType: "AWS::EC2::LaunchTemplate.NetworkInterface", UpdateType: "Mutable"}, "Placement": {PropertyType: "AWS::EC2::LaunchTemplate.Placement", UpdateType: "Mutable"}, "RamDiskId": {UpdateType: "Mutable"}, "SecurityGroupIds": {Container: "List", UpdateType: "Mutable"}, "SecurityGroups": {Container: "List", UpdateType: "Mutable"}, "TagSpecifications": {Container: "List", PropertyType: "AWS::EC2::LaunchTemplate.TagSpecification", UpdateType: "Mutable"}, "UserData": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.Monitoring": map[string]types.PropertySpec{"Enabled": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.NetworkInterface": map[string]types.PropertySpec{"AssociatePublicIpAddress": {UpdateType: "Mutable"}, "DeleteOnTermination": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "DeviceIndex": {UpdateType: "Mutable"}, "Groups": {Container: "List", UpdateType: "Mutable"}, "Ipv6AddressCount": {UpdateType: "Mutable"}, "Ipv6Addresses": {Container: "List", PropertyType: "AWS::EC2::LaunchTemplate.Ipv6Add", UpdateType: "Mutable"}, "NetworkInterfaceId": {UpdateType: "Mutable"}, "PrivateIpAddress": {UpdateType: "Mutable"}, "PrivateIpAddresses": {Container: "List", PropertyType: "AWS::EC2::LaunchTemplate.PrivateIpAdd", UpdateType: "Mutable"}, "SecondaryPrivateIpAddressCount": {UpdateType: "Mutable"}, "SubnetId": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.Placement": map[string]types.PropertySpec{"Affinity": {UpdateType: "Mutable"}, "AvailabilityZone": {UpdateType: "Mutable"}, "GroupName": {UpdateType: "Mutable"}, "HostId": {UpdateType: "Mutable"}, "Tenancy": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.PrivateIpAdd": map[string]types.PropertySpec{"Primary": {UpdateType: "Mutable"}, "PrivateIpAddress": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.SpotOptions": map[string]types.PropertySpec{"InstanceInterruptionBehavior": {UpdateType: "Mutable"}, "MaxPrice": {UpdateType: "Mutable"}, "SpotInstanceType": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::LaunchTemplate.TagSpecification": map[string]types.PropertySpec{"ResourceType": {UpdateType: "Mutable"}, "Tags": {Container: "List", PropertyType: "Tag", UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::NetworkAclEntry.Icmp": map[string]types.PropertySpec{"Code": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::NetworkAclEntry.PortRange": map[string]types.PropertySpec{"From": {UpdateType: "Mutable"}, "To": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::NetworkInterface.InstanceIpv6Address": map[string]types.PropertySpec{"Ipv6Address": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::NetworkInterface.PrivateIpAddressSpecification": map[string]types.PropertySpec{"Primary": {UpdateType: "Mutable"}, "PrivateIpAddress": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SecurityGroup.Egress": map[string]types.PropertySpec{"CidrIp": {UpdateType: "Mutable"}, "CidrIpv6": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "DestinationPrefixListId": {UpdateType: "Mutable"}, "DestinationSecurityGroupId": {UpdateType: "Mutable"}, "FromPort": {UpdateType: "Mutable"}, "IpProtocol": {UpdateType: "Mutable"}, "ToPort": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SecurityGroup.Ingress": map[string]types.PropertySpec{"CidrIp": {UpdateType: "Mutable"}, "CidrIpv6": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "FromPort": {UpdateType: "Mutable"}, "IpProtocol": {UpdateType: "Mutable"}, "SourceSecurityGroupId": {UpdateType: "Mutable"}, "SourceSecurityGroupName": {UpdateType: "Mutable"}, "SourceSecurityGroupOwnerId": {UpdateType: "Mutable"}, "ToPort": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.BlockDeviceMapping": map[string]types.PropertySpec{"DeviceName": {UpdateType: "Mutable"}, "Ebs": {PropertyType: "AWS::EC2::SpotFleet.EbsBlockDevice", UpdateType: "Mutable"}, "NoDevice": {UpdateType: "Mutable"}, "VirtualName": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.EbsBlockDevice": map[string]types.PropertySpec{"DeleteOnTermination": {UpdateType: "Mutable"}, "Encrypted": {UpdateType: "Mutable"}, "Iops": {UpdateType: "Mutable"}, "SnapshotId": {UpdateType: "Mutable"}, "VolumeSize": {UpdateType: "Mutable"}, "VolumeType": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.FleetLaunchTemplateSpecification": map[string]types.PropertySpec{"LaunchTemplateId": {UpdateType: "Mutable"}, "LaunchTemplateName": {UpdateType: "Mutable"}, "Version": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.GroupIdentifier": map[string]types.PropertySpec{"GroupId": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.IamInstanceProfileSpecification": map[string]types.PropertySpec{"Arn": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.InstanceIpv6Address": map[string]types.PropertySpec{"Ipv6Address": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.InstanceNetworkInterfaceSpecification": map[string]types.PropertySpec{"AssociatePublicIpAddress": {UpdateType: "Mutable"}, "DeleteOnTermination": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "DeviceIndex": {UpdateType: "Mutable"}, "Groups": {Container: "List", UpdateType: "Mutable"}, "Ipv6AddressCount": {UpdateType: "Mutable"}, "Ipv6Addresses": {Container: "List", PropertyType: "AWS::EC2::SpotFleet.InstanceIpv6Address", UpdateType: "Mutable"}, "NetworkInterfaceId": {UpdateType: "Mutable"}, "PrivateIpAddresses": {Container: "List", PropertyType: "AWS::EC2::SpotFleet.PrivateIpAddressSpecification", UpdateType: "Mutable"}, "SecondaryPrivateIpAddressCount": {UpdateType: "Mutable"}, "SubnetId": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.LaunchTemplateConfig": map[string]types.PropertySpec{"LaunchTemplateSpecification": {PropertyType: "AWS::EC2::SpotFleet.FleetLaunchTemplateSpecification", UpdateType: "Mutable"}, "Overrides": {Container: "List", PropertyType: "AWS::EC2::SpotFleet.LaunchTemplateOverrides", UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.LaunchTemplateOverrides": map[string]types.PropertySpec{"AvailabilityZone": {UpdateType: "Mutable"}, "InstanceType": {UpdateType: "Mutable"}, "SpotPrice": {UpdateType: "Mutable"}, "SubnetId": {UpdateType: "Mutable"}, "WeightedCapacity": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.PrivateIpAddressSpecification": map[string]types.PropertySpec{"Primary": {UpdateType: "Mutable"}, "PrivateIpAddress": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.SpotFleetLaunchSpecification": map[string]types.PropertySpec{"BlockDeviceMappings": {Container: "List", PropertyType: "AWS::EC2::SpotFleet.BlockDeviceMapping", UpdateType: "Mutable"}, "EbsOptimized": {UpdateType: "Mutable"}, "IamInstanceProfile": {PropertyType: "AWS::EC2::SpotFleet.IamInstanceProfileSpecification", UpdateType: "Mutable"}, "ImageId": {UpdateType: "Mutable"}, "InstanceType": {UpdateType: "Mutable"}, "KernelId": {UpdateType: "Mutable"}, "KeyName": {UpdateType: "Mutable"}, "Monitoring": {PropertyType: "AWS::EC2::SpotFleet.SpotFleetMonitoring", UpdateType: "Mutable"}, "NetworkInterfaces": {Container: "List", PropertyType: "AWS::EC2::SpotFleet.InstanceNetworkInterfaceSpecification", UpdateType: "Mutable"}, "Placement": {PropertyType: "AWS::EC2::SpotFleet.SpotPlacement", UpdateType: "Mutable"}, "RamdiskId": {UpdateType: "Mutable"}, "SecurityGroups": {Container: "List", PropertyType: "AWS::EC2::SpotFleet.GroupIdentifier", UpdateType: "Mutable"}, "SpotPrice": {UpdateType: "Mutable"}, "SubnetId": {UpdateType: "Mutable"}, "TagSpecifications": {Container: "List", PropertyType: "AWS::EC2::SpotFleet.SpotFleetTagSpecification", UpdateType: "Mutable"}, "UserData": {UpdateType: "Mutable"}, "WeightedCapacity": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.SpotFleetMonitoring": map[string]types.PropertySpec{"Enabled": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.SpotFleetRequestConfigData": map[string]types.PropertySpec{"AllocationStrategy": {UpdateType: "Immutable"}, "ExcessCapacityTerminationPolicy": {UpdateType: "Mutable"}, "IamFleetRole": {UpdateType: "Immutable"}, "LaunchSpecifications": {Container: "List", PropertyType: "AWS::EC2::SpotFleet.SpotFleetLaunchSpecification", UpdateType: "Immutable"}, "LaunchTemplateConfigs": {Container: "List", PropertyType: "AWS::EC2::SpotFleet.LaunchTemplateConfig", UpdateType: "Immutable"}, "ReplaceUnhealthyInstances": {UpdateType: "Immutable"}, "SpotPrice": {UpdateType: "Immutable"}, "TargetCapacity": {UpdateType: "Mutable"}, "TerminateInstancesWithExpiration": {UpdateType: "Immutable"}, "Type": {UpdateType: "Immutable"}, "ValidFrom": {UpdateType: "Immutable"}, "ValidUntil": {UpdateType: "Immutable"}, },
+		
+		"AWS::EC2::SpotFleet.SpotFleetTagSpecification": map[string]types.PropertySpec{"ResourceType": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::SpotFleet.SpotPlacement": map[string]types.PropertySpec{"AvailabilityZone": {UpdateType: "Mutable"}, "GroupName": {UpdateType: "Mutable"}, },
+		
+		"AWS::EC2::VPNConnection.VpnTunnelOptionsSpecification": map[string]types.PropertySpec{"PreSharedKey": {UpdateType: "Immutable"}, "TunnelInsideCidr": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECR::Repository.LifecyclePolicy": map[string]types.PropertySpec{"LifecyclePolicyText": {UpdateType: "Mutable"}, "RegistryId": {UpdateType: "Mutable"}, },
+		
+		"AWS::ECS::Service.AwsVpcConfiguration": map[string]types.PropertySpec{"AssignPublicIp": {UpdateType: "Mutable"}, "SecurityGroups": {Container: "List", UpdateType: "Mutable"}, "Subnets": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::ECS::Service.DeploymentConfiguration": map[string]types.PropertySpec{"MaximumPercent": {UpdateType: "Mutable"}, "MinimumHealthyPercent": {UpdateType: "Mutable"}, },
+		
+		"AWS::ECS::Service.LoadBalancer": map[string]types.PropertySpec{"ContainerName": {UpdateType: "Immutable"}, "ContainerPort": {UpdateType: "Immutable"}, "LoadBalancerName": {UpdateType: "Immutable"}, "TargetGroupArn": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::Service.NetworkConfiguration": map[string]types.PropertySpec{"AwsvpcConfiguration": {PropertyType: "AWS::ECS::Service.AwsVpcConfiguration", UpdateType: "Mutable"}, },
+		
+		"AWS::ECS::Service.PlacementConstraint": map[string]types.PropertySpec{"Expression": {UpdateType: "Immutable"}, "Type": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::Service.PlacementStrategy": map[string]types.PropertySpec{"Field": {UpdateType: "Immutable"}, "Type": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.ContainerDefinition": map[string]types.PropertySpec{"Command": {Container: "List", UpdateType: "Immutable"}, "Cpu": {UpdateType: "Immutable"}, "DisableNetworking": {UpdateType: "Immutable"}, "DnsSearchDomains": {Container: "List", UpdateType: "Immutable"}, "DnsServers": {Container: "List", UpdateType: "Immutable"}, "DockerLabels": {Container: "Map", UpdateType: "Immutable"}, "DockerSecurityOptions": {Container: "List", UpdateType: "Immutable"}, "EntryPoint": {Container: "List", UpdateType: "Immutable"}, "Environment": {Container: "List", PropertyType: "AWS::ECS::TaskDefinition.KeyValuePair", UpdateType: "Immutable"}, "Essential": {UpdateType: "Immutable"}, "ExtraHosts": {Container: "List", PropertyType: "AWS::ECS::TaskDefinition.HostEntry", UpdateType: "Immutable"}, "Hostname": {UpdateType: "Immutable"}, "Image": {UpdateType: "Immutable"}, "Links": {Container: "List", UpdateType: "Immutable"}, "LinuxParameters": {PropertyType: "AWS::ECS::TaskDefinition.LinuxParameters", UpdateType: "Immutable"}, "LogConfiguration": {PropertyType: "AWS::ECS::TaskDefinition.LogConfiguration", UpdateType: "Immutable"}, "Memory": {UpdateType: "Immutable"}, "MemoryReservation": {UpdateType: "Immutable"}, "MountPoints": {Container: "List", PropertyType: "AWS::ECS::TaskDefinition.MountPoint", UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "PortMappings": {Container: "List", PropertyType: "AWS::ECS::TaskDefinition.PortMapping", UpdateType: "Immutable"}, "Privileged": {UpdateType: "Immutable"}, "ReadonlyRootFilesystem": {UpdateType: "Immutable"}, "Ulimits": {Container: "List", PropertyType: "AWS::ECS::TaskDefinition.Ulimit", UpdateType: "Immutable"}, "User": {UpdateType: "Immutable"}, "VolumesFrom": {Container: "List", PropertyType: "AWS::ECS::TaskDefinition.VolumeFrom", UpdateType: "Immutable"}, "WorkingDirectory": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.Device": map[string]types.PropertySpec{"ContainerPath": {UpdateType: "Immutable"}, "HostPath": {UpdateType: "Immutable"}, "Permissions": {Container: "List", UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.HostEntry": map[string]types.PropertySpec{"Hostname": {UpdateType: "Immutable"}, "IpAddress": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.HostVolumeProperties": map[string]types.PropertySpec{"SourcePath": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.KernelCapabilities": map[string]types.PropertySpec{"Add": {Container: "List", UpdateType: "Immutable"}, "Drop": {Container: "List", UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.KeyValuePair": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, "Value": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.LinuxParameters": map[string]types.PropertySpec{"Capabilities": {PropertyType: "AWS::ECS::TaskDefinition.KernelCapabilities", UpdateType: "Immutable"}, "Devices": {Container: "List", PropertyType: "AWS::ECS::TaskDefinition.Device", UpdateType: "Immutable"}, "InitProcessEnabled": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.LogConfiguration": map[string]types.PropertySpec{"LogDriver": {UpdateType: "Immutable"}, "Options": {Container: "Map", UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.MountPoint": map[string]types.PropertySpec{"ContainerPath": {UpdateType: "Immutable"}, "ReadOnly": {UpdateType: "Immutable"}, "SourceVolume": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.PortMapping": map[string]types.PropertySpec{"ContainerPort": {UpdateType: "Immutable"}, "HostPort": {UpdateType: "Immutable"}, "Protocol": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.TaskDefinitionPlacementConstraint": map[string]types.PropertySpec{"Expression": {UpdateType: "Immutable"}, "Type": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.Ulimit": map[string]types.PropertySpec{"HardLimit": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "SoftLimit": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.Volume": map[string]types.PropertySpec{"Host": {PropertyType: "AWS::ECS::TaskDefinition.HostVolumeProperties", UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::ECS::TaskDefinition.VolumeFrom": map[string]types.PropertySpec{"ReadOnly": {UpdateType: "Immutable"}, "SourceContainer": {UpdateType: "Immutable"}, },
+		
+		"AWS::EFS::FileSystem.ElasticFileSystemTag": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.Application": map[string]types.PropertySpec{"AdditionalInfo": {Container: "Map", UpdateType: "Mutable"}, "Args": {Container: "List", UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "Version": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.AutoScalingPolicy": map[string]types.PropertySpec{"Constraints": {PropertyType: "AWS::EMR::Cluster.ScalingConstraints", UpdateType: "Mutable"}, "Rules": {Container: "List", PropertyType: "AWS::EMR::Cluster.ScalingRule", UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.BootstrapActionConfig": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "ScriptBootstrapAction": {PropertyType: "AWS::EMR::Cluster.ScriptBootstrapActionConfig", UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.CloudWatchAlarmDefinition": map[string]types.PropertySpec{"ComparisonOperator": {UpdateType: "Mutable"}, "Dimensions": {Container: "List", PropertyType: "AWS::EMR::Cluster.MetricDimension", UpdateType: "Mutable"}, "EvaluationPeriods": {UpdateType: "Mutable"}, "MetricName": {UpdateType: "Mutable"}, "Namespace": {UpdateType: "Mutable"}, "Period": {UpdateType: "Mutable"}, "Statistic": {UpdateType: "Mutable"}, "Threshold": {UpdateType: "Mutable"}, "Unit": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.Configuration": map[string]types.PropertySpec{"Classification": {UpdateType: "Mutable"}, "ConfigurationProperties": {Container: "Map", UpdateType: "Mutable"}, "Configurations": {Container: "List", PropertyType: "AWS::EMR::Cluster.Configuration", UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.EbsBlockDeviceConfig": map[string]types.PropertySpec{"VolumeSpecification": {PropertyType: "AWS::EMR::Cluster.VolumeSpecification", UpdateType: "Mutable"}, "VolumesPerInstance": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.EbsConfiguration": map[string]types.PropertySpec{"EbsBlockDeviceConfigs": {Container: "List", PropertyType: "AWS::EMR::Cluster.EbsBlockDeviceConfig", UpdateType: "Mutable"}, "EbsOptimized": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.InstanceFleetConfig": map[string]types.PropertySpec{"InstanceTypeConfigs": {Container: "List", PropertyType: "AWS::EMR::Cluster.InstanceTypeConfig", UpdateType: "Immutable"}, "LaunchSpecifications": {PropertyType: "AWS::EMR::Cluster.InstanceFleetProvisioningSpecifications", UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, "TargetOnDemandCapacity": {UpdateType: "Mutable"}, "TargetSpotCapacity": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.InstanceFleetProvisioningSpecifications": map[string]types.PropertySpec{"SpotSpecification": {PropertyType: "AWS::EMR::Cluster.SpotProvisioningSpecification", UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.InstanceGroupConfig": map[string]types.PropertySpec{"AutoScalingPolicy": {PropertyType: "AWS::EMR::Cluster.AutoScalingPolicy", UpdateType: "Mutable"}, "BidPrice": {UpdateType: "Immutable"}, "Configurations": {Container: "List", PropertyType: "AWS::EMR::Cluster.Configuration", UpdateType: "Immutable"}, "EbsConfiguration": {PropertyType: "AWS::EMR::Cluster.EbsConfiguration", UpdateType: "Immutable"}, "InstanceCount": {UpdateType: "Mutable"}, "InstanceType": {UpdateType: "Immutable"}, "Market": {UpdateType: "Immutable"}, "Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::EMR::Cluster.InstanceTypeConfig": map[string]types.PropertySpec{"BidPrice": {UpdateType: "Immutable"}, "BidPriceAsPercentageOfOnDemandPrice": {UpdateType: "Immutable"}, "Configurations": {Container: "List", PropertyType: "AWS::EMR::Cluster.Configuration", UpdateType: "Immutable"}, "EbsConfiguration": {PropertyType: "AWS::EMR::Cluster.EbsConfiguration", UpdateType: "Immutable"}, "InstanceType": {UpdateType: "Immutable"}, "WeightedCapacity": {UpdateType: "Immutable"}, },
+		
+		"AWS::EMR::Cluster.JobFlowInstancesConfig": map[string]types.PropertySpec{"AdditionalMasterSecurityGroups": {Container: "List", UpdateType: "Immutable"}, "AdditionalSlaveSecurityGroups": {Container: "List", UpdateType: "Immutable"}, "CoreInstanceFleet": {PropertyType: "AWS::EMR::Cluster.InstanceFleetConfig", UpdateType: "Immutable"}, "CoreInstanceGroup": {PropertyType: "AWS::EMR::Cluster.InstanceGroupConfig", UpdateType: "Immutable"}, "Ec2KeyName": {UpdateType: "Immutable"}, "Ec2SubnetId": {UpdateType: "Immutable"}, "EmrManagedMasterSecurityGroup": {UpdateType: "Immutable"}, "EmrManagedSlaveSecurityGroup": {UpdateType: "Immutable"}, "HadoopVersion": {UpdateType: "Immutable"}, "MasterInstanceFleet": {PropertyType: "AWS::EMR::Cluster.InstanceFleetConfig", UpdateType: "Immutable"}, "MasterInstanceGroup": {PropertyType: "AWS::EMR::Cluster.InstanceGroupConfig", UpdateType: "Immutable"}, "Placement": {PropertyType: "AWS::EMR::Cluster.PlacementType", UpdateType: "Immutable"}, "ServiceAccessSecurityGroup": {UpdateType: "Immutable"}, "TerminationProtected": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.MetricDimension": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.PlacementType": map[string]types.PropertySpec{"AvailabilityZone": {UpdateType: "Immutable"}, },
+		
+		"AWS::EMR::Cluster.ScalingAction": map[string]types.PropertySpec{"Market": {UpdateType: "Mutable"}, "SimpleScalingPolicyConfiguration": {PropertyType: "AWS::EMR::Cluster.SimpleScalingPolicyConfiguration", UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.ScalingConstraints": map[string]types.PropertySpec{"MaxCapacity": {UpdateType: "Mutable"}, "MinCapacity": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.ScalingRule": map[string]types.PropertySpec{"Action": {PropertyType: "AWS::EMR::Cluster.ScalingAction", UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "Trigger": {PropertyType: "AWS::EMR::Cluster.ScalingTrigger", UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.ScalingTrigger": map[string]types.PropertySpec{"CloudWatchAlarmDefinition": {PropertyType: "AWS::EMR::Cluster.CloudWatchAlarmDefinition", UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.ScriptBootstrapActionConfig": map[string]types.PropertySpec{"Args": {Container: "List", UpdateType: "Mutable"}, "Path": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.SimpleScalingPolicyConfiguration": map[string]types.PropertySpec{"AdjustmentType": {UpdateType: "Mutable"}, "CoolDown": {UpdateType: "Mutable"}, "ScalingAdjustment": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.SpotProvisioningSpecification": map[string]types.PropertySpec{"BlockDurationMinutes": {UpdateType: "Mutable"}, "TimeoutAction": {UpdateType: "Mutable"}, "TimeoutDurationMinutes": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Cluster.VolumeSpecification": map[string]types.PropertySpec{"Iops": {UpdateType: "Mutable"}, "SizeInGB": {UpdateType: "Mutable"}, "VolumeType": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceFleetConfig.Configuration": map[string]types.PropertySpec{"Classification": {UpdateType: "Immutable"}, "ConfigurationProperties": {Container: "Map", UpdateType: "Immutable"}, "Configurations": {Container: "List", PropertyType: "AWS::EMR::InstanceFleetConfig.Configuration", UpdateType: "Immutable"}, },
+		
+		"AWS::EMR::InstanceFleetConfig.EbsBlockDeviceConfig": map[string]types.PropertySpec{"VolumeSpecification": {PropertyType: "AWS::EMR::InstanceFleetConfig.VolumeSpecification", UpdateType: "Immutable"}, "VolumesPerInstance": {UpdateType: "Immutable"}, },
+		
+		"AWS::EMR::InstanceFleetConfig.EbsConfiguration": map[string]types.PropertySpec{"EbsBlockDeviceConfigs": {Container: "List", PropertyType: "AWS::EMR::InstanceFleetConfig.EbsBlockDeviceConfig", UpdateType: "Immutable"}, "EbsOptimized": {UpdateType: "Immutable"}, },
+		
+		"AWS::EMR::InstanceFleetConfig.InstanceFleetProvisioningSpecifications": map[string]types.PropertySpec{"SpotSpecification": {PropertyType: "AWS::EMR::InstanceFleetConfig.SpotProvisioningSpecification", UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceFleetConfig.InstanceTypeConfig": map[string]types.PropertySpec{"BidPrice": {UpdateType: "Immutable"}, "BidPriceAsPercentageOfOnDemandPrice": {UpdateType: "Immutable"}, "Configurations": {Container: "List", PropertyType: "AWS::EMR::InstanceFleetConfig.Configuration", UpdateType: "Immutable"}, "EbsConfiguration": {PropertyType: "AWS::EMR::InstanceFleetConfig.EbsConfiguration", UpdateType: "Immutable"}, "InstanceType": {UpdateType: "Immutable"}, "WeightedCapacity": {UpdateType: "Immutable"}, },
+		
+		"AWS::EMR::InstanceFleetConfig.SpotProvisioningSpecification": map[string]types.PropertySpec{"BlockDurationMinutes": {UpdateType: "Mutable"}, "TimeoutAction": {UpdateType: "Mutable"}, "TimeoutDurationMinutes": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceFleetConfig.VolumeSpecification": map[string]types.PropertySpec{"Iops": {UpdateType: "Immutable"}, "SizeInGB": {UpdateType: "Immutable"}, "VolumeType": {UpdateType: "Immutable"}, },
+		
+		"AWS::EMR::InstanceGroupConfig.AutoScalingPolicy": map[string]types.PropertySpec{"Constraints": {PropertyType: "AWS::EMR::InstanceGroupConfig.ScalingConstraints", UpdateType: "Mutable"}, "Rules": {Container: "List", PropertyType: "AWS::EMR::InstanceGroupConfig.ScalingRule", UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceGroupConfig.CloudWatchAlarmDefinition": map[string]types.PropertySpec{"ComparisonOperator": {UpdateType: "Mutable"}, "Dimensions": {Container: "List", PropertyType: "AWS::EMR::InstanceGroupConfig.MetricDimension", UpdateType: "Mutable"}, "EvaluationPeriods": {UpdateType: "Mutable"}, "MetricName": {UpdateType: "Mutable"}, "Namespace": {UpdateType: "Mutable"}, "Period": {UpdateType: "Mutable"}, "Statistic": {UpdateType: "Mutable"}, "Threshold": {UpdateType: "Mutable"}, "Unit": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceGroupConfig.Configuration": map[string]types.PropertySpec{"Classification": {UpdateType: "Immutable"}, "ConfigurationProperties": {Container: "Map", UpdateType: "Immutable"}, "Configurations": {Container: "List", PropertyType: "AWS::EMR::InstanceGroupConfig.Configuration", UpdateType: "Immutable"}, },
+		
+		"AWS::EMR::InstanceGroupConfig.EbsBlockDeviceConfig": map[string]types.PropertySpec{"VolumeSpecification": {PropertyType: "AWS::EMR::InstanceGroupConfig.VolumeSpecification", UpdateType: "Mutable"}, "VolumesPerInstance": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceGroupConfig.EbsConfiguration": map[string]types.PropertySpec{"EbsBlockDeviceConfigs": {Container: "List", PropertyType: "AWS::EMR::InstanceGroupConfig.EbsBlockDeviceConfig", UpdateType: "Mutable"}, "EbsOptimized": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceGroupConfig.MetricDimension": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceGroupConfig.ScalingAction": map[string]types.PropertySpec{"Market": {UpdateType: "Mutable"}, "SimpleScalingPolicyConfiguration": {PropertyType: "AWS::EMR::InstanceGroupConfig.SimpleScalingPolicyConfiguration", UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceGroupConfig.ScalingConstraints": map[string]types.PropertySpec{"MaxCapacity": {UpdateType: "Mutable"}, "MinCapacity": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceGroupConfig.ScalingRule": map[string]types.PropertySpec{"Action": {PropertyType: "AWS::EMR::InstanceGroupConfig.ScalingAction", UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "Trigger": {PropertyType: "AWS::EMR::InstanceGroupConfig.ScalingTrigger", UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceGroupConfig.ScalingTrigger": map[string]types.PropertySpec{"CloudWatchAlarmDefinition": {PropertyType: "AWS::EMR::InstanceGroupConfig.CloudWatchAlarmDefinition", UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceGroupConfig.SimpleScalingPolicyConfiguration": map[string]types.PropertySpec{"AdjustmentType": {UpdateType: "Mutable"}, "CoolDown": {UpdateType: "Mutable"}, "ScalingAdjustment": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::InstanceGroupConfig.VolumeSpecification": map[string]types.PropertySpec{"Iops": {UpdateType: "Mutable"}, "SizeInGB": {UpdateType: "Mutable"}, "VolumeType": {UpdateType: "Mutable"}, },
+		
+		"AWS::EMR::Step.HadoopJarStepConfig": map[string]types.PropertySpec{"Args": {Container: "List", UpdateType: "Immutable"}, "Jar": {UpdateType: "Immutable"}, "MainClass": {UpdateType: "Immutable"}, "StepProperties": {Container: "List", PropertyType: "AWS::EMR::Step.KeyValue", UpdateType: "Immutable"}, },
+		
+		"AWS::EMR::Step.KeyValue": map[string]types.PropertySpec{"Key": {UpdateType: "Immutable"}, "Value": {UpdateType: "Immutable"}, },
+		
+		"AWS::ElastiCache::ReplicationGroup.NodeGroupConfiguration": map[string]types.PropertySpec{"PrimaryAvailabilityZone": {UpdateType: "Immutable"}, "ReplicaAvailabilityZones": {Container: "List", UpdateType: "Immutable"}, "ReplicaCount": {UpdateType: "Immutable"}, "Slots": {UpdateType: "Immutable"}, },
+		
+		"AWS::ElasticBeanstalk::Application.ApplicationResourceLifecycleConfig": map[string]types.PropertySpec{"ServiceRole": {UpdateType: "Mutable"}, "VersionLifecycleConfig": {PropertyType: "AWS::ElasticBeanstalk::Application.ApplicationVersionLifecycleConfig", UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticBeanstalk::Application.ApplicationVersionLifecycleConfig": map[string]types.PropertySpec{"MaxAgeRule": {PropertyType: "AWS::ElasticBeanstalk::Application.MaxAgeRule", UpdateType: "Mutable"}, "MaxCountRule": {PropertyType: "AWS::ElasticBeanstalk::Application.MaxCountRule", UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticBeanstalk::Application.MaxAgeRule": map[string]types.PropertySpec{"DeleteSourceFromS3": {UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, "MaxAgeInDays": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticBeanstalk::Application.MaxCountRule": map[string]types.PropertySpec{"DeleteSourceFromS3": {UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, "MaxCount": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticBeanstalk::ApplicationVersion.SourceBundle": map[string]types.PropertySpec{"S3Bucket": {UpdateType: "Mutable"}, "S3Key": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticBeanstalk::ConfigurationTemplate.ConfigurationOptionSetting": map[string]types.PropertySpec{"Namespace": {UpdateType: "Mutable"}, "OptionName": {UpdateType: "Mutable"}, "ResourceName": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticBeanstalk::ConfigurationTemplate.SourceConfiguration": map[string]types.PropertySpec{"ApplicationName": {UpdateType: "Mutable"}, "TemplateName": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticBeanstalk::Environment.OptionSetting": map[string]types.PropertySpec{"Namespace": {UpdateType: "Mutable"}, "OptionName": {UpdateType: "Mutable"}, "ResourceName": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticBeanstalk::Environment.Tier": map[string]types.PropertySpec{"Name": {UpdateType: "Immutable"}, "Type": {UpdateType: "Immutable"}, "Version": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancing::LoadBalancer.AccessLoggingPolicy": map[string]types.PropertySpec{"EmitInterval": {UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, "S3BucketName": {UpdateType: "Mutable"}, "S3BucketPrefix": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancing::LoadBalancer.AppCookieStickinessPolicy": map[string]types.PropertySpec{"CookieName": {UpdateType: "Mutable"}, "PolicyName": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancing::LoadBalancer.ConnectionDrainingPolicy": map[string]types.PropertySpec{"Enabled": {UpdateType: "Mutable"}, "Timeout": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancing::LoadBalancer.ConnectionSettings": map[string]types.PropertySpec{"IdleTimeout": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancing::LoadBalancer.HealthCheck": map[string]types.PropertySpec{"HealthyThreshold": {UpdateType: "Mutable"}, "Interval": {UpdateType: "Mutable"}, "Target": {UpdateType: "Mutable"}, "Timeout": {UpdateType: "Mutable"}, "UnhealthyThreshold": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancing::LoadBalancer.LBCookieStickinessPolicy": map[string]types.PropertySpec{"CookieExpirationPeriod": {UpdateType: "Mutable"}, "PolicyName": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancing::LoadBalancer.Listeners": map[string]types.PropertySpec{"InstancePort": {UpdateType: "Mutable"}, "InstanceProtocol": {UpdateType: "Mutable"}, "LoadBalancerPort": {UpdateType: "Mutable"}, "PolicyNames": {Container: "List", UpdateType: "Mutable"}, "Protocol": {UpdateType: "Mutable"}, "SSLCertificateId": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancing::LoadBalancer.Policies": map[string]types.PropertySpec{"Attributes": {Container: "List", UpdateType: "Mutable"}, "InstancePorts": {Container: "List", UpdateType: "Mutable"}, "LoadBalancerPorts": {Container: "List", UpdateType: "Mutable"}, "PolicyName": {UpdateType: "Mutable"}, "PolicyType": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::Listener.Action": map[string]types.PropertySpec{"TargetGroupArn": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::Listener.Certificate": map[string]types.PropertySpec{"CertificateArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::ListenerCertificate.Certificate": map[string]types.PropertySpec{"CertificateArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::ListenerRule.Action": map[string]types.PropertySpec{"TargetGroupArn": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::ListenerRule.RuleCondition": map[string]types.PropertySpec{"Field": {UpdateType: "Mutable"}, "Values": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::LoadBalancer.LoadBalancerAttribute": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::LoadBalancer.SubnetMapping": map[string]types.PropertySpec{"AllocationId": {UpdateType: "Mutable"}, "SubnetId": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::TargetGroup.Matcher": map[string]types.PropertySpec{"HttpCode": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::TargetGroup.TargetDescription": map[string]types.PropertySpec{"AvailabilityZone": {UpdateType: "Mutable"}, "Id": {UpdateType: "Mutable"}, "Port": {UpdateType: "Mutable"}, },
+		
+		"AWS::ElasticLoadBalancingV2::TargetGroup.TargetGroupAttribute": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::Elasticsearch::Domain.EBSOptions": map[string]types.PropertySpec{"EBSEnabled": {UpdateType: "Mutable"}, "Iops": {UpdateType: "Mutable"}, "VolumeSize": {UpdateType: "Mutable"}, "VolumeType": {UpdateType: "Mutable"}, },
+		
+		"AWS::Elasticsearch::Domain.ElasticsearchClusterConfig": map[string]types.PropertySpec{"DedicatedMasterCount": {UpdateType: "Mutable"}, "DedicatedMasterEnabled": {UpdateType: "Mutable"}, "DedicatedMasterType": {UpdateType: "Mutable"}, "InstanceCount": {UpdateType: "Mutable"}, "InstanceType": {UpdateType: "Mutable"}, "ZoneAwarenessEnabled": {UpdateType: "Mutable"}, },
+		
+		"AWS::Elasticsearch::Domain.SnapshotOptions": map[string]types.PropertySpec{"AutomatedSnapshotStartHour": {UpdateType: "Mutable"}, },
+		
+		"AWS::Elasticsearch::Domain.VPCOptions": map[string]types.PropertySpec{"SecurityGroupIds": {Container: "List", UpdateType: "Mutable"}, "SubnetIds": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::Events::Rule.EcsParameters": map[string]types.PropertySpec{"TaskCount": {UpdateType: "Mutable"}, "TaskDefinitionArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::Events::Rule.InputTransformer": map[string]types.PropertySpec{"InputPathsMap": {Container: "Map", UpdateType: "Mutable"}, "InputTemplate": {UpdateType: "Mutable"}, },
+		
+		"AWS::Events::Rule.KinesisParameters": map[string]types.PropertySpec{"PartitionKeyPath": {UpdateType: "Mutable"}, },
+		
+		"AWS::Events::Rule.RunCommandParameters": map[string]types.PropertySpec{"RunCommandTargets": {Container: "List", PropertyType: "AWS::Events::Rule.RunCommandTarget", UpdateType: "Mutable"}, },
+		
+		"AWS::Events::Rule.RunCommandTarget": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Values": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::Events::Rule.Target": map[string]types.PropertySpec{"Arn": {UpdateType: "Mutable"}, "EcsParameters": {PropertyType: "AWS::Events::Rule.EcsParameters", UpdateType: "Mutable"}, "Id": {UpdateType: "Mutable"}, "Input": {UpdateType: "Mutable"}, "InputPath": {UpdateType: "Mutable"}, "InputTransformer": {PropertyType: "AWS::Events::Rule.InputTransformer", UpdateType: "Mutable"}, "KinesisParameters": {PropertyType: "AWS::Events::Rule.KinesisParameters", UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "RunCommandParameters": {PropertyType: "AWS::Events::Rule.RunCommandParameters", UpdateType: "Mutable"}, },
+		
+		"AWS::GameLift::Alias.RoutingStrategy": map[string]types.PropertySpec{"FleetId": {UpdateType: "Mutable"}, "Message": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::GameLift::Build.S3Location": map[string]types.PropertySpec{"Bucket": {UpdateType: "Immutable"}, "Key": {UpdateType: "Immutable"}, "RoleArn": {UpdateType: "Immutable"}, },
+		
+		"AWS::GameLift::Fleet.IpPermission": map[string]types.PropertySpec{"FromPort": {UpdateType: "Mutable"}, "IpRange": {UpdateType: "Mutable"}, "Protocol": {UpdateType: "Mutable"}, "ToPort": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Classifier.GrokClassifier": map[string]types.PropertySpec{"Classification": {UpdateType: "Mutable"}, "CustomPatterns": {UpdateType: "Mutable"}, "GrokPattern": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::Glue::Connection.ConnectionInput": map[string]types.PropertySpec{"ConnectionProperties": {UpdateType: "Mutable"}, "ConnectionType": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "MatchCriteria": {Container: "List", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "PhysicalConnectionRequirements": {PropertyType: "AWS::Glue::Connection.PhysicalConnectionRequirements", UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Connection.PhysicalConnectionRequirements": map[string]types.PropertySpec{"AvailabilityZone": {UpdateType: "Mutable"}, "SecurityGroupIdList": {Container: "List", UpdateType: "Mutable"}, "SubnetId": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Crawler.JdbcTarget": map[string]types.PropertySpec{"ConnectionName": {UpdateType: "Mutable"}, "Exclusions": {Container: "List", UpdateType: "Mutable"}, "Path": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Crawler.S3Target": map[string]types.PropertySpec{"Exclusions": {Container: "List", UpdateType: "Mutable"}, "Path": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Crawler.Schedule": map[string]types.PropertySpec{"ScheduleExpression": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Crawler.SchemaChangePolicy": map[string]types.PropertySpec{"DeleteBehavior": {UpdateType: "Mutable"}, "UpdateBehavior": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Crawler.Targets": map[string]types.PropertySpec{"JdbcTargets": {Container: "List", PropertyType: "AWS::Glue::Crawler.JdbcTarget", UpdateType: "Mutable"}, "S3Targets": {Container: "List", PropertyType: "AWS::Glue::Crawler.S3Target", UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Database.DatabaseInput": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "LocationUri": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "Parameters": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Job.ConnectionsList": map[string]types.PropertySpec{"Connections": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Job.ExecutionProperty": map[string]types.PropertySpec{"MaxConcurrentRuns": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Job.JobCommand": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "ScriptLocation": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Partition.Column": map[string]types.PropertySpec{"Comment": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Partition.Order": map[string]types.PropertySpec{"Column": {UpdateType: "Mutable"}, "SortOrder": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Partition.PartitionInput": map[string]types.PropertySpec{"Parameters": {UpdateType: "Mutable"}, "StorageDescriptor": {PropertyType: "AWS::Glue::Partition.StorageDescriptor", UpdateType: "Mutable"}, "Values": {Container: "List", UpdateType: "Immutable"}, },
+		
+		"AWS::Glue::Partition.SerdeInfo": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Parameters": {UpdateType: "Mutable"}, "SerializationLibrary": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Partition.SkewedInfo": map[string]types.PropertySpec{"SkewedColumnNames": {Container: "List", UpdateType: "Mutable"}, "SkewedColumnValueLocationMaps": {UpdateType: "Mutable"}, "SkewedColumnValues": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Partition.StorageDescriptor": map[string]types.PropertySpec{"BucketColumns": {Container: "List", UpdateType: "Mutable"}, "Columns": {Container: "List", PropertyType: "AWS::Glue::Partition.Column", UpdateType: "Mutable"}, "Compressed": {UpdateType: "Mutable"}, "InputFormat": {UpdateType: "Mutable"}, "Location": {UpdateType: "Mutable"}, "NumberOfBuckets": {UpdateType: "Mutable"}, "OutputFormat": {UpdateType: "Mutable"}, "Parameters": {UpdateType: "Mutable"}, "SerdeInfo": {PropertyType: "AWS::Glue::Partition.SerdeInfo", UpdateType: "Mutable"}, "SkewedInfo": {PropertyType: "AWS::Glue::Partition.SkewedInfo", UpdateType: "Mutable"}, "SortColumns": {Container: "List", PropertyType: "AWS::Glue::Partition.Order", UpdateType: "Mutable"}, "StoredAsSubDirectories": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Table.Column": map[string]types.PropertySpec{"Comment": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Table.Order": map[string]types.PropertySpec{"Column": {UpdateType: "Mutable"}, "SortOrder": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Table.SerdeInfo": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Parameters": {UpdateType: "Mutable"}, "SerializationLibrary": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Table.SkewedInfo": map[string]types.PropertySpec{"SkewedColumnNames": {Container: "List", UpdateType: "Mutable"}, "SkewedColumnValueLocationMaps": {UpdateType: "Mutable"}, "SkewedColumnValues": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Table.StorageDescriptor": map[string]types.PropertySpec{"BucketColumns": {Container: "List", UpdateType: "Mutable"}, "Columns": {Container: "List", PropertyType: "AWS::Glue::Table.Column", UpdateType: "Mutable"}, "Compressed": {UpdateType: "Mutable"}, "InputFormat": {UpdateType: "Mutable"}, "Location": {UpdateType: "Mutable"}, "NumberOfBuckets": {UpdateType: "Mutable"}, "OutputFormat": {UpdateType: "Mutable"}, "Parameters": {UpdateType: "Mutable"}, "SerdeInfo": {PropertyType: "AWS::Glue::Table.SerdeInfo", UpdateType: "Mutable"}, "SkewedInfo": {PropertyType: "AWS::Glue::Table.SkewedInfo", UpdateType: "Mutable"}, "SortColumns": {Container: "List", PropertyType: "AWS::Glue::Table.Order", UpdateType: "Mutable"}, "StoredAsSubDirectories": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Table.TableInput": map[string]types.PropertySpec{"Description": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "Owner": {UpdateType: "Mutable"}, "Parameters": {UpdateType: "Mutable"}, "PartitionKeys": {Container: "List", PropertyType: "AWS::Glue::Table.Column", UpdateType: "Mutable"}, "Retention": {UpdateType: "Mutable"}, "StorageDescriptor": {PropertyType: "AWS::Glue::Table.StorageDescriptor", UpdateType: "Mutable"}, "TableType": {UpdateType: "Mutable"}, "ViewExpandedText": {UpdateType: "Mutable"}, "ViewOriginalText": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Trigger.Action": map[string]types.PropertySpec{"Arguments": {UpdateType: "Mutable"}, "JobName": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Trigger.Condition": map[string]types.PropertySpec{"JobName": {UpdateType: "Mutable"}, "LogicalOperator": {UpdateType: "Mutable"}, "State": {UpdateType: "Mutable"}, },
+		
+		"AWS::Glue::Trigger.Predicate": map[string]types.PropertySpec{"Conditions": {Container: "List", PropertyType: "AWS::Glue::Trigger.Condition", UpdateType: "Mutable"}, "Logical": {UpdateType: "Mutable"}, },
+		
+		"AWS::GuardDuty::Filter.Condition": map[string]types.PropertySpec{"Eq": {Container: "List", UpdateType: "Mutable"}, "Gte": {UpdateType: "Mutable"}, "Lt": {UpdateType: "Mutable"}, "Lte": {UpdateType: "Mutable"}, "Neq": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::GuardDuty::Filter.FindingCriteria": map[string]types.PropertySpec{"Criterion": {UpdateType: "Mutable"}, "ItemType": {PropertyType: "AWS::GuardDuty::Filter.Condition", UpdateType: "Mutable"}, },
+		
+		"AWS::IAM::Group.Policy": map[string]types.PropertySpec{"PolicyDocument": {UpdateType: "Mutable"}, "PolicyName": {UpdateType: "Mutable"}, },
+		
+		"AWS::IAM::Role.Policy": map[string]types.PropertySpec{"PolicyDocument": {UpdateType: "Mutable"}, "PolicyName": {UpdateType: "Mutable"}, },
+		
+		"AWS::IAM::User.LoginProfile": map[string]types.PropertySpec{"Password": {UpdateType: "Mutable"}, "PasswordResetRequired": {UpdateType: "Mutable"}, },
+		
+		"AWS::IAM::User.Policy": map[string]types.PropertySpec{"PolicyDocument": {UpdateType: "Mutable"}, "PolicyName": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::Thing.AttributePayload": map[string]types.PropertySpec{"Attributes": {Container: "Map", UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.Action": map[string]types.PropertySpec{"CloudwatchAlarm": {PropertyType: "AWS::IoT::TopicRule.CloudwatchAlarmAction", UpdateType: "Mutable"}, "CloudwatchMetric": {PropertyType: "AWS::IoT::TopicRule.CloudwatchMetricAction", UpdateType: "Mutable"}, "DynamoDB": {PropertyType: "AWS::IoT::TopicRule.DynamoDBAction", UpdateType: "Mutable"}, "DynamoDBv2": {PropertyType: "AWS::IoT::TopicRule.DynamoDBv2Action", UpdateType: "Mutable"}, "Elasticsearch": {PropertyType: "AWS::IoT::TopicRule.ElasticsearchAction", UpdateType: "Mutable"}, "Firehose": {PropertyType: "AWS::IoT::TopicRule.FirehoseAction", UpdateType: "Mutable"}, "Kinesis": {PropertyType: "AWS::IoT::TopicRule.KinesisAction", UpdateType: "Mutable"}, "Lambda": {PropertyType: "AWS::IoT::TopicRule.LambdaAction", UpdateType: "Mutable"}, "Republish": {PropertyType: "AWS::IoT::TopicRule.RepublishAction", UpdateType: "Mutable"}, "S3": {PropertyType: "AWS::IoT::TopicRule.S3Action", UpdateType: "Mutable"}, "Sns": {PropertyType: "AWS::IoT::TopicRule.SnsAction", UpdateType: "Mutable"}, "Sqs": {PropertyType: "AWS::IoT::TopicRule.SqsAction", UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.CloudwatchAlarmAction": map[string]types.PropertySpec{"AlarmName": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "StateReason": {UpdateType: "Mutable"}, "StateValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.CloudwatchMetricAction": map[string]types.PropertySpec{"MetricName": {UpdateType: "Mutable"}, "MetricNamespace": {UpdateType: "Mutable"}, "MetricTimestamp": {UpdateType: "Mutable"}, "MetricUnit": {UpdateType: "Mutable"}, "MetricValue": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.DynamoDBAction": map[string]types.PropertySpec{"HashKeyField": {UpdateType: "Mutable"}, "HashKeyType": {UpdateType: "Mutable"}, "HashKeyValue": {UpdateType: "Mutable"}, "PayloadField": {UpdateType: "Mutable"}, "RangeKeyField": {UpdateType: "Mutable"}, "RangeKeyType": {UpdateType: "Mutable"}, "RangeKeyValue": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "TableName": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.DynamoDBv2Action": map[string]types.PropertySpec{"PutItem": {PropertyType: "AWS::IoT::TopicRule.PutItemInput", UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.ElasticsearchAction": map[string]types.PropertySpec{"Endpoint": {UpdateType: "Mutable"}, "Id": {UpdateType: "Mutable"}, "Index": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.FirehoseAction": map[string]types.PropertySpec{"DeliveryStreamName": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "Separator": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.KinesisAction": map[string]types.PropertySpec{"PartitionKey": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "StreamName": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.LambdaAction": map[string]types.PropertySpec{"FunctionArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.PutItemInput": map[string]types.PropertySpec{"TableName": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.RepublishAction": map[string]types.PropertySpec{"RoleArn": {UpdateType: "Mutable"}, "Topic": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.S3Action": map[string]types.PropertySpec{"BucketName": {UpdateType: "Mutable"}, "Key": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.SnsAction": map[string]types.PropertySpec{"MessageFormat": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "TargetArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.SqsAction": map[string]types.PropertySpec{"QueueUrl": {UpdateType: "Mutable"}, "RoleArn": {UpdateType: "Mutable"}, "UseBase64": {UpdateType: "Mutable"}, },
+		
+		"AWS::IoT::TopicRule.TopicRulePayload": map[string]types.PropertySpec{"Actions": {Container: "List", PropertyType: "AWS::IoT::TopicRule.Action", UpdateType: "Mutable"}, "AwsIotSqlVersion": {UpdateType: "Mutable"}, "Description": {UpdateType: "Mutable"}, "RuleDisabled": {UpdateType: "Mutable"}, "Sql": {UpdateType: "Mutable"}, },
+		
+		"AWS::Kinesis::Stream.StreamEncryption": map[string]types.PropertySpec{"EncryptionType": {UpdateType: "Mutable"}, "KeyId": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::Application.CSVMappingParameters": map[string]types.PropertySpec{"RecordColumnDelimiter": {UpdateType: "Mutable"}, "RecordRowDelimiter": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::Application.Input": map[string]types.PropertySpec{"InputParallelism": {PropertyType: "AWS::KinesisAnalytics::Application.InputParallelism", UpdateType: "Mutable"}, "InputProcessingConfiguration": {PropertyType: "AWS::KinesisAnalytics::Application.InputProcessingConfiguration", UpdateType: "Mutable"}, "InputSchema": {PropertyType: "AWS::KinesisAnalytics::Application.InputSchema", UpdateType: "Mutable"}, "KinesisFirehoseInput": {PropertyType: "AWS::KinesisAnalytics::Application.KinesisFirehoseInput", UpdateType: "Mutable"}, "KinesisStreamsInput": {PropertyType: "AWS::KinesisAnalytics::Application.KinesisStreamsInput", UpdateType: "Mutable"}, "NamePrefix": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::Application.InputLambdaProcessor": map[string]types.PropertySpec{"ResourceARN": {UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::Application.InputParallelism": map[string]types.PropertySpec{"Count": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::Application.InputProcessingConfiguration": map[string]types.PropertySpec{"InputLambdaProcessor": {PropertyType: "AWS::KinesisAnalytics::Application.InputLambdaProcessor", UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::Application.InputSchema": map[string]types.PropertySpec{"RecordColumns": {Container: "List", PropertyType: "AWS::KinesisAnalytics::Application.RecordColumn", UpdateType: "Mutable"}, "RecordEncoding": {UpdateType: "Mutable"}, "RecordFormat": {PropertyType: "AWS::KinesisAnalytics::Application.RecordFormat", UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::Application.JSONMappingParameters": map[string]types.PropertySpec{"RecordRowPath": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::Application.KinesisFirehoseInput": map[string]types.PropertySpec{"ResourceARN": {UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::Application.KinesisStreamsInput": map[string]types.PropertySpec{"ResourceARN": {UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::Application.MappingParameters": map[string]types.PropertySpec{"CSVMappingParameters": {PropertyType: "AWS::KinesisAnalytics::Application.CSVMappingParameters", UpdateType: "Mutable"}, "JSONMappingParameters": {PropertyType: "AWS::KinesisAnalytics::Application.JSONMappingParameters", UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::Application.RecordColumn": map[string]types.PropertySpec{"Mapping": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "SqlType": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::Application.RecordFormat": map[string]types.PropertySpec{"MappingParameters": {PropertyType: "AWS::KinesisAnalytics::Application.MappingParameters", UpdateType: "Mutable"}, "RecordFormatType": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationOutput.DestinationSchema": map[string]types.PropertySpec{"RecordFormatType": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationOutput.KinesisFirehoseOutput": map[string]types.PropertySpec{"ResourceARN": {UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationOutput.KinesisStreamsOutput": map[string]types.PropertySpec{"ResourceARN": {UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationOutput.LambdaOutput": map[string]types.PropertySpec{"ResourceARN": {UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationOutput.Output": map[string]types.PropertySpec{"DestinationSchema": {PropertyType: "AWS::KinesisAnalytics::ApplicationOutput.DestinationSchema", UpdateType: "Mutable"}, "KinesisFirehoseOutput": {PropertyType: "AWS::KinesisAnalytics::ApplicationOutput.KinesisFirehoseOutput", UpdateType: "Mutable"}, "KinesisStreamsOutput": {PropertyType: "AWS::KinesisAnalytics::ApplicationOutput.KinesisStreamsOutput", UpdateType: "Mutable"}, "LambdaOutput": {PropertyType: "AWS::KinesisAnalytics::ApplicationOutput.LambdaOutput", UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationReferenceDataSource.CSVMappingParameters": map[string]types.PropertySpec{"RecordColumnDelimiter": {UpdateType: "Mutable"}, "RecordRowDelimiter": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationReferenceDataSource.JSONMappingParameters": map[string]types.PropertySpec{"RecordRowPath": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationReferenceDataSource.MappingParameters": map[string]types.PropertySpec{"CSVMappingParameters": {PropertyType: "AWS::KinesisAnalytics::ApplicationReferenceDataSource.CSVMappingParameters", UpdateType: "Mutable"}, "JSONMappingParameters": {PropertyType: "AWS::KinesisAnalytics::ApplicationReferenceDataSource.JSONMappingParameters", UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationReferenceDataSource.RecordColumn": map[string]types.PropertySpec{"Mapping": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "SqlType": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationReferenceDataSource.RecordFormat": map[string]types.PropertySpec{"MappingParameters": {PropertyType: "AWS::KinesisAnalytics::ApplicationReferenceDataSource.MappingParameters", UpdateType: "Mutable"}, "RecordFormatType": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationReferenceDataSource.ReferenceDataSource": map[string]types.PropertySpec{"ReferenceSchema": {PropertyType: "AWS::KinesisAnalytics::ApplicationReferenceDataSource.ReferenceSchema", UpdateType: "Mutable"}, "S3ReferenceDataSource": {PropertyType: "AWS::KinesisAnalytics::ApplicationReferenceDataSource.S3ReferenceDataSource", UpdateType: "Mutable"}, "TableName": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationReferenceDataSource.ReferenceSchema": map[string]types.PropertySpec{"RecordColumns": {Container: "List", PropertyType: "AWS::KinesisAnalytics::ApplicationReferenceDataSource.RecordColumn", UpdateType: "Mutable"}, "RecordEncoding": {UpdateType: "Mutable"}, "RecordFormat": {PropertyType: "AWS::KinesisAnalytics::ApplicationReferenceDataSource.RecordFormat", UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisAnalytics::ApplicationReferenceDataSource.S3ReferenceDataSource": map[string]types.PropertySpec{"BucketARN": {UpdateType: "Mutable"}, "FileKey": {UpdateType: "Mutable"}, "ReferenceRoleARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.BufferingHints": map[string]types.PropertySpec{"IntervalInSeconds": {UpdateType: "Mutable"}, "SizeInMBs": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.CloudWatchLoggingOptions": map[string]types.PropertySpec{"Enabled": {UpdateType: "Mutable"}, "LogGroupName": {UpdateType: "Mutable"}, "LogStreamName": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.CopyCommand": map[string]types.PropertySpec{"CopyOptions": {UpdateType: "Mutable"}, "DataTableColumns": {UpdateType: "Mutable"}, "DataTableName": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.ElasticsearchBufferingHints": map[string]types.PropertySpec{"IntervalInSeconds": {UpdateType: "Mutable"}, "SizeInMBs": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.ElasticsearchDestinationConfiguration": map[string]types.PropertySpec{"BufferingHints": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.ElasticsearchBufferingHints", UpdateType: "Mutable"}, "CloudWatchLoggingOptions": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.CloudWatchLoggingOptions", UpdateType: "Mutable"}, "DomainARN": {UpdateType: "Mutable"}, "IndexName": {UpdateType: "Mutable"}, "IndexRotationPeriod": {UpdateType: "Mutable"}, "ProcessingConfiguration": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.ProcessingConfiguration", UpdateType: "Mutable"}, "RetryOptions": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.ElasticsearchRetryOptions", UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, "S3BackupMode": {UpdateType: "Mutable"}, "S3Configuration": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.S3DestinationConfiguration", UpdateType: "Mutable"}, "TypeName": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.ElasticsearchRetryOptions": map[string]types.PropertySpec{"DurationInSeconds": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.EncryptionConfiguration": map[string]types.PropertySpec{"KMSEncryptionConfig": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.KMSEncryptionConfig", UpdateType: "Mutable"}, "NoEncryptionConfig": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.ExtendedS3DestinationConfiguration": map[string]types.PropertySpec{"BucketARN": {UpdateType: "Mutable"}, "BufferingHints": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.BufferingHints", UpdateType: "Mutable"}, "CloudWatchLoggingOptions": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.CloudWatchLoggingOptions", UpdateType: "Mutable"}, "CompressionFormat": {UpdateType: "Mutable"}, "EncryptionConfiguration": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.EncryptionConfiguration", UpdateType: "Mutable"}, "Prefix": {UpdateType: "Mutable"}, "ProcessingConfiguration": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.ProcessingConfiguration", UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, "S3BackupConfiguration": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.S3DestinationConfiguration", UpdateType: "Mutable"}, "S3BackupMode": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.KMSEncryptionConfig": map[string]types.PropertySpec{"AWSKMSKeyARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.KinesisStreamSourceConfiguration": map[string]types.PropertySpec{"KinesisStreamARN": {UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.ProcessingConfiguration": map[string]types.PropertySpec{"Enabled": {UpdateType: "Mutable"}, "Processors": {Container: "List", PropertyType: "AWS::KinesisFirehose::DeliveryStream.Processor", UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.Processor": map[string]types.PropertySpec{"Parameters": {Container: "List", PropertyType: "AWS::KinesisFirehose::DeliveryStream.ProcessorParameter", UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.ProcessorParameter": map[string]types.PropertySpec{"ParameterName": {UpdateType: "Mutable"}, "ParameterValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.RedshiftDestinationConfiguration": map[string]types.PropertySpec{"CloudWatchLoggingOptions": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.CloudWatchLoggingOptions", UpdateType: "Mutable"}, "ClusterJDBCURL": {UpdateType: "Mutable"}, "CopyCommand": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.CopyCommand", UpdateType: "Mutable"}, "Password": {UpdateType: "Mutable"}, "ProcessingConfiguration": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.ProcessingConfiguration", UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, "S3Configuration": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.S3DestinationConfiguration", UpdateType: "Mutable"}, "Username": {UpdateType: "Mutable"}, },
+		
+		"AWS::KinesisFirehose::DeliveryStream.S3DestinationConfiguration": map[string]types.PropertySpec{"BucketARN": {UpdateType: "Mutable"}, "BufferingHints": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.BufferingHints", UpdateType: "Mutable"}, "CloudWatchLoggingOptions": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.CloudWatchLoggingOptions", UpdateType: "Mutable"}, "CompressionFormat": {UpdateType: "Mutable"}, "EncryptionConfiguration": {PropertyType: "AWS::KinesisFirehose::DeliveryStream.EncryptionConfiguration", UpdateType: "Mutable"}, "Prefix": {UpdateType: "Mutable"}, "RoleARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::Lambda::Alias.AliasRoutingConfiguration": map[string]types.PropertySpec{"AdditionalVersionWeights": {Container: "List", PropertyType: "AWS::Lambda::Alias.VersionWeight", UpdateType: "Mutable"}, },
+		
+		"AWS::Lambda::Alias.VersionWeight": map[string]types.PropertySpec{"FunctionVersion": {UpdateType: "Mutable"}, "FunctionWeight": {UpdateType: "Mutable"}, },
+		
+		"AWS::Lambda::Function.Code": map[string]types.PropertySpec{"S3Bucket": {UpdateType: "Mutable"}, "S3Key": {UpdateType: "Mutable"}, "S3ObjectVersion": {UpdateType: "Mutable"}, "ZipFile": {UpdateType: "Mutable"}, },
+		
+		"AWS::Lambda::Function.DeadLetterConfig": map[string]types.PropertySpec{"TargetArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::Lambda::Function.Environment": map[string]types.PropertySpec{"Variables": {Container: "Map", UpdateType: "Mutable"}, },
+		
+		"AWS::Lambda::Function.TracingConfig": map[string]types.PropertySpec{"Mode": {UpdateType: "Mutable"}, },
+		
+		"AWS::Lambda::Function.VpcConfig": map[string]types.PropertySpec{"SecurityGroupIds": {Container: "List", UpdateType: "Mutable"}, "SubnetIds": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::Logs::MetricFilter.MetricTransformation": map[string]types.PropertySpec{"MetricName": {UpdateType: "Mutable"}, "MetricNamespace": {UpdateType: "Mutable"}, "MetricValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::App.DataSource": map[string]types.PropertySpec{"Arn": {UpdateType: "Mutable"}, "DatabaseName": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::App.EnvironmentVariable": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Secure": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::App.Source": map[string]types.PropertySpec{"Password": {UpdateType: "Mutable"}, "Revision": {UpdateType: "Mutable"}, "SshKey": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, "Url": {UpdateType: "Mutable"}, "Username": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::App.SslConfiguration": map[string]types.PropertySpec{"Certificate": {UpdateType: "Mutable"}, "Chain": {UpdateType: "Mutable"}, "PrivateKey": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Instance.BlockDeviceMapping": map[string]types.PropertySpec{"DeviceName": {UpdateType: "Mutable"}, "Ebs": {PropertyType: "AWS::OpsWorks::Instance.EbsBlockDevice", UpdateType: "Mutable"}, "NoDevice": {UpdateType: "Mutable"}, "VirtualName": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Instance.EbsBlockDevice": map[string]types.PropertySpec{"DeleteOnTermination": {UpdateType: "Mutable"}, "Iops": {UpdateType: "Mutable"}, "SnapshotId": {UpdateType: "Mutable"}, "VolumeSize": {UpdateType: "Mutable"}, "VolumeType": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Instance.TimeBasedAutoScaling": map[string]types.PropertySpec{"Friday": {Container: "Map", UpdateType: "Mutable"}, "Monday": {Container: "Map", UpdateType: "Mutable"}, "Saturday": {Container: "Map", UpdateType: "Mutable"}, "Sunday": {Container: "Map", UpdateType: "Mutable"}, "Thursday": {Container: "Map", UpdateType: "Mutable"}, "Tuesday": {Container: "Map", UpdateType: "Mutable"}, "Wednesday": {Container: "Map", UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Layer.AutoScalingThresholds": map[string]types.PropertySpec{"CpuThreshold": {UpdateType: "Mutable"}, "IgnoreMetricsTime": {UpdateType: "Mutable"}, "InstanceCount": {UpdateType: "Mutable"}, "LoadThreshold": {UpdateType: "Mutable"}, "MemoryThreshold": {UpdateType: "Mutable"}, "ThresholdsWaitTime": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Layer.LifecycleEventConfiguration": map[string]types.PropertySpec{"ShutdownEventConfiguration": {PropertyType: "AWS::OpsWorks::Layer.ShutdownEventConfiguration", UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Layer.LoadBasedAutoScaling": map[string]types.PropertySpec{"DownScaling": {PropertyType: "AWS::OpsWorks::Layer.AutoScalingThresholds", UpdateType: "Mutable"}, "Enable": {UpdateType: "Mutable"}, "UpScaling": {PropertyType: "AWS::OpsWorks::Layer.AutoScalingThresholds", UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Layer.Recipes": map[string]types.PropertySpec{"Configure": {Container: "List", UpdateType: "Mutable"}, "Deploy": {Container: "List", UpdateType: "Mutable"}, "Setup": {Container: "List", UpdateType: "Mutable"}, "Shutdown": {Container: "List", UpdateType: "Mutable"}, "Undeploy": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Layer.ShutdownEventConfiguration": map[string]types.PropertySpec{"DelayUntilElbConnectionsDrained": {UpdateType: "Mutable"}, "ExecutionTimeout": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Layer.VolumeConfiguration": map[string]types.PropertySpec{"Iops": {UpdateType: "Mutable"}, "MountPoint": {UpdateType: "Mutable"}, "NumberOfDisks": {UpdateType: "Mutable"}, "RaidLevel": {UpdateType: "Mutable"}, "Size": {UpdateType: "Mutable"}, "VolumeType": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Stack.ChefConfiguration": map[string]types.PropertySpec{"BerkshelfVersion": {UpdateType: "Mutable"}, "ManageBerkshelf": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Stack.ElasticIp": map[string]types.PropertySpec{"Ip": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Stack.RdsDbInstance": map[string]types.PropertySpec{"DbPassword": {UpdateType: "Mutable"}, "DbUser": {UpdateType: "Mutable"}, "RdsDbInstanceArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Stack.Source": map[string]types.PropertySpec{"Password": {UpdateType: "Mutable"}, "Revision": {UpdateType: "Mutable"}, "SshKey": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, "Url": {UpdateType: "Mutable"}, "Username": {UpdateType: "Mutable"}, },
+		
+		"AWS::OpsWorks::Stack.StackConfigurationManager": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Version": {UpdateType: "Mutable"}, },
+		
+		"AWS::RDS::DBSecurityGroup.Ingress": map[string]types.PropertySpec{"CIDRIP": {UpdateType: "Mutable"}, "EC2SecurityGroupId": {UpdateType: "Immutable"}, "EC2SecurityGroupName": {UpdateType: "Immutable"}, "EC2SecurityGroupOwnerId": {UpdateType: "Immutable"}, },
+		
+		"AWS::RDS::OptionGroup.OptionConfiguration": map[string]types.PropertySpec{"DBSecurityGroupMemberships": {Container: "List", UpdateType: "Mutable"}, "OptionName": {UpdateType: "Mutable"}, "OptionSettings": {PropertyType: "AWS::RDS::OptionGroup.OptionSetting", UpdateType: "Mutable"}, "OptionVersion": {UpdateType: "Mutable"}, "Port": {UpdateType: "Mutable"}, "VpcSecurityGroupMemberships": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::RDS::OptionGroup.OptionSetting": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::Redshift::Cluster.LoggingProperties": map[string]types.PropertySpec{"BucketName": {UpdateType: "Mutable"}, "S3KeyPrefix": {UpdateType: "Mutable"}, },
+		
+		"AWS::Redshift::ClusterParameterGroup.Parameter": map[string]types.PropertySpec{"ParameterName": {UpdateType: "Mutable"}, "ParameterValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::HealthCheck.AlarmIdentifier": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Region": {UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::HealthCheck.HealthCheckConfig": map[string]types.PropertySpec{"AlarmIdentifier": {PropertyType: "AWS::Route53::HealthCheck.AlarmIdentifier", UpdateType: "Mutable"}, "ChildHealthChecks": {Container: "List", UpdateType: "Mutable"}, "EnableSNI": {UpdateType: "Mutable"}, "FailureThreshold": {UpdateType: "Mutable"}, "FullyQualifiedDomainName": {UpdateType: "Mutable"}, "HealthThreshold": {UpdateType: "Mutable"}, "IPAddress": {UpdateType: "Mutable"}, "InsufficientDataHealthStatus": {UpdateType: "Mutable"}, "Inverted": {UpdateType: "Mutable"}, "MeasureLatency": {UpdateType: "Immutable"}, "Port": {UpdateType: "Mutable"}, "Regions": {Container: "List", UpdateType: "Mutable"}, "RequestInterval": {UpdateType: "Immutable"}, "ResourcePath": {UpdateType: "Mutable"}, "SearchString": {UpdateType: "Mutable"}, "Type": {UpdateType: "Immutable"}, },
+		
+		"AWS::Route53::HealthCheck.HealthCheckTag": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::HostedZone.HostedZoneConfig": map[string]types.PropertySpec{"Comment": {UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::HostedZone.HostedZoneTag": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::HostedZone.QueryLoggingConfig": map[string]types.PropertySpec{"CloudWatchLogsLogGroupArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::HostedZone.VPC": map[string]types.PropertySpec{"VPCId": {UpdateType: "Mutable"}, "VPCRegion": {UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::RecordSet.AliasTarget": map[string]types.PropertySpec{"DNSName": {UpdateType: "Mutable"}, "EvaluateTargetHealth": {UpdateType: "Mutable"}, "HostedZoneId": {UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::RecordSet.GeoLocation": map[string]types.PropertySpec{"ContinentCode": {UpdateType: "Mutable"}, "CountryCode": {UpdateType: "Mutable"}, "SubdivisionCode": {UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::RecordSetGroup.AliasTarget": map[string]types.PropertySpec{"DNSName": {UpdateType: "Mutable"}, "EvaluateTargetHealth": {UpdateType: "Mutable"}, "HostedZoneId": {UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::RecordSetGroup.GeoLocation": map[string]types.PropertySpec{"ContinentCode": {UpdateType: "Mutable"}, "CountryCode": {UpdateType: "Mutable"}, "SubdivisionCode": {UpdateType: "Mutable"}, },
+		
+		"AWS::Route53::RecordSetGroup.RecordSet": map[string]types.PropertySpec{"AliasTarget": {PropertyType: "AWS::Route53::RecordSetGroup.AliasTarget", UpdateType: "Mutable"}, "Comment": {UpdateType: "Mutable"}, "Failover": {UpdateType: "Mutable"}, "GeoLocation": {PropertyType: "AWS::Route53::RecordSetGroup.GeoLocation", UpdateType: "Mutable"}, "HealthCheckId": {UpdateType: "Mutable"}, "HostedZoneId": {UpdateType: "Mutable"}, "HostedZoneName": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "Region": {UpdateType: "Mutable"}, "ResourceRecords": {Container: "List", UpdateType: "Mutable"}, "SetIdentifier": {UpdateType: "Mutable"}, "TTL": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, "Weight": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.AbortIncompleteMultipartUpload": map[string]types.PropertySpec{"DaysAfterInitiation": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.AccelerateConfiguration": map[string]types.PropertySpec{"AccelerationStatus": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.AccessControlTranslation": map[string]types.PropertySpec{"Owner": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.AnalyticsConfiguration": map[string]types.PropertySpec{"Id": {UpdateType: "Mutable"}, "Prefix": {UpdateType: "Mutable"}, "StorageClassAnalysis": {PropertyType: "AWS::S3::Bucket.StorageClassAnalysis", UpdateType: "Mutable"}, "TagFilters": {Container: "List", PropertyType: "AWS::S3::Bucket.TagFilter", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.BucketEncryption": map[string]types.PropertySpec{"ServerSideEncryptionConfiguration": {Container: "List", PropertyType: "AWS::S3::Bucket.ServerSideEncryptionRule", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.CorsConfiguration": map[string]types.PropertySpec{"CorsRules": {Container: "List", PropertyType: "AWS::S3::Bucket.CorsRule", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.CorsRule": map[string]types.PropertySpec{"AllowedHeaders": {Container: "List", UpdateType: "Mutable"}, "AllowedMethods": {Container: "List", UpdateType: "Mutable"}, "AllowedOrigins": {Container: "List", UpdateType: "Mutable"}, "ExposedHeaders": {Container: "List", UpdateType: "Mutable"}, "Id": {UpdateType: "Mutable"}, "MaxAge": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.DataExport": map[string]types.PropertySpec{"Destination": {PropertyType: "AWS::S3::Bucket.Destination", UpdateType: "Mutable"}, "OutputSchemaVersion": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.Destination": map[string]types.PropertySpec{"BucketAccountId": {UpdateType: "Mutable"}, "BucketArn": {UpdateType: "Mutable"}, "Format": {UpdateType: "Mutable"}, "Prefix": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.EncryptionConfiguration": map[string]types.PropertySpec{"ReplicaKmsKeyID": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.FilterRule": map[string]types.PropertySpec{"Name": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.InventoryConfiguration": map[string]types.PropertySpec{"Destination": {PropertyType: "AWS::S3::Bucket.Destination", UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, "Id": {UpdateType: "Mutable"}, "IncludedObjectVersions": {UpdateType: "Mutable"}, "OptionalFields": {Container: "List", UpdateType: "Mutable"}, "Prefix": {UpdateType: "Mutable"}, "ScheduleFrequency": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.LambdaConfiguration": map[string]types.PropertySpec{"Event": {UpdateType: "Mutable"}, "Filter": {PropertyType: "AWS::S3::Bucket.NotificationFilter", UpdateType: "Mutable"}, "Function": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.LifecycleConfiguration": map[string]types.PropertySpec{"Rules": {Container: "List", PropertyType: "AWS::S3::Bucket.Rule", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.LoggingConfiguration": map[string]types.PropertySpec{"DestinationBucketName": {UpdateType: "Mutable"}, "LogFilePrefix": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.MetricsConfiguration": map[string]types.PropertySpec{"Id": {UpdateType: "Mutable"}, "Prefix": {UpdateType: "Mutable"}, "TagFilters": {Container: "List", PropertyType: "AWS::S3::Bucket.TagFilter", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.NoncurrentVersionTransition": map[string]types.PropertySpec{"StorageClass": {UpdateType: "Mutable"}, "TransitionInDays": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.NotificationConfiguration": map[string]types.PropertySpec{"LambdaConfigurations": {Container: "List", PropertyType: "AWS::S3::Bucket.LambdaConfiguration", UpdateType: "Mutable"}, "QueueConfigurations": {Container: "List", PropertyType: "AWS::S3::Bucket.QueueConfiguration", UpdateType: "Mutable"}, "TopicConfigurations": {Container: "List", PropertyType: "AWS::S3::Bucket.TopicConfiguration", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.NotificationFilter": map[string]types.PropertySpec{"S3Key": {PropertyType: "AWS::S3::Bucket.S3KeyFilter", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.QueueConfiguration": map[string]types.PropertySpec{"Event": {UpdateType: "Mutable"}, "Filter": {PropertyType: "AWS::S3::Bucket.NotificationFilter", UpdateType: "Mutable"}, "Queue": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.RedirectAllRequestsTo": map[string]types.PropertySpec{"HostName": {UpdateType: "Mutable"}, "Protocol": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.RedirectRule": map[string]types.PropertySpec{"HostName": {UpdateType: "Mutable"}, "HttpRedirectCode": {UpdateType: "Mutable"}, "Protocol": {UpdateType: "Mutable"}, "ReplaceKeyPrefixWith": {UpdateType: "Mutable"}, "ReplaceKeyWith": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.ReplicationConfiguration": map[string]types.PropertySpec{"Role": {UpdateType: "Mutable"}, "Rules": {Container: "List", PropertyType: "AWS::S3::Bucket.ReplicationRule", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.ReplicationDestination": map[string]types.PropertySpec{"AccessControlTranslation": {PropertyType: "AWS::S3::Bucket.AccessControlTranslation", UpdateType: "Mutable"}, "Account": {UpdateType: "Mutable"}, "Bucket": {UpdateType: "Mutable"}, "EncryptionConfiguration": {PropertyType: "AWS::S3::Bucket.EncryptionConfiguration", UpdateType: "Mutable"}, "StorageClass": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.ReplicationRule": map[string]types.PropertySpec{"Destination": {PropertyType: "AWS::S3::Bucket.ReplicationDestination", UpdateType: "Mutable"}, "Id": {UpdateType: "Mutable"}, "Prefix": {UpdateType: "Mutable"}, "SourceSelectionCriteria": {PropertyType: "AWS::S3::Bucket.SourceSelectionCriteria", UpdateType: "Mutable"}, "Status": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.RoutingRule": map[string]types.PropertySpec{"RedirectRule": {PropertyType: "AWS::S3::Bucket.RedirectRule", UpdateType: "Mutable"}, "RoutingRuleCondition": {PropertyType: "AWS::S3::Bucket.RoutingRuleCondition", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.RoutingRuleCondition": map[string]types.PropertySpec{"HttpErrorCodeReturnedEquals": {UpdateType: "Mutable"}, "KeyPrefixEquals": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.Rule": map[string]types.PropertySpec{"AbortIncompleteMultipartUpload": {PropertyType: "AWS::S3::Bucket.AbortIncompleteMultipartUpload", UpdateType: "Mutable"}, "ExpirationDate": {UpdateType: "Mutable"}, "ExpirationInDays": {UpdateType: "Mutable"}, "Id": {UpdateType: "Mutable"}, "NoncurrentVersionExpirationInDays": {UpdateType: "Mutable"}, "NoncurrentVersionTransition": {PropertyType: "AWS::S3::Bucket.NoncurrentVersionTransition", UpdateType: "Mutable"}, "NoncurrentVersionTransitions": {Container: "List", PropertyType: "AWS::S3::Bucket.NoncurrentVersionTransition", UpdateType: "Mutable"}, "Prefix": {UpdateType: "Mutable"}, "Status": {UpdateType: "Mutable"}, "TagFilters": {Container: "List", PropertyType: "AWS::S3::Bucket.TagFilter", UpdateType: "Mutable"}, "Transition": {PropertyType: "AWS::S3::Bucket.Transition", UpdateType: "Mutable"}, "Transitions": {Container: "List", PropertyType: "AWS::S3::Bucket.Transition", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.S3KeyFilter": map[string]types.PropertySpec{"Rules": {Container: "List", PropertyType: "AWS::S3::Bucket.FilterRule", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.ServerSideEncryptionByDefault": map[string]types.PropertySpec{"KMSMasterKeyID": {UpdateType: "Mutable"}, "SSEAlgorithm": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.ServerSideEncryptionRule": map[string]types.PropertySpec{"ServerSideEncryptionByDefault": {PropertyType: "AWS::S3::Bucket.ServerSideEncryptionByDefault", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.SourceSelectionCriteria": map[string]types.PropertySpec{"SseKmsEncryptedObjects": {PropertyType: "AWS::S3::Bucket.SseKmsEncryptedObjects", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.SseKmsEncryptedObjects": map[string]types.PropertySpec{"Status": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.StorageClassAnalysis": map[string]types.PropertySpec{"DataExport": {PropertyType: "AWS::S3::Bucket.DataExport", UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.TagFilter": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.TopicConfiguration": map[string]types.PropertySpec{"Event": {UpdateType: "Mutable"}, "Filter": {PropertyType: "AWS::S3::Bucket.NotificationFilter", UpdateType: "Mutable"}, "Topic": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.Transition": map[string]types.PropertySpec{"StorageClass": {UpdateType: "Mutable"}, "TransitionDate": {UpdateType: "Mutable"}, "TransitionInDays": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.VersioningConfiguration": map[string]types.PropertySpec{"Status": {UpdateType: "Mutable"}, },
+		
+		"AWS::S3::Bucket.WebsiteConfiguration": map[string]types.PropertySpec{"ErrorDocument": {UpdateType: "Mutable"}, "IndexDocument": {UpdateType: "Mutable"}, "RedirectAllRequestsTo": {PropertyType: "AWS::S3::Bucket.RedirectAllRequestsTo", UpdateType: "Mutable"}, "RoutingRules": {Container: "List", PropertyType: "AWS::S3::Bucket.RoutingRule", UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ConfigurationSetEventDestination.CloudWatchDestination": map[string]types.PropertySpec{"DimensionConfigurations": {Container: "List", PropertyType: "AWS::SES::ConfigurationSetEventDestination.DimensionConfiguration", UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ConfigurationSetEventDestination.DimensionConfiguration": map[string]types.PropertySpec{"DefaultDimensionValue": {UpdateType: "Mutable"}, "DimensionName": {UpdateType: "Mutable"}, "DimensionValueSource": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ConfigurationSetEventDestination.EventDestination": map[string]types.PropertySpec{"CloudWatchDestination": {PropertyType: "AWS::SES::ConfigurationSetEventDestination.CloudWatchDestination", UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, "KinesisFirehoseDestination": {PropertyType: "AWS::SES::ConfigurationSetEventDestination.KinesisFirehoseDestination", UpdateType: "Mutable"}, "MatchingEventTypes": {Container: "List", UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ConfigurationSetEventDestination.KinesisFirehoseDestination": map[string]types.PropertySpec{"DeliveryStreamARN": {UpdateType: "Mutable"}, "IAMRoleARN": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ReceiptFilter.Filter": map[string]types.PropertySpec{"IpFilter": {PropertyType: "AWS::SES::ReceiptFilter.IpFilter", UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ReceiptFilter.IpFilter": map[string]types.PropertySpec{"Cidr": {UpdateType: "Mutable"}, "Policy": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ReceiptRule.Action": map[string]types.PropertySpec{"AddHeaderAction": {PropertyType: "AWS::SES::ReceiptRule.AddHeaderAction", UpdateType: "Mutable"}, "BounceAction": {PropertyType: "AWS::SES::ReceiptRule.BounceAction", UpdateType: "Mutable"}, "LambdaAction": {PropertyType: "AWS::SES::ReceiptRule.LambdaAction", UpdateType: "Mutable"}, "S3Action": {PropertyType: "AWS::SES::ReceiptRule.S3Action", UpdateType: "Mutable"}, "SNSAction": {PropertyType: "AWS::SES::ReceiptRule.SNSAction", UpdateType: "Mutable"}, "StopAction": {PropertyType: "AWS::SES::ReceiptRule.StopAction", UpdateType: "Mutable"}, "WorkmailAction": {PropertyType: "AWS::SES::ReceiptRule.WorkmailAction", UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ReceiptRule.AddHeaderAction": map[string]types.PropertySpec{"HeaderName": {UpdateType: "Mutable"}, "HeaderValue": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ReceiptRule.BounceAction": map[string]types.PropertySpec{"Message": {UpdateType: "Mutable"}, "Sender": {UpdateType: "Mutable"}, "SmtpReplyCode": {UpdateType: "Mutable"}, "StatusCode": {UpdateType: "Mutable"}, "TopicArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ReceiptRule.LambdaAction": map[string]types.PropertySpec{"FunctionArn": {UpdateType: "Mutable"}, "InvocationType": {UpdateType: "Mutable"}, "TopicArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ReceiptRule.Rule": map[string]types.PropertySpec{"Actions": {Container: "List", PropertyType: "AWS::SES::ReceiptRule.Action", UpdateType: "Mutable"}, "Enabled": {UpdateType: "Mutable"}, "Name": {UpdateType: "Immutable"}, "Recipients": {Container: "List", UpdateType: "Mutable"}, "ScanEnabled": {UpdateType: "Mutable"}, "TlsPolicy": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ReceiptRule.S3Action": map[string]types.PropertySpec{"BucketName": {UpdateType: "Mutable"}, "KmsKeyArn": {UpdateType: "Mutable"}, "ObjectKeyPrefix": {UpdateType: "Mutable"}, "TopicArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ReceiptRule.SNSAction": map[string]types.PropertySpec{"Encoding": {UpdateType: "Mutable"}, "TopicArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ReceiptRule.StopAction": map[string]types.PropertySpec{"Scope": {UpdateType: "Mutable"}, "TopicArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::ReceiptRule.WorkmailAction": map[string]types.PropertySpec{"OrganizationArn": {UpdateType: "Mutable"}, "TopicArn": {UpdateType: "Mutable"}, },
+		
+		"AWS::SES::Template.Template": map[string]types.PropertySpec{"HtmlPart": {UpdateType: "Mutable"}, "SubjectPart": {UpdateType: "Mutable"}, "TemplateName": {UpdateType: "Immutable"}, "TextPart": {UpdateType: "Mutable"}, },
+		
+		"AWS::SNS::Topic.Subscription": map[string]types.PropertySpec{"Endpoint": {UpdateType: "Immutable"}, "Protocol": {UpdateType: "Immutable"}, },
+		
+		"AWS::SSM::Association.ParameterValues": map[string]types.PropertySpec{"ParameterValues": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::Association.Target": map[string]types.PropertySpec{"Key": {UpdateType: "Immutable"}, "Values": {Container: "List", UpdateType: "Immutable"}, },
+		
+		"AWS::SSM::MaintenanceWindowTask.LoggingInfo": map[string]types.PropertySpec{"Region": {UpdateType: "Mutable"}, "S3Bucket": {UpdateType: "Mutable"}, "S3Prefix": {UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::MaintenanceWindowTask.MaintenanceWindowAutomationParameters": map[string]types.PropertySpec{"DocumentVersion": {UpdateType: "Mutable"}, "Parameters": {UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::MaintenanceWindowTask.MaintenanceWindowLambdaParameters": map[string]types.PropertySpec{"ClientContext": {UpdateType: "Mutable"}, "Payload": {UpdateType: "Mutable"}, "Qualifier": {UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::MaintenanceWindowTask.MaintenanceWindowRunCommandParameters": map[string]types.PropertySpec{"Comment": {UpdateType: "Mutable"}, "DocumentHash": {UpdateType: "Mutable"}, "DocumentHashType": {UpdateType: "Mutable"}, "NotificationConfig": {PropertyType: "AWS::SSM::MaintenanceWindowTask.NotificationConfig", UpdateType: "Mutable"}, "OutputS3BucketName": {UpdateType: "Mutable"}, "OutputS3KeyPrefix": {UpdateType: "Mutable"}, "Parameters": {UpdateType: "Mutable"}, "ServiceRoleArn": {UpdateType: "Mutable"}, "TimeoutSeconds": {UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::MaintenanceWindowTask.MaintenanceWindowStepFunctionsParameters": map[string]types.PropertySpec{"Input": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::MaintenanceWindowTask.NotificationConfig": map[string]types.PropertySpec{"NotificationArn": {UpdateType: "Mutable"}, "NotificationEvents": {Container: "List", UpdateType: "Mutable"}, "NotificationType": {UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::MaintenanceWindowTask.Target": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Values": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::MaintenanceWindowTask.TaskInvocationParameters": map[string]types.PropertySpec{"MaintenanceWindowAutomationParameters": {PropertyType: "AWS::SSM::MaintenanceWindowTask.MaintenanceWindowAutomationParameters", UpdateType: "Mutable"}, "MaintenanceWindowLambdaParameters": {PropertyType: "AWS::SSM::MaintenanceWindowTask.MaintenanceWindowLambdaParameters", UpdateType: "Mutable"}, "MaintenanceWindowRunCommandParameters": {PropertyType: "AWS::SSM::MaintenanceWindowTask.MaintenanceWindowRunCommandParameters", UpdateType: "Mutable"}, "MaintenanceWindowStepFunctionsParameters": {PropertyType: "AWS::SSM::MaintenanceWindowTask.MaintenanceWindowStepFunctionsParameters", UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::PatchBaseline.PatchFilter": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Values": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::PatchBaseline.PatchFilterGroup": map[string]types.PropertySpec{"PatchFilters": {Container: "List", PropertyType: "AWS::SSM::PatchBaseline.PatchFilter", UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::PatchBaseline.PatchSource": map[string]types.PropertySpec{"Configuration": {UpdateType: "Mutable"}, "Name": {UpdateType: "Mutable"}, "Products": {Container: "List", UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::PatchBaseline.Rule": map[string]types.PropertySpec{"ApproveAfterDays": {UpdateType: "Mutable"}, "ComplianceLevel": {UpdateType: "Mutable"}, "EnableNonSecurity": {UpdateType: "Mutable"}, "PatchFilterGroup": {PropertyType: "AWS::SSM::PatchBaseline.PatchFilterGroup", UpdateType: "Mutable"}, },
+		
+		"AWS::SSM::PatchBaseline.RuleGroup": map[string]types.PropertySpec{"PatchRules": {Container: "List", PropertyType: "AWS::SSM::PatchBaseline.Rule", UpdateType: "Mutable"}, },
+		
+		"AWS::ServiceCatalog::CloudFormationProvisionedProduct.ProvisioningParameter": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::ServiceDiscovery::Service.DnsConfig": map[string]types.PropertySpec{"DnsRecords": {Container: "List", PropertyType: "AWS::ServiceDiscovery::Service.DnsRecord", UpdateType: "Mutable"}, "NamespaceId": {UpdateType: "Immutable"}, },
+		
+		"AWS::ServiceDiscovery::Service.DnsRecord": map[string]types.PropertySpec{"TTL": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::ServiceDiscovery::Service.HealthCheckConfig": map[string]types.PropertySpec{"FailureThreshold": {UpdateType: "Mutable"}, "ResourcePath": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::ByteMatchSet.ByteMatchTuple": map[string]types.PropertySpec{"FieldToMatch": {PropertyType: "AWS::WAF::ByteMatchSet.FieldToMatch", UpdateType: "Mutable"}, "PositionalConstraint": {UpdateType: "Mutable"}, "TargetString": {UpdateType: "Mutable"}, "TargetStringBase64": {UpdateType: "Mutable"}, "TextTransformation": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::ByteMatchSet.FieldToMatch": map[string]types.PropertySpec{"Data": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::IPSet.IPSetDescriptor": map[string]types.PropertySpec{"Type": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::Rule.Predicate": map[string]types.PropertySpec{"DataId": {UpdateType: "Mutable"}, "Negated": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::SizeConstraintSet.FieldToMatch": map[string]types.PropertySpec{"Data": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::SizeConstraintSet.SizeConstraint": map[string]types.PropertySpec{"ComparisonOperator": {UpdateType: "Mutable"}, "FieldToMatch": {PropertyType: "AWS::WAF::SizeConstraintSet.FieldToMatch", UpdateType: "Mutable"}, "Size": {UpdateType: "Mutable"}, "TextTransformation": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::SqlInjectionMatchSet.FieldToMatch": map[string]types.PropertySpec{"Data": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::SqlInjectionMatchSet.SqlInjectionMatchTuple": map[string]types.PropertySpec{"FieldToMatch": {PropertyType: "AWS::WAF::SqlInjectionMatchSet.FieldToMatch", UpdateType: "Mutable"}, "TextTransformation": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::WebACL.ActivatedRule": map[string]types.PropertySpec{"Action": {PropertyType: "AWS::WAF::WebACL.WafAction", UpdateType: "Mutable"}, "Priority": {UpdateType: "Mutable"}, "RuleId": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::WebACL.WafAction": map[string]types.PropertySpec{"Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::XssMatchSet.FieldToMatch": map[string]types.PropertySpec{"Data": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAF::XssMatchSet.XssMatchTuple": map[string]types.PropertySpec{"FieldToMatch": {PropertyType: "AWS::WAF::XssMatchSet.FieldToMatch", UpdateType: "Mutable"}, "TextTransformation": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::ByteMatchSet.ByteMatchTuple": map[string]types.PropertySpec{"FieldToMatch": {PropertyType: "AWS::WAFRegional::ByteMatchSet.FieldToMatch", UpdateType: "Mutable"}, "PositionalConstraint": {UpdateType: "Mutable"}, "TargetString": {UpdateType: "Mutable"}, "TargetStringBase64": {UpdateType: "Mutable"}, "TextTransformation": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::ByteMatchSet.FieldToMatch": map[string]types.PropertySpec{"Data": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::IPSet.IPSetDescriptor": map[string]types.PropertySpec{"Type": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::Rule.Predicate": map[string]types.PropertySpec{"DataId": {UpdateType: "Mutable"}, "Negated": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::SizeConstraintSet.FieldToMatch": map[string]types.PropertySpec{"Data": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::SizeConstraintSet.SizeConstraint": map[string]types.PropertySpec{"ComparisonOperator": {UpdateType: "Mutable"}, "FieldToMatch": {PropertyType: "AWS::WAFRegional::SizeConstraintSet.FieldToMatch", UpdateType: "Mutable"}, "Size": {UpdateType: "Mutable"}, "TextTransformation": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::SqlInjectionMatchSet.FieldToMatch": map[string]types.PropertySpec{"Data": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::SqlInjectionMatchSet.SqlInjectionMatchTuple": map[string]types.PropertySpec{"FieldToMatch": {PropertyType: "AWS::WAFRegional::SqlInjectionMatchSet.FieldToMatch", UpdateType: "Mutable"}, "TextTransformation": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::WebACL.Action": map[string]types.PropertySpec{"Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::WebACL.Rule": map[string]types.PropertySpec{"Action": {PropertyType: "AWS::WAFRegional::WebACL.Action", UpdateType: "Mutable"}, "Priority": {UpdateType: "Mutable"}, "RuleId": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::XssMatchSet.FieldToMatch": map[string]types.PropertySpec{"Data": {UpdateType: "Mutable"}, "Type": {UpdateType: "Mutable"}, },
+		
+		"AWS::WAFRegional::XssMatchSet.XssMatchTuple": map[string]types.PropertySpec{"FieldToMatch": {PropertyType: "AWS::WAFRegional::XssMatchSet.FieldToMatch", UpdateType: "Mutable"}, "TextTransformation": {UpdateType: "Mutable"}, },
+		
+		"Tag": map[string]types.PropertySpec{"Key": {UpdateType: "Mutable"}, "Value": {UpdateType: "Mutable"}, },
+		
+	}
+}
@@ -7,11 +7,15 @@ import (
 )
 
 type ApiGatewayAccount struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayAccountProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayAccountProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayAccountProperties struct {
@@ -32,9 +36,10 @@ func ParseApiGatewayAccount(name string, data string) (cf types.ValueMap, err er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayAccount - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -47,5 +52,7 @@ func (resource ApiGatewayAccount) Validate() []error {
 
 func (resource ApiGatewayAccountProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CloudWatchRoleArn", resource.CloudWatchRoleArn)...)
 	return errs
 }
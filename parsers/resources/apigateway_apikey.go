@@ -4,14 +4,19 @@ import (
 	yaml "github.com/KablamoOSS/yaml"
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type ApiGatewayApiKey struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayApiKeyProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayApiKeyProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayApiKeyProperties struct {
@@ -37,9 +42,10 @@ func ParseApiGatewayApiKey(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayApiKey - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,5 +58,17 @@ func (resource ApiGatewayApiKey) Validate() []error {
 
 func (resource ApiGatewayApiKeyProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CustomerId", resource.CustomerId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("GenerateDistinctId", resource.GenerateDistinctId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.ApiKey_StageKey{} }))("StageKeys", resource.StageKeys)...)
 	return errs
 }
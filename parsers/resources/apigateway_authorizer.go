@@ -8,11 +8,15 @@ import (
 )
 
 type ApiGatewayAuthorizer struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayAuthorizerProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayAuthorizerProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayAuthorizerProperties struct {
@@ -42,9 +46,10 @@ func ParseApiGatewayAuthorizer(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayAuthorizer - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -57,8 +62,27 @@ func (resource ApiGatewayAuthorizer) Validate() []error {
 
 func (resource ApiGatewayAuthorizerProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AuthType", resource.AuthType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AuthorizerCredentials", resource.AuthorizerCredentials)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("AuthorizerResultTtlInSeconds", resource.AuthorizerResultTtlInSeconds)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AuthorizerUri", resource.AuthorizerUri)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("IdentitySource", resource.IdentitySource)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("IdentityValidationExpression", resource.IdentityValidationExpression)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.RestApiId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RestApiId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RestApiId", resource.RestApiId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ProviderARNs", resource.ProviderARNs)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type ApiGatewayBasePathMapping struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayBasePathMappingProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayBasePathMappingProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayBasePathMappingProperties struct {
@@ -36,9 +40,10 @@ func ParseApiGatewayBasePathMapping(name string, data string) (cf types.ValueMap
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayBasePathMapping - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,8 +56,15 @@ func (resource ApiGatewayBasePathMapping) Validate() []error {
 
 func (resource ApiGatewayBasePathMappingProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("BasePath", resource.BasePath)...)
 	if resource.DomainName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DomainName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DomainName", resource.DomainName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RestApiId", resource.RestApiId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Stage", resource.Stage)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type ApiGatewayDeployment struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayDeploymentProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayDeploymentProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayDeploymentProperties struct {
@@ -37,9 +41,10 @@ func ParseApiGatewayDeployment(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayDeployment - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,17 @@ func (resource ApiGatewayDeployment) Validate() []error {
 
 func (resource ApiGatewayDeploymentProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.RestApiId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RestApiId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RestApiId", resource.RestApiId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("StageName", resource.StageName)...)
+	
+	if resource.StageDescription != nil {
+		errs = append(errs, types.PrefixErrors("StageDescription", resource.StageDescription.Validate())...)
+	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type ApiGatewayDocumentationPart struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayDocumentationPartProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayDocumentationPartProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayDocumentationPartProperties struct {
@@ -36,9 +40,10 @@ func ParseApiGatewayDocumentationPart(name string, data string) (cf types.ValueM
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayDocumentationPart - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,13 +59,16 @@ func (resource ApiGatewayDocumentationPartProperties) Validate() []error {
 	if resource.Properties == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Properties'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Properties", resource.Properties)...)
 	if resource.RestApiId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RestApiId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RestApiId", resource.RestApiId)...)
 	if resource.Location == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Location'"))
-	} else {
-		errs = append(errs, resource.Location.Validate()...)
+	}
+	if resource.Location != nil {
+		errs = append(errs, types.PrefixErrors("Location", resource.Location.Validate())...)
 	}
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type ApiGatewayDocumentationVersion struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayDocumentationVersionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayDocumentationVersionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayDocumentationVersionProperties struct {
@@ -35,9 +39,10 @@ func ParseApiGatewayDocumentationVersion(name string, data string) (cf types.Val
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayDocumentationVersion - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,11 +55,15 @@ func (resource ApiGatewayDocumentationVersion) Validate() []error {
 
 func (resource ApiGatewayDocumentationVersionProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.DocumentationVersion == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DocumentationVersion'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DocumentationVersion", resource.DocumentationVersion)...)
 	if resource.RestApiId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RestApiId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RestApiId", resource.RestApiId)...)
 	return errs
 }
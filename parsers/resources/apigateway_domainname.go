@@ -9,11 +9,15 @@ import (
 )
 
 type ApiGatewayDomainName struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayDomainNameProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayDomainNameProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayDomainNameProperties struct {
@@ -37,9 +41,10 @@ func ParseApiGatewayDomainName(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayDomainName - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,17 @@ func (resource ApiGatewayDomainName) Validate() []error {
 
 func (resource ApiGatewayDomainNameProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CertificateArn", resource.CertificateArn)...)
 	if resource.DomainName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DomainName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DomainName", resource.DomainName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RegionalCertificateArn", resource.RegionalCertificateArn)...)
+	
+	if resource.EndpointConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("EndpointConfiguration", resource.EndpointConfiguration.Validate())...)
+	}
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type ApiGatewayGatewayResponse struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayGatewayResponseProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayGatewayResponseProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayGatewayResponseProperties struct {
@@ -37,9 +41,10 @@ func ParseApiGatewayGatewayResponse(name string, data string) (cf types.ValueMap
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayGatewayResponse - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -55,8 +60,16 @@ func (resource ApiGatewayGatewayResponseProperties) Validate() []error {
 	if resource.ResponseType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ResponseType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ResponseType", resource.ResponseType)...)
 	if resource.RestApiId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RestApiId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RestApiId", resource.RestApiId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("StatusCode", resource.StatusCode)...)
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("ResponseParameters", resource.ResponseParameters)...)
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("ResponseTemplates", resource.ResponseTemplates)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type ApiGatewayMethod struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayMethodProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayMethodProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayMethodProperties struct {
@@ -45,9 +49,10 @@ func ParseApiGatewayMethod(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayMethod - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -60,14 +65,37 @@ func (resource ApiGatewayMethod) Validate() []error {
 
 func (resource ApiGatewayMethodProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ApiKeyRequired", resource.ApiKeyRequired)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AuthorizationType", resource.AuthorizationType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AuthorizerId", resource.AuthorizerId)...)
 	if resource.HttpMethod == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'HttpMethod'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("HttpMethod", resource.HttpMethod)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("OperationName", resource.OperationName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RequestValidatorId", resource.RequestValidatorId)...)
 	if resource.ResourceId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ResourceId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ResourceId", resource.ResourceId)...)
 	if resource.RestApiId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RestApiId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RestApiId", resource.RestApiId)...)
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("RequestModels", resource.RequestModels)...)
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("Boolean"))("RequestParameters", resource.RequestParameters)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Method_MethodResponse{} }))("MethodResponses", resource.MethodResponses)...)
+	
+	if resource.Integration != nil {
+		errs = append(errs, types.PrefixErrors("Integration", resource.Integration.Validate())...)
+	}
 	return errs
 }
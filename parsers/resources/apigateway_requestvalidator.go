@@ -8,11 +8,15 @@ import (
 )
 
 type ApiGatewayRequestValidator struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayRequestValidatorProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayRequestValidatorProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayRequestValidatorProperties struct {
@@ -36,9 +40,10 @@ func ParseApiGatewayRequestValidator(name string, data string) (cf types.ValueMa
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayRequestValidator - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,8 +56,15 @@ func (resource ApiGatewayRequestValidator) Validate() []error {
 
 func (resource ApiGatewayRequestValidatorProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.RestApiId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RestApiId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RestApiId", resource.RestApiId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ValidateRequestBody", resource.ValidateRequestBody)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ValidateRequestParameters", resource.ValidateRequestParameters)...)
 	return errs
 }
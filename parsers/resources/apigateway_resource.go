@@ -8,11 +8,15 @@ import (
 )
 
 type ApiGatewayResource struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayResourceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayResourceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayResourceProperties struct {
@@ -35,9 +39,10 @@ func ParseApiGatewayResource(name string, data string) (cf types.ValueMap, err e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayResource - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,11 +58,14 @@ func (resource ApiGatewayResourceProperties) Validate() []error {
 	if resource.ParentId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ParentId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ParentId", resource.ParentId)...)
 	if resource.PathPart == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PathPart'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PathPart", resource.PathPart)...)
 	if resource.RestApiId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RestApiId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RestApiId", resource.RestApiId)...)
 	return errs
 }
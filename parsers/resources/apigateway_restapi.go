@@ -8,11 +8,15 @@ import (
 )
 
 type ApiGatewayRestApi struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayRestApiProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayRestApiProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayRestApiProperties struct {
@@ -43,9 +47,10 @@ func ParseApiGatewayRestApi(name string, data string) (cf types.ValueMap, err er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayRestApi - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -58,5 +63,31 @@ func (resource ApiGatewayRestApi) Validate() []error {
 
 func (resource ApiGatewayRestApiProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ApiKeySourceType", resource.ApiKeySourceType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("Body", resource.Body)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CloneFrom", resource.CloneFrom)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("FailOnWarnings", resource.FailOnWarnings)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MinimumCompressionSize", resource.MinimumCompressionSize)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	if resource.BodyS3Location != nil {
+		errs = append(errs, types.PrefixErrors("BodyS3Location", resource.BodyS3Location.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Parameters", resource.Parameters)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("BinaryMediaTypes", resource.BinaryMediaTypes)...)
+	
+	if resource.EndpointConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("EndpointConfiguration", resource.EndpointConfiguration.Validate())...)
+	}
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type ApiGatewayStage struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayStageProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayStageProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayStageProperties struct {
@@ -42,9 +47,10 @@ func ParseApiGatewayStage(name string, data string) (cf types.ValueMap, err erro
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayStage - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -57,8 +63,27 @@ func (resource ApiGatewayStage) Validate() []error {
 
 func (resource ApiGatewayStageProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("CacheClusterEnabled", resource.CacheClusterEnabled)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CacheClusterSize", resource.CacheClusterSize)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ClientCertificateId", resource.ClientCertificateId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DeploymentId", resource.DeploymentId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DocumentationVersion", resource.DocumentationVersion)...)
 	if resource.RestApiId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RestApiId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RestApiId", resource.RestApiId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("StageName", resource.StageName)...)
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Variables", resource.Variables)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Stage_MethodSetting{} }))("MethodSettings", resource.MethodSettings)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type ApiGatewayUsagePlan struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayUsagePlanProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayUsagePlanProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayUsagePlanProperties struct {
@@ -37,9 +41,10 @@ func ParseApiGatewayUsagePlan(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayUsagePlan - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,5 +57,19 @@ func (resource ApiGatewayUsagePlan) Validate() []error {
 
 func (resource ApiGatewayUsagePlanProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("UsagePlanName", resource.UsagePlanName)...)
+	
+	if resource.Throttle != nil {
+		errs = append(errs, types.PrefixErrors("Throttle", resource.Throttle.Validate())...)
+	}
+	
+	if resource.Quota != nil {
+		errs = append(errs, types.PrefixErrors("Quota", resource.Quota.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.UsagePlan_ApiStage{} }))("ApiStages", resource.ApiStages)...)
 	return errs
 }
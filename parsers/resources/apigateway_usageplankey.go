@@ -8,11 +8,15 @@ import (
 )
 
 type ApiGatewayUsagePlanKey struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayUsagePlanKeyProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayUsagePlanKeyProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayUsagePlanKeyProperties struct {
@@ -35,9 +39,10 @@ func ParseApiGatewayUsagePlanKey(name string, data string) (cf types.ValueMap, e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayUsagePlanKey - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,11 +58,14 @@ func (resource ApiGatewayUsagePlanKeyProperties) Validate() []error {
 	if resource.KeyId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'KeyId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("KeyId", resource.KeyId)...)
 	if resource.KeyType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'KeyType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("KeyType", resource.KeyType)...)
 	if resource.UsagePlanId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'UsagePlanId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("UsagePlanId", resource.UsagePlanId)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type ApiGatewayVpcLink struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApiGatewayVpcLinkProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApiGatewayVpcLinkProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApiGatewayVpcLinkProperties struct {
@@ -35,9 +39,10 @@ func ParseApiGatewayVpcLink(name string, data string) (cf types.ValueMap, err er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApiGatewayVpcLink - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,11 +55,15 @@ func (resource ApiGatewayVpcLink) Validate() []error {
 
 func (resource ApiGatewayVpcLinkProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.TargetArns == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TargetArns'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("TargetArns", resource.TargetArns)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type ApplicationAutoScalingScalableTarget struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApplicationAutoScalingScalableTargetProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApplicationAutoScalingScalableTargetProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApplicationAutoScalingScalableTargetProperties struct {
@@ -39,9 +44,10 @@ func ParseApplicationAutoScalingScalableTarget(name string, data string) (cf typ
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApplicationAutoScalingScalableTarget - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -57,20 +63,28 @@ func (resource ApplicationAutoScalingScalableTargetProperties) Validate() []erro
 	if resource.MaxCapacity == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MaxCapacity'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("MaxCapacity", resource.MaxCapacity)...)
 	if resource.MinCapacity == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MinCapacity'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("MinCapacity", resource.MinCapacity)...)
 	if resource.ResourceId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ResourceId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ResourceId", resource.ResourceId)...)
 	if resource.RoleARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleARN", resource.RoleARN)...)
 	if resource.ScalableDimension == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ScalableDimension'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ScalableDimension", resource.ScalableDimension)...)
 	if resource.ServiceNamespace == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ServiceNamespace'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceNamespace", resource.ServiceNamespace)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.ScalableTarget_ScheduledAction{} }))("ScheduledActions", resource.ScheduledActions)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type ApplicationAutoScalingScalingPolicy struct {
-	Type       string                      `yaml:"Type"`
-	Properties ApplicationAutoScalingScalingPolicyProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ApplicationAutoScalingScalingPolicyProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ApplicationAutoScalingScalingPolicyProperties struct {
@@ -41,9 +45,10 @@ func ParseApplicationAutoScalingScalingPolicy(name string, data string) (cf type
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ApplicationAutoScalingScalingPolicy - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -59,8 +64,26 @@ func (resource ApplicationAutoScalingScalingPolicyProperties) Validate() []error
 	if resource.PolicyName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PolicyName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PolicyName", resource.PolicyName)...)
 	if resource.PolicyType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PolicyType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PolicyType", resource.PolicyType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ResourceId", resource.ResourceId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ScalableDimension", resource.ScalableDimension)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ScalingTargetId", resource.ScalingTargetId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceNamespace", resource.ServiceNamespace)...)
+	
+	if resource.TargetTrackingScalingPolicyConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("TargetTrackingScalingPolicyConfiguration", resource.TargetTrackingScalingPolicyConfiguration.Validate())...)
+	}
+	
+	if resource.StepScalingPolicyConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("StepScalingPolicyConfiguration", resource.StepScalingPolicyConfiguration.Validate())...)
+	}
 	return errs
 }
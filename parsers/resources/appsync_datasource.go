@@ -9,11 +9,15 @@ import (
 )
 
 type AppSyncDataSource struct {
-	Type       string                      `yaml:"Type"`
-	Properties AppSyncDataSourceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          AppSyncDataSourceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type AppSyncDataSourceProperties struct {
@@ -41,9 +45,10 @@ func ParseAppSyncDataSource(name string, data string) (cf types.ValueMap, err er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: AppSyncDataSource - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -59,11 +64,30 @@ func (resource AppSyncDataSourceProperties) Validate() []error {
 	if resource.ApiId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ApiId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ApiId", resource.ApiId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceRoleArn", resource.ServiceRoleArn)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	if resource.LambdaConfig != nil {
+		errs = append(errs, types.PrefixErrors("LambdaConfig", resource.LambdaConfig.Validate())...)
+	}
+	
+	if resource.ElasticsearchConfig != nil {
+		errs = append(errs, types.PrefixErrors("ElasticsearchConfig", resource.ElasticsearchConfig.Validate())...)
+	}
+	
+	if resource.DynamoDBConfig != nil {
+		errs = append(errs, types.PrefixErrors("DynamoDBConfig", resource.DynamoDBConfig.Validate())...)
+	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type AppSyncGraphQLApi struct {
-	Type       string                      `yaml:"Type"`
-	Properties AppSyncGraphQLApiProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          AppSyncGraphQLApiProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type AppSyncGraphQLApiProperties struct {
@@ -38,9 +42,10 @@ func ParseAppSyncGraphQLApi(name string, data string) (cf types.ValueMap, err er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: AppSyncGraphQLApi - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,8 +61,22 @@ func (resource AppSyncGraphQLApiProperties) Validate() []error {
 	if resource.AuthenticationType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AuthenticationType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AuthenticationType", resource.AuthenticationType)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	if resource.UserPoolConfig != nil {
+		errs = append(errs, types.PrefixErrors("UserPoolConfig", resource.UserPoolConfig.Validate())...)
+	}
+	
+	if resource.OpenIDConnectConfig != nil {
+		errs = append(errs, types.PrefixErrors("OpenIDConnectConfig", resource.OpenIDConnectConfig.Validate())...)
+	}
+	
+	if resource.LogConfig != nil {
+		errs = append(errs, types.PrefixErrors("LogConfig", resource.LogConfig.Validate())...)
+	}
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type AppSyncGraphQLSchema struct {
-	Type       string                      `yaml:"Type"`
-	Properties AppSyncGraphQLSchemaProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          AppSyncGraphQLSchemaProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type AppSyncGraphQLSchemaProperties struct {
@@ -35,9 +39,10 @@ func ParseAppSyncGraphQLSchema(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: AppSyncGraphQLSchema - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,5 +58,10 @@ func (resource AppSyncGraphQLSchemaProperties) Validate() []error {
 	if resource.ApiId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ApiId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ApiId", resource.ApiId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Definition", resource.Definition)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DefinitionS3Location", resource.DefinitionS3Location)...)
 	return errs
 }
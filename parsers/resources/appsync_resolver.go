@@ -8,11 +8,15 @@ import (
 )
 
 type AppSyncResolver struct {
-	Type       string                      `yaml:"Type"`
-	Properties AppSyncResolverProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          AppSyncResolverProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type AppSyncResolverProperties struct {
@@ -40,9 +44,10 @@ func ParseAppSyncResolver(name string, data string) (cf types.ValueMap, err erro
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: AppSyncResolver - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -58,14 +63,26 @@ func (resource AppSyncResolverProperties) Validate() []error {
 	if resource.ApiId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ApiId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ApiId", resource.ApiId)...)
 	if resource.DataSourceName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DataSourceName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DataSourceName", resource.DataSourceName)...)
 	if resource.FieldName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FieldName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("FieldName", resource.FieldName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RequestMappingTemplate", resource.RequestMappingTemplate)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RequestMappingTemplateS3Location", resource.RequestMappingTemplateS3Location)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ResponseMappingTemplate", resource.ResponseMappingTemplate)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ResponseMappingTemplateS3Location", resource.ResponseMappingTemplateS3Location)...)
 	if resource.TypeName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TypeName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TypeName", resource.TypeName)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type AthenaNamedQuery struct {
-	Type       string                      `yaml:"Type"`
-	Properties AthenaNamedQueryProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          AthenaNamedQueryProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type AthenaNamedQueryProperties struct {
@@ -36,9 +40,10 @@ func ParseAthenaNamedQuery(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: AthenaNamedQuery - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,8 +59,14 @@ func (resource AthenaNamedQueryProperties) Validate() []error {
 	if resource.Database == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Database'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Database", resource.Database)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.QueryString == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'QueryString'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("QueryString", resource.QueryString)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type AutoScalingAutoScalingGroup struct {
-	Type       string                      `yaml:"Type"`
-	Properties AutoScalingAutoScalingGroupProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          AutoScalingAutoScalingGroupProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type AutoScalingAutoScalingGroupProperties struct {
@@ -51,9 +56,10 @@ func ParseAutoScalingAutoScalingGroup(name string, data string) (cf types.ValueM
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: AutoScalingAutoScalingGroup - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -66,11 +72,47 @@ func (resource AutoScalingAutoScalingGroup) Validate() []error {
 
 func (resource AutoScalingAutoScalingGroupProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AutoScalingGroupName", resource.AutoScalingGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Cooldown", resource.Cooldown)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DesiredCapacity", resource.DesiredCapacity)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("HealthCheckGracePeriod", resource.HealthCheckGracePeriod)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HealthCheckType", resource.HealthCheckType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceId", resource.InstanceId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("LaunchConfigurationName", resource.LaunchConfigurationName)...)
 	if resource.MaxSize == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MaxSize'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MaxSize", resource.MaxSize)...)
 	if resource.MinSize == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MinSize'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MinSize", resource.MinSize)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PlacementGroup", resource.PlacementGroup)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AvailabilityZones", resource.AvailabilityZones)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.AutoScalingGroup_LifecycleHookSpecification{} }))("LifecycleHookSpecificationList", resource.LifecycleHookSpecificationList)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("LoadBalancerNames", resource.LoadBalancerNames)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.AutoScalingGroup_MetricsCollection{} }))("MetricsCollection", resource.MetricsCollection)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.AutoScalingGroup_NotificationConfiguration{} }))("NotificationConfigurations", resource.NotificationConfigurations)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.AutoScalingGroup_TagProperty{} }))("Tags", resource.Tags)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("TargetGroupARNs", resource.TargetGroupARNs)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("TerminationPolicies", resource.TerminationPolicies)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("VPCZoneIdentifier", resource.VPCZoneIdentifier)...)
 	return errs
 }
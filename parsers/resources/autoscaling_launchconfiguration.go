@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type AutoScalingLaunchConfiguration struct {
-	Type       string                      `yaml:"Type"`
-	Properties AutoScalingLaunchConfigurationProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          AutoScalingLaunchConfigurationProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type AutoScalingLaunchConfigurationProperties struct {
@@ -49,9 +54,10 @@ func ParseAutoScalingLaunchConfiguration(name string, data string) (cf types.Val
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: AutoScalingLaunchConfiguration - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -64,11 +70,43 @@ func (resource AutoScalingLaunchConfiguration) Validate() []error {
 
 func (resource AutoScalingLaunchConfigurationProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AssociatePublicIpAddress", resource.AssociatePublicIpAddress)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ClassicLinkVPCId", resource.ClassicLinkVPCId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EbsOptimized", resource.EbsOptimized)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("IamInstanceProfile", resource.IamInstanceProfile)...)
 	if resource.ImageId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ImageId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ImageId", resource.ImageId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceId", resource.InstanceId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("InstanceMonitoring", resource.InstanceMonitoring)...)
 	if resource.InstanceType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceType", resource.InstanceType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KernelId", resource.KernelId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KeyName", resource.KeyName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PlacementTenancy", resource.PlacementTenancy)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RamDiskId", resource.RamDiskId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SpotPrice", resource.SpotPrice)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("UserData", resource.UserData)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.LaunchConfiguration_BlockDeviceMapping{} }))("BlockDeviceMappings", resource.BlockDeviceMappings)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ClassicLinkVPCSecurityGroups", resource.ClassicLinkVPCSecurityGroups)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroups", resource.SecurityGroups)...)
 	return errs
 }
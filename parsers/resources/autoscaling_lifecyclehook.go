@@ -8,11 +8,15 @@ import (
 )
 
 type AutoScalingLifecycleHook struct {
-	Type       string                      `yaml:"Type"`
-	Properties AutoScalingLifecycleHookProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          AutoScalingLifecycleHookProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type AutoScalingLifecycleHookProperties struct {
@@ -40,9 +44,10 @@ func ParseAutoScalingLifecycleHook(name string, data string) (cf types.ValueMap,
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: AutoScalingLifecycleHook - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -58,8 +63,22 @@ func (resource AutoScalingLifecycleHookProperties) Validate() []error {
 	if resource.AutoScalingGroupName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AutoScalingGroupName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AutoScalingGroupName", resource.AutoScalingGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DefaultResult", resource.DefaultResult)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("HeartbeatTimeout", resource.HeartbeatTimeout)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("LifecycleHookName", resource.LifecycleHookName)...)
 	if resource.LifecycleTransition == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'LifecycleTransition'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("LifecycleTransition", resource.LifecycleTransition)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NotificationMetadata", resource.NotificationMetadata)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NotificationTargetARN", resource.NotificationTargetARN)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RoleARN", resource.RoleARN)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type AutoScalingScalingPolicy struct {
-	Type       string                      `yaml:"Type"`
-	Properties AutoScalingScalingPolicyProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          AutoScalingScalingPolicyProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type AutoScalingScalingPolicyProperties struct {
@@ -43,9 +47,10 @@ func ParseAutoScalingScalingPolicy(name string, data string) (cf types.ValueMap,
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: AutoScalingScalingPolicy - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -58,8 +63,29 @@ func (resource AutoScalingScalingPolicy) Validate() []error {
 
 func (resource AutoScalingScalingPolicyProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AdjustmentType", resource.AdjustmentType)...)
 	if resource.AutoScalingGroupName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AutoScalingGroupName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AutoScalingGroupName", resource.AutoScalingGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Cooldown", resource.Cooldown)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("EstimatedInstanceWarmup", resource.EstimatedInstanceWarmup)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("MetricAggregationType", resource.MetricAggregationType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MinAdjustmentMagnitude", resource.MinAdjustmentMagnitude)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PolicyType", resource.PolicyType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ScalingAdjustment", resource.ScalingAdjustment)...)
+	
+	if resource.TargetTrackingConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("TargetTrackingConfiguration", resource.TargetTrackingConfiguration.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.ScalingPolicy_StepAdjustment{} }))("StepAdjustments", resource.StepAdjustments)...)
 	return errs
 }
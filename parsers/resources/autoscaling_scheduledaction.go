@@ -8,11 +8,15 @@ import (
 )
 
 type AutoScalingScheduledAction struct {
-	Type       string                      `yaml:"Type"`
-	Properties AutoScalingScheduledActionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          AutoScalingScheduledActionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type AutoScalingScheduledActionProperties struct {
@@ -39,9 +43,10 @@ func ParseAutoScalingScheduledAction(name string, data string) (cf types.ValueMa
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: AutoScalingScheduledAction - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -57,5 +62,18 @@ func (resource AutoScalingScheduledActionProperties) Validate() []error {
 	if resource.AutoScalingGroupName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AutoScalingGroupName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AutoScalingGroupName", resource.AutoScalingGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("DesiredCapacity", resource.DesiredCapacity)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EndTime", resource.EndTime)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MaxSize", resource.MaxSize)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MinSize", resource.MinSize)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Recurrence", resource.Recurrence)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("StartTime", resource.StartTime)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type AutoScalingPlansScalingPlan struct {
-	Type       string                      `yaml:"Type"`
-	Properties AutoScalingPlansScalingPlanProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          AutoScalingPlansScalingPlanProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type AutoScalingPlansScalingPlanProperties struct {
@@ -35,9 +39,10 @@ func ParseAutoScalingPlansScalingPlan(name string, data string) (cf types.ValueM
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: AutoScalingPlansScalingPlan - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,10 +58,12 @@ func (resource AutoScalingPlansScalingPlanProperties) Validate() []error {
 	if resource.ScalingInstructions == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ScalingInstructions'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.ScalingPlan_ScalingInstruction{} }))("ScalingInstructions", resource.ScalingInstructions)...)
 	if resource.ApplicationSource == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ApplicationSource'"))
-	} else {
-		errs = append(errs, resource.ApplicationSource.Validate()...)
+	}
+	if resource.ApplicationSource != nil {
+		errs = append(errs, types.PrefixErrors("ApplicationSource", resource.ApplicationSource.Validate())...)
 	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type BatchComputeEnvironment struct {
-	Type       string                      `yaml:"Type"`
-	Properties BatchComputeEnvironmentProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          BatchComputeEnvironmentProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type BatchComputeEnvironmentProperties struct {
@@ -38,9 +42,10 @@ func ParseBatchComputeEnvironment(name string, data string) (cf types.ValueMap,
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: BatchComputeEnvironment - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,16 +58,23 @@ func (resource BatchComputeEnvironment) Validate() []error {
 
 func (resource BatchComputeEnvironmentProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ComputeEnvironmentName", resource.ComputeEnvironmentName)...)
 	if resource.ServiceRole == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ServiceRole'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceRole", resource.ServiceRole)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("State", resource.State)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	if resource.ComputeResources == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ComputeResources'"))
-	} else {
-		errs = append(errs, resource.ComputeResources.Validate()...)
+	}
+	if resource.ComputeResources != nil {
+		errs = append(errs, types.PrefixErrors("ComputeResources", resource.ComputeResources.Validate())...)
 	}
 	return errs
 }
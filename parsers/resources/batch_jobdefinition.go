@@ -9,11 +9,15 @@ import (
 )
 
 type BatchJobDefinition struct {
-	Type       string                      `yaml:"Type"`
-	Properties BatchJobDefinitionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          BatchJobDefinitionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type BatchJobDefinitionProperties struct {
@@ -38,9 +42,10 @@ func ParseBatchJobDefinition(name string, data string) (cf types.ValueMap, err e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: BatchJobDefinition - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,13 +58,23 @@ func (resource BatchJobDefinition) Validate() []error {
 
 func (resource BatchJobDefinitionProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("JobDefinitionName", resource.JobDefinitionName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("Parameters", resource.Parameters)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	if resource.RetryStrategy != nil {
+		errs = append(errs, types.PrefixErrors("RetryStrategy", resource.RetryStrategy.Validate())...)
+	}
 	if resource.ContainerProperties == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ContainerProperties'"))
-	} else {
-		errs = append(errs, resource.ContainerProperties.Validate()...)
+	}
+	if resource.ContainerProperties != nil {
+		errs = append(errs, types.PrefixErrors("ContainerProperties", resource.ContainerProperties.Validate())...)
 	}
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type BatchJobQueue struct {
-	Type       string                      `yaml:"Type"`
-	Properties BatchJobQueueProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          BatchJobQueueProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type BatchJobQueueProperties struct {
@@ -36,9 +41,10 @@ func ParseBatchJobQueue(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: BatchJobQueue - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,11 +57,17 @@ func (resource BatchJobQueue) Validate() []error {
 
 func (resource BatchJobQueueProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("JobQueueName", resource.JobQueueName)...)
 	if resource.Priority == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Priority'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Priority", resource.Priority)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("State", resource.State)...)
 	if resource.ComputeEnvironmentOrder == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ComputeEnvironmentOrder'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.JobQueue_ComputeEnvironmentOrder{} }))("ComputeEnvironmentOrder", resource.ComputeEnvironmentOrder)...)
 	return errs
 }
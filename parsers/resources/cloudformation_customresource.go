@@ -8,11 +8,15 @@ import (
 )
 
 type CloudFormationCustomResource struct {
-	Type       string                      `yaml:"Type"`
-	Properties CloudFormationCustomResourceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CloudFormationCustomResourceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CloudFormationCustomResourceProperties struct {
@@ -33,9 +37,10 @@ func ParseCloudFormationCustomResource(name string, data string) (cf types.Value
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CloudFormationCustomResource - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,5 +56,6 @@ func (resource CloudFormationCustomResourceProperties) Validate() []error {
 	if resource.ServiceToken == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ServiceToken'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceToken", resource.ServiceToken)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type CloudFormationStack struct {
-	Type       string                      `yaml:"Type"`
-	Properties CloudFormationStackProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CloudFormationStackProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CloudFormationStackProperties struct {
@@ -37,9 +42,10 @@ func ParseCloudFormationStack(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CloudFormationStack - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -55,5 +61,14 @@ func (resource CloudFormationStackProperties) Validate() []error {
 	if resource.TemplateURL == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TemplateURL'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TemplateURL", resource.TemplateURL)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("TimeoutInMinutes", resource.TimeoutInMinutes)...)
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Parameters", resource.Parameters)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("NotificationARNs", resource.NotificationARNs)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type CloudFormationWaitCondition struct {
-	Type       string                      `yaml:"Type"`
-	Properties CloudFormationWaitConditionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CloudFormationWaitConditionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CloudFormationWaitConditionProperties struct {
@@ -35,9 +39,10 @@ func ParseCloudFormationWaitCondition(name string, data string) (cf types.ValueM
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CloudFormationWaitCondition - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,11 +55,15 @@ func (resource CloudFormationWaitCondition) Validate() []error {
 
 func (resource CloudFormationWaitConditionProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Count", resource.Count)...)
 	if resource.Handle == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Handle'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Handle", resource.Handle)...)
 	if resource.Timeout == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Timeout'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Timeout", resource.Timeout)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type CloudFrontCloudFrontOriginAccessIdentity struct {
-	Type       string                      `yaml:"Type"`
-	Properties CloudFrontCloudFrontOriginAccessIdentityProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CloudFrontCloudFrontOriginAccessIdentityProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CloudFrontCloudFrontOriginAccessIdentityProperties struct {
@@ -34,9 +38,10 @@ func ParseCloudFrontCloudFrontOriginAccessIdentity(name string, data string) (cf
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CloudFrontCloudFrontOriginAccessIdentity - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,8 +56,9 @@ func (resource CloudFrontCloudFrontOriginAccessIdentityProperties) Validate() []
 	errs := []error{}
 	if resource.CloudFrontOriginAccessIdentityConfig == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CloudFrontOriginAccessIdentityConfig'"))
-	} else {
-		errs = append(errs, resource.CloudFrontOriginAccessIdentityConfig.Validate()...)
+	}
+	if resource.CloudFrontOriginAccessIdentityConfig != nil {
+		errs = append(errs, types.PrefixErrors("CloudFrontOriginAccessIdentityConfig", resource.CloudFrontOriginAccessIdentityConfig.Validate())...)
 	}
 	return errs
 }
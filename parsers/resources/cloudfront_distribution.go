@@ -9,11 +9,15 @@ import (
 )
 
 type CloudFrontDistribution struct {
-	Type       string                      `yaml:"Type"`
-	Properties CloudFrontDistributionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CloudFrontDistributionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CloudFrontDistributionProperties struct {
@@ -35,9 +39,10 @@ func ParseCloudFrontDistribution(name string, data string) (cf types.ValueMap, e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CloudFrontDistribution - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,10 +55,13 @@ func (resource CloudFrontDistribution) Validate() []error {
 
 func (resource CloudFrontDistributionProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	if resource.DistributionConfig == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DistributionConfig'"))
-	} else {
-		errs = append(errs, resource.DistributionConfig.Validate()...)
+	}
+	if resource.DistributionConfig != nil {
+		errs = append(errs, types.PrefixErrors("DistributionConfig", resource.DistributionConfig.Validate())...)
 	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type CloudFrontStreamingDistribution struct {
-	Type       string                      `yaml:"Type"`
-	Properties CloudFrontStreamingDistributionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CloudFrontStreamingDistributionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CloudFrontStreamingDistributionProperties struct {
@@ -35,9 +39,10 @@ func ParseCloudFrontStreamingDistribution(name string, data string) (cf types.Va
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CloudFrontStreamingDistribution - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,11 +57,13 @@ func (resource CloudFrontStreamingDistributionProperties) Validate() []error {
 	errs := []error{}
 	if resource.StreamingDistributionConfig == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StreamingDistributionConfig'"))
-	} else {
-		errs = append(errs, resource.StreamingDistributionConfig.Validate()...)
+	}
+	if resource.StreamingDistributionConfig != nil {
+		errs = append(errs, types.PrefixErrors("StreamingDistributionConfig", resource.StreamingDistributionConfig.Validate())...)
 	}
 	if resource.Tags == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Tags'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type CloudTrailTrail struct {
-	Type       string                      `yaml:"Type"`
-	Properties CloudTrailTrailProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CloudTrailTrailProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CloudTrailTrailProperties struct {
@@ -45,9 +50,10 @@ func ParseCloudTrailTrail(name string, data string) (cf types.ValueMap, err erro
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CloudTrailTrail - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -60,11 +66,35 @@ func (resource CloudTrailTrail) Validate() []error {
 
 func (resource CloudTrailTrailProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CloudWatchLogsLogGroupArn", resource.CloudWatchLogsLogGroupArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CloudWatchLogsRoleArn", resource.CloudWatchLogsRoleArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EnableLogFileValidation", resource.EnableLogFileValidation)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("IncludeGlobalServiceEvents", resource.IncludeGlobalServiceEvents)...)
 	if resource.IsLogging == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IsLogging'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("IsLogging", resource.IsLogging)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("IsMultiRegionTrail", resource.IsMultiRegionTrail)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KMSKeyId", resource.KMSKeyId)...)
 	if resource.S3BucketName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'S3BucketName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("S3BucketName", resource.S3BucketName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("S3KeyPrefix", resource.S3KeyPrefix)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SnsTopicName", resource.SnsTopicName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TrailName", resource.TrailName)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Trail_EventSelector{} }))("EventSelectors", resource.EventSelectors)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
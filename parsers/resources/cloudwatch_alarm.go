@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type CloudWatchAlarm struct {
-	Type       string                      `yaml:"Type"`
-	Properties CloudWatchAlarmProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CloudWatchAlarmProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CloudWatchAlarmProperties struct {
@@ -50,9 +55,10 @@ func ParseCloudWatchAlarm(name string, data string) (cf types.ValueMap, err erro
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CloudWatchAlarm - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -65,23 +71,53 @@ func (resource CloudWatchAlarm) Validate() []error {
 
 func (resource CloudWatchAlarmProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ActionsEnabled", resource.ActionsEnabled)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AlarmDescription", resource.AlarmDescription)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AlarmName", resource.AlarmName)...)
 	if resource.ComparisonOperator == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ComparisonOperator'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ComparisonOperator", resource.ComparisonOperator)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EvaluateLowSampleCountPercentile", resource.EvaluateLowSampleCountPercentile)...)
 	if resource.EvaluationPeriods == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'EvaluationPeriods'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("EvaluationPeriods", resource.EvaluationPeriods)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ExtendedStatistic", resource.ExtendedStatistic)...)
 	if resource.MetricName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MetricName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MetricName", resource.MetricName)...)
 	if resource.Namespace == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Namespace'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Namespace", resource.Namespace)...)
 	if resource.Period == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Period'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Period", resource.Period)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Statistic", resource.Statistic)...)
 	if resource.Threshold == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Threshold'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Double")("Threshold", resource.Threshold)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TreatMissingData", resource.TreatMissingData)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Unit", resource.Unit)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AlarmActions", resource.AlarmActions)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Alarm_Dimension{} }))("Dimensions", resource.Dimensions)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("InsufficientDataActions", resource.InsufficientDataActions)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("OKActions", resource.OKActions)...)
 	return errs
 }
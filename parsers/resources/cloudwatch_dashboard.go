@@ -8,11 +8,15 @@ import (
 )
 
 type CloudWatchDashboard struct {
-	Type       string                      `yaml:"Type"`
-	Properties CloudWatchDashboardProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CloudWatchDashboardProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CloudWatchDashboardProperties struct {
@@ -34,9 +38,10 @@ func ParseCloudWatchDashboard(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CloudWatchDashboard - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,5 +57,8 @@ func (resource CloudWatchDashboardProperties) Validate() []error {
 	if resource.DashboardBody == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DashboardBody'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DashboardBody", resource.DashboardBody)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DashboardName", resource.DashboardName)...)
 	return errs
 }
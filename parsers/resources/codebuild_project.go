@@ -9,11 +9,15 @@ import (
 )
 
 type CodeBuildProject struct {
-	Type       string                      `yaml:"Type"`
-	Properties CodeBuildProjectProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CodeBuildProjectProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CodeBuildProjectProperties struct {
@@ -46,9 +50,10 @@ func ParseCodeBuildProject(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CodeBuildProject - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -61,23 +66,51 @@ func (resource CodeBuildProject) Validate() []error {
 
 func (resource CodeBuildProjectProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("BadgeEnabled", resource.BadgeEnabled)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EncryptionKey", resource.EncryptionKey)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.ServiceRole == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ServiceRole'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceRole", resource.ServiceRole)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("TimeoutInMinutes", resource.TimeoutInMinutes)...)
+	
+	if resource.VpcConfig != nil {
+		errs = append(errs, types.PrefixErrors("VpcConfig", resource.VpcConfig.Validate())...)
+	}
 	if resource.Source == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Source'"))
-	} else {
-		errs = append(errs, resource.Source.Validate()...)
 	}
+	if resource.Source != nil {
+		errs = append(errs, types.PrefixErrors("Source", resource.Source.Validate())...)
+	}
+	
+	if resource.Triggers != nil {
+		errs = append(errs, types.PrefixErrors("Triggers", resource.Triggers.Validate())...)
+	}
+	
+	if resource.Cache != nil {
+		errs = append(errs, types.PrefixErrors("Cache", resource.Cache.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	if resource.Environment == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Environment'"))
-	} else {
-		errs = append(errs, resource.Environment.Validate()...)
+	}
+	if resource.Environment != nil {
+		errs = append(errs, types.PrefixErrors("Environment", resource.Environment.Validate())...)
 	}
 	if resource.Artifacts == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Artifacts'"))
-	} else {
-		errs = append(errs, resource.Artifacts.Validate()...)
+	}
+	if resource.Artifacts != nil {
+		errs = append(errs, types.PrefixErrors("Artifacts", resource.Artifacts.Validate())...)
 	}
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type CodeCommitRepository struct {
-	Type       string                      `yaml:"Type"`
-	Properties CodeCommitRepositoryProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CodeCommitRepositoryProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CodeCommitRepositoryProperties struct {
@@ -35,9 +40,10 @@ func ParseCodeCommitRepository(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CodeCommitRepository - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,8 +56,13 @@ func (resource CodeCommitRepository) Validate() []error {
 
 func (resource CodeCommitRepositoryProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RepositoryDescription", resource.RepositoryDescription)...)
 	if resource.RepositoryName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RepositoryName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RepositoryName", resource.RepositoryName)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Repository_RepositoryTrigger{} }))("Triggers", resource.Triggers)...)
 	return errs
 }
@@ -7,11 +7,15 @@ import (
 )
 
 type CodeDeployApplication struct {
-	Type       string                      `yaml:"Type"`
-	Properties CodeDeployApplicationProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CodeDeployApplicationProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CodeDeployApplicationProperties struct {
@@ -33,9 +37,10 @@ func ParseCodeDeployApplication(name string, data string) (cf types.ValueMap, er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CodeDeployApplication - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -48,5 +53,9 @@ func (resource CodeDeployApplication) Validate() []error {
 
 func (resource CodeDeployApplicationProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ApplicationName", resource.ApplicationName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ComputePlatform", resource.ComputePlatform)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type CodeDeployDeploymentConfig struct {
-	Type       string                      `yaml:"Type"`
-	Properties CodeDeployDeploymentConfigProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CodeDeployDeploymentConfigProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CodeDeployDeploymentConfigProperties struct {
@@ -34,9 +38,10 @@ func ParseCodeDeployDeploymentConfig(name string, data string) (cf types.ValueMa
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CodeDeployDeploymentConfig - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -49,5 +54,11 @@ func (resource CodeDeployDeploymentConfig) Validate() []error {
 
 func (resource CodeDeployDeploymentConfigProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DeploymentConfigName", resource.DeploymentConfigName)...)
+	
+	if resource.MinimumHealthyHosts != nil {
+		errs = append(errs, types.PrefixErrors("MinimumHealthyHosts", resource.MinimumHealthyHosts.Validate())...)
+	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type CodeDeployDeploymentGroup struct {
-	Type       string                      `yaml:"Type"`
-	Properties CodeDeployDeploymentGroupProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CodeDeployDeploymentGroupProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CodeDeployDeploymentGroupProperties struct {
@@ -22,9 +26,9 @@ type CodeDeployDeploymentGroupProperties struct {
 	DeploymentGroupName interface{} `yaml:"DeploymentGroupName,omitempty"`
 	ServiceRoleArn interface{} `yaml:"ServiceRoleArn"`
 	LoadBalancerInfo *properties.DeploymentGroup_LoadBalancerInfo `yaml:"LoadBalancerInfo,omitempty"`
-	OnPremisesInstanceTagFilters interface{} `yaml:"OnPremisesInstanceTagFilters,omitempty"`
 	AutoScalingGroups interface{} `yaml:"AutoScalingGroups,omitempty"`
 	Ec2TagFilters interface{} `yaml:"Ec2TagFilters,omitempty"`
+	OnPremisesInstanceTagFilters interface{} `yaml:"OnPremisesInstanceTagFilters,omitempty"`
 	TriggerConfigurations interface{} `yaml:"TriggerConfigurations,omitempty"`
 	DeploymentStyle *properties.DeploymentGroup_DeploymentStyle `yaml:"DeploymentStyle,omitempty"`
 	Deployment *properties.DeploymentGroup_Deployment `yaml:"Deployment,omitempty"`
@@ -46,9 +50,10 @@ func ParseCodeDeployDeploymentGroup(name string, data string) (cf types.ValueMap
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CodeDeployDeploymentGroup - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -64,8 +69,42 @@ func (resource CodeDeployDeploymentGroupProperties) Validate() []error {
 	if resource.ApplicationName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ApplicationName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ApplicationName", resource.ApplicationName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DeploymentConfigName", resource.DeploymentConfigName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DeploymentGroupName", resource.DeploymentGroupName)...)
 	if resource.ServiceRoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ServiceRoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceRoleArn", resource.ServiceRoleArn)...)
+	
+	if resource.LoadBalancerInfo != nil {
+		errs = append(errs, types.PrefixErrors("LoadBalancerInfo", resource.LoadBalancerInfo.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AutoScalingGroups", resource.AutoScalingGroups)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.DeploymentGroup_EC2TagFilter{} }))("Ec2TagFilters", resource.Ec2TagFilters)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.DeploymentGroup_TagFilter{} }))("OnPremisesInstanceTagFilters", resource.OnPremisesInstanceTagFilters)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.DeploymentGroup_TriggerConfig{} }))("TriggerConfigurations", resource.TriggerConfigurations)...)
+	
+	if resource.DeploymentStyle != nil {
+		errs = append(errs, types.PrefixErrors("DeploymentStyle", resource.DeploymentStyle.Validate())...)
+	}
+	
+	if resource.Deployment != nil {
+		errs = append(errs, types.PrefixErrors("Deployment", resource.Deployment.Validate())...)
+	}
+	
+	if resource.AutoRollbackConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("AutoRollbackConfiguration", resource.AutoRollbackConfiguration.Validate())...)
+	}
+	
+	if resource.AlarmConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("AlarmConfiguration", resource.AlarmConfiguration.Validate())...)
+	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type CodePipelineCustomActionType struct {
-	Type       string                      `yaml:"Type"`
-	Properties CodePipelineCustomActionTypeProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CodePipelineCustomActionTypeProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CodePipelineCustomActionTypeProperties struct {
@@ -40,9 +44,10 @@ func ParseCodePipelineCustomActionType(name string, data string) (cf types.Value
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CodePipelineCustomActionType - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -58,18 +63,30 @@ func (resource CodePipelineCustomActionTypeProperties) Validate() []error {
 	if resource.Category == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Category'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Category", resource.Category)...)
 	if resource.Provider == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Provider'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Provider", resource.Provider)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Version", resource.Version)...)
+	
+	if resource.Settings != nil {
+		errs = append(errs, types.PrefixErrors("Settings", resource.Settings.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.CustomActionType_ConfigurationProperties{} }))("ConfigurationProperties", resource.ConfigurationProperties)...)
 	if resource.InputArtifactDetails == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InputArtifactDetails'"))
-	} else {
-		errs = append(errs, resource.InputArtifactDetails.Validate()...)
+	}
+	if resource.InputArtifactDetails != nil {
+		errs = append(errs, types.PrefixErrors("InputArtifactDetails", resource.InputArtifactDetails.Validate())...)
 	}
 	if resource.OutputArtifactDetails == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'OutputArtifactDetails'"))
-	} else {
-		errs = append(errs, resource.OutputArtifactDetails.Validate()...)
+	}
+	if resource.OutputArtifactDetails != nil {
+		errs = append(errs, types.PrefixErrors("OutputArtifactDetails", resource.OutputArtifactDetails.Validate())...)
 	}
 	return errs
 }
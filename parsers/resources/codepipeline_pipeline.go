@@ -9,11 +9,15 @@ import (
 )
 
 type CodePipelinePipeline struct {
-	Type       string                      `yaml:"Type"`
-	Properties CodePipelinePipelineProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CodePipelinePipelineProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CodePipelinePipelineProperties struct {
@@ -39,9 +43,10 @@ func ParseCodePipelinePipeline(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CodePipelinePipeline - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,16 +59,25 @@ func (resource CodePipelinePipeline) Validate() []error {
 
 func (resource CodePipelinePipelineProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("RestartExecutionOnUpdate", resource.RestartExecutionOnUpdate)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Pipeline_StageTransition{} }))("DisableInboundStageTransitions", resource.DisableInboundStageTransitions)...)
 	if resource.Stages == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Stages'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Pipeline_StageDeclaration{} }))("Stages", resource.Stages)...)
 	if resource.ArtifactStore == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ArtifactStore'"))
-	} else {
-		errs = append(errs, resource.ArtifactStore.Validate()...)
+	}
+	if resource.ArtifactStore != nil {
+		errs = append(errs, types.PrefixErrors("ArtifactStore", resource.ArtifactStore.Validate())...)
 	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type CognitoIdentityPool struct {
-	Type       string                      `yaml:"Type"`
-	Properties CognitoIdentityPoolProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CognitoIdentityPoolProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CognitoIdentityPoolProperties struct {
@@ -43,9 +47,10 @@ func ParseCognitoIdentityPool(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CognitoIdentityPool - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -61,5 +66,28 @@ func (resource CognitoIdentityPoolProperties) Validate() []error {
 	if resource.AllowUnauthenticatedIdentities == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AllowUnauthenticatedIdentities'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AllowUnauthenticatedIdentities", resource.AllowUnauthenticatedIdentities)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("CognitoEvents", resource.CognitoEvents)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DeveloperProviderName", resource.DeveloperProviderName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("IdentityPoolName", resource.IdentityPoolName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("SupportedLoginProviders", resource.SupportedLoginProviders)...)
+	
+	if resource.PushSync != nil {
+		errs = append(errs, types.PrefixErrors("PushSync", resource.PushSync.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.IdentityPool_CognitoIdentityProvider{} }))("CognitoIdentityProviders", resource.CognitoIdentityProviders)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("OpenIdConnectProviderARNs", resource.OpenIdConnectProviderARNs)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SamlProviderARNs", resource.SamlProviderARNs)...)
+	
+	if resource.CognitoStreams != nil {
+		errs = append(errs, types.PrefixErrors("CognitoStreams", resource.CognitoStreams.Validate())...)
+	}
 	return errs
 }
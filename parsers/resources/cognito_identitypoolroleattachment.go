@@ -8,11 +8,15 @@ import (
 )
 
 type CognitoIdentityPoolRoleAttachment struct {
-	Type       string                      `yaml:"Type"`
-	Properties CognitoIdentityPoolRoleAttachmentProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CognitoIdentityPoolRoleAttachmentProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CognitoIdentityPoolRoleAttachmentProperties struct {
@@ -35,9 +39,10 @@ func ParseCognitoIdentityPoolRoleAttachment(name string, data string) (cf types.
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CognitoIdentityPoolRoleAttachment - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,5 +58,10 @@ func (resource CognitoIdentityPoolRoleAttachmentProperties) Validate() []error {
 	if resource.IdentityPoolId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IdentityPoolId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IdentityPoolId", resource.IdentityPoolId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("RoleMappings", resource.RoleMappings)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("Roles", resource.Roles)...)
 	return errs
 }
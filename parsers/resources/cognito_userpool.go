@@ -8,11 +8,15 @@ import (
 )
 
 type CognitoUserPool struct {
-	Type       string                      `yaml:"Type"`
-	Properties CognitoUserPoolProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CognitoUserPoolProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CognitoUserPoolProperties struct {
@@ -26,9 +30,9 @@ type CognitoUserPoolProperties struct {
 	SmsConfiguration *properties.UserPool_SmsConfiguration `yaml:"SmsConfiguration,omitempty"`
 	Policies *properties.UserPool_Policies `yaml:"Policies,omitempty"`
 	AliasAttributes interface{} `yaml:"AliasAttributes,omitempty"`
-	UsernameAttributes interface{} `yaml:"UsernameAttributes,omitempty"`
-	Schema interface{} `yaml:"Schema,omitempty"`
 	AutoVerifiedAttributes interface{} `yaml:"AutoVerifiedAttributes,omitempty"`
+	Schema interface{} `yaml:"Schema,omitempty"`
+	UsernameAttributes interface{} `yaml:"UsernameAttributes,omitempty"`
 	LambdaConfig *properties.UserPool_LambdaConfig `yaml:"LambdaConfig,omitempty"`
 	EmailConfiguration *properties.UserPool_EmailConfiguration `yaml:"EmailConfiguration,omitempty"`
 	DeviceConfiguration *properties.UserPool_DeviceConfiguration `yaml:"DeviceConfiguration,omitempty"`
@@ -49,9 +53,10 @@ func ParseCognitoUserPool(name string, data string) (cf types.ValueMap, err erro
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CognitoUserPool - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -64,5 +69,51 @@ func (resource CognitoUserPool) Validate() []error {
 
 func (resource CognitoUserPoolProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EmailVerificationMessage", resource.EmailVerificationMessage)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EmailVerificationSubject", resource.EmailVerificationSubject)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("MfaConfiguration", resource.MfaConfiguration)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SmsAuthenticationMessage", resource.SmsAuthenticationMessage)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SmsVerificationMessage", resource.SmsVerificationMessage)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("UserPoolName", resource.UserPoolName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("UserPoolTags", resource.UserPoolTags)...)
+	
+	if resource.SmsConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("SmsConfiguration", resource.SmsConfiguration.Validate())...)
+	}
+	
+	if resource.Policies != nil {
+		errs = append(errs, types.PrefixErrors("Policies", resource.Policies.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AliasAttributes", resource.AliasAttributes)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AutoVerifiedAttributes", resource.AutoVerifiedAttributes)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.UserPool_SchemaAttribute{} }))("Schema", resource.Schema)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("UsernameAttributes", resource.UsernameAttributes)...)
+	
+	if resource.LambdaConfig != nil {
+		errs = append(errs, types.PrefixErrors("LambdaConfig", resource.LambdaConfig.Validate())...)
+	}
+	
+	if resource.EmailConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("EmailConfiguration", resource.EmailConfiguration.Validate())...)
+	}
+	
+	if resource.DeviceConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("DeviceConfiguration", resource.DeviceConfiguration.Validate())...)
+	}
+	
+	if resource.AdminCreateUserConfig != nil {
+		errs = append(errs, types.PrefixErrors("AdminCreateUserConfig", resource.AdminCreateUserConfig.Validate())...)
+	}
 	return errs
 }
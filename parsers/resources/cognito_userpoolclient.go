@@ -8,11 +8,15 @@ import (
 )
 
 type CognitoUserPoolClient struct {
-	Type       string                      `yaml:"Type"`
-	Properties CognitoUserPoolClientProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CognitoUserPoolClientProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CognitoUserPoolClientProperties struct {
@@ -39,9 +43,10 @@ func ParseCognitoUserPoolClient(name string, data string) (cf types.ValueMap, er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CognitoUserPoolClient - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,8 +59,21 @@ func (resource CognitoUserPoolClient) Validate() []error {
 
 func (resource CognitoUserPoolClientProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ClientName", resource.ClientName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("GenerateSecret", resource.GenerateSecret)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Double")("RefreshTokenValidity", resource.RefreshTokenValidity)...)
 	if resource.UserPoolId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'UserPoolId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("UserPoolId", resource.UserPoolId)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ExplicitAuthFlows", resource.ExplicitAuthFlows)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ReadAttributes", resource.ReadAttributes)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("WriteAttributes", resource.WriteAttributes)...)
 	return errs
 }
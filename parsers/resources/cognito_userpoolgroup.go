@@ -8,11 +8,15 @@ import (
 )
 
 type CognitoUserPoolGroup struct {
-	Type       string                      `yaml:"Type"`
-	Properties CognitoUserPoolGroupProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CognitoUserPoolGroupProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CognitoUserPoolGroupProperties struct {
@@ -37,9 +41,10 @@ func ParseCognitoUserPoolGroup(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CognitoUserPoolGroup - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,17 @@ func (resource CognitoUserPoolGroup) Validate() []error {
 
 func (resource CognitoUserPoolGroupProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("GroupName", resource.GroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Double")("Precedence", resource.Precedence)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	if resource.UserPoolId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'UserPoolId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("UserPoolId", resource.UserPoolId)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type CognitoUserPoolUser struct {
-	Type       string                      `yaml:"Type"`
-	Properties CognitoUserPoolUserProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CognitoUserPoolUserProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CognitoUserPoolUserProperties struct {
@@ -39,9 +44,10 @@ func ParseCognitoUserPoolUser(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CognitoUserPoolUser - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,8 +60,21 @@ func (resource CognitoUserPoolUser) Validate() []error {
 
 func (resource CognitoUserPoolUserProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ForceAliasCreation", resource.ForceAliasCreation)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("MessageAction", resource.MessageAction)...)
 	if resource.UserPoolId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'UserPoolId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("UserPoolId", resource.UserPoolId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Username", resource.Username)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("DesiredDeliveryMediums", resource.DesiredDeliveryMediums)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.UserPoolUser_AttributeType{} }))("UserAttributes", resource.UserAttributes)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.UserPoolUser_AttributeType{} }))("ValidationData", resource.ValidationData)...)
 	return errs
 }
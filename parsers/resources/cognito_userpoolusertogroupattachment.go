@@ -8,11 +8,15 @@ import (
 )
 
 type CognitoUserPoolUserToGroupAttachment struct {
-	Type       string                      `yaml:"Type"`
-	Properties CognitoUserPoolUserToGroupAttachmentProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          CognitoUserPoolUserToGroupAttachmentProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type CognitoUserPoolUserToGroupAttachmentProperties struct {
@@ -35,9 +39,10 @@ func ParseCognitoUserPoolUserToGroupAttachment(name string, data string) (cf typ
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: CognitoUserPoolUserToGroupAttachment - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,11 +58,14 @@ func (resource CognitoUserPoolUserToGroupAttachmentProperties) Validate() []erro
 	if resource.GroupName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'GroupName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("GroupName", resource.GroupName)...)
 	if resource.UserPoolId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'UserPoolId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("UserPoolId", resource.UserPoolId)...)
 	if resource.Username == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Username'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Username", resource.Username)...)
 	return errs
 }
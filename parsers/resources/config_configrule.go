@@ -9,11 +9,15 @@ import (
 )
 
 type ConfigConfigRule struct {
-	Type       string                      `yaml:"Type"`
-	Properties ConfigConfigRuleProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ConfigConfigRuleProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ConfigConfigRuleProperties struct {
@@ -39,9 +43,10 @@ func ParseConfigConfigRule(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ConfigConfigRule - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,10 +59,23 @@ func (resource ConfigConfigRule) Validate() []error {
 
 func (resource ConfigConfigRuleProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ConfigRuleName", resource.ConfigRuleName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("InputParameters", resource.InputParameters)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("MaximumExecutionFrequency", resource.MaximumExecutionFrequency)...)
 	if resource.Source == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Source'"))
-	} else {
-		errs = append(errs, resource.Source.Validate()...)
+	}
+	if resource.Source != nil {
+		errs = append(errs, types.PrefixErrors("Source", resource.Source.Validate())...)
+	}
+	
+	if resource.Scope != nil {
+		errs = append(errs, types.PrefixErrors("Scope", resource.Scope.Validate())...)
 	}
 	return errs
 }
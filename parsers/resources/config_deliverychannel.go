@@ -9,11 +9,15 @@ import (
 )
 
 type ConfigDeliveryChannel struct {
-	Type       string                      `yaml:"Type"`
-	Properties ConfigDeliveryChannelProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ConfigDeliveryChannelProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ConfigDeliveryChannelProperties struct {
@@ -38,9 +42,10 @@ func ParseConfigDeliveryChannel(name string, data string) (cf types.ValueMap, er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ConfigDeliveryChannel - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,8 +58,19 @@ func (resource ConfigDeliveryChannel) Validate() []error {
 
 func (resource ConfigDeliveryChannelProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.S3BucketName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'S3BucketName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("S3BucketName", resource.S3BucketName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("S3KeyPrefix", resource.S3KeyPrefix)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SnsTopicARN", resource.SnsTopicARN)...)
+	
+	if resource.ConfigSnapshotDeliveryProperties != nil {
+		errs = append(errs, types.PrefixErrors("ConfigSnapshotDeliveryProperties", resource.ConfigSnapshotDeliveryProperties.Validate())...)
+	}
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type DAXCluster struct {
-	Type       string                      `yaml:"Type"`
-	Properties DAXClusterProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          DAXClusterProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type DAXClusterProperties struct {
@@ -44,9 +48,10 @@ func ParseDAXCluster(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: DAXCluster - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -59,14 +64,35 @@ func (resource DAXCluster) Validate() []error {
 
 func (resource DAXClusterProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ClusterName", resource.ClusterName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.IAMRoleARN == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IAMRoleARN'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IAMRoleARN", resource.IAMRoleARN)...)
 	if resource.NodeType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'NodeType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("NodeType", resource.NodeType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NotificationTopicARN", resource.NotificationTopicARN)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ParameterGroupName", resource.ParameterGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PreferredMaintenanceWindow", resource.PreferredMaintenanceWindow)...)
 	if resource.ReplicationFactor == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ReplicationFactor'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("ReplicationFactor", resource.ReplicationFactor)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetGroupName", resource.SubnetGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("Tags", resource.Tags)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AvailabilityZones", resource.AvailabilityZones)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroupIds", resource.SecurityGroupIds)...)
 	return errs
 }
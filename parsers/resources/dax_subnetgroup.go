@@ -8,11 +8,15 @@ import (
 )
 
 type DAXSubnetGroup struct {
-	Type       string                      `yaml:"Type"`
-	Properties DAXSubnetGroupProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          DAXSubnetGroupProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type DAXSubnetGroupProperties struct {
@@ -35,9 +39,10 @@ func ParseDAXSubnetGroup(name string, data string) (cf types.ValueMap, err error
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: DAXSubnetGroup - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,8 +55,13 @@ func (resource DAXSubnetGroup) Validate() []error {
 
 func (resource DAXSubnetGroupProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetGroupName", resource.SubnetGroupName)...)
 	if resource.SubnetIds == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SubnetIds'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SubnetIds", resource.SubnetIds)...)
 	return errs
 }
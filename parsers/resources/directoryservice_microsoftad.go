@@ -9,11 +9,15 @@ import (
 )
 
 type DirectoryServiceMicrosoftAD struct {
-	Type       string                      `yaml:"Type"`
-	Properties DirectoryServiceMicrosoftADProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          DirectoryServiceMicrosoftADProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type DirectoryServiceMicrosoftADProperties struct {
@@ -39,9 +43,10 @@ func ParseDirectoryServiceMicrosoftAD(name string, data string) (cf types.ValueM
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: DirectoryServiceMicrosoftAD - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,16 +59,25 @@ func (resource DirectoryServiceMicrosoftAD) Validate() []error {
 
 func (resource DirectoryServiceMicrosoftADProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("CreateAlias", resource.CreateAlias)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EnableSso", resource.EnableSso)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Password == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Password'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Password", resource.Password)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ShortName", resource.ShortName)...)
 	if resource.VpcSettings == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VpcSettings'"))
-	} else {
-		errs = append(errs, resource.VpcSettings.Validate()...)
+	}
+	if resource.VpcSettings != nil {
+		errs = append(errs, types.PrefixErrors("VpcSettings", resource.VpcSettings.Validate())...)
 	}
 	return errs
 }
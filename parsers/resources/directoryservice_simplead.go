@@ -9,11 +9,15 @@ import (
 )
 
 type DirectoryServiceSimpleAD struct {
-	Type       string                      `yaml:"Type"`
-	Properties DirectoryServiceSimpleADProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          DirectoryServiceSimpleADProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type DirectoryServiceSimpleADProperties struct {
@@ -41,9 +45,10 @@ func ParseDirectoryServiceSimpleAD(name string, data string) (cf types.ValueMap,
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: DirectoryServiceSimpleAD - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,19 +61,31 @@ func (resource DirectoryServiceSimpleAD) Validate() []error {
 
 func (resource DirectoryServiceSimpleADProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("CreateAlias", resource.CreateAlias)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EnableSso", resource.EnableSso)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Password == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Password'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Password", resource.Password)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ShortName", resource.ShortName)...)
 	if resource.Size == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Size'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Size", resource.Size)...)
 	if resource.VpcSettings == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VpcSettings'"))
-	} else {
-		errs = append(errs, resource.VpcSettings.Validate()...)
+	}
+	if resource.VpcSettings != nil {
+		errs = append(errs, types.PrefixErrors("VpcSettings", resource.VpcSettings.Validate())...)
 	}
 	return errs
 }
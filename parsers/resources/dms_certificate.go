@@ -7,11 +7,15 @@ import (
 )
 
 type DMSCertificate struct {
-	Type       string                      `yaml:"Type"`
-	Properties DMSCertificateProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          DMSCertificateProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type DMSCertificateProperties struct {
@@ -34,9 +38,10 @@ func ParseDMSCertificate(name string, data string) (cf types.ValueMap, err error
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: DMSCertificate - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -49,5 +54,11 @@ func (resource DMSCertificate) Validate() []error {
 
 func (resource DMSCertificateProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CertificateIdentifier", resource.CertificateIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CertificatePem", resource.CertificatePem)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CertificateWallet", resource.CertificateWallet)...)
 	return errs
 }
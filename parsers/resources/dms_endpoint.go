@@ -9,11 +9,15 @@ import (
 )
 
 type DMSEndpoint struct {
-	Type       string                      `yaml:"Type"`
-	Properties DMSEndpointProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          DMSEndpointProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type DMSEndpointProperties struct {
@@ -49,9 +53,10 @@ func ParseDMSEndpoint(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: DMSEndpoint - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -64,11 +69,47 @@ func (resource DMSEndpoint) Validate() []error {
 
 func (resource DMSEndpointProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CertificateArn", resource.CertificateArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DatabaseName", resource.DatabaseName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EndpointIdentifier", resource.EndpointIdentifier)...)
 	if resource.EndpointType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'EndpointType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("EndpointType", resource.EndpointType)...)
 	if resource.EngineName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'EngineName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("EngineName", resource.EngineName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ExtraConnectionAttributes", resource.ExtraConnectionAttributes)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KmsKeyId", resource.KmsKeyId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Password", resource.Password)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Port", resource.Port)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ServerName", resource.ServerName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SslMode", resource.SslMode)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Username", resource.Username)...)
+	
+	if resource.S3Settings != nil {
+		errs = append(errs, types.PrefixErrors("S3Settings", resource.S3Settings.Validate())...)
+	}
+	
+	if resource.MongoDbSettings != nil {
+		errs = append(errs, types.PrefixErrors("MongoDbSettings", resource.MongoDbSettings.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	if resource.DynamoDbSettings != nil {
+		errs = append(errs, types.PrefixErrors("DynamoDbSettings", resource.DynamoDbSettings.Validate())...)
+	}
 	return errs
 }
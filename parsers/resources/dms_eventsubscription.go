@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type DMSEventSubscription struct {
-	Type       string                      `yaml:"Type"`
-	Properties DMSEventSubscriptionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          DMSEventSubscriptionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type DMSEventSubscriptionProperties struct {
@@ -39,9 +44,10 @@ func ParseDMSEventSubscription(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: DMSEventSubscription - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,8 +60,21 @@ func (resource DMSEventSubscription) Validate() []error {
 
 func (resource DMSEventSubscriptionProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	if resource.SnsTopicArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SnsTopicArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("SnsTopicArn", resource.SnsTopicArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceType", resource.SourceType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SubscriptionName", resource.SubscriptionName)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("EventCategories", resource.EventCategories)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SourceIds", resource.SourceIds)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type DMSReplicationInstance struct {
-	Type       string                      `yaml:"Type"`
-	Properties DMSReplicationInstanceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          DMSReplicationInstanceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type DMSReplicationInstanceProperties struct {
@@ -46,9 +51,10 @@ func ParseDMSReplicationInstance(name string, data string) (cf types.ValueMap, e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: DMSReplicationInstance - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -61,8 +67,35 @@ func (resource DMSReplicationInstance) Validate() []error {
 
 func (resource DMSReplicationInstanceProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("AllocatedStorage", resource.AllocatedStorage)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AllowMajorVersionUpgrade", resource.AllowMajorVersionUpgrade)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AutoMinorVersionUpgrade", resource.AutoMinorVersionUpgrade)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AvailabilityZone", resource.AvailabilityZone)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EngineVersion", resource.EngineVersion)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KmsKeyId", resource.KmsKeyId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("MultiAZ", resource.MultiAZ)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PreferredMaintenanceWindow", resource.PreferredMaintenanceWindow)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("PubliclyAccessible", resource.PubliclyAccessible)...)
 	if resource.ReplicationInstanceClass == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ReplicationInstanceClass'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ReplicationInstanceClass", resource.ReplicationInstanceClass)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ReplicationInstanceIdentifier", resource.ReplicationInstanceIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ReplicationSubnetGroupIdentifier", resource.ReplicationSubnetGroupIdentifier)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("VpcSecurityGroupIds", resource.VpcSecurityGroupIds)...)
 	return errs
 }
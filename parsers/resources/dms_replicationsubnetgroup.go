@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type DMSReplicationSubnetGroup struct {
-	Type       string                      `yaml:"Type"`
-	Properties DMSReplicationSubnetGroupProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          DMSReplicationSubnetGroupProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type DMSReplicationSubnetGroupProperties struct {
@@ -36,9 +41,10 @@ func ParseDMSReplicationSubnetGroup(name string, data string) (cf types.ValueMap
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: DMSReplicationSubnetGroup - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,8 +60,14 @@ func (resource DMSReplicationSubnetGroupProperties) Validate() []error {
 	if resource.ReplicationSubnetGroupDescription == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ReplicationSubnetGroupDescription'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ReplicationSubnetGroupDescription", resource.ReplicationSubnetGroupDescription)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ReplicationSubnetGroupIdentifier", resource.ReplicationSubnetGroupIdentifier)...)
 	if resource.SubnetIds == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SubnetIds'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SubnetIds", resource.SubnetIds)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
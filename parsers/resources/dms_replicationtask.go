@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type DMSReplicationTask struct {
-	Type       string                      `yaml:"Type"`
-	Properties DMSReplicationTaskProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          DMSReplicationTaskProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type DMSReplicationTaskProperties struct {
@@ -41,9 +46,10 @@ func ParseDMSReplicationTask(name string, data string) (cf types.ValueMap, err e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: DMSReplicationTask - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,20 +62,33 @@ func (resource DMSReplicationTask) Validate() []error {
 
 func (resource DMSReplicationTaskProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Double")("CdcStartTime", resource.CdcStartTime)...)
 	if resource.MigrationType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MigrationType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MigrationType", resource.MigrationType)...)
 	if resource.ReplicationInstanceArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ReplicationInstanceArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ReplicationInstanceArn", resource.ReplicationInstanceArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ReplicationTaskIdentifier", resource.ReplicationTaskIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ReplicationTaskSettings", resource.ReplicationTaskSettings)...)
 	if resource.SourceEndpointArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SourceEndpointArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("SourceEndpointArn", resource.SourceEndpointArn)...)
 	if resource.TableMappings == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TableMappings'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TableMappings", resource.TableMappings)...)
 	if resource.TargetEndpointArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TargetEndpointArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TargetEndpointArn", resource.TargetEndpointArn)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
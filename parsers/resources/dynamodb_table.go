@@ -9,11 +9,15 @@ import (
 )
 
 type DynamoDBTable struct {
-	Type       string                      `yaml:"Type"`
-	Properties DynamoDBTableProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          DynamoDBTableProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type DynamoDBTableProperties struct {
@@ -22,11 +26,11 @@ type DynamoDBTableProperties struct {
 	StreamSpecification *properties.Table_StreamSpecification `yaml:"StreamSpecification,omitempty"`
 	SSESpecification *properties.Table_SSESpecification `yaml:"SSESpecification,omitempty"`
 	ProvisionedThroughput *properties.Table_ProvisionedThroughput `yaml:"ProvisionedThroughput"`
+	AttributeDefinitions interface{} `yaml:"AttributeDefinitions,omitempty"`
 	GlobalSecondaryIndexes interface{} `yaml:"GlobalSecondaryIndexes,omitempty"`
+	KeySchema interface{} `yaml:"KeySchema"`
 	LocalSecondaryIndexes interface{} `yaml:"LocalSecondaryIndexes,omitempty"`
-	AttributeDefinitions interface{} `yaml:"AttributeDefinitions,omitempty"`
 	Tags interface{} `yaml:"Tags,omitempty"`
-	KeySchema interface{} `yaml:"KeySchema"`
 }
 
 func NewDynamoDBTable(properties DynamoDBTableProperties, deps ...interface{}) DynamoDBTable {
@@ -43,9 +47,10 @@ func ParseDynamoDBTable(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: DynamoDBTable - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -58,13 +63,37 @@ func (resource DynamoDBTable) Validate() []error {
 
 func (resource DynamoDBTableProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TableName", resource.TableName)...)
+	
+	if resource.TimeToLiveSpecification != nil {
+		errs = append(errs, types.PrefixErrors("TimeToLiveSpecification", resource.TimeToLiveSpecification.Validate())...)
+	}
+	
+	if resource.StreamSpecification != nil {
+		errs = append(errs, types.PrefixErrors("StreamSpecification", resource.StreamSpecification.Validate())...)
+	}
+	
+	if resource.SSESpecification != nil {
+		errs = append(errs, types.PrefixErrors("SSESpecification", resource.SSESpecification.Validate())...)
+	}
 	if resource.ProvisionedThroughput == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ProvisionedThroughput'"))
-	} else {
-		errs = append(errs, resource.ProvisionedThroughput.Validate()...)
 	}
+	if resource.ProvisionedThroughput != nil {
+		errs = append(errs, types.PrefixErrors("ProvisionedThroughput", resource.ProvisionedThroughput.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Table_AttributeDefinition{} }))("AttributeDefinitions", resource.AttributeDefinitions)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Table_GlobalSecondaryIndex{} }))("GlobalSecondaryIndexes", resource.GlobalSecondaryIndexes)...)
 	if resource.KeySchema == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'KeySchema'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Table_KeySchema{} }))("KeySchema", resource.KeySchema)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Table_LocalSecondaryIndex{} }))("LocalSecondaryIndexes", resource.LocalSecondaryIndexes)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type EC2CustomerGateway struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2CustomerGatewayProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2CustomerGatewayProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2CustomerGatewayProperties struct {
@@ -36,9 +41,10 @@ func ParseEC2CustomerGateway(name string, data string) (cf types.ValueMap, err e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2CustomerGateway - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,11 +60,16 @@ func (resource EC2CustomerGatewayProperties) Validate() []error {
 	if resource.BgpAsn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BgpAsn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("BgpAsn", resource.BgpAsn)...)
 	if resource.IpAddress == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IpAddress'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IpAddress", resource.IpAddress)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
@@ -4,14 +4,19 @@ import (
 	yaml "github.com/KablamoOSS/yaml"
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type EC2DHCPOptions struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2DHCPOptionsProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2DHCPOptionsProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2DHCPOptionsProperties struct {
@@ -37,9 +42,10 @@ func ParseEC2DHCPOptions(name string, data string) (cf types.ValueMap, err error
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2DHCPOptions - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,5 +58,17 @@ func (resource EC2DHCPOptions) Validate() []error {
 
 func (resource EC2DHCPOptionsProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DomainName", resource.DomainName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("NetbiosNodeType", resource.NetbiosNodeType)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("DomainNameServers", resource.DomainNameServers)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("NetbiosNameServers", resource.NetbiosNameServers)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("NtpServers", resource.NtpServers)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type EC2EgressOnlyInternetGateway struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2EgressOnlyInternetGatewayProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2EgressOnlyInternetGatewayProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2EgressOnlyInternetGatewayProperties struct {
@@ -33,9 +37,10 @@ func ParseEC2EgressOnlyInternetGateway(name string, data string) (cf types.Value
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2EgressOnlyInternetGateway - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,5 +56,6 @@ func (resource EC2EgressOnlyInternetGatewayProperties) Validate() []error {
 	if resource.VpcId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VpcId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VpcId", resource.VpcId)...)
 	return errs
 }
@@ -7,11 +7,15 @@ import (
 )
 
 type EC2EIPAssociation struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2EIPAssociationProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2EIPAssociationProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2EIPAssociationProperties struct {
@@ -36,9 +40,10 @@ func ParseEC2EIPAssociation(name string, data string) (cf types.ValueMap, err er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2EIPAssociation - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,5 +56,15 @@ func (resource EC2EIPAssociation) Validate() []error {
 
 func (resource EC2EIPAssociationProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AllocationId", resource.AllocationId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EIP", resource.EIP)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceId", resource.InstanceId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NetworkInterfaceId", resource.NetworkInterfaceId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PrivateIpAddress", resource.PrivateIpAddress)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type EC2FlowLog struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2FlowLogProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2FlowLogProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2FlowLogProperties struct {
@@ -37,9 +41,10 @@ func ParseEC2FlowLog(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2FlowLog - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -55,17 +60,22 @@ func (resource EC2FlowLogProperties) Validate() []error {
 	if resource.DeliverLogsPermissionArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DeliverLogsPermissionArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DeliverLogsPermissionArn", resource.DeliverLogsPermissionArn)...)
 	if resource.LogGroupName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'LogGroupName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("LogGroupName", resource.LogGroupName)...)
 	if resource.ResourceId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ResourceId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ResourceId", resource.ResourceId)...)
 	if resource.ResourceType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ResourceType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ResourceType", resource.ResourceType)...)
 	if resource.TrafficType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TrafficType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TrafficType", resource.TrafficType)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type EC2Instance struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2InstanceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2InstanceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2InstanceProperties struct {
@@ -39,12 +43,12 @@ type EC2InstanceProperties struct {
 	Tenancy interface{} `yaml:"Tenancy,omitempty"`
 	UserData interface{} `yaml:"UserData,omitempty"`
 	BlockDeviceMappings interface{} `yaml:"BlockDeviceMappings,omitempty"`
-	SsmAssociations interface{} `yaml:"SsmAssociations,omitempty"`
 	ElasticGpuSpecifications interface{} `yaml:"ElasticGpuSpecifications,omitempty"`
 	Ipv6Addresses interface{} `yaml:"Ipv6Addresses,omitempty"`
 	NetworkInterfaces interface{} `yaml:"NetworkInterfaces,omitempty"`
 	SecurityGroupIds interface{} `yaml:"SecurityGroupIds,omitempty"`
 	SecurityGroups interface{} `yaml:"SecurityGroups,omitempty"`
+	SsmAssociations interface{} `yaml:"SsmAssociations,omitempty"`
 	Tags interface{} `yaml:"Tags,omitempty"`
 	Volumes interface{} `yaml:"Volumes,omitempty"`
 	CreditSpecification *properties.Instance_CreditSpecification `yaml:"CreditSpecification,omitempty"`
@@ -64,9 +68,10 @@ func ParseEC2Instance(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2Instance - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -79,8 +84,71 @@ func (resource EC2Instance) Validate() []error {
 
 func (resource EC2InstanceProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AdditionalInfo", resource.AdditionalInfo)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Affinity", resource.Affinity)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AvailabilityZone", resource.AvailabilityZone)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DisableApiTermination", resource.DisableApiTermination)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EbsOptimized", resource.EbsOptimized)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HostId", resource.HostId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("IamInstanceProfile", resource.IamInstanceProfile)...)
 	if resource.ImageId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ImageId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ImageId", resource.ImageId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceInitiatedShutdownBehavior", resource.InstanceInitiatedShutdownBehavior)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceType", resource.InstanceType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Ipv6AddressCount", resource.Ipv6AddressCount)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KernelId", resource.KernelId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KeyName", resource.KeyName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Monitoring", resource.Monitoring)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PlacementGroupName", resource.PlacementGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PrivateIpAddress", resource.PrivateIpAddress)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RamdiskId", resource.RamdiskId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("SourceDestCheck", resource.SourceDestCheck)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Tenancy", resource.Tenancy)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("UserData", resource.UserData)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Instance_BlockDeviceMapping{} }))("BlockDeviceMappings", resource.BlockDeviceMappings)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Instance_ElasticGpuSpecification{} }))("ElasticGpuSpecifications", resource.ElasticGpuSpecifications)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Instance_InstanceIpv6Address{} }))("Ipv6Addresses", resource.Ipv6Addresses)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Instance_NetworkInterface{} }))("NetworkInterfaces", resource.NetworkInterfaces)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroupIds", resource.SecurityGroupIds)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroups", resource.SecurityGroups)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Instance_SsmAssociation{} }))("SsmAssociations", resource.SsmAssociations)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Instance_Volume{} }))("Volumes", resource.Volumes)...)
+	
+	if resource.CreditSpecification != nil {
+		errs = append(errs, types.PrefixErrors("CreditSpecification", resource.CreditSpecification.Validate())...)
+	}
 	return errs
 }
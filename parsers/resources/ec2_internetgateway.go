@@ -4,14 +4,19 @@ import (
 	yaml "github.com/KablamoOSS/yaml"
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type EC2InternetGateway struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2InternetGatewayProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2InternetGatewayProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2InternetGatewayProperties struct {
@@ -32,9 +37,10 @@ func ParseEC2InternetGateway(name string, data string) (cf types.ValueMap, err e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2InternetGateway - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -47,5 +53,7 @@ func (resource EC2InternetGateway) Validate() []error {
 
 func (resource EC2InternetGatewayProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type EC2LaunchTemplate struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2LaunchTemplateProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2LaunchTemplateProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2LaunchTemplateProperties struct {
@@ -34,9 +38,10 @@ func ParseEC2LaunchTemplate(name string, data string) (cf types.ValueMap, err er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2LaunchTemplate - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -49,5 +54,11 @@ func (resource EC2LaunchTemplate) Validate() []error {
 
 func (resource EC2LaunchTemplateProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("LaunchTemplateName", resource.LaunchTemplateName)...)
+	
+	if resource.LaunchTemplateData != nil {
+		errs = append(errs, types.PrefixErrors("LaunchTemplateData", resource.LaunchTemplateData.Validate())...)
+	}
 	return errs
 }
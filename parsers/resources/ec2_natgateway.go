@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type EC2NatGateway struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2NatGatewayProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2NatGatewayProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2NatGatewayProperties struct {
@@ -35,9 +40,10 @@ func ParseEC2NatGateway(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2NatGateway - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,8 +59,12 @@ func (resource EC2NatGatewayProperties) Validate() []error {
 	if resource.AllocationId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AllocationId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AllocationId", resource.AllocationId)...)
 	if resource.SubnetId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SubnetId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
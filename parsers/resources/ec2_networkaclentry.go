@@ -9,11 +9,15 @@ import (
 )
 
 type EC2NetworkAclEntry struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2NetworkAclEntryProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2NetworkAclEntryProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2NetworkAclEntryProperties struct {
@@ -42,9 +46,10 @@ func ParseEC2NetworkAclEntry(name string, data string) (cf types.ValueMap, err e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2NetworkAclEntry - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -60,17 +65,34 @@ func (resource EC2NetworkAclEntryProperties) Validate() []error {
 	if resource.CidrBlock == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CidrBlock'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CidrBlock", resource.CidrBlock)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Egress", resource.Egress)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Ipv6CidrBlock", resource.Ipv6CidrBlock)...)
 	if resource.NetworkAclId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'NetworkAclId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("NetworkAclId", resource.NetworkAclId)...)
 	if resource.Protocol == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Protocol'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Protocol", resource.Protocol)...)
 	if resource.RuleAction == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RuleAction'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RuleAction", resource.RuleAction)...)
 	if resource.RuleNumber == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RuleNumber'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("RuleNumber", resource.RuleNumber)...)
+	
+	if resource.PortRange != nil {
+		errs = append(errs, types.PrefixErrors("PortRange", resource.PortRange.Validate())...)
+	}
+	
+	if resource.Icmp != nil {
+		errs = append(errs, types.PrefixErrors("Icmp", resource.Icmp.Validate())...)
+	}
 	return errs
 }
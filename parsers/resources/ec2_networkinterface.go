@@ -9,11 +9,15 @@ import (
 )
 
 type EC2NetworkInterface struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2NetworkInterfaceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2NetworkInterfaceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2NetworkInterfaceProperties struct {
@@ -44,9 +48,10 @@ func ParseEC2NetworkInterface(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2NetworkInterface - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -59,8 +64,31 @@ func (resource EC2NetworkInterface) Validate() []error {
 
 func (resource EC2NetworkInterfaceProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InterfaceType", resource.InterfaceType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Ipv6AddressCount", resource.Ipv6AddressCount)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PrivateIpAddress", resource.PrivateIpAddress)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("SecondaryPrivateIpAddressCount", resource.SecondaryPrivateIpAddressCount)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("SourceDestCheck", resource.SourceDestCheck)...)
 	if resource.SubnetId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SubnetId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("GroupSet", resource.GroupSet)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.NetworkInterface_PrivateIpAddressSpecification{} }))("PrivateIpAddresses", resource.PrivateIpAddresses)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	if resource.Ipv6Addresses != nil {
+		errs = append(errs, types.PrefixErrors("Ipv6Addresses", resource.Ipv6Addresses.Validate())...)
+	}
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type EC2NetworkInterfaceAttachment struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2NetworkInterfaceAttachmentProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2NetworkInterfaceAttachmentProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2NetworkInterfaceAttachmentProperties struct {
@@ -36,9 +40,10 @@ func ParseEC2NetworkInterfaceAttachment(name string, data string) (cf types.Valu
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2NetworkInterfaceAttachment - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,14 +56,19 @@ func (resource EC2NetworkInterfaceAttachment) Validate() []error {
 
 func (resource EC2NetworkInterfaceAttachmentProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DeleteOnTermination", resource.DeleteOnTermination)...)
 	if resource.DeviceIndex == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DeviceIndex'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DeviceIndex", resource.DeviceIndex)...)
 	if resource.InstanceId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceId", resource.InstanceId)...)
 	if resource.NetworkInterfaceId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'NetworkInterfaceId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("NetworkInterfaceId", resource.NetworkInterfaceId)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type EC2NetworkInterfacePermission struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2NetworkInterfacePermissionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2NetworkInterfacePermissionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2NetworkInterfacePermissionProperties struct {
@@ -35,9 +39,10 @@ func ParseEC2NetworkInterfacePermission(name string, data string) (cf types.Valu
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2NetworkInterfacePermission - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,11 +58,14 @@ func (resource EC2NetworkInterfacePermissionProperties) Validate() []error {
 	if resource.AwsAccountId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AwsAccountId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AwsAccountId", resource.AwsAccountId)...)
 	if resource.NetworkInterfaceId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'NetworkInterfaceId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("NetworkInterfaceId", resource.NetworkInterfaceId)...)
 	if resource.Permission == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Permission'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Permission", resource.Permission)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type EC2Route struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2RouteProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2RouteProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2RouteProperties struct {
@@ -41,9 +45,10 @@ func ParseEC2Route(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2Route - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,8 +61,25 @@ func (resource EC2Route) Validate() []error {
 
 func (resource EC2RouteProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DestinationCidrBlock", resource.DestinationCidrBlock)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DestinationIpv6CidrBlock", resource.DestinationIpv6CidrBlock)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EgressOnlyInternetGatewayId", resource.EgressOnlyInternetGatewayId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("GatewayId", resource.GatewayId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceId", resource.InstanceId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NatGatewayId", resource.NatGatewayId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NetworkInterfaceId", resource.NetworkInterfaceId)...)
 	if resource.RouteTableId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RouteTableId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RouteTableId", resource.RouteTableId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("VpcPeeringConnectionId", resource.VpcPeeringConnectionId)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type EC2SecurityGroup struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2SecurityGroupProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2SecurityGroupProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2SecurityGroupProperties struct {
@@ -38,9 +43,10 @@ func ParseEC2SecurityGroup(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2SecurityGroup - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,5 +62,16 @@ func (resource EC2SecurityGroupProperties) Validate() []error {
 	if resource.GroupDescription == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'GroupDescription'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("GroupDescription", resource.GroupDescription)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("GroupName", resource.GroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("VpcId", resource.VpcId)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.SecurityGroup_Egress{} }))("SecurityGroupEgress", resource.SecurityGroupEgress)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.SecurityGroup_Ingress{} }))("SecurityGroupIngress", resource.SecurityGroupIngress)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type EC2SecurityGroupEgress struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2SecurityGroupEgressProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2SecurityGroupEgressProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2SecurityGroupEgressProperties struct {
@@ -41,9 +45,10 @@ func ParseEC2SecurityGroupEgress(name string, data string) (cf types.ValueMap, e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2SecurityGroupEgress - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,11 +61,27 @@ func (resource EC2SecurityGroupEgress) Validate() []error {
 
 func (resource EC2SecurityGroupEgressProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CidrIp", resource.CidrIp)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CidrIpv6", resource.CidrIpv6)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DestinationPrefixListId", resource.DestinationPrefixListId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DestinationSecurityGroupId", resource.DestinationSecurityGroupId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("FromPort", resource.FromPort)...)
 	if resource.GroupId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'GroupId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("GroupId", resource.GroupId)...)
 	if resource.IpProtocol == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IpProtocol'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IpProtocol", resource.IpProtocol)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ToPort", resource.ToPort)...)
 	return errs
 }
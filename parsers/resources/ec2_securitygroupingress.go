@@ -8,11 +8,15 @@ import (
 )
 
 type EC2SecurityGroupIngress struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2SecurityGroupIngressProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2SecurityGroupIngressProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2SecurityGroupIngressProperties struct {
@@ -43,9 +47,10 @@ func ParseEC2SecurityGroupIngress(name string, data string) (cf types.ValueMap,
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2SecurityGroupIngress - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -58,8 +63,29 @@ func (resource EC2SecurityGroupIngress) Validate() []error {
 
 func (resource EC2SecurityGroupIngressProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CidrIp", resource.CidrIp)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CidrIpv6", resource.CidrIpv6)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("FromPort", resource.FromPort)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("GroupId", resource.GroupId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("GroupName", resource.GroupName)...)
 	if resource.IpProtocol == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IpProtocol'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IpProtocol", resource.IpProtocol)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceSecurityGroupId", resource.SourceSecurityGroupId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceSecurityGroupName", resource.SourceSecurityGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceSecurityGroupOwnerId", resource.SourceSecurityGroupOwnerId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ToPort", resource.ToPort)...)
 	return errs
 }
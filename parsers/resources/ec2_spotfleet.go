@@ -9,11 +9,15 @@ import (
 )
 
 type EC2SpotFleet struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2SpotFleetProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2SpotFleetProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2SpotFleetProperties struct {
@@ -34,9 +38,10 @@ func ParseEC2SpotFleet(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2SpotFleet - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,8 +56,9 @@ func (resource EC2SpotFleetProperties) Validate() []error {
 	errs := []error{}
 	if resource.SpotFleetRequestConfigData == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SpotFleetRequestConfigData'"))
-	} else {
-		errs = append(errs, resource.SpotFleetRequestConfigData.Validate()...)
+	}
+	if resource.SpotFleetRequestConfigData != nil {
+		errs = append(errs, types.PrefixErrors("SpotFleetRequestConfigData", resource.SpotFleetRequestConfigData.Validate())...)
 	}
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type EC2SubnetCidrBlock struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2SubnetCidrBlockProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2SubnetCidrBlockProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2SubnetCidrBlockProperties struct {
@@ -34,9 +38,10 @@ func ParseEC2SubnetCidrBlock(name string, data string) (cf types.ValueMap, err e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2SubnetCidrBlock - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,10 @@ func (resource EC2SubnetCidrBlockProperties) Validate() []error {
 	if resource.Ipv6CidrBlock == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Ipv6CidrBlock'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Ipv6CidrBlock", resource.Ipv6CidrBlock)...)
 	if resource.SubnetId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SubnetId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
 	return errs
 }
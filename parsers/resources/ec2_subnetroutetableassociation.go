@@ -8,11 +8,15 @@ import (
 )
 
 type EC2SubnetRouteTableAssociation struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2SubnetRouteTableAssociationProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2SubnetRouteTableAssociationProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2SubnetRouteTableAssociationProperties struct {
@@ -34,9 +38,10 @@ func ParseEC2SubnetRouteTableAssociation(name string, data string) (cf types.Val
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2SubnetRouteTableAssociation - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,10 @@ func (resource EC2SubnetRouteTableAssociationProperties) Validate() []error {
 	if resource.RouteTableId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RouteTableId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RouteTableId", resource.RouteTableId)...)
 	if resource.SubnetId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SubnetId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type EC2TrunkInterfaceAssociation struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2TrunkInterfaceAssociationProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2TrunkInterfaceAssociationProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2TrunkInterfaceAssociationProperties struct {
@@ -36,9 +40,10 @@ func ParseEC2TrunkInterfaceAssociation(name string, data string) (cf types.Value
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2TrunkInterfaceAssociation - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,8 +59,14 @@ func (resource EC2TrunkInterfaceAssociationProperties) Validate() []error {
 	if resource.BranchInterfaceId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BranchInterfaceId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("BranchInterfaceId", resource.BranchInterfaceId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("GREKey", resource.GREKey)...)
 	if resource.TrunkInterfaceId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TrunkInterfaceId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TrunkInterfaceId", resource.TrunkInterfaceId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("VLANId", resource.VLANId)...)
 	return errs
 }
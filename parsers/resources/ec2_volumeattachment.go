@@ -8,11 +8,15 @@ import (
 )
 
 type EC2VolumeAttachment struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2VolumeAttachmentProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2VolumeAttachmentProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2VolumeAttachmentProperties struct {
@@ -35,9 +39,10 @@ func ParseEC2VolumeAttachment(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2VolumeAttachment - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,11 +58,14 @@ func (resource EC2VolumeAttachmentProperties) Validate() []error {
 	if resource.Device == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Device'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Device", resource.Device)...)
 	if resource.InstanceId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceId", resource.InstanceId)...)
 	if resource.VolumeId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VolumeId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VolumeId", resource.VolumeId)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type EC2VPC struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2VPCProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2VPCProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2VPCProperties struct {
@@ -37,9 +42,10 @@ func ParseEC2VPC(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2VPC - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -55,5 +61,14 @@ func (resource EC2VPCProperties) Validate() []error {
 	if resource.CidrBlock == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CidrBlock'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CidrBlock", resource.CidrBlock)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EnableDnsHostnames", resource.EnableDnsHostnames)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EnableDnsSupport", resource.EnableDnsSupport)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceTenancy", resource.InstanceTenancy)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
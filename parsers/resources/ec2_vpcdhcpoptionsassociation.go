@@ -8,11 +8,15 @@ import (
 )
 
 type EC2VPCDHCPOptionsAssociation struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2VPCDHCPOptionsAssociationProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2VPCDHCPOptionsAssociationProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2VPCDHCPOptionsAssociationProperties struct {
@@ -34,9 +38,10 @@ func ParseEC2VPCDHCPOptionsAssociation(name string, data string) (cf types.Value
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2VPCDHCPOptionsAssociation - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,10 @@ func (resource EC2VPCDHCPOptionsAssociationProperties) Validate() []error {
 	if resource.DhcpOptionsId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DhcpOptionsId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DhcpOptionsId", resource.DhcpOptionsId)...)
 	if resource.VpcId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VpcId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VpcId", resource.VpcId)...)
 	return errs
 }
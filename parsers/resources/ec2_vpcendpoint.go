@@ -8,11 +8,15 @@ import (
 )
 
 type EC2VPCEndpoint struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2VPCEndpointProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2VPCEndpointProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2VPCEndpointProperties struct {
@@ -36,9 +40,10 @@ func ParseEC2VPCEndpoint(name string, data string) (cf types.ValueMap, err error
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2VPCEndpoint - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,11 +56,17 @@ func (resource EC2VPCEndpoint) Validate() []error {
 
 func (resource EC2VPCEndpointProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("PolicyDocument", resource.PolicyDocument)...)
 	if resource.ServiceName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ServiceName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceName", resource.ServiceName)...)
 	if resource.VpcId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VpcId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VpcId", resource.VpcId)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("RouteTableIds", resource.RouteTableIds)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type EC2VPNConnection struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2VPNConnectionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2VPNConnectionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2VPNConnectionProperties struct {
@@ -38,9 +43,10 @@ func ParseEC2VPNConnection(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2VPNConnection - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,11 +62,20 @@ func (resource EC2VPNConnectionProperties) Validate() []error {
 	if resource.CustomerGatewayId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CustomerGatewayId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CustomerGatewayId", resource.CustomerGatewayId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("StaticRoutesOnly", resource.StaticRoutesOnly)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
 	if resource.VpnGatewayId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VpnGatewayId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VpnGatewayId", resource.VpnGatewayId)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.VPNConnection_VpnTunnelOptionsSpecification{} }))("VpnTunnelOptionsSpecifications", resource.VpnTunnelOptionsSpecifications)...)
 	return errs
 }
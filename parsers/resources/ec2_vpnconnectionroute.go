@@ -8,11 +8,15 @@ import (
 )
 
 type EC2VPNConnectionRoute struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2VPNConnectionRouteProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2VPNConnectionRouteProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2VPNConnectionRouteProperties struct {
@@ -34,9 +38,10 @@ func ParseEC2VPNConnectionRoute(name string, data string) (cf types.ValueMap, er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2VPNConnectionRoute - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,10 @@ func (resource EC2VPNConnectionRouteProperties) Validate() []error {
 	if resource.DestinationCidrBlock == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DestinationCidrBlock'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DestinationCidrBlock", resource.DestinationCidrBlock)...)
 	if resource.VpnConnectionId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VpnConnectionId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VpnConnectionId", resource.VpnConnectionId)...)
 	return errs
 }
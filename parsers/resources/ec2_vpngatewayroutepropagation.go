@@ -8,11 +8,15 @@ import (
 )
 
 type EC2VPNGatewayRoutePropagation struct {
-	Type       string                      `yaml:"Type"`
-	Properties EC2VPNGatewayRoutePropagationProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EC2VPNGatewayRoutePropagationProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EC2VPNGatewayRoutePropagationProperties struct {
@@ -34,9 +38,10 @@ func ParseEC2VPNGatewayRoutePropagation(name string, data string) (cf types.Valu
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EC2VPNGatewayRoutePropagation - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,10 @@ func (resource EC2VPNGatewayRoutePropagationProperties) Validate() []error {
 	if resource.VpnGatewayId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VpnGatewayId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VpnGatewayId", resource.VpnGatewayId)...)
 	if resource.RouteTableIds == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RouteTableIds'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("RouteTableIds", resource.RouteTableIds)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type ECRRepository struct {
-	Type       string                      `yaml:"Type"`
-	Properties ECRRepositoryProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ECRRepositoryProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ECRRepositoryProperties struct {
@@ -35,9 +39,10 @@ func ParseECRRepository(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ECRRepository - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,5 +55,13 @@ func (resource ECRRepository) Validate() []error {
 
 func (resource ECRRepositoryProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RepositoryName", resource.RepositoryName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("RepositoryPolicyText", resource.RepositoryPolicyText)...)
+	
+	if resource.LifecyclePolicy != nil {
+		errs = append(errs, types.PrefixErrors("LifecyclePolicy", resource.LifecyclePolicy.Validate())...)
+	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type ECSService struct {
-	Type       string                      `yaml:"Type"`
-	Properties ECSServiceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ECSServiceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ECSServiceProperties struct {
@@ -46,9 +50,10 @@ func ParseECSService(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ECSService - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -61,8 +66,37 @@ func (resource ECSService) Validate() []error {
 
 func (resource ECSServiceProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Cluster", resource.Cluster)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("DesiredCount", resource.DesiredCount)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("HealthCheckGracePeriodSeconds", resource.HealthCheckGracePeriodSeconds)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("LaunchType", resource.LaunchType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PlatformVersion", resource.PlatformVersion)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Role", resource.Role)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceName", resource.ServiceName)...)
 	if resource.TaskDefinition == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TaskDefinition'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TaskDefinition", resource.TaskDefinition)...)
+	
+	if resource.NetworkConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("NetworkConfiguration", resource.NetworkConfiguration.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Service_LoadBalancer{} }))("LoadBalancers", resource.LoadBalancers)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Service_PlacementConstraint{} }))("PlacementConstraints", resource.PlacementConstraints)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Service_PlacementStrategy{} }))("PlacementStrategies", resource.PlacementStrategies)...)
+	
+	if resource.DeploymentConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("DeploymentConfiguration", resource.DeploymentConfiguration.Validate())...)
+	}
 	return errs
 }
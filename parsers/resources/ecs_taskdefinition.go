@@ -4,14 +4,19 @@ import (
 	yaml "github.com/KablamoOSS/yaml"
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type ECSTaskDefinition struct {
-	Type       string                      `yaml:"Type"`
-	Properties ECSTaskDefinitionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ECSTaskDefinitionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ECSTaskDefinitionProperties struct {
@@ -41,9 +46,10 @@ func ParseECSTaskDefinition(name string, data string) (cf types.ValueMap, err er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ECSTaskDefinition - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,5 +62,25 @@ func (resource ECSTaskDefinition) Validate() []error {
 
 func (resource ECSTaskDefinitionProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Cpu", resource.Cpu)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ExecutionRoleArn", resource.ExecutionRoleArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Family", resource.Family)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Memory", resource.Memory)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NetworkMode", resource.NetworkMode)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TaskRoleArn", resource.TaskRoleArn)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.TaskDefinition_ContainerDefinition{} }))("ContainerDefinitions", resource.ContainerDefinitions)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.TaskDefinition_TaskDefinitionPlacementConstraint{} }))("PlacementConstraints", resource.PlacementConstraints)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("RequiresCompatibilities", resource.RequiresCompatibilities)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.TaskDefinition_Volume{} }))("Volumes", resource.Volumes)...)
 	return errs
 }
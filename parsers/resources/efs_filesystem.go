@@ -4,14 +4,19 @@ import (
 	yaml "github.com/KablamoOSS/yaml"
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type EFSFileSystem struct {
-	Type       string                      `yaml:"Type"`
-	Properties EFSFileSystemProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EFSFileSystemProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EFSFileSystemProperties struct {
@@ -35,9 +40,10 @@ func ParseEFSFileSystem(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EFSFileSystem - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,5 +56,13 @@ func (resource EFSFileSystem) Validate() []error {
 
 func (resource EFSFileSystemProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Encrypted", resource.Encrypted)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KmsKeyId", resource.KmsKeyId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PerformanceMode", resource.PerformanceMode)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.FileSystem_ElasticFileSystemTag{} }))("FileSystemTags", resource.FileSystemTags)...)
 	return errs
 }
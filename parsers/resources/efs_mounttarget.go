@@ -8,11 +8,15 @@ import (
 )
 
 type EFSMountTarget struct {
-	Type       string                      `yaml:"Type"`
-	Properties EFSMountTargetProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EFSMountTargetProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EFSMountTargetProperties struct {
@@ -36,9 +40,10 @@ func ParseEFSMountTarget(name string, data string) (cf types.ValueMap, err error
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EFSMountTarget - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,11 +59,16 @@ func (resource EFSMountTargetProperties) Validate() []error {
 	if resource.FileSystemId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FileSystemId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("FileSystemId", resource.FileSystemId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("IpAddress", resource.IpAddress)...)
 	if resource.SubnetId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SubnetId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
 	if resource.SecurityGroups == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SecurityGroups'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroups", resource.SecurityGroups)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type ElastiCacheCacheCluster struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElastiCacheCacheClusterProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElastiCacheCacheClusterProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElastiCacheCacheClusterProperties struct {
@@ -53,9 +58,10 @@ func ParseElastiCacheCacheCluster(name string, data string) (cf types.ValueMap,
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElastiCacheCacheCluster - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -68,14 +74,53 @@ func (resource ElastiCacheCacheCluster) Validate() []error {
 
 func (resource ElastiCacheCacheClusterProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AZMode", resource.AZMode)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AutoMinorVersionUpgrade", resource.AutoMinorVersionUpgrade)...)
 	if resource.CacheNodeType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CacheNodeType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CacheNodeType", resource.CacheNodeType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CacheParameterGroupName", resource.CacheParameterGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CacheSubnetGroupName", resource.CacheSubnetGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ClusterName", resource.ClusterName)...)
 	if resource.Engine == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Engine'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Engine", resource.Engine)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EngineVersion", resource.EngineVersion)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NotificationTopicArn", resource.NotificationTopicArn)...)
 	if resource.NumCacheNodes == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'NumCacheNodes'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("NumCacheNodes", resource.NumCacheNodes)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Port", resource.Port)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PreferredAvailabilityZone", resource.PreferredAvailabilityZone)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PreferredMaintenanceWindow", resource.PreferredMaintenanceWindow)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SnapshotName", resource.SnapshotName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("SnapshotRetentionLimit", resource.SnapshotRetentionLimit)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SnapshotWindow", resource.SnapshotWindow)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("CacheSecurityGroupNames", resource.CacheSecurityGroupNames)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("PreferredAvailabilityZones", resource.PreferredAvailabilityZones)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SnapshotArns", resource.SnapshotArns)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("VpcSecurityGroupIds", resource.VpcSecurityGroupIds)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type ElastiCacheParameterGroup struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElastiCacheParameterGroupProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElastiCacheParameterGroupProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElastiCacheParameterGroupProperties struct {
@@ -35,9 +39,10 @@ func ParseElastiCacheParameterGroup(name string, data string) (cf types.ValueMap
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElastiCacheParameterGroup - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,8 +58,12 @@ func (resource ElastiCacheParameterGroupProperties) Validate() []error {
 	if resource.CacheParameterGroupFamily == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CacheParameterGroupFamily'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CacheParameterGroupFamily", resource.CacheParameterGroupFamily)...)
 	if resource.Description == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Description'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Properties", resource.Properties)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type ElastiCacheReplicationGroup struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElastiCacheReplicationGroupProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElastiCacheReplicationGroupProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElastiCacheReplicationGroupProperties struct {
@@ -61,9 +66,10 @@ func ParseElastiCacheReplicationGroup(name string, data string) (cf types.ValueM
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElastiCacheReplicationGroup - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -76,8 +82,65 @@ func (resource ElastiCacheReplicationGroup) Validate() []error {
 
 func (resource ElastiCacheReplicationGroupProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AtRestEncryptionEnabled", resource.AtRestEncryptionEnabled)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AuthToken", resource.AuthToken)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AutoMinorVersionUpgrade", resource.AutoMinorVersionUpgrade)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AutomaticFailoverEnabled", resource.AutomaticFailoverEnabled)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CacheNodeType", resource.CacheNodeType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CacheParameterGroupName", resource.CacheParameterGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CacheSubnetGroupName", resource.CacheSubnetGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Engine", resource.Engine)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EngineVersion", resource.EngineVersion)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("NotificationTopicArn", resource.NotificationTopicArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("NumCacheClusters", resource.NumCacheClusters)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("NumNodeGroups", resource.NumNodeGroups)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Port", resource.Port)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PreferredMaintenanceWindow", resource.PreferredMaintenanceWindow)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PrimaryClusterId", resource.PrimaryClusterId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ReplicasPerNodeGroup", resource.ReplicasPerNodeGroup)...)
 	if resource.ReplicationGroupDescription == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ReplicationGroupDescription'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ReplicationGroupDescription", resource.ReplicationGroupDescription)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ReplicationGroupId", resource.ReplicationGroupId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SnapshotName", resource.SnapshotName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("SnapshotRetentionLimit", resource.SnapshotRetentionLimit)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SnapshotWindow", resource.SnapshotWindow)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SnapshottingClusterId", resource.SnapshottingClusterId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("TransitEncryptionEnabled", resource.TransitEncryptionEnabled)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("CacheSecurityGroupNames", resource.CacheSecurityGroupNames)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.ReplicationGroup_NodeGroupConfiguration{} }))("NodeGroupConfiguration", resource.NodeGroupConfiguration)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("PreferredCacheClusterAZs", resource.PreferredCacheClusterAZs)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroupIds", resource.SecurityGroupIds)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SnapshotArns", resource.SnapshotArns)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
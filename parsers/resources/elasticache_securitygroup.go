@@ -8,11 +8,15 @@ import (
 )
 
 type ElastiCacheSecurityGroup struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElastiCacheSecurityGroupProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElastiCacheSecurityGroupProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElastiCacheSecurityGroupProperties struct {
@@ -33,9 +37,10 @@ func ParseElastiCacheSecurityGroup(name string, data string) (cf types.ValueMap,
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElastiCacheSecurityGroup - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,5 +56,6 @@ func (resource ElastiCacheSecurityGroupProperties) Validate() []error {
 	if resource.Description == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Description'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	return errs
 }
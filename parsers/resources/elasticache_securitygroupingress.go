@@ -8,11 +8,15 @@ import (
 )
 
 type ElastiCacheSecurityGroupIngress struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElastiCacheSecurityGroupIngressProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElastiCacheSecurityGroupIngressProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElastiCacheSecurityGroupIngressProperties struct {
@@ -35,9 +39,10 @@ func ParseElastiCacheSecurityGroupIngress(name string, data string) (cf types.Va
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElastiCacheSecurityGroupIngress - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,8 +58,12 @@ func (resource ElastiCacheSecurityGroupIngressProperties) Validate() []error {
 	if resource.CacheSecurityGroupName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CacheSecurityGroupName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CacheSecurityGroupName", resource.CacheSecurityGroupName)...)
 	if resource.EC2SecurityGroupName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'EC2SecurityGroupName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("EC2SecurityGroupName", resource.EC2SecurityGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EC2SecurityGroupOwnerId", resource.EC2SecurityGroupOwnerId)...)
 	return errs
 }
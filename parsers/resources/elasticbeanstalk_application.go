@@ -8,11 +8,15 @@ import (
 )
 
 type ElasticBeanstalkApplication struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElasticBeanstalkApplicationProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElasticBeanstalkApplicationProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElasticBeanstalkApplicationProperties struct {
@@ -35,9 +39,10 @@ func ParseElasticBeanstalkApplication(name string, data string) (cf types.ValueM
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElasticBeanstalkApplication - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,5 +55,13 @@ func (resource ElasticBeanstalkApplication) Validate() []error {
 
 func (resource ElasticBeanstalkApplicationProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ApplicationName", resource.ApplicationName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	if resource.ResourceLifecycleConfig != nil {
+		errs = append(errs, types.PrefixErrors("ResourceLifecycleConfig", resource.ResourceLifecycleConfig.Validate())...)
+	}
 	return errs
 }
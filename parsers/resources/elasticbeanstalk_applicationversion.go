@@ -9,11 +9,15 @@ import (
 )
 
 type ElasticBeanstalkApplicationVersion struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElasticBeanstalkApplicationVersionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElasticBeanstalkApplicationVersionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElasticBeanstalkApplicationVersionProperties struct {
@@ -36,9 +40,10 @@ func ParseElasticBeanstalkApplicationVersion(name string, data string) (cf types
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElasticBeanstalkApplicationVersion - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,10 +59,14 @@ func (resource ElasticBeanstalkApplicationVersionProperties) Validate() []error
 	if resource.ApplicationName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ApplicationName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ApplicationName", resource.ApplicationName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.SourceBundle == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SourceBundle'"))
-	} else {
-		errs = append(errs, resource.SourceBundle.Validate()...)
+	}
+	if resource.SourceBundle != nil {
+		errs = append(errs, types.PrefixErrors("SourceBundle", resource.SourceBundle.Validate())...)
 	}
 	return errs
 }
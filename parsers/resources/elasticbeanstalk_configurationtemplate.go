@@ -9,11 +9,15 @@ import (
 )
 
 type ElasticBeanstalkConfigurationTemplate struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElasticBeanstalkConfigurationTemplateProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElasticBeanstalkConfigurationTemplateProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElasticBeanstalkConfigurationTemplateProperties struct {
@@ -40,9 +44,10 @@ func ParseElasticBeanstalkConfigurationTemplate(name string, data string) (cf ty
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElasticBeanstalkConfigurationTemplate - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -58,5 +63,20 @@ func (resource ElasticBeanstalkConfigurationTemplateProperties) Validate() []err
 	if resource.ApplicationName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ApplicationName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ApplicationName", resource.ApplicationName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EnvironmentId", resource.EnvironmentId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PlatformArn", resource.PlatformArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SolutionStackName", resource.SolutionStackName)...)
+	
+	if resource.SourceConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("SourceConfiguration", resource.SourceConfiguration.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.ConfigurationTemplate_ConfigurationOptionSetting{} }))("OptionSettings", resource.OptionSettings)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type ElasticBeanstalkEnvironment struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElasticBeanstalkEnvironmentProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElasticBeanstalkEnvironmentProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElasticBeanstalkEnvironmentProperties struct {
@@ -44,9 +48,10 @@ func ParseElasticBeanstalkEnvironment(name string, data string) (cf types.ValueM
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElasticBeanstalkEnvironment - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -62,5 +67,28 @@ func (resource ElasticBeanstalkEnvironmentProperties) Validate() []error {
 	if resource.ApplicationName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ApplicationName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ApplicationName", resource.ApplicationName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CNAMEPrefix", resource.CNAMEPrefix)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EnvironmentName", resource.EnvironmentName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PlatformArn", resource.PlatformArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SolutionStackName", resource.SolutionStackName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TemplateName", resource.TemplateName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("VersionLabel", resource.VersionLabel)...)
+	
+	if resource.Tier != nil {
+		errs = append(errs, types.PrefixErrors("Tier", resource.Tier.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Environment_OptionSetting{} }))("OptionSettings", resource.OptionSettings)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
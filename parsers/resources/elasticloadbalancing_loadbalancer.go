@@ -9,26 +9,30 @@ import (
 )
 
 type ElasticLoadBalancingLoadBalancer struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElasticLoadBalancingLoadBalancerProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElasticLoadBalancingLoadBalancerProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElasticLoadBalancingLoadBalancerProperties struct {
 	CrossZone interface{} `yaml:"CrossZone,omitempty"`
 	LoadBalancerName interface{} `yaml:"LoadBalancerName,omitempty"`
 	Scheme interface{} `yaml:"Scheme,omitempty"`
-	Tags interface{} `yaml:"Tags,omitempty"`
+	Policies interface{} `yaml:"Policies,omitempty"`
 	AppCookieStickinessPolicy interface{} `yaml:"AppCookieStickinessPolicy,omitempty"`
 	AvailabilityZones interface{} `yaml:"AvailabilityZones,omitempty"`
-	Subnets interface{} `yaml:"Subnets,omitempty"`
-	SecurityGroups interface{} `yaml:"SecurityGroups,omitempty"`
-	Policies interface{} `yaml:"Policies,omitempty"`
 	Instances interface{} `yaml:"Instances,omitempty"`
 	LBCookieStickinessPolicy interface{} `yaml:"LBCookieStickinessPolicy,omitempty"`
 	Listeners interface{} `yaml:"Listeners"`
+	SecurityGroups interface{} `yaml:"SecurityGroups,omitempty"`
+	Subnets interface{} `yaml:"Subnets,omitempty"`
+	Tags interface{} `yaml:"Tags,omitempty"`
 	HealthCheck *properties.LoadBalancer_HealthCheck `yaml:"HealthCheck,omitempty"`
 	ConnectionSettings *properties.LoadBalancer_ConnectionSettings `yaml:"ConnectionSettings,omitempty"`
 	ConnectionDrainingPolicy *properties.LoadBalancer_ConnectionDrainingPolicy `yaml:"ConnectionDrainingPolicy,omitempty"`
@@ -49,9 +53,10 @@ func ParseElasticLoadBalancingLoadBalancer(name string, data string) (cf types.V
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElasticLoadBalancingLoadBalancer - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -64,8 +69,47 @@ func (resource ElasticLoadBalancingLoadBalancer) Validate() []error {
 
 func (resource ElasticLoadBalancingLoadBalancerProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("CrossZone", resource.CrossZone)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("LoadBalancerName", resource.LoadBalancerName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Scheme", resource.Scheme)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.LoadBalancer_Policies{} }))("Policies", resource.Policies)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.LoadBalancer_AppCookieStickinessPolicy{} }))("AppCookieStickinessPolicy", resource.AppCookieStickinessPolicy)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AvailabilityZones", resource.AvailabilityZones)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Instances", resource.Instances)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.LoadBalancer_LBCookieStickinessPolicy{} }))("LBCookieStickinessPolicy", resource.LBCookieStickinessPolicy)...)
 	if resource.Listeners == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Listeners'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.LoadBalancer_Listeners{} }))("Listeners", resource.Listeners)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroups", resource.SecurityGroups)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Subnets", resource.Subnets)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	if resource.HealthCheck != nil {
+		errs = append(errs, types.PrefixErrors("HealthCheck", resource.HealthCheck.Validate())...)
+	}
+	
+	if resource.ConnectionSettings != nil {
+		errs = append(errs, types.PrefixErrors("ConnectionSettings", resource.ConnectionSettings.Validate())...)
+	}
+	
+	if resource.ConnectionDrainingPolicy != nil {
+		errs = append(errs, types.PrefixErrors("ConnectionDrainingPolicy", resource.ConnectionDrainingPolicy.Validate())...)
+	}
+	
+	if resource.AccessLoggingPolicy != nil {
+		errs = append(errs, types.PrefixErrors("AccessLoggingPolicy", resource.AccessLoggingPolicy.Validate())...)
+	}
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type ElasticLoadBalancingV2Listener struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElasticLoadBalancingV2ListenerProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElasticLoadBalancingV2ListenerProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElasticLoadBalancingV2ListenerProperties struct {
@@ -38,9 +43,10 @@ func ParseElasticLoadBalancingV2Listener(name string, data string) (cf types.Val
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElasticLoadBalancingV2Listener - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,14 +62,22 @@ func (resource ElasticLoadBalancingV2ListenerProperties) Validate() []error {
 	if resource.LoadBalancerArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'LoadBalancerArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("LoadBalancerArn", resource.LoadBalancerArn)...)
 	if resource.Port == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Port'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Port", resource.Port)...)
 	if resource.Protocol == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Protocol'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Protocol", resource.Protocol)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SslPolicy", resource.SslPolicy)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Listener_Certificate{} }))("Certificates", resource.Certificates)...)
 	if resource.DefaultActions == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DefaultActions'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Listener_Action{} }))("DefaultActions", resource.DefaultActions)...)
 	return errs
 }
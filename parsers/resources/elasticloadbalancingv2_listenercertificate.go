@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type ElasticLoadBalancingV2ListenerCertificate struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElasticLoadBalancingV2ListenerCertificateProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElasticLoadBalancingV2ListenerCertificateProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElasticLoadBalancingV2ListenerCertificateProperties struct {
@@ -34,9 +39,10 @@ func ParseElasticLoadBalancingV2ListenerCertificate(name string, data string) (c
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElasticLoadBalancingV2ListenerCertificate - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +58,10 @@ func (resource ElasticLoadBalancingV2ListenerCertificateProperties) Validate() [
 	if resource.ListenerArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ListenerArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ListenerArn", resource.ListenerArn)...)
 	if resource.Certificates == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Certificates'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.ListenerCertificate_Certificate{} }))("Certificates", resource.Certificates)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type ElasticLoadBalancingV2ListenerRule struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElasticLoadBalancingV2ListenerRuleProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElasticLoadBalancingV2ListenerRuleProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElasticLoadBalancingV2ListenerRuleProperties struct {
@@ -36,9 +41,10 @@ func ParseElasticLoadBalancingV2ListenerRule(name string, data string) (cf types
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElasticLoadBalancingV2ListenerRule - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,14 +60,18 @@ func (resource ElasticLoadBalancingV2ListenerRuleProperties) Validate() []error
 	if resource.ListenerArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ListenerArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ListenerArn", resource.ListenerArn)...)
 	if resource.Priority == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Priority'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Priority", resource.Priority)...)
 	if resource.Actions == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Actions'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.ListenerRule_Action{} }))("Actions", resource.Actions)...)
 	if resource.Conditions == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Conditions'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.ListenerRule_RuleCondition{} }))("Conditions", resource.Conditions)...)
 	return errs
 }
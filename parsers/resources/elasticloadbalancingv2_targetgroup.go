@@ -9,11 +9,15 @@ import (
 )
 
 type ElasticLoadBalancingV2TargetGroup struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElasticLoadBalancingV2TargetGroupProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElasticLoadBalancingV2TargetGroupProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElasticLoadBalancingV2TargetGroupProperties struct {
@@ -49,9 +53,10 @@ func ParseElasticLoadBalancingV2TargetGroup(name string, data string) (cf types.
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElasticLoadBalancingV2TargetGroup - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -64,14 +69,45 @@ func (resource ElasticLoadBalancingV2TargetGroup) Validate() []error {
 
 func (resource ElasticLoadBalancingV2TargetGroupProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("HealthCheckIntervalSeconds", resource.HealthCheckIntervalSeconds)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HealthCheckPath", resource.HealthCheckPath)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HealthCheckPort", resource.HealthCheckPort)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HealthCheckProtocol", resource.HealthCheckProtocol)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("HealthCheckTimeoutSeconds", resource.HealthCheckTimeoutSeconds)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("HealthyThresholdCount", resource.HealthyThresholdCount)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Port == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Port'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Port", resource.Port)...)
 	if resource.Protocol == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Protocol'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Protocol", resource.Protocol)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TargetType", resource.TargetType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("UnhealthyThresholdCount", resource.UnhealthyThresholdCount)...)
 	if resource.VpcId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'VpcId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("VpcId", resource.VpcId)...)
+	
+	if resource.Matcher != nil {
+		errs = append(errs, types.PrefixErrors("Matcher", resource.Matcher.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.TargetGroup_TargetGroupAttribute{} }))("TargetGroupAttributes", resource.TargetGroupAttributes)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.TargetGroup_TargetDescription{} }))("Targets", resource.Targets)...)
 	return errs
 }
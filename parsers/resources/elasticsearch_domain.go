@@ -8,11 +8,15 @@ import (
 )
 
 type ElasticsearchDomain struct {
-	Type       string                      `yaml:"Type"`
-	Properties ElasticsearchDomainProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ElasticsearchDomainProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ElasticsearchDomainProperties struct {
@@ -41,9 +45,10 @@ func ParseElasticsearchDomain(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ElasticsearchDomain - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,5 +61,31 @@ func (resource ElasticsearchDomain) Validate() []error {
 
 func (resource ElasticsearchDomainProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("AccessPolicies", resource.AccessPolicies)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DomainName", resource.DomainName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ElasticsearchVersion", resource.ElasticsearchVersion)...)
+	
+	if resource.VPCOptions != nil {
+		errs = append(errs, types.PrefixErrors("VPCOptions", resource.VPCOptions.Validate())...)
+	}
+	
+	if resource.SnapshotOptions != nil {
+		errs = append(errs, types.PrefixErrors("SnapshotOptions", resource.SnapshotOptions.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("AdvancedOptions", resource.AdvancedOptions)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	if resource.ElasticsearchClusterConfig != nil {
+		errs = append(errs, types.PrefixErrors("ElasticsearchClusterConfig", resource.ElasticsearchClusterConfig.Validate())...)
+	}
+	
+	if resource.EBSOptions != nil {
+		errs = append(errs, types.PrefixErrors("EBSOptions", resource.EBSOptions.Validate())...)
+	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type EMRCluster struct {
-	Type       string                      `yaml:"Type"`
-	Properties EMRClusterProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EMRClusterProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EMRClusterProperties struct {
@@ -50,9 +54,10 @@ func ParseEMRCluster(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EMRCluster - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -65,19 +70,49 @@ func (resource EMRCluster) Validate() []error {
 
 func (resource EMRClusterProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("AdditionalInfo", resource.AdditionalInfo)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AutoScalingRole", resource.AutoScalingRole)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CustomAmiId", resource.CustomAmiId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("EbsRootVolumeSize", resource.EbsRootVolumeSize)...)
 	if resource.JobFlowRole == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'JobFlowRole'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("JobFlowRole", resource.JobFlowRole)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("LogUri", resource.LogUri)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ReleaseLabel", resource.ReleaseLabel)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ScaleDownBehavior", resource.ScaleDownBehavior)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SecurityConfiguration", resource.SecurityConfiguration)...)
 	if resource.ServiceRole == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ServiceRole'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceRole", resource.ServiceRole)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("VisibleToAllUsers", resource.VisibleToAllUsers)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Cluster_Application{} }))("Applications", resource.Applications)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Cluster_BootstrapActionConfig{} }))("BootstrapActions", resource.BootstrapActions)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Cluster_Configuration{} }))("Configurations", resource.Configurations)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	if resource.Instances == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Instances'"))
-	} else {
-		errs = append(errs, resource.Instances.Validate()...)
+	}
+	if resource.Instances != nil {
+		errs = append(errs, types.PrefixErrors("Instances", resource.Instances.Validate())...)
 	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type EMRInstanceFleetConfig struct {
-	Type       string                      `yaml:"Type"`
-	Properties EMRInstanceFleetConfigProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EMRInstanceFleetConfigProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EMRInstanceFleetConfigProperties struct {
@@ -40,9 +44,10 @@ func ParseEMRInstanceFleetConfig(name string, data string) (cf types.ValueMap, e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EMRInstanceFleetConfig - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -58,8 +63,22 @@ func (resource EMRInstanceFleetConfigProperties) Validate() []error {
 	if resource.ClusterId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ClusterId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ClusterId", resource.ClusterId)...)
 	if resource.InstanceFleetType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceFleetType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceFleetType", resource.InstanceFleetType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("TargetOnDemandCapacity", resource.TargetOnDemandCapacity)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("TargetSpotCapacity", resource.TargetSpotCapacity)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.InstanceFleetConfig_InstanceTypeConfig{} }))("InstanceTypeConfigs", resource.InstanceTypeConfigs)...)
+	
+	if resource.LaunchSpecifications != nil {
+		errs = append(errs, types.PrefixErrors("LaunchSpecifications", resource.LaunchSpecifications.Validate())...)
+	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type EMRInstanceGroupConfig struct {
-	Type       string                      `yaml:"Type"`
-	Properties EMRInstanceGroupConfigProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EMRInstanceGroupConfigProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EMRInstanceGroupConfigProperties struct {
@@ -43,9 +47,10 @@ func ParseEMRInstanceGroupConfig(name string, data string) (cf types.ValueMap, e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EMRInstanceGroupConfig - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -58,17 +63,37 @@ func (resource EMRInstanceGroupConfig) Validate() []error {
 
 func (resource EMRInstanceGroupConfigProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("BidPrice", resource.BidPrice)...)
 	if resource.InstanceCount == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceCount'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("InstanceCount", resource.InstanceCount)...)
 	if resource.InstanceRole == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceRole'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceRole", resource.InstanceRole)...)
 	if resource.InstanceType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceType", resource.InstanceType)...)
 	if resource.JobFlowId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'JobFlowId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("JobFlowId", resource.JobFlowId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Market", resource.Market)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.InstanceGroupConfig_Configuration{} }))("Configurations", resource.Configurations)...)
+	
+	if resource.EbsConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("EbsConfiguration", resource.EbsConfiguration.Validate())...)
+	}
+	
+	if resource.AutoScalingPolicy != nil {
+		errs = append(errs, types.PrefixErrors("AutoScalingPolicy", resource.AutoScalingPolicy.Validate())...)
+	}
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type EMRSecurityConfiguration struct {
-	Type       string                      `yaml:"Type"`
-	Properties EMRSecurityConfigurationProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EMRSecurityConfigurationProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EMRSecurityConfigurationProperties struct {
@@ -34,9 +38,10 @@ func ParseEMRSecurityConfiguration(name string, data string) (cf types.ValueMap,
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EMRSecurityConfiguration - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -49,8 +54,11 @@ func (resource EMRSecurityConfiguration) Validate() []error {
 
 func (resource EMRSecurityConfigurationProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.SecurityConfiguration == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SecurityConfiguration'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Json")("SecurityConfiguration", resource.SecurityConfiguration)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type EMRStep struct {
-	Type       string                      `yaml:"Type"`
-	Properties EMRStepProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          EMRStepProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type EMRStepProperties struct {
@@ -37,9 +41,10 @@ func ParseEMRStep(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: EMRStep - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -55,16 +60,20 @@ func (resource EMRStepProperties) Validate() []error {
 	if resource.ActionOnFailure == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ActionOnFailure'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ActionOnFailure", resource.ActionOnFailure)...)
 	if resource.JobFlowId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'JobFlowId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("JobFlowId", resource.JobFlowId)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.HadoopJarStep == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'HadoopJarStep'"))
-	} else {
-		errs = append(errs, resource.HadoopJarStep.Validate()...)
+	}
+	if resource.HadoopJarStep != nil {
+		errs = append(errs, types.PrefixErrors("HadoopJarStep", resource.HadoopJarStep.Validate())...)
 	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type GameLiftAlias struct {
-	Type       string                      `yaml:"Type"`
-	Properties GameLiftAliasProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GameLiftAliasProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GameLiftAliasProperties struct {
@@ -36,9 +40,10 @@ func ParseGameLiftAlias(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GameLiftAlias - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,13 +56,17 @@ func (resource GameLiftAlias) Validate() []error {
 
 func (resource GameLiftAliasProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.RoutingStrategy == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoutingStrategy'"))
-	} else {
-		errs = append(errs, resource.RoutingStrategy.Validate()...)
+	}
+	if resource.RoutingStrategy != nil {
+		errs = append(errs, types.PrefixErrors("RoutingStrategy", resource.RoutingStrategy.Validate())...)
 	}
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type GameLiftFleet struct {
-	Type       string                      `yaml:"Type"`
-	Properties GameLiftFleetProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GameLiftFleetProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GameLiftFleetProperties struct {
@@ -43,9 +48,10 @@ func ParseGameLiftFleet(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GameLiftFleet - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -61,17 +67,34 @@ func (resource GameLiftFleetProperties) Validate() []error {
 	if resource.BuildId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BuildId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("BuildId", resource.BuildId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.DesiredEC2Instances == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DesiredEC2Instances'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("DesiredEC2Instances", resource.DesiredEC2Instances)...)
 	if resource.EC2InstanceType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'EC2InstanceType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("EC2InstanceType", resource.EC2InstanceType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MaxSize", resource.MaxSize)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MinSize", resource.MinSize)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ServerLaunchParameters", resource.ServerLaunchParameters)...)
 	if resource.ServerLaunchPath == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ServerLaunchPath'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ServerLaunchPath", resource.ServerLaunchPath)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Fleet_IpPermission{} }))("EC2InboundPermissions", resource.EC2InboundPermissions)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("LogPaths", resource.LogPaths)...)
 	return errs
 }
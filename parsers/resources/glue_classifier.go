@@ -8,11 +8,15 @@ import (
 )
 
 type GlueClassifier struct {
-	Type       string                      `yaml:"Type"`
-	Properties GlueClassifierProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GlueClassifierProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GlueClassifierProperties struct {
@@ -33,9 +37,10 @@ func ParseGlueClassifier(name string, data string) (cf types.ValueMap, err error
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GlueClassifier - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -48,5 +53,9 @@ func (resource GlueClassifier) Validate() []error {
 
 func (resource GlueClassifierProperties) Validate() []error {
 	errs := []error{}
+	
+	if resource.GrokClassifier != nil {
+		errs = append(errs, types.PrefixErrors("GrokClassifier", resource.GrokClassifier.Validate())...)
+	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type GlueConnection struct {
-	Type       string                      `yaml:"Type"`
-	Properties GlueConnectionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GlueConnectionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GlueConnectionProperties struct {
@@ -35,9 +39,10 @@ func ParseGlueConnection(name string, data string) (cf types.ValueMap, err error
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GlueConnection - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,10 +58,12 @@ func (resource GlueConnectionProperties) Validate() []error {
 	if resource.CatalogId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CatalogId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CatalogId", resource.CatalogId)...)
 	if resource.ConnectionInput == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ConnectionInput'"))
-	} else {
-		errs = append(errs, resource.ConnectionInput.Validate()...)
+	}
+	if resource.ConnectionInput != nil {
+		errs = append(errs, types.PrefixErrors("ConnectionInput", resource.ConnectionInput.Validate())...)
 	}
 	return errs
 }
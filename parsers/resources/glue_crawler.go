@@ -9,11 +9,15 @@ import (
 )
 
 type GlueCrawler struct {
-	Type       string                      `yaml:"Type"`
-	Properties GlueCrawlerProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GlueCrawlerProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GlueCrawlerProperties struct {
@@ -42,9 +46,10 @@ func ParseGlueCrawler(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GlueCrawler - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -60,13 +65,32 @@ func (resource GlueCrawlerProperties) Validate() []error {
 	if resource.DatabaseName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DatabaseName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DatabaseName", resource.DatabaseName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Role == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Role'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Role", resource.Role)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TablePrefix", resource.TablePrefix)...)
 	if resource.Targets == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Targets'"))
-	} else {
-		errs = append(errs, resource.Targets.Validate()...)
 	}
+	if resource.Targets != nil {
+		errs = append(errs, types.PrefixErrors("Targets", resource.Targets.Validate())...)
+	}
+	
+	if resource.SchemaChangePolicy != nil {
+		errs = append(errs, types.PrefixErrors("SchemaChangePolicy", resource.SchemaChangePolicy.Validate())...)
+	}
+	
+	if resource.Schedule != nil {
+		errs = append(errs, types.PrefixErrors("Schedule", resource.Schedule.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Classifiers", resource.Classifiers)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type GlueDatabase struct {
-	Type       string                      `yaml:"Type"`
-	Properties GlueDatabaseProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GlueDatabaseProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GlueDatabaseProperties struct {
@@ -35,9 +39,10 @@ func ParseGlueDatabase(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GlueDatabase - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,10 +58,12 @@ func (resource GlueDatabaseProperties) Validate() []error {
 	if resource.CatalogId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CatalogId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CatalogId", resource.CatalogId)...)
 	if resource.DatabaseInput == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DatabaseInput'"))
-	} else {
-		errs = append(errs, resource.DatabaseInput.Validate()...)
+	}
+	if resource.DatabaseInput != nil {
+		errs = append(errs, types.PrefixErrors("DatabaseInput", resource.DatabaseInput.Validate())...)
 	}
 	return errs
 }
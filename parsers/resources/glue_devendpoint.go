@@ -8,11 +8,15 @@ import (
 )
 
 type GlueDevEndpoint struct {
-	Type       string                      `yaml:"Type"`
-	Properties GlueDevEndpointProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GlueDevEndpointProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GlueDevEndpointProperties struct {
@@ -40,9 +44,10 @@ func ParseGlueDevEndpoint(name string, data string) (cf types.ValueMap, err erro
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GlueDevEndpoint - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -55,11 +60,25 @@ func (resource GlueDevEndpoint) Validate() []error {
 
 func (resource GlueDevEndpointProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EndpointName", resource.EndpointName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ExtraJarsS3Path", resource.ExtraJarsS3Path)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ExtraPythonLibsS3Path", resource.ExtraPythonLibsS3Path)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("NumberOfNodes", resource.NumberOfNodes)...)
 	if resource.PublicKey == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PublicKey'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PublicKey", resource.PublicKey)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SecurityGroupIds", resource.SecurityGroupIds)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type GlueJob struct {
-	Type       string                      `yaml:"Type"`
-	Properties GlueJobProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GlueJobProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GlueJobProperties struct {
@@ -43,9 +47,10 @@ func ParseGlueJob(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GlueJob - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -58,13 +63,35 @@ func (resource GlueJob) Validate() []error {
 
 func (resource GlueJobProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Double")("AllocatedCapacity", resource.AllocatedCapacity)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("DefaultArguments", resource.DefaultArguments)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("LogUri", resource.LogUri)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Double")("MaxRetries", resource.MaxRetries)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Role == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Role'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Role", resource.Role)...)
 	if resource.Command == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Command'"))
-	} else {
-		errs = append(errs, resource.Command.Validate()...)
+	}
+	if resource.Command != nil {
+		errs = append(errs, types.PrefixErrors("Command", resource.Command.Validate())...)
+	}
+	
+	if resource.ExecutionProperty != nil {
+		errs = append(errs, types.PrefixErrors("ExecutionProperty", resource.ExecutionProperty.Validate())...)
+	}
+	
+	if resource.Connections != nil {
+		errs = append(errs, types.PrefixErrors("Connections", resource.Connections.Validate())...)
 	}
 	return errs
 }
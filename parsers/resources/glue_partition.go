@@ -9,11 +9,15 @@ import (
 )
 
 type GluePartition struct {
-	Type       string                      `yaml:"Type"`
-	Properties GluePartitionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GluePartitionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GluePartitionProperties struct {
@@ -37,9 +41,10 @@ func ParseGluePartition(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GluePartition - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -55,16 +60,20 @@ func (resource GluePartitionProperties) Validate() []error {
 	if resource.CatalogId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CatalogId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CatalogId", resource.CatalogId)...)
 	if resource.DatabaseName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DatabaseName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DatabaseName", resource.DatabaseName)...)
 	if resource.TableName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TableName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TableName", resource.TableName)...)
 	if resource.PartitionInput == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PartitionInput'"))
-	} else {
-		errs = append(errs, resource.PartitionInput.Validate()...)
+	}
+	if resource.PartitionInput != nil {
+		errs = append(errs, types.PrefixErrors("PartitionInput", resource.PartitionInput.Validate())...)
 	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type GlueTable struct {
-	Type       string                      `yaml:"Type"`
-	Properties GlueTableProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GlueTableProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GlueTableProperties struct {
@@ -36,9 +40,10 @@ func ParseGlueTable(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GlueTable - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,13 +59,16 @@ func (resource GlueTableProperties) Validate() []error {
 	if resource.CatalogId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CatalogId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CatalogId", resource.CatalogId)...)
 	if resource.DatabaseName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DatabaseName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DatabaseName", resource.DatabaseName)...)
 	if resource.TableInput == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TableInput'"))
-	} else {
-		errs = append(errs, resource.TableInput.Validate()...)
+	}
+	if resource.TableInput != nil {
+		errs = append(errs, types.PrefixErrors("TableInput", resource.TableInput.Validate())...)
 	}
 	return errs
 }
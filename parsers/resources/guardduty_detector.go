@@ -8,11 +8,15 @@ import (
 )
 
 type GuardDutyDetector struct {
-	Type       string                      `yaml:"Type"`
-	Properties GuardDutyDetectorProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GuardDutyDetectorProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GuardDutyDetectorProperties struct {
@@ -33,9 +37,10 @@ func ParseGuardDutyDetector(name string, data string) (cf types.ValueMap, err er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GuardDutyDetector - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,5 +56,6 @@ func (resource GuardDutyDetectorProperties) Validate() []error {
 	if resource.Enable == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Enable'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enable", resource.Enable)...)
 	return errs
 }
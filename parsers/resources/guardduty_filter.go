@@ -9,11 +9,15 @@ import (
 )
 
 type GuardDutyFilter struct {
-	Type       string                      `yaml:"Type"`
-	Properties GuardDutyFilterProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GuardDutyFilterProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GuardDutyFilterProperties struct {
@@ -39,9 +43,10 @@ func ParseGuardDutyFilter(name string, data string) (cf types.ValueMap, err erro
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GuardDutyFilter - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -57,19 +62,26 @@ func (resource GuardDutyFilterProperties) Validate() []error {
 	if resource.Action == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Action'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Action", resource.Action)...)
 	if resource.Description == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Description'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.DetectorId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DetectorId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DetectorId", resource.DetectorId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Rank == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Rank'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Rank", resource.Rank)...)
 	if resource.FindingCriteria == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FindingCriteria'"))
-	} else {
-		errs = append(errs, resource.FindingCriteria.Validate()...)
+	}
+	if resource.FindingCriteria != nil {
+		errs = append(errs, types.PrefixErrors("FindingCriteria", resource.FindingCriteria.Validate())...)
 	}
 	return errs
 }
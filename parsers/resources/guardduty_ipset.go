@@ -8,11 +8,15 @@ import (
 )
 
 type GuardDutyIPSet struct {
-	Type       string                      `yaml:"Type"`
-	Properties GuardDutyIPSetProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GuardDutyIPSetProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GuardDutyIPSetProperties struct {
@@ -37,9 +41,10 @@ func ParseGuardDutyIPSet(name string, data string) (cf types.ValueMap, err error
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GuardDutyIPSet - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -55,14 +60,20 @@ func (resource GuardDutyIPSetProperties) Validate() []error {
 	if resource.Activate == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Activate'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Activate", resource.Activate)...)
 	if resource.DetectorId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DetectorId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DetectorId", resource.DetectorId)...)
 	if resource.Format == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Format'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Format", resource.Format)...)
 	if resource.Location == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Location'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Location", resource.Location)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type GuardDutyMaster struct {
-	Type       string                      `yaml:"Type"`
-	Properties GuardDutyMasterProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GuardDutyMasterProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GuardDutyMasterProperties struct {
@@ -35,9 +39,10 @@ func ParseGuardDutyMaster(name string, data string) (cf types.ValueMap, err erro
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GuardDutyMaster - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,11 +58,14 @@ func (resource GuardDutyMasterProperties) Validate() []error {
 	if resource.DetectorId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DetectorId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DetectorId", resource.DetectorId)...)
 	if resource.InvitationId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InvitationId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InvitationId", resource.InvitationId)...)
 	if resource.MasterId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MasterId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MasterId", resource.MasterId)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type GuardDutyMember struct {
-	Type       string                      `yaml:"Type"`
-	Properties GuardDutyMemberProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          GuardDutyMemberProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type GuardDutyMemberProperties struct {
@@ -38,9 +42,10 @@ func ParseGuardDutyMember(name string, data string) (cf types.ValueMap, err erro
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: GuardDutyMember - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,11 +61,20 @@ func (resource GuardDutyMemberProperties) Validate() []error {
 	if resource.DetectorId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DetectorId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DetectorId", resource.DetectorId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("DisableEmailNotification", resource.DisableEmailNotification)...)
 	if resource.Email == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Email'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Email", resource.Email)...)
 	if resource.MemberId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MemberId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MemberId", resource.MemberId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Message", resource.Message)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Status", resource.Status)...)
 	return errs
 }
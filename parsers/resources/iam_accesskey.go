@@ -8,11 +8,15 @@ import (
 )
 
 type IAMAccessKey struct {
-	Type       string                      `yaml:"Type"`
-	Properties IAMAccessKeyProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          IAMAccessKeyProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type IAMAccessKeyProperties struct {
@@ -35,9 +39,10 @@ func ParseIAMAccessKey(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: IAMAccessKey - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,8 +55,13 @@ func (resource IAMAccessKey) Validate() []error {
 
 func (resource IAMAccessKeyProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Serial", resource.Serial)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Status", resource.Status)...)
 	if resource.UserName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'UserName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("UserName", resource.UserName)...)
 	return errs
 }
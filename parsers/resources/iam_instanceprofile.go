@@ -8,11 +8,15 @@ import (
 )
 
 type IAMInstanceProfile struct {
-	Type       string                      `yaml:"Type"`
-	Properties IAMInstanceProfileProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          IAMInstanceProfileProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type IAMInstanceProfileProperties struct {
@@ -35,9 +39,10 @@ func ParseIAMInstanceProfile(name string, data string) (cf types.ValueMap, err e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: IAMInstanceProfile - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,8 +55,13 @@ func (resource IAMInstanceProfile) Validate() []error {
 
 func (resource IAMInstanceProfileProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceProfileName", resource.InstanceProfileName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Path", resource.Path)...)
 	if resource.Roles == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Roles'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Roles", resource.Roles)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type IAMManagedPolicy struct {
-	Type       string                      `yaml:"Type"`
-	Properties IAMManagedPolicyProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          IAMManagedPolicyProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type IAMManagedPolicyProperties struct {
@@ -39,9 +43,10 @@ func ParseIAMManagedPolicy(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: IAMManagedPolicy - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,8 +59,21 @@ func (resource IAMManagedPolicy) Validate() []error {
 
 func (resource IAMManagedPolicyProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ManagedPolicyName", resource.ManagedPolicyName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Path", resource.Path)...)
 	if resource.PolicyDocument == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PolicyDocument'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Json")("PolicyDocument", resource.PolicyDocument)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Groups", resource.Groups)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Roles", resource.Roles)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Users", resource.Users)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type IAMPolicy struct {
-	Type       string                      `yaml:"Type"`
-	Properties IAMPolicyProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          IAMPolicyProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type IAMPolicyProperties struct {
@@ -37,9 +41,10 @@ func ParseIAMPolicy(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: IAMPolicy - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -55,8 +60,16 @@ func (resource IAMPolicyProperties) Validate() []error {
 	if resource.PolicyDocument == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PolicyDocument'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Json")("PolicyDocument", resource.PolicyDocument)...)
 	if resource.PolicyName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'PolicyName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("PolicyName", resource.PolicyName)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Groups", resource.Groups)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Roles", resource.Roles)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Users", resource.Users)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type IAMRole struct {
-	Type       string                      `yaml:"Type"`
-	Properties IAMRoleProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          IAMRoleProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type IAMRoleProperties struct {
@@ -37,9 +42,10 @@ func ParseIAMRole(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: IAMRole - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -55,5 +61,14 @@ func (resource IAMRoleProperties) Validate() []error {
 	if resource.AssumeRolePolicyDocument == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AssumeRolePolicyDocument'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Json")("AssumeRolePolicyDocument", resource.AssumeRolePolicyDocument)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Path", resource.Path)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RoleName", resource.RoleName)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ManagedPolicyArns", resource.ManagedPolicyArns)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Role_Policy{} }))("Policies", resource.Policies)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type IAMUser struct {
-	Type       string                      `yaml:"Type"`
-	Properties IAMUserProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          IAMUserProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type IAMUserProperties struct {
@@ -38,9 +42,10 @@ func ParseIAMUser(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: IAMUser - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,5 +58,19 @@ func (resource IAMUser) Validate() []error {
 
 func (resource IAMUserProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Path", resource.Path)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("UserName", resource.UserName)...)
+	
+	if resource.LoginProfile != nil {
+		errs = append(errs, types.PrefixErrors("LoginProfile", resource.LoginProfile.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Groups", resource.Groups)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ManagedPolicyArns", resource.ManagedPolicyArns)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.User_Policy{} }))("Policies", resource.Policies)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type IAMUserToGroupAddition struct {
-	Type       string                      `yaml:"Type"`
-	Properties IAMUserToGroupAdditionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          IAMUserToGroupAdditionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type IAMUserToGroupAdditionProperties struct {
@@ -34,9 +38,10 @@ func ParseIAMUserToGroupAddition(name string, data string) (cf types.ValueMap, e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: IAMUserToGroupAddition - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,10 @@ func (resource IAMUserToGroupAdditionProperties) Validate() []error {
 	if resource.GroupName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'GroupName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("GroupName", resource.GroupName)...)
 	if resource.Users == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Users'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Users", resource.Users)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type InspectorAssessmentTarget struct {
-	Type       string                      `yaml:"Type"`
-	Properties InspectorAssessmentTargetProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          InspectorAssessmentTargetProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type InspectorAssessmentTargetProperties struct {
@@ -34,9 +38,10 @@ func ParseInspectorAssessmentTarget(name string, data string) (cf types.ValueMap
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: InspectorAssessmentTarget - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -49,8 +54,11 @@ func (resource InspectorAssessmentTarget) Validate() []error {
 
 func (resource InspectorAssessmentTargetProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AssessmentTargetName", resource.AssessmentTargetName)...)
 	if resource.ResourceGroupArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ResourceGroupArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ResourceGroupArn", resource.ResourceGroupArn)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type InspectorAssessmentTemplate struct {
-	Type       string                      `yaml:"Type"`
-	Properties InspectorAssessmentTemplateProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          InspectorAssessmentTemplateProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type InspectorAssessmentTemplateProperties struct {
@@ -37,9 +42,10 @@ func ParseInspectorAssessmentTemplate(name string, data string) (cf types.ValueM
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: InspectorAssessmentTemplate - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -55,11 +61,18 @@ func (resource InspectorAssessmentTemplateProperties) Validate() []error {
 	if resource.AssessmentTargetArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AssessmentTargetArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AssessmentTargetArn", resource.AssessmentTargetArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AssessmentTemplateName", resource.AssessmentTemplateName)...)
 	if resource.DurationInSeconds == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DurationInSeconds'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("DurationInSeconds", resource.DurationInSeconds)...)
 	if resource.RulesPackageArns == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RulesPackageArns'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("RulesPackageArns", resource.RulesPackageArns)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("UserAttributesForFindings", resource.UserAttributesForFindings)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type IoTCertificate struct {
-	Type       string                      `yaml:"Type"`
-	Properties IoTCertificateProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          IoTCertificateProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type IoTCertificateProperties struct {
@@ -34,9 +38,10 @@ func ParseIoTCertificate(name string, data string) (cf types.ValueMap, err error
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: IoTCertificate - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,10 @@ func (resource IoTCertificateProperties) Validate() []error {
 	if resource.CertificateSigningRequest == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'CertificateSigningRequest'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("CertificateSigningRequest", resource.CertificateSigningRequest)...)
 	if resource.Status == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Status'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Status", resource.Status)...)
 	return errs
 }
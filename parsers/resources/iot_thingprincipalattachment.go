@@ -8,11 +8,15 @@ import (
 )
 
 type IoTThingPrincipalAttachment struct {
-	Type       string                      `yaml:"Type"`
-	Properties IoTThingPrincipalAttachmentProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          IoTThingPrincipalAttachmentProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type IoTThingPrincipalAttachmentProperties struct {
@@ -34,9 +38,10 @@ func ParseIoTThingPrincipalAttachment(name string, data string) (cf types.ValueM
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: IoTThingPrincipalAttachment - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,10 @@ func (resource IoTThingPrincipalAttachmentProperties) Validate() []error {
 	if resource.Principal == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Principal'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Principal", resource.Principal)...)
 	if resource.ThingName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ThingName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ThingName", resource.ThingName)...)
 	return errs
 }
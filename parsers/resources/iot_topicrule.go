@@ -9,11 +9,15 @@ import (
 )
 
 type IoTTopicRule struct {
-	Type       string                      `yaml:"Type"`
-	Properties IoTTopicRuleProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          IoTTopicRuleProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type IoTTopicRuleProperties struct {
@@ -35,9 +39,10 @@ func ParseIoTTopicRule(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: IoTTopicRule - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,10 +55,13 @@ func (resource IoTTopicRule) Validate() []error {
 
 func (resource IoTTopicRuleProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RuleName", resource.RuleName)...)
 	if resource.TopicRulePayload == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TopicRulePayload'"))
-	} else {
-		errs = append(errs, resource.TopicRulePayload.Validate()...)
+	}
+	if resource.TopicRulePayload != nil {
+		errs = append(errs, types.PrefixErrors("TopicRulePayload", resource.TopicRulePayload.Validate())...)
 	}
 	return errs
 }
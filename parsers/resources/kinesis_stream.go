@@ -9,11 +9,15 @@ import (
 )
 
 type KinesisStream struct {
-	Type       string                      `yaml:"Type"`
-	Properties KinesisStreamProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          KinesisStreamProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type KinesisStreamProperties struct {
@@ -38,9 +42,10 @@ func ParseKinesisStream(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: KinesisStream - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,8 +58,19 @@ func (resource KinesisStream) Validate() []error {
 
 func (resource KinesisStreamProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("RetentionPeriodHours", resource.RetentionPeriodHours)...)
 	if resource.ShardCount == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ShardCount'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("ShardCount", resource.ShardCount)...)
+	
+	if resource.StreamEncryption != nil {
+		errs = append(errs, types.PrefixErrors("StreamEncryption", resource.StreamEncryption.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
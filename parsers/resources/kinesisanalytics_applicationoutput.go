@@ -9,11 +9,15 @@ import (
 )
 
 type KinesisAnalyticsApplicationOutput struct {
-	Type       string                      `yaml:"Type"`
-	Properties KinesisAnalyticsApplicationOutputProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          KinesisAnalyticsApplicationOutputProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type KinesisAnalyticsApplicationOutputProperties struct {
@@ -35,9 +39,10 @@ func ParseKinesisAnalyticsApplicationOutput(name string, data string) (cf types.
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: KinesisAnalyticsApplicationOutput - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,10 +58,12 @@ func (resource KinesisAnalyticsApplicationOutputProperties) Validate() []error {
 	if resource.ApplicationName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ApplicationName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ApplicationName", resource.ApplicationName)...)
 	if resource.Output == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Output'"))
-	} else {
-		errs = append(errs, resource.Output.Validate()...)
+	}
+	if resource.Output != nil {
+		errs = append(errs, types.PrefixErrors("Output", resource.Output.Validate())...)
 	}
 	return errs
 }
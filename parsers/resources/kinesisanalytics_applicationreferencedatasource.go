@@ -9,11 +9,15 @@ import (
 )
 
 type KinesisAnalyticsApplicationReferenceDataSource struct {
-	Type       string                      `yaml:"Type"`
-	Properties KinesisAnalyticsApplicationReferenceDataSourceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          KinesisAnalyticsApplicationReferenceDataSourceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type KinesisAnalyticsApplicationReferenceDataSourceProperties struct {
@@ -35,9 +39,10 @@ func ParseKinesisAnalyticsApplicationReferenceDataSource(name string, data strin
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: KinesisAnalyticsApplicationReferenceDataSource - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,10 +58,12 @@ func (resource KinesisAnalyticsApplicationReferenceDataSourceProperties) Validat
 	if resource.ApplicationName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ApplicationName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ApplicationName", resource.ApplicationName)...)
 	if resource.ReferenceDataSource == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ReferenceDataSource'"))
-	} else {
-		errs = append(errs, resource.ReferenceDataSource.Validate()...)
+	}
+	if resource.ReferenceDataSource != nil {
+		errs = append(errs, types.PrefixErrors("ReferenceDataSource", resource.ReferenceDataSource.Validate())...)
 	}
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type KinesisFirehoseDeliveryStream struct {
-	Type       string                      `yaml:"Type"`
-	Properties KinesisFirehoseDeliveryStreamProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          KinesisFirehoseDeliveryStreamProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type KinesisFirehoseDeliveryStreamProperties struct {
@@ -39,9 +43,10 @@ func ParseKinesisFirehoseDeliveryStream(name string, data string) (cf types.Valu
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: KinesisFirehoseDeliveryStream - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,5 +59,29 @@ func (resource KinesisFirehoseDeliveryStream) Validate() []error {
 
 func (resource KinesisFirehoseDeliveryStreamProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DeliveryStreamName", resource.DeliveryStreamName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DeliveryStreamType", resource.DeliveryStreamType)...)
+	
+	if resource.S3DestinationConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("S3DestinationConfiguration", resource.S3DestinationConfiguration.Validate())...)
+	}
+	
+	if resource.RedshiftDestinationConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("RedshiftDestinationConfiguration", resource.RedshiftDestinationConfiguration.Validate())...)
+	}
+	
+	if resource.KinesisStreamSourceConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("KinesisStreamSourceConfiguration", resource.KinesisStreamSourceConfiguration.Validate())...)
+	}
+	
+	if resource.ExtendedS3DestinationConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("ExtendedS3DestinationConfiguration", resource.ExtendedS3DestinationConfiguration.Validate())...)
+	}
+	
+	if resource.ElasticsearchDestinationConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("ElasticsearchDestinationConfiguration", resource.ElasticsearchDestinationConfiguration.Validate())...)
+	}
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type KMSAlias struct {
-	Type       string                      `yaml:"Type"`
-	Properties KMSAliasProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          KMSAliasProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type KMSAliasProperties struct {
@@ -34,9 +38,10 @@ func ParseKMSAlias(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: KMSAlias - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,10 @@ func (resource KMSAliasProperties) Validate() []error {
 	if resource.AliasName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AliasName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("AliasName", resource.AliasName)...)
 	if resource.TargetKeyId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TargetKeyId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TargetKeyId", resource.TargetKeyId)...)
 	return errs
 }
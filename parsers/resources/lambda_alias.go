@@ -9,11 +9,15 @@ import (
 )
 
 type LambdaAlias struct {
-	Type       string                      `yaml:"Type"`
-	Properties LambdaAliasProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          LambdaAliasProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type LambdaAliasProperties struct {
@@ -38,9 +42,10 @@ func ParseLambdaAlias(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: LambdaAlias - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,14 +58,23 @@ func (resource LambdaAlias) Validate() []error {
 
 func (resource LambdaAliasProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.FunctionName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FunctionName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("FunctionName", resource.FunctionName)...)
 	if resource.FunctionVersion == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FunctionVersion'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("FunctionVersion", resource.FunctionVersion)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	if resource.RoutingConfig != nil {
+		errs = append(errs, types.PrefixErrors("RoutingConfig", resource.RoutingConfig.Validate())...)
+	}
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type LambdaEventSourceMapping struct {
-	Type       string                      `yaml:"Type"`
-	Properties LambdaEventSourceMappingProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          LambdaEventSourceMappingProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type LambdaEventSourceMappingProperties struct {
@@ -37,9 +41,10 @@ func ParseLambdaEventSourceMapping(name string, data string) (cf types.ValueMap,
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: LambdaEventSourceMapping - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,14 +57,21 @@ func (resource LambdaEventSourceMapping) Validate() []error {
 
 func (resource LambdaEventSourceMappingProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("BatchSize", resource.BatchSize)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	if resource.EventSourceArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'EventSourceArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("EventSourceArn", resource.EventSourceArn)...)
 	if resource.FunctionName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FunctionName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("FunctionName", resource.FunctionName)...)
 	if resource.StartingPosition == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StartingPosition'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StartingPosition", resource.StartingPosition)...)
 	return errs
 }
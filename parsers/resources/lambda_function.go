@@ -9,11 +9,15 @@ import (
 )
 
 type LambdaFunction struct {
-	Type       string                      `yaml:"Type"`
-	Properties LambdaFunctionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          LambdaFunctionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type LambdaFunctionProperties struct {
@@ -48,9 +52,10 @@ func ParseLambdaFunction(name string, data string) (cf types.ValueMap, err error
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: LambdaFunction - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -63,19 +68,53 @@ func (resource LambdaFunction) Validate() []error {
 
 func (resource LambdaFunctionProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("FunctionName", resource.FunctionName)...)
 	if resource.Handler == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Handler'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Handler", resource.Handler)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KmsKeyArn", resource.KmsKeyArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MemorySize", resource.MemorySize)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ReservedConcurrentExecutions", resource.ReservedConcurrentExecutions)...)
 	if resource.Role == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Role'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Role", resource.Role)...)
 	if resource.Runtime == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Runtime'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Runtime", resource.Runtime)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Timeout", resource.Timeout)...)
+	
+	if resource.VpcConfig != nil {
+		errs = append(errs, types.PrefixErrors("VpcConfig", resource.VpcConfig.Validate())...)
+	}
+	
+	if resource.TracingConfig != nil {
+		errs = append(errs, types.PrefixErrors("TracingConfig", resource.TracingConfig.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	if resource.Environment != nil {
+		errs = append(errs, types.PrefixErrors("Environment", resource.Environment.Validate())...)
+	}
+	
+	if resource.DeadLetterConfig != nil {
+		errs = append(errs, types.PrefixErrors("DeadLetterConfig", resource.DeadLetterConfig.Validate())...)
+	}
 	if resource.Code == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Code'"))
-	} else {
-		errs = append(errs, resource.Code.Validate()...)
+	}
+	if resource.Code != nil {
+		errs = append(errs, types.PrefixErrors("Code", resource.Code.Validate())...)
 	}
 	return errs
 }
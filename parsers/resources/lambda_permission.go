@@ -8,11 +8,15 @@ import (
 )
 
 type LambdaPermission struct {
-	Type       string                      `yaml:"Type"`
-	Properties LambdaPermissionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          LambdaPermissionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type LambdaPermissionProperties struct {
@@ -38,9 +42,10 @@ func ParseLambdaPermission(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: LambdaPermission - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,11 +61,20 @@ func (resource LambdaPermissionProperties) Validate() []error {
 	if resource.Action == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Action'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Action", resource.Action)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EventSourceToken", resource.EventSourceToken)...)
 	if resource.FunctionName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FunctionName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("FunctionName", resource.FunctionName)...)
 	if resource.Principal == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Principal'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Principal", resource.Principal)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceAccount", resource.SourceAccount)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceArn", resource.SourceArn)...)
 	return errs
 }
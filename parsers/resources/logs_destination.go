@@ -8,11 +8,15 @@ import (
 )
 
 type LogsDestination struct {
-	Type       string                      `yaml:"Type"`
-	Properties LogsDestinationProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          LogsDestinationProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type LogsDestinationProperties struct {
@@ -36,9 +40,10 @@ func ParseLogsDestination(name string, data string) (cf types.ValueMap, err erro
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: LogsDestination - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,14 +59,18 @@ func (resource LogsDestinationProperties) Validate() []error {
 	if resource.DestinationName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DestinationName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DestinationName", resource.DestinationName)...)
 	if resource.DestinationPolicy == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DestinationPolicy'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DestinationPolicy", resource.DestinationPolicy)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	if resource.TargetArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TargetArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TargetArn", resource.TargetArn)...)
 	return errs
 }
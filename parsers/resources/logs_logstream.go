@@ -8,11 +8,15 @@ import (
 )
 
 type LogsLogStream struct {
-	Type       string                      `yaml:"Type"`
-	Properties LogsLogStreamProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          LogsLogStreamProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type LogsLogStreamProperties struct {
@@ -34,9 +38,10 @@ func ParseLogsLogStream(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: LogsLogStream - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,5 +57,8 @@ func (resource LogsLogStreamProperties) Validate() []error {
 	if resource.LogGroupName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'LogGroupName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("LogGroupName", resource.LogGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("LogStreamName", resource.LogStreamName)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type LogsMetricFilter struct {
-	Type       string                      `yaml:"Type"`
-	Properties LogsMetricFilterProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          LogsMetricFilterProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type LogsMetricFilterProperties struct {
@@ -35,9 +40,10 @@ func ParseLogsMetricFilter(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: LogsMetricFilter - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,11 +59,14 @@ func (resource LogsMetricFilterProperties) Validate() []error {
 	if resource.FilterPattern == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FilterPattern'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("FilterPattern", resource.FilterPattern)...)
 	if resource.LogGroupName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'LogGroupName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("LogGroupName", resource.LogGroupName)...)
 	if resource.MetricTransformations == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MetricTransformations'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.MetricFilter_MetricTransformation{} }))("MetricTransformations", resource.MetricTransformations)...)
 	return errs
 }
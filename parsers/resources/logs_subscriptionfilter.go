@@ -8,11 +8,15 @@ import (
 )
 
 type LogsSubscriptionFilter struct {
-	Type       string                      `yaml:"Type"`
-	Properties LogsSubscriptionFilterProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          LogsSubscriptionFilterProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type LogsSubscriptionFilterProperties struct {
@@ -36,9 +40,10 @@ func ParseLogsSubscriptionFilter(name string, data string) (cf types.ValueMap, e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: LogsSubscriptionFilter - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,11 +59,16 @@ func (resource LogsSubscriptionFilterProperties) Validate() []error {
 	if resource.DestinationArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DestinationArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DestinationArn", resource.DestinationArn)...)
 	if resource.FilterPattern == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'FilterPattern'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("FilterPattern", resource.FilterPattern)...)
 	if resource.LogGroupName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'LogGroupName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("LogGroupName", resource.LogGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
 	return errs
 }
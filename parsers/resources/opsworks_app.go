@@ -9,11 +9,15 @@ import (
 )
 
 type OpsWorksApp struct {
-	Type       string                      `yaml:"Type"`
-	Properties OpsWorksAppProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          OpsWorksAppProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type OpsWorksAppProperties struct {
@@ -45,9 +49,10 @@ func ParseOpsWorksApp(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: OpsWorksApp - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -60,14 +65,39 @@ func (resource OpsWorksApp) Validate() []error {
 
 func (resource OpsWorksAppProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EnableSsl", resource.EnableSsl)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Shortname", resource.Shortname)...)
 	if resource.StackId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StackId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StackId", resource.StackId)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	if resource.SslConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("SslConfiguration", resource.SslConfiguration.Validate())...)
+	}
+	
+	if resource.AppSource != nil {
+		errs = append(errs, types.PrefixErrors("AppSource", resource.AppSource.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Attributes", resource.Attributes)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.App_DataSource{} }))("DataSources", resource.DataSources)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Domains", resource.Domains)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.App_EnvironmentVariable{} }))("Environment", resource.Environment)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type OpsWorksElasticLoadBalancerAttachment struct {
-	Type       string                      `yaml:"Type"`
-	Properties OpsWorksElasticLoadBalancerAttachmentProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          OpsWorksElasticLoadBalancerAttachmentProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type OpsWorksElasticLoadBalancerAttachmentProperties struct {
@@ -34,9 +38,10 @@ func ParseOpsWorksElasticLoadBalancerAttachment(name string, data string) (cf ty
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: OpsWorksElasticLoadBalancerAttachment - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,10 @@ func (resource OpsWorksElasticLoadBalancerAttachmentProperties) Validate() []err
 	if resource.ElasticLoadBalancerName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ElasticLoadBalancerName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ElasticLoadBalancerName", resource.ElasticLoadBalancerName)...)
 	if resource.LayerId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'LayerId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("LayerId", resource.LayerId)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type OpsWorksInstance struct {
-	Type       string                      `yaml:"Type"`
-	Properties OpsWorksInstanceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          OpsWorksInstanceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type OpsWorksInstanceProperties struct {
@@ -54,9 +58,10 @@ func ParseOpsWorksInstance(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: OpsWorksInstance - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -69,14 +74,55 @@ func (resource OpsWorksInstance) Validate() []error {
 
 func (resource OpsWorksInstanceProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AgentVersion", resource.AgentVersion)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AmiId", resource.AmiId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Architecture", resource.Architecture)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AutoScalingType", resource.AutoScalingType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AvailabilityZone", resource.AvailabilityZone)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EbsOptimized", resource.EbsOptimized)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Hostname", resource.Hostname)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("InstallUpdatesOnBoot", resource.InstallUpdatesOnBoot)...)
 	if resource.InstanceType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceType", resource.InstanceType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Os", resource.Os)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("RootDeviceType", resource.RootDeviceType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SshKeyName", resource.SshKeyName)...)
 	if resource.StackId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StackId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StackId", resource.StackId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SubnetId", resource.SubnetId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Tenancy", resource.Tenancy)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("VirtualizationType", resource.VirtualizationType)...)
+	
+	if resource.TimeBasedAutoScaling != nil {
+		errs = append(errs, types.PrefixErrors("TimeBasedAutoScaling", resource.TimeBasedAutoScaling.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Instance_BlockDeviceMapping{} }))("BlockDeviceMappings", resource.BlockDeviceMappings)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ElasticIps", resource.ElasticIps)...)
 	if resource.LayerIds == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'LayerIds'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("LayerIds", resource.LayerIds)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Volumes", resource.Volumes)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type OpsWorksLayer struct {
-	Type       string                      `yaml:"Type"`
-	Properties OpsWorksLayerProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          OpsWorksLayerProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type OpsWorksLayerProperties struct {
@@ -52,9 +56,10 @@ func ParseOpsWorksLayer(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: OpsWorksLayer - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -70,23 +75,60 @@ func (resource OpsWorksLayerProperties) Validate() []error {
 	if resource.AutoAssignElasticIps == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AutoAssignElasticIps'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AutoAssignElasticIps", resource.AutoAssignElasticIps)...)
 	if resource.AutoAssignPublicIps == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'AutoAssignPublicIps'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AutoAssignPublicIps", resource.AutoAssignPublicIps)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CustomInstanceProfileArn", resource.CustomInstanceProfileArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("CustomJson", resource.CustomJson)...)
 	if resource.EnableAutoHealing == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'EnableAutoHealing'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Boolean")("EnableAutoHealing", resource.EnableAutoHealing)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("InstallUpdatesOnBoot", resource.InstallUpdatesOnBoot)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Shortname == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Shortname'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Shortname", resource.Shortname)...)
 	if resource.StackId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StackId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StackId", resource.StackId)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("UseEbsOptimizedInstances", resource.UseEbsOptimizedInstances)...)
+	
+	if resource.CustomRecipes != nil {
+		errs = append(errs, types.PrefixErrors("CustomRecipes", resource.CustomRecipes.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Attributes", resource.Attributes)...)
+	
+	if resource.LoadBasedAutoScaling != nil {
+		errs = append(errs, types.PrefixErrors("LoadBasedAutoScaling", resource.LoadBasedAutoScaling.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("CustomSecurityGroupIds", resource.CustomSecurityGroupIds)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("Packages", resource.Packages)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Layer_VolumeConfiguration{} }))("VolumeConfigurations", resource.VolumeConfigurations)...)
+	
+	if resource.LifecycleEventConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("LifecycleEventConfiguration", resource.LifecycleEventConfiguration.Validate())...)
+	}
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type OpsWorksStack struct {
-	Type       string                      `yaml:"Type"`
-	Properties OpsWorksStackProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          OpsWorksStackProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type OpsWorksStackProperties struct {
@@ -37,10 +41,10 @@ type OpsWorksStackProperties struct {
 	ConfigurationManager *properties.Stack_StackConfigurationManager `yaml:"ConfigurationManager,omitempty"`
 	CustomCookbooksSource *properties.Stack_Source `yaml:"CustomCookbooksSource,omitempty"`
 	Attributes interface{} `yaml:"Attributes,omitempty"`
-	Tags interface{} `yaml:"Tags,omitempty"`
 	CloneAppIds interface{} `yaml:"CloneAppIds,omitempty"`
 	ElasticIps interface{} `yaml:"ElasticIps,omitempty"`
 	RdsDbInstances interface{} `yaml:"RdsDbInstances,omitempty"`
+	Tags interface{} `yaml:"Tags,omitempty"`
 	ChefConfiguration *properties.Stack_ChefConfiguration `yaml:"ChefConfiguration,omitempty"`
 }
 
@@ -58,9 +62,10 @@ func ParseOpsWorksStack(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: OpsWorksStack - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -73,14 +78,67 @@ func (resource OpsWorksStack) Validate() []error {
 
 func (resource OpsWorksStackProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AgentVersion", resource.AgentVersion)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ClonePermissions", resource.ClonePermissions)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("CustomJson", resource.CustomJson)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DefaultAvailabilityZone", resource.DefaultAvailabilityZone)...)
 	if resource.DefaultInstanceProfileArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DefaultInstanceProfileArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DefaultInstanceProfileArn", resource.DefaultInstanceProfileArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DefaultOs", resource.DefaultOs)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DefaultRootDeviceType", resource.DefaultRootDeviceType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DefaultSshKeyName", resource.DefaultSshKeyName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DefaultSubnetId", resource.DefaultSubnetId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EcsClusterArn", resource.EcsClusterArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HostnameTheme", resource.HostnameTheme)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.ServiceRoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ServiceRoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceRoleArn", resource.ServiceRoleArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceStackId", resource.SourceStackId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("UseCustomCookbooks", resource.UseCustomCookbooks)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("UseOpsworksSecurityGroups", resource.UseOpsworksSecurityGroups)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("VpcId", resource.VpcId)...)
+	
+	if resource.ConfigurationManager != nil {
+		errs = append(errs, types.PrefixErrors("ConfigurationManager", resource.ConfigurationManager.Validate())...)
+	}
+	
+	if resource.CustomCookbooksSource != nil {
+		errs = append(errs, types.PrefixErrors("CustomCookbooksSource", resource.CustomCookbooksSource.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateMap(types.ValidatePrimitive("String"))("Attributes", resource.Attributes)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("CloneAppIds", resource.CloneAppIds)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Stack_ElasticIp{} }))("ElasticIps", resource.ElasticIps)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Stack_RdsDbInstance{} }))("RdsDbInstances", resource.RdsDbInstances)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	if resource.ChefConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("ChefConfiguration", resource.ChefConfiguration.Validate())...)
+	}
 	return errs
 }
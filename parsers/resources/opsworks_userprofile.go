@@ -8,11 +8,15 @@ import (
 )
 
 type OpsWorksUserProfile struct {
-	Type       string                      `yaml:"Type"`
-	Properties OpsWorksUserProfileProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          OpsWorksUserProfileProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type OpsWorksUserProfileProperties struct {
@@ -36,9 +40,10 @@ func ParseOpsWorksUserProfile(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: OpsWorksUserProfile - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,8 +56,15 @@ func (resource OpsWorksUserProfile) Validate() []error {
 
 func (resource OpsWorksUserProfileProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AllowSelfManagement", resource.AllowSelfManagement)...)
 	if resource.IamUserArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'IamUserArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("IamUserArn", resource.IamUserArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SshPublicKey", resource.SshPublicKey)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SshUsername", resource.SshUsername)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type OpsWorksVolume struct {
-	Type       string                      `yaml:"Type"`
-	Properties OpsWorksVolumeProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          OpsWorksVolumeProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type OpsWorksVolumeProperties struct {
@@ -36,9 +40,10 @@ func ParseOpsWorksVolume(name string, data string) (cf types.ValueMap, err error
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: OpsWorksVolume - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,8 +59,14 @@ func (resource OpsWorksVolumeProperties) Validate() []error {
 	if resource.Ec2VolumeId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Ec2VolumeId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Ec2VolumeId", resource.Ec2VolumeId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("MountPoint", resource.MountPoint)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.StackId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'StackId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("StackId", resource.StackId)...)
 	return errs
 }
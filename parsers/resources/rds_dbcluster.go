@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type RDSDBCluster struct {
-	Type       string                      `yaml:"Type"`
-	Properties RDSDBClusterProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          RDSDBClusterProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type RDSDBClusterProperties struct {
@@ -51,9 +56,10 @@ func ParseRDSDBCluster(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: RDSDBCluster - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -66,8 +72,45 @@ func (resource RDSDBCluster) Validate() []error {
 
 func (resource RDSDBClusterProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("BackupRetentionPeriod", resource.BackupRetentionPeriod)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DBClusterIdentifier", resource.DBClusterIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DBClusterParameterGroupName", resource.DBClusterParameterGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DBSubnetGroupName", resource.DBSubnetGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DatabaseName", resource.DatabaseName)...)
 	if resource.Engine == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Engine'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Engine", resource.Engine)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EngineVersion", resource.EngineVersion)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KmsKeyId", resource.KmsKeyId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("MasterUserPassword", resource.MasterUserPassword)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("MasterUsername", resource.MasterUsername)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Port", resource.Port)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PreferredBackupWindow", resource.PreferredBackupWindow)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PreferredMaintenanceWindow", resource.PreferredMaintenanceWindow)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ReplicationSourceIdentifier", resource.ReplicationSourceIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SnapshotIdentifier", resource.SnapshotIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("StorageEncrypted", resource.StorageEncrypted)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("AvailabilityZones", resource.AvailabilityZones)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("VpcSecurityGroupIds", resource.VpcSecurityGroupIds)...)
 	return errs
 }
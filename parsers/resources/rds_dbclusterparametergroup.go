@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type RDSDBClusterParameterGroup struct {
-	Type       string                      `yaml:"Type"`
-	Properties RDSDBClusterParameterGroupProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          RDSDBClusterParameterGroupProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type RDSDBClusterParameterGroupProperties struct {
@@ -36,9 +41,10 @@ func ParseRDSDBClusterParameterGroup(name string, data string) (cf types.ValueMa
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: RDSDBClusterParameterGroup - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -54,11 +60,16 @@ func (resource RDSDBClusterParameterGroupProperties) Validate() []error {
 	if resource.Description == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Description'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.Family == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Family'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Family", resource.Family)...)
 	if resource.Parameters == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Parameters'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Json")("Parameters", resource.Parameters)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type RDSDBInstance struct {
-	Type       string                      `yaml:"Type"`
-	Properties RDSDBInstanceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          RDSDBInstanceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type RDSDBInstanceProperties struct {
@@ -71,9 +76,10 @@ func ParseRDSDBInstance(name string, data string) (cf types.ValueMap, err error)
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: RDSDBInstance - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -86,8 +92,85 @@ func (resource RDSDBInstance) Validate() []error {
 
 func (resource RDSDBInstanceProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AllocatedStorage", resource.AllocatedStorage)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AllowMajorVersionUpgrade", resource.AllowMajorVersionUpgrade)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AutoMinorVersionUpgrade", resource.AutoMinorVersionUpgrade)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AvailabilityZone", resource.AvailabilityZone)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("BackupRetentionPeriod", resource.BackupRetentionPeriod)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CharacterSetName", resource.CharacterSetName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("CopyTagsToSnapshot", resource.CopyTagsToSnapshot)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DBClusterIdentifier", resource.DBClusterIdentifier)...)
 	if resource.DBInstanceClass == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DBInstanceClass'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DBInstanceClass", resource.DBInstanceClass)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DBInstanceIdentifier", resource.DBInstanceIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DBName", resource.DBName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DBParameterGroupName", resource.DBParameterGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DBSnapshotIdentifier", resource.DBSnapshotIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DBSubnetGroupName", resource.DBSubnetGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Domain", resource.Domain)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("DomainIAMRoleName", resource.DomainIAMRoleName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Engine", resource.Engine)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EngineVersion", resource.EngineVersion)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Iops", resource.Iops)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KmsKeyId", resource.KmsKeyId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("LicenseModel", resource.LicenseModel)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("MasterUserPassword", resource.MasterUserPassword)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("MasterUsername", resource.MasterUsername)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MonitoringInterval", resource.MonitoringInterval)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("MonitoringRoleArn", resource.MonitoringRoleArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("MultiAZ", resource.MultiAZ)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("OptionGroupName", resource.OptionGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Port", resource.Port)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PreferredBackupWindow", resource.PreferredBackupWindow)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PreferredMaintenanceWindow", resource.PreferredMaintenanceWindow)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("PubliclyAccessible", resource.PubliclyAccessible)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceDBInstanceIdentifier", resource.SourceDBInstanceIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceRegion", resource.SourceRegion)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("StorageEncrypted", resource.StorageEncrypted)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("StorageType", resource.StorageType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Timezone", resource.Timezone)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("DBSecurityGroups", resource.DBSecurityGroups)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("VPCSecurityGroups", resource.VPCSecurityGroups)...)
 	return errs
 }
@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type RDSDBSecurityGroup struct {
-	Type       string                      `yaml:"Type"`
-	Properties RDSDBSecurityGroupProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          RDSDBSecurityGroupProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type RDSDBSecurityGroupProperties struct {
@@ -36,9 +41,10 @@ func ParseRDSDBSecurityGroup(name string, data string) (cf types.ValueMap, err e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: RDSDBSecurityGroup - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,11 +57,17 @@ func (resource RDSDBSecurityGroup) Validate() []error {
 
 func (resource RDSDBSecurityGroupProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EC2VpcId", resource.EC2VpcId)...)
 	if resource.GroupDescription == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'GroupDescription'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("GroupDescription", resource.GroupDescription)...)
 	if resource.DBSecurityGroupIngress == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DBSecurityGroupIngress'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.DBSecurityGroup_Ingress{} }))("DBSecurityGroupIngress", resource.DBSecurityGroupIngress)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type RDSDBSecurityGroupIngress struct {
-	Type       string                      `yaml:"Type"`
-	Properties RDSDBSecurityGroupIngressProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          RDSDBSecurityGroupIngressProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type RDSDBSecurityGroupIngressProperties struct {
@@ -37,9 +41,10 @@ func ParseRDSDBSecurityGroupIngress(name string, data string) (cf types.ValueMap
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: RDSDBSecurityGroupIngress - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,17 @@ func (resource RDSDBSecurityGroupIngress) Validate() []error {
 
 func (resource RDSDBSecurityGroupIngressProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CIDRIP", resource.CIDRIP)...)
 	if resource.DBSecurityGroupName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DBSecurityGroupName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DBSecurityGroupName", resource.DBSecurityGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EC2SecurityGroupId", resource.EC2SecurityGroupId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EC2SecurityGroupName", resource.EC2SecurityGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EC2SecurityGroupOwnerId", resource.EC2SecurityGroupOwnerId)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type RDSEventSubscription struct {
-	Type       string                      `yaml:"Type"`
-	Properties RDSEventSubscriptionProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          RDSEventSubscriptionProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type RDSEventSubscriptionProperties struct {
@@ -37,9 +41,10 @@ func ParseRDSEventSubscription(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: RDSEventSubscription - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,17 @@ func (resource RDSEventSubscription) Validate() []error {
 
 func (resource RDSEventSubscriptionProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Enabled", resource.Enabled)...)
 	if resource.SnsTopicArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'SnsTopicArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("SnsTopicArn", resource.SnsTopicArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SourceType", resource.SourceType)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("EventCategories", resource.EventCategories)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("SourceIds", resource.SourceIds)...)
 	return errs
 }
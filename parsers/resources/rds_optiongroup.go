@@ -5,14 +5,19 @@ import (
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
 	"fmt"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type RDSOptionGroup struct {
-	Type       string                      `yaml:"Type"`
-	Properties RDSOptionGroupProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          RDSOptionGroupProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type RDSOptionGroupProperties struct {
@@ -37,9 +42,10 @@ func ParseRDSOptionGroup(name string, data string) (cf types.ValueMap, err error
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: RDSOptionGroup - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -55,14 +61,20 @@ func (resource RDSOptionGroupProperties) Validate() []error {
 	if resource.EngineName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'EngineName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("EngineName", resource.EngineName)...)
 	if resource.MajorEngineVersion == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MajorEngineVersion'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MajorEngineVersion", resource.MajorEngineVersion)...)
 	if resource.OptionGroupDescription == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'OptionGroupDescription'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("OptionGroupDescription", resource.OptionGroupDescription)...)
 	if resource.OptionConfigurations == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'OptionConfigurations'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.OptionGroup_OptionConfiguration{} }))("OptionConfigurations", resource.OptionConfigurations)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
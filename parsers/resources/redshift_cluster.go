@@ -9,11 +9,15 @@ import (
 )
 
 type RedshiftCluster struct {
-	Type       string                      `yaml:"Type"`
-	Properties RedshiftClusterProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          RedshiftClusterProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type RedshiftClusterProperties struct {
@@ -62,9 +66,10 @@ func ParseRedshiftCluster(name string, data string) (cf types.ValueMap, err erro
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: RedshiftCluster - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -77,20 +82,75 @@ func (resource RedshiftCluster) Validate() []error {
 
 func (resource RedshiftClusterProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("AllowVersionUpgrade", resource.AllowVersionUpgrade)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("AutomatedSnapshotRetentionPeriod", resource.AutomatedSnapshotRetentionPeriod)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AvailabilityZone", resource.AvailabilityZone)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ClusterIdentifier", resource.ClusterIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ClusterParameterGroupName", resource.ClusterParameterGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ClusterSubnetGroupName", resource.ClusterSubnetGroupName)...)
 	if resource.ClusterType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ClusterType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ClusterType", resource.ClusterType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ClusterVersion", resource.ClusterVersion)...)
 	if resource.DBName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DBName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DBName", resource.DBName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ElasticIp", resource.ElasticIp)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("Encrypted", resource.Encrypted)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HsmClientCertificateIdentifier", resource.HsmClientCertificateIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HsmConfigurationIdentifier", resource.HsmConfigurationIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KmsKeyId", resource.KmsKeyId)...)
 	if resource.MasterUserPassword == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MasterUserPassword'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MasterUserPassword", resource.MasterUserPassword)...)
 	if resource.MasterUsername == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MasterUsername'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MasterUsername", resource.MasterUsername)...)
 	if resource.NodeType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'NodeType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("NodeType", resource.NodeType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("NumberOfNodes", resource.NumberOfNodes)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("OwnerAccount", resource.OwnerAccount)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Port", resource.Port)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PreferredMaintenanceWindow", resource.PreferredMaintenanceWindow)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("PubliclyAccessible", resource.PubliclyAccessible)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SnapshotClusterIdentifier", resource.SnapshotClusterIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SnapshotIdentifier", resource.SnapshotIdentifier)...)
+	
+	if resource.LoggingProperties != nil {
+		errs = append(errs, types.PrefixErrors("LoggingProperties", resource.LoggingProperties.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ClusterSecurityGroups", resource.ClusterSecurityGroups)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("IamRoles", resource.IamRoles)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("VpcSecurityGroupIds", resource.VpcSecurityGroupIds)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type RedshiftClusterSecurityGroupIngress struct {
-	Type       string                      `yaml:"Type"`
-	Properties RedshiftClusterSecurityGroupIngressProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          RedshiftClusterSecurityGroupIngressProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type RedshiftClusterSecurityGroupIngressProperties struct {
@@ -36,9 +40,10 @@ func ParseRedshiftClusterSecurityGroupIngress(name string, data string) (cf type
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: RedshiftClusterSecurityGroupIngress - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,8 +56,15 @@ func (resource RedshiftClusterSecurityGroupIngress) Validate() []error {
 
 func (resource RedshiftClusterSecurityGroupIngressProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("CIDRIP", resource.CIDRIP)...)
 	if resource.ClusterSecurityGroupName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ClusterSecurityGroupName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ClusterSecurityGroupName", resource.ClusterSecurityGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EC2SecurityGroupName", resource.EC2SecurityGroupName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("EC2SecurityGroupOwnerId", resource.EC2SecurityGroupOwnerId)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type Route53HealthCheck struct {
-	Type       string                      `yaml:"Type"`
-	Properties Route53HealthCheckProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          Route53HealthCheckProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type Route53HealthCheckProperties struct {
@@ -35,9 +39,10 @@ func ParseRoute53HealthCheck(name string, data string) (cf types.ValueMap, err e
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: Route53HealthCheck - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,10 +55,13 @@ func (resource Route53HealthCheck) Validate() []error {
 
 func (resource Route53HealthCheckProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.HealthCheck_HealthCheckTag{} }))("HealthCheckTags", resource.HealthCheckTags)...)
 	if resource.HealthCheckConfig == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'HealthCheckConfig'"))
-	} else {
-		errs = append(errs, resource.HealthCheckConfig.Validate()...)
+	}
+	if resource.HealthCheckConfig != nil {
+		errs = append(errs, types.PrefixErrors("HealthCheckConfig", resource.HealthCheckConfig.Validate())...)
 	}
 	return errs
 }
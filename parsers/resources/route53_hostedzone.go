@@ -9,11 +9,15 @@ import (
 )
 
 type Route53HostedZone struct {
-	Type       string                      `yaml:"Type"`
-	Properties Route53HostedZoneProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          Route53HostedZoneProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type Route53HostedZoneProperties struct {
@@ -38,9 +42,10 @@ func ParseRoute53HostedZone(name string, data string) (cf types.ValueMap, err er
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: Route53HostedZone - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,5 +61,18 @@ func (resource Route53HostedZoneProperties) Validate() []error {
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	if resource.QueryLoggingConfig != nil {
+		errs = append(errs, types.PrefixErrors("QueryLoggingConfig", resource.QueryLoggingConfig.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.HostedZone_HostedZoneTag{} }))("HostedZoneTags", resource.HostedZoneTags)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.HostedZone_VPC{} }))("VPCs", resource.VPCs)...)
+	
+	if resource.HostedZoneConfig != nil {
+		errs = append(errs, types.PrefixErrors("HostedZoneConfig", resource.HostedZoneConfig.Validate())...)
+	}
 	return errs
 }
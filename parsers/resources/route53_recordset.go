@@ -9,11 +9,15 @@ import (
 )
 
 type Route53RecordSet struct {
-	Type       string                      `yaml:"Type"`
-	Properties Route53RecordSetProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          Route53RecordSetProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type Route53RecordSetProperties struct {
@@ -47,9 +51,10 @@ func ParseRoute53RecordSet(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: Route53RecordSet - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -62,11 +67,41 @@ func (resource Route53RecordSet) Validate() []error {
 
 func (resource Route53RecordSetProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Comment", resource.Comment)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Failover", resource.Failover)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HealthCheckId", resource.HealthCheckId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HostedZoneId", resource.HostedZoneId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("HostedZoneName", resource.HostedZoneName)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Region", resource.Region)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("SetIdentifier", resource.SetIdentifier)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("TTL", resource.TTL)...)
 	if resource.Type == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Type'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Type", resource.Type)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("Weight", resource.Weight)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ResourceRecords", resource.ResourceRecords)...)
+	
+	if resource.GeoLocation != nil {
+		errs = append(errs, types.PrefixErrors("GeoLocation", resource.GeoLocation.Validate())...)
+	}
+	
+	if resource.AliasTarget != nil {
+		errs = append(errs, types.PrefixErrors("AliasTarget", resource.AliasTarget.Validate())...)
+	}
 	return errs
 }
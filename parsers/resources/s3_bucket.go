@@ -8,11 +8,15 @@ import (
 )
 
 type S3Bucket struct {
-	Type       string                      `yaml:"Type"`
-	Properties S3BucketProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          S3BucketProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type S3BucketProperties struct {
@@ -23,10 +27,10 @@ type S3BucketProperties struct {
 	ReplicationConfiguration *properties.Bucket_ReplicationConfiguration `yaml:"ReplicationConfiguration,omitempty"`
 	NotificationConfiguration *properties.Bucket_NotificationConfiguration `yaml:"NotificationConfiguration,omitempty"`
 	LoggingConfiguration *properties.Bucket_LoggingConfiguration `yaml:"LoggingConfiguration,omitempty"`
-	AnalyticsConfigurations interface{} `yaml:"AnalyticsConfigurations,omitempty"`
-	InventoryConfigurations interface{} `yaml:"InventoryConfigurations,omitempty"`
 	MetricsConfigurations interface{} `yaml:"MetricsConfigurations,omitempty"`
+	InventoryConfigurations interface{} `yaml:"InventoryConfigurations,omitempty"`
 	Tags interface{} `yaml:"Tags,omitempty"`
+	AnalyticsConfigurations interface{} `yaml:"AnalyticsConfigurations,omitempty"`
 	LifecycleConfiguration *properties.Bucket_LifecycleConfiguration `yaml:"LifecycleConfiguration,omitempty"`
 	CorsConfiguration *properties.Bucket_CorsConfiguration `yaml:"CorsConfiguration,omitempty"`
 	BucketEncryption *properties.Bucket_BucketEncryption `yaml:"BucketEncryption,omitempty"`
@@ -47,9 +51,10 @@ func ParseS3Bucket(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: S3Bucket - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -62,5 +67,53 @@ func (resource S3Bucket) Validate() []error {
 
 func (resource S3BucketProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AccessControl", resource.AccessControl)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("BucketName", resource.BucketName)...)
+	
+	if resource.WebsiteConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("WebsiteConfiguration", resource.WebsiteConfiguration.Validate())...)
+	}
+	
+	if resource.VersioningConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("VersioningConfiguration", resource.VersioningConfiguration.Validate())...)
+	}
+	
+	if resource.ReplicationConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("ReplicationConfiguration", resource.ReplicationConfiguration.Validate())...)
+	}
+	
+	if resource.NotificationConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("NotificationConfiguration", resource.NotificationConfiguration.Validate())...)
+	}
+	
+	if resource.LoggingConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("LoggingConfiguration", resource.LoggingConfiguration.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Bucket_MetricsConfiguration{} }))("MetricsConfigurations", resource.MetricsConfigurations)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Bucket_InventoryConfiguration{} }))("InventoryConfigurations", resource.InventoryConfigurations)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Bucket_AnalyticsConfiguration{} }))("AnalyticsConfigurations", resource.AnalyticsConfigurations)...)
+	
+	if resource.LifecycleConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("LifecycleConfiguration", resource.LifecycleConfiguration.Validate())...)
+	}
+	
+	if resource.CorsConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("CorsConfiguration", resource.CorsConfiguration.Validate())...)
+	}
+	
+	if resource.BucketEncryption != nil {
+		errs = append(errs, types.PrefixErrors("BucketEncryption", resource.BucketEncryption.Validate())...)
+	}
+	
+	if resource.AccelerateConfiguration != nil {
+		errs = append(errs, types.PrefixErrors("AccelerateConfiguration", resource.AccelerateConfiguration.Validate())...)
+	}
 	return errs
 }
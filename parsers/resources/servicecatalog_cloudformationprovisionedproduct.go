@@ -4,14 +4,19 @@ import (
 	yaml "github.com/KablamoOSS/yaml"
 	"github.com/KablamoOSS/kombustion/types"
 	"log"
+	"github.com/KablamoOSS/kombustion/parsers/properties"
 )
 
 type ServiceCatalogCloudFormationProvisionedProduct struct {
-	Type       string                      `yaml:"Type"`
-	Properties ServiceCatalogCloudFormationProvisionedProductProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ServiceCatalogCloudFormationProvisionedProductProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ServiceCatalogCloudFormationProvisionedProductProperties struct {
@@ -41,9 +46,10 @@ func ParseServiceCatalogCloudFormationProvisionedProduct(name string, data strin
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ServiceCatalogCloudFormationProvisionedProduct - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,5 +62,25 @@ func (resource ServiceCatalogCloudFormationProvisionedProduct) Validate() []erro
 
 func (resource ServiceCatalogCloudFormationProvisionedProductProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("AcceptLanguage", resource.AcceptLanguage)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("PathId", resource.PathId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ProductId", resource.ProductId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ProductName", resource.ProductName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ProvisionedProductName", resource.ProvisionedProductName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ProvisioningArtifactId", resource.ProvisioningArtifactId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ProvisioningArtifactName", resource.ProvisioningArtifactName)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("NotificationArns", resource.NotificationArns)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.CloudFormationProvisionedProduct_ProvisioningParameter{} }))("ProvisioningParameters", resource.ProvisioningParameters)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.Tag{} }))("Tags", resource.Tags)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type ServiceDiscoveryInstance struct {
-	Type       string                      `yaml:"Type"`
-	Properties ServiceDiscoveryInstanceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ServiceDiscoveryInstanceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ServiceDiscoveryInstanceProperties struct {
@@ -35,9 +39,10 @@ func ParseServiceDiscoveryInstance(name string, data string) (cf types.ValueMap,
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ServiceDiscoveryInstance - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,8 +58,12 @@ func (resource ServiceDiscoveryInstanceProperties) Validate() []error {
 	if resource.InstanceAttributes == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'InstanceAttributes'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Json")("InstanceAttributes", resource.InstanceAttributes)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("InstanceId", resource.InstanceId)...)
 	if resource.ServiceId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ServiceId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceId", resource.ServiceId)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type ServiceDiscoveryPrivateDnsNamespace struct {
-	Type       string                      `yaml:"Type"`
-	Properties ServiceDiscoveryPrivateDnsNamespaceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ServiceDiscoveryPrivateDnsNamespaceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ServiceDiscoveryPrivateDnsNamespaceProperties struct {
@@ -35,9 +39,10 @@ func ParseServiceDiscoveryPrivateDnsNamespace(name string, data string) (cf type
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ServiceDiscoveryPrivateDnsNamespace - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -50,11 +55,15 @@ func (resource ServiceDiscoveryPrivateDnsNamespace) Validate() []error {
 
 func (resource ServiceDiscoveryPrivateDnsNamespaceProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Vpc == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Vpc'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Vpc", resource.Vpc)...)
 	return errs
 }
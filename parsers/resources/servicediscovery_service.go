@@ -9,11 +9,15 @@ import (
 )
 
 type ServiceDiscoveryService struct {
-	Type       string                      `yaml:"Type"`
-	Properties ServiceDiscoveryServiceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          ServiceDiscoveryServiceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type ServiceDiscoveryServiceProperties struct {
@@ -37,9 +41,10 @@ func ParseServiceDiscoveryService(name string, data string) (cf types.ValueMap,
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: ServiceDiscoveryService - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,10 +57,19 @@ func (resource ServiceDiscoveryService) Validate() []error {
 
 func (resource ServiceDiscoveryServiceProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	if resource.HealthCheckConfig != nil {
+		errs = append(errs, types.PrefixErrors("HealthCheckConfig", resource.HealthCheckConfig.Validate())...)
+	}
 	if resource.DnsConfig == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DnsConfig'"))
-	} else {
-		errs = append(errs, resource.DnsConfig.Validate()...)
+	}
+	if resource.DnsConfig != nil {
+		errs = append(errs, types.PrefixErrors("DnsConfig", resource.DnsConfig.Validate())...)
 	}
 	return errs
 }
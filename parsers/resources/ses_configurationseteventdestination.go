@@ -9,11 +9,15 @@ import (
 )
 
 type SESConfigurationSetEventDestination struct {
-	Type       string                      `yaml:"Type"`
-	Properties SESConfigurationSetEventDestinationProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          SESConfigurationSetEventDestinationProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type SESConfigurationSetEventDestinationProperties struct {
@@ -35,9 +39,10 @@ func ParseSESConfigurationSetEventDestination(name string, data string) (cf type
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: SESConfigurationSetEventDestination - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,10 +58,12 @@ func (resource SESConfigurationSetEventDestinationProperties) Validate() []error
 	if resource.ConfigurationSetName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ConfigurationSetName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ConfigurationSetName", resource.ConfigurationSetName)...)
 	if resource.EventDestination == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'EventDestination'"))
-	} else {
-		errs = append(errs, resource.EventDestination.Validate()...)
+	}
+	if resource.EventDestination != nil {
+		errs = append(errs, types.PrefixErrors("EventDestination", resource.EventDestination.Validate())...)
 	}
 	return errs
 }
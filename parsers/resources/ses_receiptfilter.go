@@ -9,11 +9,15 @@ import (
 )
 
 type SESReceiptFilter struct {
-	Type       string                      `yaml:"Type"`
-	Properties SESReceiptFilterProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          SESReceiptFilterProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type SESReceiptFilterProperties struct {
@@ -34,9 +38,10 @@ func ParseSESReceiptFilter(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: SESReceiptFilter - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,8 +56,9 @@ func (resource SESReceiptFilterProperties) Validate() []error {
 	errs := []error{}
 	if resource.Filter == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Filter'"))
-	} else {
-		errs = append(errs, resource.Filter.Validate()...)
+	}
+	if resource.Filter != nil {
+		errs = append(errs, types.PrefixErrors("Filter", resource.Filter.Validate())...)
 	}
 	return errs
 }
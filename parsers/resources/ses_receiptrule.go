@@ -9,11 +9,15 @@ import (
 )
 
 type SESReceiptRule struct {
-	Type       string                      `yaml:"Type"`
-	Properties SESReceiptRuleProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          SESReceiptRuleProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type SESReceiptRuleProperties struct {
@@ -36,9 +40,10 @@ func ParseSESReceiptRule(name string, data string) (cf types.ValueMap, err error
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: SESReceiptRule - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -51,13 +56,17 @@ func (resource SESReceiptRule) Validate() []error {
 
 func (resource SESReceiptRuleProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("After", resource.After)...)
 	if resource.RuleSetName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RuleSetName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RuleSetName", resource.RuleSetName)...)
 	if resource.Rule == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Rule'"))
-	} else {
-		errs = append(errs, resource.Rule.Validate()...)
+	}
+	if resource.Rule != nil {
+		errs = append(errs, types.PrefixErrors("Rule", resource.Rule.Validate())...)
 	}
 	return errs
 }
@@ -7,11 +7,15 @@ import (
 )
 
 type SQSQueue struct {
-	Type       string                      `yaml:"Type"`
-	Properties SQSQueueProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          SQSQueueProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type SQSQueueProperties struct {
@@ -42,9 +46,10 @@ func ParseSQSQueue(name string, data string) (cf types.ValueMap, err error) {
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: SQSQueue - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -57,5 +62,27 @@ func (resource SQSQueue) Validate() []error {
 
 func (resource SQSQueueProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ContentBasedDeduplication", resource.ContentBasedDeduplication)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("DelaySeconds", resource.DelaySeconds)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("FifoQueue", resource.FifoQueue)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("KmsDataKeyReusePeriodSeconds", resource.KmsDataKeyReusePeriodSeconds)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("KmsMasterKeyId", resource.KmsMasterKeyId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MaximumMessageSize", resource.MaximumMessageSize)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("MessageRetentionPeriod", resource.MessageRetentionPeriod)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("QueueName", resource.QueueName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("ReceiveMessageWaitTimeSeconds", resource.ReceiveMessageWaitTimeSeconds)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("RedrivePolicy", resource.RedrivePolicy)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Integer")("VisibilityTimeout", resource.VisibilityTimeout)...)
 	return errs
 }
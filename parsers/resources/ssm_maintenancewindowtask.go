@@ -9,11 +9,15 @@ import (
 )
 
 type SSMMaintenanceWindowTask struct {
-	Type       string                      `yaml:"Type"`
-	Properties SSMMaintenanceWindowTaskProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          SSMMaintenanceWindowTaskProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type SSMMaintenanceWindowTaskProperties struct {
@@ -46,9 +50,10 @@ func ParseSSMMaintenanceWindowTask(name string, data string) (cf types.ValueMap,
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: SSMMaintenanceWindowTask - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -61,26 +66,49 @@ func (resource SSMMaintenanceWindowTask) Validate() []error {
 
 func (resource SSMMaintenanceWindowTaskProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.MaxConcurrency == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MaxConcurrency'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MaxConcurrency", resource.MaxConcurrency)...)
 	if resource.MaxErrors == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'MaxErrors'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("MaxErrors", resource.MaxErrors)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
 	if resource.Priority == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Priority'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("Integer")("Priority", resource.Priority)...)
 	if resource.ServiceRoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ServiceRoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ServiceRoleArn", resource.ServiceRoleArn)...)
 	if resource.TaskArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TaskArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TaskArn", resource.TaskArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Json")("TaskParameters", resource.TaskParameters)...)
 	if resource.TaskType == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'TaskType'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("TaskType", resource.TaskType)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("WindowId", resource.WindowId)...)
+	
+	if resource.TaskInvocationParameters != nil {
+		errs = append(errs, types.PrefixErrors("TaskInvocationParameters", resource.TaskInvocationParameters.Validate())...)
+	}
+	
+	if resource.LoggingInfo != nil {
+		errs = append(errs, types.PrefixErrors("LoggingInfo", resource.LoggingInfo.Validate())...)
+	}
 	if resource.Targets == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Targets'"))
 	}
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.MaintenanceWindowTask_Target{} }))("Targets", resource.Targets)...)
 	return errs
 }
@@ -9,11 +9,15 @@ import (
 )
 
 type SSMPatchBaseline struct {
-	Type       string                      `yaml:"Type"`
-	Properties SSMPatchBaselineProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          SSMPatchBaselineProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type SSMPatchBaselineProperties struct {
@@ -44,9 +48,10 @@ func ParseSSMPatchBaseline(name string, data string) (cf types.ValueMap, err err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: SSMPatchBaseline - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -59,8 +64,33 @@ func (resource SSMPatchBaseline) Validate() []error {
 
 func (resource SSMPatchBaselineProperties) Validate() []error {
 	errs := []error{}
+	
+	errs = append(errs, types.ValidatePrimitive("String")("ApprovedPatchesComplianceLevel", resource.ApprovedPatchesComplianceLevel)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("ApprovedPatchesEnableNonSecurity", resource.ApprovedPatchesEnableNonSecurity)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("Description", resource.Description)...)
 	if resource.Name == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'Name'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("Name", resource.Name)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("OperatingSystem", resource.OperatingSystem)...)
+	
+	if resource.ApprovalRules != nil {
+		errs = append(errs, types.PrefixErrors("ApprovalRules", resource.ApprovalRules.Validate())...)
+	}
+	
+	if resource.GlobalFilters != nil {
+		errs = append(errs, types.PrefixErrors("GlobalFilters", resource.GlobalFilters.Validate())...)
+	}
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("ApprovedPatches", resource.ApprovedPatches)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("PatchGroups", resource.PatchGroups)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePrimitive("String"))("RejectedPatches", resource.RejectedPatches)...)
+	
+	errs = append(errs, types.ValidateList(types.ValidatePropertyType(func() types.Validatable { return &properties.PatchBaseline_PatchSource{} }))("Sources", resource.Sources)...)
 	return errs
 }
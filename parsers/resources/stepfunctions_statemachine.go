@@ -8,11 +8,15 @@ import (
 )
 
 type StepFunctionsStateMachine struct {
-	Type       string                      `yaml:"Type"`
-	Properties StepFunctionsStateMachineProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          StepFunctionsStateMachineProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type StepFunctionsStateMachineProperties struct {
@@ -35,9 +39,10 @@ func ParseStepFunctionsStateMachine(name string, data string) (cf types.ValueMap
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: StepFunctionsStateMachine - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -53,8 +58,12 @@ func (resource StepFunctionsStateMachineProperties) Validate() []error {
 	if resource.DefinitionString == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DefinitionString'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DefinitionString", resource.DefinitionString)...)
 	if resource.RoleArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'RoleArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("RoleArn", resource.RoleArn)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("StateMachineName", resource.StateMachineName)...)
 	return errs
 }
@@ -8,11 +8,15 @@ import (
 )
 
 type WAFRegionalWebACLAssociation struct {
-	Type       string                      `yaml:"Type"`
-	Properties WAFRegionalWebACLAssociationProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          WAFRegionalWebACLAssociationProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type WAFRegionalWebACLAssociationProperties struct {
@@ -34,9 +38,10 @@ func ParseWAFRegionalWebACLAssociation(name string, data string) (cf types.Value
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: WAFRegionalWebACLAssociation - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -52,8 +57,10 @@ func (resource WAFRegionalWebACLAssociationProperties) Validate() []error {
 	if resource.ResourceArn == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'ResourceArn'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("ResourceArn", resource.ResourceArn)...)
 	if resource.WebACLId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'WebACLId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("WebACLId", resource.WebACLId)...)
 	return errs
 }
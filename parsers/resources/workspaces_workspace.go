@@ -8,11 +8,15 @@ import (
 )
 
 type WorkSpacesWorkspace struct {
-	Type       string                      `yaml:"Type"`
-	Properties WorkSpacesWorkspaceProperties `yaml:"Properties"`
-	Condition  interface{}                 `yaml:"Condition,omitempty"`
-	Metadata   interface{}                 `yaml:"Metadata,omitempty"`
-	DependsOn  interface{}                 `yaml:"DependsOn,omitempty"`
+	Type                string                      `yaml:"Type"`
+	Properties          WorkSpacesWorkspaceProperties `yaml:"Properties"`
+	Condition           interface{}                 `yaml:"Condition,omitempty"`
+	Metadata            interface{}                 `yaml:"Metadata,omitempty"`
+	DependsOn           interface{}                 `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{}                 `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{}                 `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{}                 `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{}                 `yaml:"UpdatePolicy,omitempty"`
 }
 
 type WorkSpacesWorkspaceProperties struct {
@@ -38,9 +42,10 @@ func ParseWorkSpacesWorkspace(name string, data string) (cf types.ValueMap, err
 		return
 	}
 	if errs := resource.Properties.Validate(); len(errs) > 0 {
-		for _, err = range errs {
+		for _, err := range errs {
 			log.Println("WARNING: WorkSpacesWorkspace - ", err)
 		}
+		err = types.ValidationErrors(errs)
 		return
 	}
 	cf = types.ValueMap{name: resource}
@@ -56,11 +61,20 @@ func (resource WorkSpacesWorkspaceProperties) Validate() []error {
 	if resource.BundleId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'BundleId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("BundleId", resource.BundleId)...)
 	if resource.DirectoryId == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'DirectoryId'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("DirectoryId", resource.DirectoryId)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("RootVolumeEncryptionEnabled", resource.RootVolumeEncryptionEnabled)...)
 	if resource.UserName == nil {
 		errs = append(errs, fmt.Errorf("Missing required field 'UserName'"))
 	}
+	errs = append(errs, types.ValidatePrimitive("String")("UserName", resource.UserName)...)
+	
+	errs = append(errs, types.ValidatePrimitive("Boolean")("UserVolumeEncryptionEnabled", resource.UserVolumeEncryptionEnabled)...)
+	
+	errs = append(errs, types.ValidatePrimitive("String")("VolumeEncryptionKey", resource.VolumeEncryptionKey)...)
 	return errs
 }
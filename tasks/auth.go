@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/s3"
 )
 
 func getCreds() *credentials.Credentials {
@@ -43,7 +44,7 @@ func getSession(profile string) (*session.Session, error) {
 	return awsSession, err
 }
 
-func getCF(profile string, region string) *cloudformation.CloudFormation {
+func getCF(profile string, region string, endpoint string) *cloudformation.CloudFormation {
 	var awsConfig *aws.Config
 
 	// If we have a custom env var for assuming a role, lets assume
@@ -54,7 +55,37 @@ func getCF(profile string, region string) *cloudformation.CloudFormation {
 		awsConfig = &aws.Config{Region: aws.String(region)}
 	}
 
+	// eg. a local stand-in for cloudformation, for testing
+	if len(endpoint) > 0 {
+		awsConfig.Endpoint = aws.String(endpoint)
+	}
+
 	awsSession := session.Must(getSession(profile))
 	cf := cloudformation.New(awsSession, awsConfig)
 	return cf
 }
+
+/*
+	getS3
+	an S3 client, with the same credentials as getCF. It uses path style urls,
+	like the template urls cloudformation is given
+*/
+func getS3(profile string, region string, endpoint string) *s3.S3 {
+	awsConfig := &aws.Config{
+		Region:           aws.String(region),
+		S3ForcePathStyle: aws.Bool(true),
+	}
+
+	// If we have a custom env var for assuming a role, lets assume
+	if len(os.Getenv("ASSUMED_ROLE")) > 0 {
+		awsConfig.Credentials = getCreds()
+	}
+
+	// eg. a local stand-in for S3, for testing
+	if len(endpoint) > 0 {
+		awsConfig.Endpoint = aws.String(endpoint)
+	}
+
+	awsSession := session.Must(getSession(profile))
+	return s3.New(awsSession, awsConfig)
+}
@@ -0,0 +1,158 @@
+package tasks
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/urfave/cli"
+)
+
+// templateBodyLimit - the largest template cloudformation takes as a TemplateBody, in bytes
+const templateBodyLimit = 51200
+
+/*
+	artifactStore
+	an S3 bucket that templates too large for a TemplateBody are uploaded to.
+	Templates are keyed by the sha256 of their content, so each version is only
+	uploaded once
+*/
+type artifactStore struct {
+	Bucket string
+	Prefix string
+	S3     *s3.S3
+}
+
+// getArtifactStore - the artifact bucket given by --artifactBucket, or nil if there isn't one
+func getArtifactStore(c *cli.Context) *artifactStore {
+	if len(c.String("artifactBucket")) == 0 {
+		return nil
+	}
+	return &artifactStore{
+		Bucket: c.String("artifactBucket"),
+		Prefix: c.String("artifactPrefix"),
+		S3:     getS3(c.GlobalString("profile"), c.String("region"), c.GlobalString("s3Endpoint")),
+	}
+}
+
+// key - the key a template is uploaded to, from its sha256
+func (store *artifactStore) key(data []byte) string {
+	sum := sha256.Sum256(data)
+	prefix := store.Prefix
+	if len(prefix) > 0 && !strings.HasSuffix(prefix, "/") {
+		prefix = prefix + "/"
+	}
+	extension := ".yaml"
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		extension = ".json"
+	}
+	return prefix + hex.EncodeToString(sum[:]) + extension
+}
+
+// objectURL - the path style url of an object in the bucket
+func (store *artifactStore) objectURL(key string) string {
+	return fmt.Sprintf("%v/%v/%v", strings.TrimSuffix(store.S3.Endpoint, "/"), store.Bucket, key)
+}
+
+/*
+	upload
+	uploads a template to the bucket, unless it's already there, and returns its
+	url
+*/
+func (store *artifactStore) upload(data []byte) (string, error) {
+	key := store.key(data)
+	url := store.objectURL(key)
+
+	_, err := store.S3.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(store.Bucket),
+		Key:    aws.String(key),
+	})
+	if err == nil {
+		log.WithFields(log.Fields{
+			"url": url,
+		}).Info("Template already uploaded")
+		return url, nil
+	}
+	// without s3:ListBucket, a missing object is a 403 rather than a 404, so
+	// any error response means it needs uploading
+	if _, ok := err.(awserr.RequestFailure); !ok {
+		return "", err
+	}
+
+	_, err = store.S3.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(store.Bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Uploading the template to %v failed: %v", url, err)
+	}
+	log.WithFields(log.Fields{
+		"url":  url,
+		"size": len(data),
+	}).Info("Uploaded template")
+	return url, nil
+}
+
+/*
+	fitTemplate
+	deploys a template too large for a TemplateBody from the artifact bucket
+	instead. With compact, the template is compacted first, and only uploaded if
+	it's still too large
+*/
+func fitTemplate(template stackTemplate, store *artifactStore, compact bool) (stackTemplate, error) {
+	if len(template.Body) <= templateBodyLimit {
+		return template, nil
+	}
+
+	if compact {
+		data, err := compactTemplate([]byte(template.Body))
+		if err != nil {
+			log.Warn("Couldn't compact the template: ", err)
+		} else {
+			log.WithFields(log.Fields{
+				"before": len(template.Body),
+				"after":  len(data),
+			}).Info("Compacted template")
+			template.Body = string(data)
+			if len(template.Body) <= templateBodyLimit {
+				return template, nil
+			}
+		}
+	}
+
+	if store == nil {
+		return template, fmt.Errorf(
+			"The template is %v bytes, more than the %v cloudformation takes directly. Give an --artifactBucket to upload it to, or try --compact",
+			len(template.Body), templateBodyLimit,
+		)
+	}
+	url, err := store.upload([]byte(template.Body))
+	if err != nil {
+		return template, err
+	}
+	template.URL = url
+	template.Body = ""
+	return template, nil
+}
+
+/*
+	compactTemplate
+	a yaml or json template as compact json, which cloudformation takes as
+	either
+*/
+func compactTemplate(data []byte) ([]byte, error) {
+	template, err := cloudformation.ParseTemplate(data)
+	if err != nil {
+		return nil, err
+	}
+	return cloudformation.MarshalCompactJson(template)
+}
@@ -0,0 +1,148 @@
+package tasks
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeS3 - a local stand-in for S3, which keeps the objects put to it
+type fakeS3 struct {
+	sync.Mutex
+	t        *testing.T
+	objects  map[string][]byte
+	requests []string
+}
+
+func newFakeS3(t *testing.T) (*artifactStore, *fakeS3, func()) {
+	fake := &fakeS3{t: t, objects: make(map[string][]byte)}
+	server := httptest.NewServer(fake)
+	store := &artifactStore{
+		Bucket: "artifacts",
+		Prefix: "templates",
+		S3: s3.New(session.Must(session.NewSession()), &aws.Config{
+			Credentials:      credentials.NewStaticCredentials("id", "secret", ""),
+			Endpoint:         aws.String(server.URL),
+			Region:           aws.String("ap-southeast-2"),
+			S3ForcePathStyle: aws.Bool(true),
+			MaxRetries:       aws.Int(0),
+		}),
+	}
+	return store, fake, server.Close
+}
+
+func (fake *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fake.Lock()
+	defer fake.Unlock()
+
+	fake.requests = append(fake.requests, fmt.Sprintf("%v %v", r.Method, r.URL.Path))
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch r.Method {
+	case "HEAD":
+		if _, ok := fake.objects[r.URL.Path]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case "PUT":
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			fake.t.Fatal(err)
+		}
+		fake.objects[r.URL.Path] = data
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// largeTemplate - a yaml template of more than size bytes
+func largeTemplate(size int) string {
+	template := "Resources:\n"
+	for i := 0; len(template) <= size; i++ {
+		template += fmt.Sprintf("  Queue%v:\n    Type: AWS::SQS::Queue\n    Properties:\n      QueueName: queue-%v\n", i, i)
+	}
+	return template
+}
+
+func TestArtifactStore_key(t *testing.T) {
+	store := &artifactStore{Bucket: "artifacts", Prefix: "templates", S3: getS3("", "ap-southeast-2", "")}
+	assert.Equal(t, "templates/2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae.yaml", store.key([]byte("foo")))
+	assert.Equal(t, "templates/44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a.json", store.key([]byte("{}")))
+	assert.Equal(t,
+		"https://s3.ap-southeast-2.amazonaws.com/artifacts/templates/test.yaml",
+		store.objectURL("templates/test.yaml"),
+	)
+}
+
+func TestFitTemplate(t *testing.T) {
+	store, fake, close := newFakeS3(t)
+	defer close()
+
+	small := stackTemplate{Body: "Resources: {}"}
+	template, err := fitTemplate(small, store, false)
+	assert.Nil(t, err)
+	assert.Equal(t, small, template)
+	assert.Empty(t, fake.requests)
+
+	large := stackTemplate{Body: largeTemplate(templateBodyLimit)}
+	_, err = fitTemplate(large, nil, false)
+	assert.Contains(t, err.Error(), "--artifactBucket")
+
+	template, err = fitTemplate(large, store, false)
+	assert.Nil(t, err)
+	assert.Empty(t, template.Body)
+	key := store.key([]byte(large.Body))
+	assert.Equal(t, store.objectURL(key), template.URL)
+	assert.Equal(t, large.Body, string(fake.objects["/artifacts/"+key]))
+
+	// the same template isn't uploaded again
+	_, err = fitTemplate(large, store, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"HEAD /artifacts/" + key,
+		"PUT /artifacts/" + key,
+		"HEAD /artifacts/" + key,
+	}, fake.requests)
+}
+
+func TestFitTemplate_compact(t *testing.T) {
+	// indented json that fits once it's compacted
+	body := "{\n  \"Resources\": {\n"
+	for i := 0; len(body) <= templateBodyLimit; i++ {
+		body += fmt.Sprintf("    \"Queue%v\": {\n      \"Type\": \"AWS::SQS::Queue\"\n    },\n", i)
+	}
+	body += "    \"Last\": {\n      \"Type\": \"AWS::SQS::Queue\"\n    }\n  }\n}\n"
+
+	template, err := fitTemplate(stackTemplate{Body: body}, nil, true)
+	assert.Nil(t, err)
+	assert.Empty(t, template.URL)
+	assert.True(t, len(template.Body) <= templateBodyLimit)
+	assert.True(t, strings.HasPrefix(template.Body, `{"Resources":{"Last":{"Type":"AWS::SQS::Queue"}`))
+}
+
+func TestCompactTemplate(t *testing.T) {
+	data, err := compactTemplate([]byte("Resources:\n  Queue:\n    Type: AWS::SQS::Queue\n    Properties:\n      DelaySeconds: 5\n      QueueName: a<b\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Resources":{"Queue":{"Properties":{"DelaySeconds":5,"QueueName":"a<b"},"Type":"AWS::SQS::Queue"}}}`, string(data))
+
+	// short form intrinsic functions are expanded, with GetAtt as a list
+	data, err = compactTemplate([]byte("Conditions:\n  IsProd: !Equals [!Ref Env, prod]\nOutputs:\n  Arn:\n    Value: !GetAtt Queue.Arn\n  Url:\n    Value:\n      Fn::GetAtt: Queue.QueueUrl\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Conditions":{"IsProd":{"Fn::Equals":[{"Ref":"Env"},"prod"]}},"Outputs":{"Arn":{"Value":{"Fn::GetAtt":["Queue","Arn"]}},"Url":{"Value":{"Fn::GetAtt":["Queue","QueueUrl"]}}}}`, string(data))
+
+	data, err = compactTemplate([]byte("Description: \"a !Important note\"\nMetadata:\n  Note: 'see !Ref'\n  Count: !!str 5\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Description":"a !Important note","Metadata":{"Count":"5","Note":"see !Ref"}}`, string(data))
+}
@@ -0,0 +1,371 @@
+package tasks
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/aws/aws-sdk-go/aws"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/urfave/cli"
+)
+
+var Plan_Flags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "region, r",
+		Usage: "region to deploy to",
+		Value: "ap-southeast-2",
+	},
+	cli.StringFlag{
+		Name:  "stackName",
+		Usage: "stack name to deploy (defaults to filename)",
+	},
+	cli.StringFlag{
+		Name:  "changeSetName",
+		Usage: "name of the change set to create (defaults to kombustion-<timestamp>)",
+	},
+
+	// artifact flags
+	cli.StringFlag{
+		Name:   "artifactBucket",
+		Usage:  "S3 bucket to upload templates too large to deploy directly to",
+		EnvVar: "KOMBUSTION_ARTIFACT_BUCKET",
+	},
+	cli.StringFlag{
+		Name:   "artifactPrefix",
+		Usage:  "key prefix for templates uploaded to the artifact bucket",
+		EnvVar: "KOMBUSTION_ARTIFACT_PREFIX",
+	},
+	cli.BoolFlag{
+		Name:  "compact",
+		Usage: "compact templates too large to deploy directly, to avoid uploading them",
+	},
+
+	// cf generate flags
+	cli.StringFlag{
+		Name:  "format, f",
+		Usage: "cf output format (yaml or json)",
+		Value: "yaml",
+	},
+	cli.StringFlag{
+		Name:  "env",
+		Usage: "environment config to use from ./config/environment.yaml",
+	},
+	cli.StringFlag{
+		Name:  "envFile",
+		Usage: "path to the environment.yaml file",
+	},
+	cli.StringSliceFlag{
+		Name:  "param, p",
+		Usage: "cloudformation parameters. eg. ( --param Env=dev --param BucketName=test )",
+	},
+	cli.BoolFlag{
+		Name:  "noBaseOutputs, b",
+		Usage: "disable generation of outputs for Base AWS types",
+	},
+	cli.BoolFlag{
+		Name:  "strict",
+		Usage: "fail on unknown resource types, unknown properties and duplicate keys, instead of warning",
+	},
+	cli.BoolFlag{
+		Name:  "allowIAMUpsert, i",
+		Usage: "gives the capability to perform upserts of IAM resources",
+	},
+}
+
+var Apply_Flags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "region, r",
+		Usage: "region to deploy to",
+		Value: "ap-southeast-2",
+	},
+	cli.StringFlag{
+		Name:  "changeSet, c",
+		Usage: "name or ID of the change set to execute (a name also needs the stack name)",
+	},
+	cli.BoolFlag{
+		Name:  "nestedEvents",
+		Usage: "also print the events of nested stacks",
+	},
+	cli.BoolFlag{
+		Name:  "enableTerminationProtection",
+		Usage: "enable (or with =false, disable) termination protection once the change set is executing",
+	},
+}
+
+// changeSetInput - what to create a change set from
+type changeSetInput struct {
+	StackName     string
+	ChangeSetName string
+	TemplateBody  string
+	TemplateURL   string
+	Parameters    []*awsCF.Parameter
+	Capabilities  []*string
+	Options       cloudformation.StackOptions
+
+	// TemplateParameters - the parameters the template declares
+	TemplateParameters types.ValueMap
+}
+
+func Plan(c *cli.Context) {
+	stackName := c.Args().Get(0)
+	if len(c.String("stackName")) > 0 {
+		stackName = c.String("stackName")
+	}
+
+	changeSetName := c.String("changeSetName")
+	if len(changeSetName) == 0 {
+		changeSetName = fmt.Sprintf("kombustion-%v", time.Now().Unix())
+	}
+
+	capabilities := aws.StringSlice([]string{})
+	if c.Bool("allowIAMUpsert") {
+		capabilities = aws.StringSlice([]string{"CAPABILITY_NAMED_IAM"})
+	}
+
+	data, cfYaml := generateTemplate(c)
+	cf := getCF(c.GlobalString("profile"), c.String("region"), c.GlobalString("endpoint"))
+
+	options, err := resolveStackOptions(c, cfYaml.StackOptions)
+	checkError(err)
+
+	template, err := fitTemplate(stackTemplate{Body: string(data)}, getArtifactStore(c), c.Bool("compact"))
+	checkError(err)
+
+	changeSet, err := planChangeSet(cf, changeSetInput{
+		StackName:     stackName,
+		ChangeSetName: changeSetName,
+		TemplateBody:  template.Body,
+		TemplateURL:   template.URL,
+		Parameters:    resolveParameters(c, cfYaml),
+		Capabilities:  capabilities,
+		Options:       options,
+
+		TemplateParameters: cfYaml.Parameters,
+	})
+	checkError(err)
+
+	if changeSet == nil {
+		fmt.Println("No changes to deploy.")
+		return
+	}
+
+	printChangeSet(os.Stdout, changeSet)
+	fmt.Println()
+	fmt.Println("To deploy these changes run:")
+	apply := fmt.Sprintf("kombustion cf apply --region %v --changeSet %v", c.String("region"), *changeSet.ChangeSetId)
+	if options.EnableTerminationProtection != nil {
+		// change sets can't set termination protection, so apply sets it
+		apply += fmt.Sprintf(" --enableTerminationProtection=%v", *options.EnableTerminationProtection)
+	}
+	fmt.Println("  " + apply)
+}
+
+func Apply(c *cli.Context) {
+	if len(c.String("changeSet")) == 0 {
+		log.Fatal("A change set name or ID is required (--changeSet)")
+	}
+
+	cf := getCF(c.GlobalString("profile"), c.String("region"), c.GlobalString("endpoint"))
+	changeSet, err := applyChangeSet(cf, c.Args().Get(0), c.String("changeSet"))
+	checkError(err)
+
+	if c.IsSet("enableTerminationProtection") {
+		options := cloudformation.StackOptions{EnableTerminationProtection: aws.Bool(c.Bool("enableTerminationProtection"))}
+		checkError(applyTerminationProtection(cf, aws.StringValue(changeSet.StackId), options))
+	}
+
+	// the stack's events since the change set was created are from executing it
+	events := newEventStream(cf, *changeSet.StackId, aws.TimeValue(changeSet.CreationTime), c.Bool("nestedEvents"))
+	waitForUpsert(cf, *changeSet.StackName, events, nil)
+}
+
+/*
+	planChangeSet
+	creates a change set for a stack, creating the stack if it doesn't exist,
+	and waits for it to be ready. A change set without any changes is deleted,
+	and nil is returned
+*/
+func planChangeSet(cf *awsCF.CloudFormation, input changeSetInput) (*awsCF.DescribeChangeSetOutput, error) {
+	changeSetType := awsCF.ChangeSetTypeUpdate
+	status, err := cf.DescribeStacks(&awsCF.DescribeStacksInput{StackName: aws.String(input.StackName)})
+	if err != nil {
+		if !isStackNotFound(err) {
+			return nil, err
+		}
+		changeSetType = awsCF.ChangeSetTypeCreate
+	} else if len(status.Stacks) > 0 && aws.StringValue(status.Stacks[0].StackStatus) == awsCF.StackStatusReviewInProgress {
+		// created by a change set that was never executed
+		changeSetType = awsCF.ChangeSetTypeCreate
+	}
+
+	parameters := input.Parameters
+	if changeSetType == awsCF.ChangeSetTypeUpdate && len(status.Stacks) > 0 {
+		// parameters that aren't given keep their deployed value, instead of their default
+		parameters = usePreviousValues(parameters, status.Stacks[0].Parameters, input.TemplateParameters)
+	}
+	if input.TemplateParameters != nil {
+		if err := checkRequiredParameters(input.TemplateParameters, parameters); err != nil {
+			return nil, err
+		}
+	}
+
+	createInput := &awsCF.CreateChangeSetInput{
+		StackName:     aws.String(input.StackName),
+		ChangeSetName: aws.String(input.ChangeSetName),
+		ChangeSetType: aws.String(changeSetType),
+		TemplateBody:  optionalString(input.TemplateBody),
+		TemplateURL:   optionalString(input.TemplateURL),
+		Parameters:    parameters,
+		Capabilities:  input.Capabilities,
+		Description:   aws.String("Created by kombustion"),
+	}
+	changeSetOptions(createInput, input.Options)
+
+	created, err := cf.CreateChangeSet(createInput)
+	if err != nil {
+		return nil, err
+	}
+
+	changeSet, err := waitForChangeSet(cf, *created.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(changeSet.Status) == awsCF.ChangeSetStatusFailed {
+		if !isEmptyChangeSet(changeSet) {
+			return nil, fmt.Errorf("Change set %v failed: %v", input.ChangeSetName, aws.StringValue(changeSet.StatusReason))
+		}
+		_, err = cf.DeleteChangeSet(&awsCF.DeleteChangeSetInput{ChangeSetName: created.Id})
+		return nil, err
+	}
+
+	return changeSet, nil
+}
+
+/*
+	applyChangeSet
+	executes a change set by its ID, or by its name and stack name
+*/
+func applyChangeSet(cf *awsCF.CloudFormation, stackName, changeSetName string) (*awsCF.DescribeChangeSetOutput, error) {
+	changeSet, err := describeChangeSet(cf, stackName, changeSetName)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(changeSet.ExecutionStatus) != awsCF.ExecutionStatusAvailable {
+		return nil, fmt.Errorf(
+			"Change set %v can't be executed, its status is %v (%v)",
+			changeSetName,
+			aws.StringValue(changeSet.ExecutionStatus),
+			aws.StringValue(changeSet.StatusReason),
+		)
+	}
+
+	printChangeSet(os.Stdout, changeSet)
+	fmt.Println()
+
+	_, err = cf.ExecuteChangeSet(&awsCF.ExecuteChangeSetInput{
+		ChangeSetName: changeSet.ChangeSetId,
+	})
+	return changeSet, err
+}
+
+// waitForChangeSet - polls a change set until it has been created, or has failed
+func waitForChangeSet(cf *awsCF.CloudFormation, changeSetID string) (*awsCF.DescribeChangeSetOutput, error) {
+	for {
+		changeSet, err := describeChangeSet(cf, "", changeSetID)
+		if err != nil {
+			return nil, err
+		}
+		switch aws.StringValue(changeSet.Status) {
+		case awsCF.ChangeSetStatusCreatePending, awsCF.ChangeSetStatusCreateInProgress:
+			time.Sleep(pollInterval)
+		default:
+			return changeSet, nil
+		}
+	}
+}
+
+// describeChangeSet - describes a change set, with all pages of its changes
+func describeChangeSet(cf *awsCF.CloudFormation, stackName, changeSetName string) (*awsCF.DescribeChangeSetOutput, error) {
+	input := &awsCF.DescribeChangeSetInput{ChangeSetName: aws.String(changeSetName)}
+	if len(stackName) > 0 {
+		input.StackName = aws.String(stackName)
+	}
+
+	changeSet, err := cf.DescribeChangeSet(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for page := changeSet; page.NextToken != nil; {
+		input.NextToken = page.NextToken
+		if page, err = cf.DescribeChangeSet(input); err != nil {
+			return nil, err
+		}
+		changeSet.Changes = append(changeSet.Changes, page.Changes...)
+	}
+	changeSet.NextToken = nil
+
+	return changeSet, nil
+}
+
+func isEmptyChangeSet(changeSet *awsCF.DescribeChangeSetOutput) bool {
+	reason := aws.StringValue(changeSet.StatusReason)
+	return len(changeSet.Changes) == 0 &&
+		(strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed"))
+}
+
+func isStackNotFound(err error) bool {
+	return strings.Contains(err.Error(), "Stack with id") && strings.Contains(err.Error(), "does not exist")
+}
+
+/*
+	printChangeSet
+	prints the Add/Modify/Remove action for each resource in a change set,
+	with whether it'll be replaced, and what caused the change
+*/
+func printChangeSet(w io.Writer, changeSet *awsCF.DescribeChangeSetOutput) {
+	fmt.Fprintf(w, "Change set %v for stack %v:\n", aws.StringValue(changeSet.ChangeSetName), aws.StringValue(changeSet.StackName))
+	fmt.Fprintln(w)
+	row := func(columns ...interface{}) {
+		line := fmt.Sprintf(" %-8v | %-30v | %-40v | %-11v | %v", columns...)
+		fmt.Fprintln(w, strings.TrimRight(line, " "))
+	}
+
+	row("Action", "LogicalID", "Type", "Replacement", "Scope")
+	for _, change := range changeSet.Changes {
+		resource := change.ResourceChange
+		if resource == nil {
+			continue
+		}
+		row(
+			aws.StringValue(resource.Action),
+			aws.StringValue(resource.LogicalResourceId),
+			aws.StringValue(resource.ResourceType),
+			aws.StringValue(resource.Replacement),
+			strings.Join(aws.StringValueSlice(resource.Scope), ", "),
+		)
+		for _, detail := range resource.Details {
+			if detail.Target == nil {
+				continue
+			}
+			target := aws.StringValue(detail.Target.Attribute)
+			if detail.Target.Name != nil {
+				target += "." + aws.StringValue(detail.Target.Name)
+			}
+			fmt.Fprintf(w, "            %v (%v, recreation: %v)\n",
+				target,
+				aws.StringValue(detail.ChangeSource),
+				aws.StringValue(detail.Target.RequiresRecreation),
+			)
+		}
+	}
+}
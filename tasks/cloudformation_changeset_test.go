@@ -0,0 +1,203 @@
+package tasks
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/aws/aws-sdk-go/aws"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/assert"
+)
+
+const testChangeSetID = "arn:aws:cloudformation:ap-southeast-2:123456789012:changeSet/test-plan/1"
+
+const testChanges = `<Changes>
+  <member><Type>Resource</Type><ResourceChange>
+    <Action>Add</Action><LogicalResourceId>testTopic</LogicalResourceId><ResourceType>AWS::SNS::Topic</ResourceType>
+  </ResourceChange></member>
+  <member><Type>Resource</Type><ResourceChange>
+    <Action>Modify</Action><LogicalResourceId>testBucket</LogicalResourceId><ResourceType>AWS::S3::Bucket</ResourceType>
+    <Replacement>True</Replacement><Scope><member>Properties</member></Scope>
+    <Details><member>
+      <Target><Attribute>Properties</Attribute><Name>BucketName</Name><RequiresRecreation>Always</RequiresRecreation></Target>
+      <Evaluation>Static</Evaluation><ChangeSource>DirectModification</ChangeSource>
+    </member></Details>
+  </ResourceChange></member>
+</Changes>`
+
+func TestPlanChangeSet_update(t *testing.T) {
+	cf, fake, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DescribeStacks":  {fakeStack("test", "UPDATE_COMPLETE")},
+		"CreateChangeSet": {"<Id>" + testChangeSetID + "</Id>"},
+		"DescribeChangeSet": {
+			"<ChangeSetName>test-plan</ChangeSetName><ChangeSetId>" + testChangeSetID + "</ChangeSetId><StackName>test</StackName><Status>CREATE_IN_PROGRESS</Status>",
+			"<ChangeSetName>test-plan</ChangeSetName><ChangeSetId>" + testChangeSetID + "</ChangeSetId><StackName>test</StackName><Status>CREATE_COMPLETE</Status><ExecutionStatus>AVAILABLE</ExecutionStatus>" + testChanges,
+		},
+	})
+	defer closeFake()
+
+	changeSet, err := planChangeSet(cf, changeSetInput{
+		StackName:     "test",
+		ChangeSetName: "test-plan",
+		TemplateBody:  "Resources: {}",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"DescribeStacks", "CreateChangeSet", "DescribeChangeSet", "DescribeChangeSet"}, fake.actions())
+	assert.Equal(t, "UPDATE", fake.request("CreateChangeSet").Get("ChangeSetType"))
+	assert.Equal(t, "Resources: {}", fake.request("CreateChangeSet").Get("TemplateBody"))
+
+	var output bytes.Buffer
+	printChangeSet(&output, changeSet)
+	assert.Equal(t, `Change set test-plan for stack test:
+
+ Action   | LogicalID                      | Type                                     | Replacement | Scope
+ Add      | testTopic                      | AWS::SNS::Topic                          |             |
+ Modify   | testBucket                     | AWS::S3::Bucket                          | True        | Properties
+            Properties.BucketName (DirectModification, recreation: Always)
+`, output.String())
+}
+
+func TestPlanChangeSet_create(t *testing.T) {
+	cf, fake, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DescribeStacks":    {fakeError("Stack with id test does not exist")},
+		"CreateChangeSet":   {"<Id>" + testChangeSetID + "</Id>"},
+		"DescribeChangeSet": {"<ChangeSetId>" + testChangeSetID + "</ChangeSetId><Status>CREATE_COMPLETE</Status>" + testChanges},
+	})
+	defer closeFake()
+
+	changeSet, err := planChangeSet(cf, changeSetInput{StackName: "test", ChangeSetName: "test-plan", TemplateBody: "Resources: {}"})
+	assert.Nil(t, err)
+	assert.Len(t, changeSet.Changes, 2)
+	assert.Equal(t, "CREATE", fake.request("CreateChangeSet").Get("ChangeSetType"))
+}
+
+func TestPlanChangeSet_options(t *testing.T) {
+	cf, fake, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DescribeStacks":    {fakeError("Stack with id test does not exist")},
+		"CreateChangeSet":   {"<Id>" + testChangeSetID + "</Id>"},
+		"DescribeChangeSet": {"<ChangeSetId>" + testChangeSetID + "</ChangeSetId><Status>CREATE_COMPLETE</Status>" + testChanges},
+	})
+	defer closeFake()
+
+	_, err := planChangeSet(cf, changeSetInput{
+		StackName:     "test",
+		ChangeSetName: "test-plan",
+		TemplateBody:  "Resources: {}",
+		Options:       testStackOptions(),
+	})
+	assert.Nil(t, err)
+
+	request := fake.request("CreateChangeSet")
+	assert.Equal(t, "Env", request.Get("Tags.member.1.Key"))
+	assert.Equal(t, "prod", request.Get("Tags.member.1.Value"))
+	assert.Equal(t, "Team", request.Get("Tags.member.2.Key"))
+	assert.Equal(t, "arn:aws:iam::123456789012:role/deploy", request.Get("RoleARN"))
+	assert.Equal(t, "arn:aws:sns:ap-southeast-2:123456789012:deploys", request.Get("NotificationARNs.member.1"))
+	assert.Equal(t, "10", request.Get("RollbackConfiguration.MonitoringTimeInMinutes"))
+	assert.Equal(t, "arn:aws:cloudwatch:ap-southeast-2:123456789012:alarm:errors", request.Get("RollbackConfiguration.RollbackTriggers.member.1.Arn"))
+
+	// change sets don't take these
+	assert.Empty(t, request.Get("EnableTerminationProtection"))
+	assert.Empty(t, request.Get("TimeoutInMinutes"))
+	assert.Empty(t, request.Get("OnFailure"))
+}
+
+func TestPlanChangeSet_empty(t *testing.T) {
+	cf, fake, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DescribeStacks":  {fakeStack("test", "UPDATE_COMPLETE")},
+		"CreateChangeSet": {"<Id>" + testChangeSetID + "</Id>"},
+		"DescribeChangeSet": {
+			"<ChangeSetId>" + testChangeSetID + "</ChangeSetId><Status>FAILED</Status><StatusReason>The submitted information didn't contain changes. Submit different information to create a change set.</StatusReason>",
+		},
+		"DeleteChangeSet": {""},
+	})
+	defer closeFake()
+
+	changeSet, err := planChangeSet(cf, changeSetInput{StackName: "test", ChangeSetName: "test-plan", TemplateBody: "Resources: {}"})
+	assert.Nil(t, err)
+	assert.Nil(t, changeSet)
+	assert.Equal(t, []string{"DescribeStacks", "CreateChangeSet", "DescribeChangeSet", "DeleteChangeSet"}, fake.actions())
+	assert.Equal(t, testChangeSetID, fake.request("DeleteChangeSet").Get("ChangeSetName"))
+}
+
+func TestPlanChangeSet_failed(t *testing.T) {
+	cf, _, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DescribeStacks":    {fakeStack("test", "UPDATE_COMPLETE")},
+		"CreateChangeSet":   {"<Id>" + testChangeSetID + "</Id>"},
+		"DescribeChangeSet": {"<Status>FAILED</Status><StatusReason>Template format error</StatusReason>"},
+	})
+	defer closeFake()
+
+	_, err := planChangeSet(cf, changeSetInput{StackName: "test", ChangeSetName: "test-plan", TemplateBody: "Resources: {}"})
+	assert.EqualError(t, err, "Change set test-plan failed: Template format error")
+}
+
+func TestApplyChangeSet(t *testing.T) {
+	cf, fake, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DescribeChangeSet": {"<ChangeSetName>test-plan</ChangeSetName><ChangeSetId>" + testChangeSetID + "</ChangeSetId><StackName>test</StackName><Status>CREATE_COMPLETE</Status><ExecutionStatus>AVAILABLE</ExecutionStatus>"},
+		"ExecuteChangeSet":  {""},
+	})
+	defer closeFake()
+
+	changeSet, err := applyChangeSet(cf, "test", "test-plan")
+	assert.Nil(t, err)
+	assert.Equal(t, "test", aws.StringValue(changeSet.StackName))
+	assert.Equal(t, "test", fake.request("DescribeChangeSet").Get("StackName"))
+	assert.Equal(t, testChangeSetID, fake.request("ExecuteChangeSet").Get("ChangeSetName"))
+}
+
+func TestApplyChangeSet_unavailable(t *testing.T) {
+	cf, fake, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DescribeChangeSet": {"<ChangeSetId>" + testChangeSetID + "</ChangeSetId><Status>CREATE_COMPLETE</Status><ExecutionStatus>EXECUTE_COMPLETE</ExecutionStatus>"},
+	})
+	defer closeFake()
+
+	_, err := applyChangeSet(cf, "", testChangeSetID)
+	assert.EqualError(t, err, "Change set "+testChangeSetID+" can't be executed, its status is EXECUTE_COMPLETE ()")
+	assert.Equal(t, []string{"DescribeChangeSet"}, fake.actions())
+}
+
+func TestPlanChangeSet_parameters(t *testing.T) {
+	cf, fake, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DescribeStacks": {`<Stacks><member>
+  <StackName>test</StackName><StackStatus>UPDATE_COMPLETE</StackStatus><CreationTime>2018-01-01T00:00:00Z</CreationTime>
+  <Parameters>
+    <member><ParameterKey>Env</ParameterKey><ParameterValue>dev</ParameterValue></member>
+    <member><ParameterKey>Size</ParameterKey><ParameterValue>small</ParameterValue></member>
+  </Parameters>
+</member></Stacks>`},
+		"CreateChangeSet":   {"<Id>" + testChangeSetID + "</Id>"},
+		"DescribeChangeSet": {"<ChangeSetId>" + testChangeSetID + "</ChangeSetId><Status>CREATE_COMPLETE</Status>" + testChanges},
+	})
+	defer closeFake()
+
+	templateParameters := types.ValueMap{
+		"Env":  map[interface{}]interface{}{"Type": "String"},
+		"Size": map[interface{}]interface{}{"Type": "String"},
+		"Name": map[interface{}]interface{}{"Type": "String"},
+	}
+	_, err := planChangeSet(cf, changeSetInput{
+		StackName:          "test",
+		ChangeSetName:      "test-plan",
+		TemplateBody:       "Resources: {}",
+		Parameters:         []*awsCF.Parameter{testParameter("Env", "prod")},
+		TemplateParameters: templateParameters,
+	})
+	assert.EqualError(t, err, "Missing a value for required parameter(s) Name, which have no Default")
+	assert.Equal(t, []string{"DescribeStacks"}, fake.actions())
+
+	_, err = planChangeSet(cf, changeSetInput{
+		StackName:          "test",
+		ChangeSetName:      "test-plan",
+		TemplateBody:       "Resources: {}",
+		Parameters:         []*awsCF.Parameter{testParameter("Env", "prod"), testParameter("Name", "api")},
+		TemplateParameters: templateParameters,
+	})
+	assert.Nil(t, err)
+	request := fake.request("CreateChangeSet")
+	assert.Equal(t, "Env", request.Get("Parameters.member.1.ParameterKey"))
+	assert.Equal(t, "prod", request.Get("Parameters.member.1.ParameterValue"))
+	assert.Equal(t, "Size", request.Get("Parameters.member.3.ParameterKey"))
+	assert.Equal(t, "true", request.Get("Parameters.member.3.UsePreviousValue"))
+}
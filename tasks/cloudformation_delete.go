@@ -5,6 +5,8 @@ import (
 	"os"
 	"time"
 
+	log "github.com/sirupsen/logrus"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/urfave/cli"
@@ -16,10 +18,14 @@ var Delete_Flags = []cli.Flag{
 		Usage: "region to delete from",
 		Value: "ap-southeast-2",
 	},
+	cli.BoolFlag{
+		Name:  "nestedEvents",
+		Usage: "also print the events of nested stacks",
+	},
 }
 
 func Delete(c *cli.Context) {
-	deleteStack(c, getCF(c.GlobalString("profile"), c.String("region")))
+	deleteStack(c, getCF(c.GlobalString("profile"), c.String("region"), c.GlobalString("endpoint")))
 }
 
 func deleteStack(c *cli.Context, cf *cloudformation.CloudFormation) {
@@ -29,26 +35,35 @@ func deleteStack(c *cli.Context, cf *cloudformation.CloudFormation) {
 	_, err := cf.DescribeStacks(&cloudformation.DescribeStacksInput{StackName: aws.String(stackName)})
 	checkError(err)
 
+	events := startEventStream(cf, stackName, c.Bool("nestedEvents"))
+
 	_, err = cf.DeleteStack(&cloudformation.DeleteStackInput{StackName: aws.String(stackName)})
 	checkError(err)
 
 	// status polling
 	for {
-		time.Sleep(2 * time.Second)
+		time.Sleep(pollInterval)
+		checkError(events.poll(os.Stdout))
 		status, _ := cf.DescribeStacks(&cloudformation.DescribeStacksInput{StackName: aws.String(stackName)})
 
 		if len(status.Stacks) > 0 {
 			stackStatus := *status.Stacks[0].StackStatus
-			fmt.Println(stackStatus)
 			if stackStatus == cloudformation.StackStatusDeleteInProgress {
 				continue
 			}
 		}
 		break
 	}
+	// print the events that happened since the last poll
+	checkError(events.poll(os.Stdout))
+
+	status, err := cf.DescribeStacks(&cloudformation.DescribeStacksInput{StackName: aws.String(stackName)})
+	if err == nil && len(status.Stacks) > 0 && *status.Stacks[0].StackStatus == cloudformation.StackStatusDeleteFailed {
+		log.Error("Delete Failed: ", *status.Stacks[0].StackStatus)
+		os.Exit(1)
+	}
 
 	// Make sure delete worked
-	_, err = cf.DescribeStacks(&cloudformation.DescribeStacksInput{StackName: aws.String(stackName)})
 	if err != nil {
 		checkErrorDeletePoll(err)
 	} else {
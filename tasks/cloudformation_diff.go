@@ -0,0 +1,278 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	"github.com/aws/aws-sdk-go/aws"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/urfave/cli"
+)
+
+var Diff_Flags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "region, r",
+		Usage: "region the stack is deployed to",
+		Value: "ap-southeast-2",
+	},
+	cli.StringFlag{
+		Name:  "stackName",
+		Usage: "stack name to compare with (defaults to filename)",
+	},
+	cli.StringFlag{
+		Name:  "against",
+		Usage: "a previously compiled template to compare with, instead of the deployed stack",
+	},
+	cli.StringFlag{
+		Name:  "format, f",
+		Usage: "diff output format (text or json)",
+		Value: "text",
+	},
+
+	// cf generate flags
+	cli.StringSliceFlag{
+		Name:  "env",
+		Usage: "environment config to use from ./config/environment.yaml, give two to compare environments. eg. ( --env dev --env prod )",
+	},
+	cli.StringFlag{
+		Name:  "envFile",
+		Usage: "path to the environment.yaml file",
+	},
+	cli.StringSliceFlag{
+		Name:  "param, p",
+		Usage: "cloudformation parameters. eg. ( --param Env=dev --param BucketName=test )",
+	},
+	cli.BoolFlag{
+		Name:  "noBaseOutputs, b",
+		Usage: "disable generation of outputs for Base AWS types",
+	},
+	cli.BoolFlag{
+		Name:  "strict",
+		Usage: "fail on unknown resource types, unknown properties and duplicate keys, instead of warning",
+	},
+}
+
+func Diff(c *cli.Context) {
+	envs := c.StringSlice("env")
+	if len(envs) > 2 {
+		log.Fatal("Only two environments can be compared")
+	}
+
+	var diff cloudformation.TemplateDiff
+	var err error
+	if len(envs) == 2 {
+		// compare environments offline
+		diff, err = diffEnvironments(c, envs[0], envs[1])
+		checkError(err)
+	} else {
+		env := ""
+		if len(envs) == 1 {
+			env = envs[0]
+		}
+		stack := generateStackForEnv(c, env)
+
+		if len(c.String("against")) > 0 {
+			data, err := ioutil.ReadFile(c.String("against"))
+			checkError(err)
+			before, err := cloudformation.ParseTemplate(data)
+			checkError(err)
+			diff, err = cloudformation.DiffStack(before, stack)
+			checkError(err)
+		} else {
+			stackName := c.Args().Get(0)
+			if len(c.String("stackName")) > 0 {
+				stackName = c.String("stackName")
+			}
+			cf := getCF(c.GlobalString("profile"), c.String("region"), c.GlobalString("endpoint"))
+			parameters := parameterValues(resolveParametersForEnv(c, env, stack))
+			diff, err = diffDeployedStack(cf, stackName, stack, parameters)
+			checkError(err)
+		}
+	}
+
+	switch c.String("format") {
+	case "text":
+		printDiff(os.Stdout, diff)
+	case "json":
+		output, err := json.MarshalIndent(diff, "", "  ")
+		checkError(err)
+		fmt.Println(string(output))
+	default:
+		log.Fatal("Output format not supported: ", c.String("format"))
+	}
+}
+
+/*
+	diffEnvironments
+	compiles the config for two environments, and compares the templates and
+	the parameters each would be deployed with
+*/
+func diffEnvironments(c *cli.Context, beforeEnv, afterEnv string) (diff cloudformation.TemplateDiff, err error) {
+	before := generateStackForEnv(c, beforeEnv)
+	after := generateStackForEnv(c, afterEnv)
+	if diff, err = cloudformation.DiffStacks(before, after); err != nil {
+		return
+	}
+
+	diff.Parameters = cloudformation.DiffParameters(
+		parameterValues(resolveParametersForEnv(c, beforeEnv, before)),
+		parameterValues(resolveParametersForEnv(c, afterEnv, after)),
+	)
+	return
+}
+
+/*
+	diffDeployedStack
+	compares a compiled stack, and the parameters it would be deployed with,
+	against the template and parameters of the deployed stack
+*/
+func diffDeployedStack(cf *awsCF.CloudFormation, stackName string, stack cloudformation.YamlCloudformation, parameters map[string]string) (diff cloudformation.TemplateDiff, err error) {
+	deployed, err := getDeployedTemplate(cf, stackName)
+	if err != nil {
+		return
+	}
+	if diff, err = cloudformation.DiffStack(deployed, stack); err != nil {
+		return
+	}
+
+	status, err := cf.DescribeStacks(&awsCF.DescribeStacksInput{StackName: aws.String(stackName)})
+	if err != nil {
+		return
+	}
+	deployedParameters := make(map[string]string)
+	if len(status.Stacks) > 0 {
+		deployedParameters = parameterValues(status.Stacks[0].Parameters)
+	}
+	given := make(map[string]string)
+	for k, v := range parameters {
+		given[k] = v
+	}
+	for k, v := range deployedParameters {
+		if _, ok := given[k]; !ok {
+			if _, declared := stack.Parameters[k]; declared {
+				// upserts keep the deployed value of parameters that aren't given
+				given[k] = v
+			}
+		}
+		if v == noEchoValue {
+			// NoEcho parameters can't be compared
+			deployedParameters[k] = given[k]
+		}
+	}
+	diff.Parameters = cloudformation.DiffParameters(deployedParameters, given)
+	return
+}
+
+// noEchoValue - how cloudformation describes the value of a NoEcho parameter
+const noEchoValue = "****"
+
+func parameterValues(parameters []*awsCF.Parameter) map[string]string {
+	values := make(map[string]string)
+	for _, parameter := range parameters {
+		values[aws.StringValue(parameter.ParameterKey)] = aws.StringValue(parameter.ParameterValue)
+	}
+	return values
+}
+
+// getDeployedTemplate - fetches and parses the template a stack was deployed with
+func getDeployedTemplate(cf *awsCF.CloudFormation, stackName string) (map[string]interface{}, error) {
+	output, err := cf.GetTemplate(&awsCF.GetTemplateInput{StackName: aws.String(stackName)})
+	if err != nil {
+		return nil, err
+	}
+	return cloudformation.ParseTemplate([]byte(aws.StringValue(output.TemplateBody)))
+}
+
+/*
+	checkReplacements
+	predicts which resources an update to a deployed stack will replace, and
+	returns an error if any will be, unless replacement is allowed
+*/
+func checkReplacements(cf *awsCF.CloudFormation, stackName string, template map[string]interface{}, allowReplacement bool) error {
+	deployed, err := getDeployedTemplate(cf, stackName)
+	if err != nil {
+		return err
+	}
+	replacements := cloudformation.PredictTemplateReplacements(deployed, template)
+
+	replaced := 0
+	for _, replacement := range replacements {
+		log.Warn(replacement)
+		if !replacement.Conditional {
+			replaced++
+		}
+	}
+	if replaced > 0 && !allowReplacement {
+		return fmt.Errorf("%v resource(s) will be replaced by this update, use --allow-replacement to deploy it anyway", replaced)
+	}
+	return nil
+}
+
+// printDiff - prints a template diff, as +/-/~ lines grouped by section
+func printDiff(w io.Writer, diff cloudformation.TemplateDiff) {
+	if diff.Empty() {
+		fmt.Fprintln(w, "No differences.")
+		return
+	}
+
+	if len(diff.Resources) > 0 {
+		fmt.Fprintln(w, "Resources")
+		for _, resource := range diff.Resources {
+			line := fmt.Sprintf("  %v %v (%v)", diffSymbol(resource.Action), resource.LogicalID, resource.Type)
+			if resource.Replacement != nil {
+				line += " " + resource.Replacement.Reason()
+			}
+			fmt.Fprintln(w, line)
+			printChanges(w, "      ", resource.Changes)
+		}
+	}
+	if len(diff.Outputs) > 0 {
+		fmt.Fprintln(w, "Outputs")
+		printChanges(w, "  ", diff.Outputs)
+	}
+	if len(diff.Parameters) > 0 {
+		fmt.Fprintln(w, "Parameters")
+		printChanges(w, "  ", diff.Parameters)
+	}
+	if len(diff.Template) > 0 {
+		fmt.Fprintln(w, "Template")
+		printChanges(w, "  ", diff.Template)
+	}
+}
+
+func printChanges(w io.Writer, indent string, changes []cloudformation.ValueChange) {
+	for _, change := range changes {
+		switch change.Action {
+		case cloudformation.ChangeAdd:
+			fmt.Fprintf(w, "%v+ %v: %v\n", indent, change.Path, diffValue(change.After))
+		case cloudformation.ChangeRemove:
+			fmt.Fprintf(w, "%v- %v: %v\n", indent, change.Path, diffValue(change.Before))
+		default:
+			fmt.Fprintf(w, "%v~ %v: %v => %v\n", indent, change.Path, diffValue(change.Before), diffValue(change.After))
+		}
+	}
+}
+
+func diffSymbol(action string) string {
+	switch action {
+	case cloudformation.ChangeAdd:
+		return "+"
+	case cloudformation.ChangeRemove:
+		return "-"
+	}
+	return "~"
+}
+
+func diffValue(value interface{}) string {
+	output, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(output)
+}
@@ -0,0 +1,110 @@
+package tasks
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	"github.com/KablamoOSS/kombustion/parsers/resources"
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDeployedTemplate = `<TemplateBody>{
+  "Resources": {
+    "testBucket": {
+      "Type": "AWS::S3::Bucket",
+      "Properties": { "BucketName": "old-bucket" }
+    }
+  }
+}</TemplateBody>`
+
+// compiledTemplate - a stack as it's deployed
+func compiledTemplate(t *testing.T, stack cloudformation.YamlCloudformation) map[string]interface{} {
+	data, err := cloudformation.MarshalJson(stack)
+	assert.Nil(t, err)
+	template, err := cloudformation.ParseTemplate(data)
+	assert.Nil(t, err)
+	return template
+}
+
+func TestCheckReplacements(t *testing.T) {
+	cf, fake, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"GetTemplate": {testDeployedTemplate},
+	})
+	defer closeFake()
+
+	stack := cloudformation.YamlCloudformation{
+		Resources: types.ValueMap{
+			"testBucket": resources.NewS3Bucket(resources.S3BucketProperties{BucketName: "new-bucket"}),
+		},
+	}
+
+	err := checkReplacements(cf, "test", compiledTemplate(t, stack), false)
+	assert.EqualError(t, err, "1 resource(s) will be replaced by this update, use --allow-replacement to deploy it anyway")
+	assert.Equal(t, "test", fake.request("GetTemplate").Get("StackName"))
+
+	err = checkReplacements(cf, "test", compiledTemplate(t, stack), true)
+	assert.Nil(t, err)
+}
+
+func TestCheckReplacements_mutable(t *testing.T) {
+	cf, _, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"GetTemplate": {testDeployedTemplate},
+	})
+	defer closeFake()
+
+	stack := cloudformation.YamlCloudformation{
+		Resources: types.ValueMap{
+			"testBucket": resources.NewS3Bucket(resources.S3BucketProperties{
+				BucketName:    "old-bucket",
+				AccessControl: "Private",
+			}),
+		},
+	}
+
+	assert.Nil(t, checkReplacements(cf, "test", compiledTemplate(t, stack), false))
+}
+
+func TestDiffDeployedStack(t *testing.T) {
+	cf, _, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"GetTemplate": {testDeployedTemplate},
+		"DescribeStacks": {`<Stacks><member>
+  <StackName>test</StackName><StackStatus>UPDATE_COMPLETE</StackStatus><CreationTime>2018-01-01T00:00:00Z</CreationTime>
+  <Parameters>
+    <member><ParameterKey>Env</ParameterKey><ParameterValue>dev</ParameterValue></member>
+    <member><ParameterKey>Password</ParameterKey><ParameterValue>****</ParameterValue></member>
+  </Parameters>
+</member></Stacks>`},
+	})
+	defer closeFake()
+
+	stack := cloudformation.YamlCloudformation{
+		Resources: types.ValueMap{
+			"testBucket": resources.NewS3Bucket(resources.S3BucketProperties{
+				BucketName:    "new-bucket",
+				AccessControl: "Private",
+			}),
+		},
+		Outputs: types.ValueMap{
+			"testBucketArn": map[string]interface{}{
+				"Value": map[string]interface{}{"Fn::GetAtt": "testBucket.Arn"},
+			},
+		},
+	}
+
+	diff, err := diffDeployedStack(cf, "test", stack, map[string]string{"Env": "prod", "Password": "secret"})
+	assert.Nil(t, err)
+
+	var output bytes.Buffer
+	printDiff(&output, diff)
+	assert.Equal(t, `Resources
+  ~ testBucket (AWS::S3::Bucket) will be replaced, because BucketName changed
+      + Properties.AccessControl: "Private"
+      ~ Properties.BucketName: "old-bucket" => "new-bucket"
+Outputs
+  + testBucketArn: {"Value":{"Fn::GetAtt":"testBucket.Arn"}}
+Parameters
+  ~ Env: "dev" => "prod"
+`, output.String())
+}
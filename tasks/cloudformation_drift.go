@@ -0,0 +1,173 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/urfave/cli"
+)
+
+var Drift_Flags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "region, r",
+		Usage: "region the stack is deployed to",
+		Value: "ap-southeast-2",
+	},
+	cli.StringFlag{
+		Name:  "format, f",
+		Usage: "drift output format (table or json)",
+		Value: "table",
+	},
+}
+
+func Drift(c *cli.Context) {
+	stackName := c.Args().Get(0)
+	if len(stackName) == 0 {
+		log.Fatal("A stack name is required")
+	}
+
+	cf := getCF(c.GlobalString("profile"), c.String("region"), c.GlobalString("endpoint"))
+	report, err := detectDrift(cf, stackName)
+	checkError(err)
+
+	switch c.String("format") {
+	case "table":
+		printDrift(os.Stdout, report)
+	case "json":
+		output, err := json.MarshalIndent(report, "", "  ")
+		checkError(err)
+		fmt.Println(string(output))
+	default:
+		log.Fatal("Output format not supported: ", c.String("format"))
+	}
+
+	if report.StackDriftStatus != awsCF.StackDriftStatusInSync {
+		os.Exit(1)
+	}
+}
+
+// driftReport - the drift status of a stack, and its resources that have drifted
+type driftReport struct {
+	StackName        string          `json:"stackName"`
+	StackID          string          `json:"stackId"`
+	StackDriftStatus string          `json:"stackDriftStatus"`
+	Resources        []resourceDrift `json:"resources"`
+}
+
+// resourceDrift - a resource that has been modified or deleted outside of cloudformation
+type resourceDrift struct {
+	LogicalID   string               `json:"logicalId"`
+	PhysicalID  string               `json:"physicalId"`
+	Type        string               `json:"type"`
+	DriftStatus string               `json:"driftStatus"`
+	Differences []propertyDifference `json:"differences"`
+}
+
+type propertyDifference struct {
+	Path           string `json:"path"`
+	DifferenceType string `json:"differenceType"`
+	Expected       string `json:"expected"`
+	Actual         string `json:"actual"`
+}
+
+/*
+	detectDrift
+	starts drift detection on a stack, waits for it to finish, and describes
+	each resource that has been modified or deleted
+*/
+func detectDrift(cf *awsCF.CloudFormation, stackName string) (report driftReport, err error) {
+	report = driftReport{StackName: stackName, Resources: []resourceDrift{}}
+
+	detection, err := cf.DetectStackDrift(&awsCF.DetectStackDriftInput{StackName: aws.String(stackName)})
+	if err != nil {
+		return
+	}
+
+	var status *awsCF.DescribeStackDriftDetectionStatusOutput
+	for {
+		status, err = cf.DescribeStackDriftDetectionStatus(&awsCF.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: detection.StackDriftDetectionId,
+		})
+		if err != nil {
+			return
+		}
+		if aws.StringValue(status.DetectionStatus) != awsCF.StackDriftDetectionStatusDetectionInProgress {
+			break
+		}
+		time.Sleep(pollInterval)
+	}
+	if aws.StringValue(status.DetectionStatus) == awsCF.StackDriftDetectionStatusDetectionFailed && status.StackDriftStatus == nil {
+		err = fmt.Errorf("Drift detection failed: %v", aws.StringValue(status.DetectionStatusReason))
+		return
+	}
+	if aws.StringValue(status.DetectionStatus) == awsCF.StackDriftDetectionStatusDetectionFailed {
+		// some resources couldn't be checked, but the rest of the results are usable
+		log.Warn("Drift detection failed for some resources: ", aws.StringValue(status.DetectionStatusReason))
+	}
+	report.StackID = aws.StringValue(status.StackId)
+	report.StackDriftStatus = aws.StringValue(status.StackDriftStatus)
+
+	input := &awsCF.DescribeStackResourceDriftsInput{
+		StackName: aws.String(stackName),
+		StackResourceDriftStatusFilters: aws.StringSlice([]string{
+			awsCF.StackResourceDriftStatusModified,
+			awsCF.StackResourceDriftStatusDeleted,
+		}),
+	}
+	err = cf.DescribeStackResourceDriftsPages(input, func(page *awsCF.DescribeStackResourceDriftsOutput, lastPage bool) bool {
+		for _, drift := range page.StackResourceDrifts {
+			report.Resources = append(report.Resources, newResourceDrift(drift))
+		}
+		return true
+	})
+	return
+}
+
+func newResourceDrift(drift *awsCF.StackResourceDrift) resourceDrift {
+	resource := resourceDrift{
+		LogicalID:   aws.StringValue(drift.LogicalResourceId),
+		PhysicalID:  aws.StringValue(drift.PhysicalResourceId),
+		Type:        aws.StringValue(drift.ResourceType),
+		DriftStatus: aws.StringValue(drift.StackResourceDriftStatus),
+		Differences: []propertyDifference{},
+	}
+	for _, difference := range drift.PropertyDifferences {
+		resource.Differences = append(resource.Differences, propertyDifference{
+			Path:           aws.StringValue(difference.PropertyPath),
+			DifferenceType: aws.StringValue(difference.DifferenceType),
+			Expected:       aws.StringValue(difference.ExpectedValue),
+			Actual:         aws.StringValue(difference.ActualValue),
+		})
+	}
+	return resource
+}
+
+// printDrift - prints each drifted resource, with its expected and actual property values
+func printDrift(w io.Writer, report driftReport) {
+	fmt.Fprintf(w, "Stack %v is %v\n", report.StackName, report.StackDriftStatus)
+	if len(report.Resources) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w)
+	row := func(columns ...interface{}) {
+		line := fmt.Sprintf(" %-8v | %-30v | %-40v | %v", columns...)
+		fmt.Fprintln(w, strings.TrimRight(line, " "))
+	}
+	row("Drift", "LogicalID", "Type", "PhysicalID")
+	for _, resource := range report.Resources {
+		row(resource.DriftStatus, resource.LogicalID, resource.Type, resource.PhysicalID)
+		for _, difference := range resource.Differences {
+			fmt.Fprintf(w, "            %v %v: expected %v, actual %v\n",
+				difference.DifferenceType, difference.Path, difference.Expected, difference.Actual)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package tasks
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDriftDetectionID = "b78ac9b0-dec1-11e7-a451-503a3e7b3b5d"
+
+func TestDetectDrift(t *testing.T) {
+	cf, fake, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DetectStackDrift": {"<StackDriftDetectionId>" + testDriftDetectionID + "</StackDriftDetectionId>"},
+		"DescribeStackDriftDetectionStatus": {
+			"<StackDriftDetectionId>" + testDriftDetectionID + "</StackDriftDetectionId><DetectionStatus>DETECTION_IN_PROGRESS</DetectionStatus>",
+			"<StackId>arn:aws:cloudformation:ap-southeast-2:123456789012:stack/test/1</StackId><StackDriftDetectionId>" + testDriftDetectionID + "</StackDriftDetectionId><DetectionStatus>DETECTION_COMPLETE</DetectionStatus><StackDriftStatus>DRIFTED</StackDriftStatus><DriftedStackResourceCount>2</DriftedStackResourceCount>",
+		},
+		"DescribeStackResourceDrifts": {
+			`<StackResourceDrifts>
+  <member>
+    <LogicalResourceId>testQueue</LogicalResourceId><PhysicalResourceId>https://sqs.ap-southeast-2.amazonaws.com/123456789012/test</PhysicalResourceId>
+    <ResourceType>AWS::SQS::Queue</ResourceType><StackResourceDriftStatus>MODIFIED</StackResourceDriftStatus>
+    <PropertyDifferences><member>
+      <PropertyPath>/DelaySeconds</PropertyPath><ExpectedValue>5</ExpectedValue><ActualValue>10</ActualValue><DifferenceType>NOT_EQUAL</DifferenceType>
+    </member></PropertyDifferences>
+  </member>
+</StackResourceDrifts><NextToken>page2</NextToken>`,
+			`<StackResourceDrifts>
+  <member>
+    <LogicalResourceId>testTopic</LogicalResourceId><PhysicalResourceId>arn:aws:sns:ap-southeast-2:123456789012:test</PhysicalResourceId>
+    <ResourceType>AWS::SNS::Topic</ResourceType><StackResourceDriftStatus>DELETED</StackResourceDriftStatus>
+  </member>
+</StackResourceDrifts>`,
+		},
+	})
+	defer closeFake()
+
+	report, err := detectDrift(cf, "test")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"DetectStackDrift",
+		"DescribeStackDriftDetectionStatus",
+		"DescribeStackDriftDetectionStatus",
+		"DescribeStackResourceDrifts",
+		"DescribeStackResourceDrifts",
+	}, fake.actions())
+	assert.Equal(t, "test", fake.request("DetectStackDrift").Get("StackName"))
+	assert.Equal(t, testDriftDetectionID, fake.request("DescribeStackDriftDetectionStatus").Get("StackDriftDetectionId"))
+	assert.Equal(t, "MODIFIED", fake.request("DescribeStackResourceDrifts").Get("StackResourceDriftStatusFilters.member.1"))
+	assert.Equal(t, "DRIFTED", report.StackDriftStatus)
+	assert.Len(t, report.Resources, 2)
+
+	var output bytes.Buffer
+	printDrift(&output, report)
+	assert.Equal(t, `Stack test is DRIFTED
+
+ Drift    | LogicalID                      | Type                                     | PhysicalID
+ MODIFIED | testQueue                      | AWS::SQS::Queue                          | https://sqs.ap-southeast-2.amazonaws.com/123456789012/test
+            NOT_EQUAL /DelaySeconds: expected 5, actual 10
+ DELETED  | testTopic                      | AWS::SNS::Topic                          | arn:aws:sns:ap-southeast-2:123456789012:test
+`, output.String())
+}
+
+func TestDetectDrift_inSync(t *testing.T) {
+	cf, _, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DetectStackDrift":                  {"<StackDriftDetectionId>" + testDriftDetectionID + "</StackDriftDetectionId>"},
+		"DescribeStackDriftDetectionStatus": {"<DetectionStatus>DETECTION_COMPLETE</DetectionStatus><StackDriftStatus>IN_SYNC</StackDriftStatus>"},
+		"DescribeStackResourceDrifts":       {"<StackResourceDrifts></StackResourceDrifts>"},
+	})
+	defer closeFake()
+
+	report, err := detectDrift(cf, "test")
+	assert.Nil(t, err)
+	assert.Equal(t, "IN_SYNC", report.StackDriftStatus)
+	assert.Equal(t, []resourceDrift{}, report.Resources)
+}
+
+func TestDetectDrift_failed(t *testing.T) {
+	cf, _, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DetectStackDrift":                  {"<StackDriftDetectionId>" + testDriftDetectionID + "</StackDriftDetectionId>"},
+		"DescribeStackDriftDetectionStatus": {"<DetectionStatus>DETECTION_FAILED</DetectionStatus><DetectionStatusReason>Stack is being updated</DetectionStatusReason>"},
+	})
+	defer closeFake()
+
+	_, err := detectDrift(cf, "test")
+	assert.EqualError(t, err, "Drift detection failed: Stack is being updated")
+}
@@ -1,6 +1,19 @@
 package tasks
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/urfave/cli"
 )
 
@@ -10,10 +23,390 @@ var PrintEvents_Flags = []cli.Flag{
 		Usage: "region to deploy to",
 		Value: "ap-southeast-2",
 	},
+	cli.StringFlag{
+		Name:  "since",
+		Usage: "only print events since a time, or a duration ago. eg. ( --since 2018-06-01T09:00:00Z or --since 2h )",
+	},
+	cli.StringSliceFlag{
+		Name:  "status",
+		Usage: "only print events with a status containing this. eg. ( --status FAILED )",
+	},
+	cli.StringSliceFlag{
+		Name:  "type",
+		Usage: "only print events for resources of this type. eg. ( --type AWS::S3::Bucket )",
+	},
+	cli.StringSliceFlag{
+		Name:  "logical-id",
+		Usage: "only print events for the resource with this logical ID",
+	},
+	cli.BoolFlag{
+		Name:  "nested",
+		Usage: "also print the events of nested stacks",
+	},
+	cli.StringFlag{
+		Name:  "format, f",
+		Usage: "events output format (table, json or csv)",
+		Value: "table",
+	},
 }
 
 func PrintEvents(c *cli.Context) {
-	cf := getCF(c.GlobalString("profile"), c.String("region"))
+	// a stack ID can be used to print the events of a deleted stack
 	stackName := c.Args().Get(0)
-	printStackEvents(cf, stackName)
+	if len(stackName) == 0 {
+		log.Fatal("A stack name or ID is required")
+	}
+
+	since, err := parseSince(c.String("since"), time.Now())
+	checkError(err)
+
+	cf := getCF(c.GlobalString("profile"), c.String("region"), c.GlobalString("endpoint"))
+	events, err := newEventStream(cf, stackName, since, c.Bool("nested")).events()
+	checkError(err)
+
+	filter := eventFilter{
+		Statuses:   c.StringSlice("status"),
+		Types:      c.StringSlice("type"),
+		LogicalIDs: c.StringSlice("logical-id"),
+	}
+	events = filter.apply(events)
+
+	switch c.String("format") {
+	case "table":
+		printEventTable(os.Stdout, stackName, events, isTerminal(os.Stdout))
+	case "json":
+		output, err := json.MarshalIndent(stackEvents(events), "", "  ")
+		checkError(err)
+		fmt.Println(string(output))
+	case "csv":
+		checkError(printEventsCsv(os.Stdout, events))
+	default:
+		log.Fatal("Output format not supported: ", c.String("format"))
+	}
+}
+
+/*
+	parseSince
+	parses a time, or a duration before now, eg. 2018-06-01T09:00:00Z,
+	2018-06-01 or 2h. No value is the zero time
+*/
+func parseSince(value string, now time.Time) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		return now.Add(-duration), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+		if since, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return since, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("Invalid --since %v, expected a time (eg. 2018-06-01T09:00:00Z) or a duration (eg. 2h)", value)
+}
+
+// eventFilter - which events to print, matching any of the values given for each field
+type eventFilter struct {
+	Statuses   []string
+	Types      []string
+	LogicalIDs []string
+}
+
+func (filter eventFilter) apply(events []*awsCF.StackEvent) []*awsCF.StackEvent {
+	filtered := []*awsCF.StackEvent{}
+	for _, event := range events {
+		if filter.matches(event) {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
+func (filter eventFilter) matches(event *awsCF.StackEvent) bool {
+	return matchesAny(filter.Statuses, aws.StringValue(event.ResourceStatus), true) &&
+		matchesAny(filter.Types, aws.StringValue(event.ResourceType), false) &&
+		matchesAny(filter.LogicalIDs, aws.StringValue(event.LogicalResourceId), false)
+}
+
+/*
+	matchesAny
+	whether a value matches any of the values given, ignoring case, or none
+	were given. A partial match is enough when contains is set
+*/
+func matchesAny(values []string, value string, contains bool) bool {
+	if len(values) == 0 {
+		return true
+	}
+	value = strings.ToUpper(value)
+	for _, v := range values {
+		v = strings.ToUpper(v)
+		if value == v || (contains && strings.Contains(value, v)) {
+			return true
+		}
+	}
+	return false
+}
+
+// stackEvent - a stack event, as printed by --format json and csv
+type stackEvent struct {
+	Timestamp  time.Time `json:"timestamp"`
+	StackName  string    `json:"stackName"`
+	StackID    string    `json:"stackId"`
+	EventID    string    `json:"eventId"`
+	LogicalID  string    `json:"logicalId"`
+	PhysicalID string    `json:"physicalId"`
+	Type       string    `json:"type"`
+	Status     string    `json:"status"`
+	Reason     string    `json:"reason"`
+}
+
+func stackEvents(events []*awsCF.StackEvent) []stackEvent {
+	results := []stackEvent{}
+	for _, event := range events {
+		results = append(results, stackEvent{
+			Timestamp:  aws.TimeValue(event.Timestamp),
+			StackName:  aws.StringValue(event.StackName),
+			StackID:    aws.StringValue(event.StackId),
+			EventID:    aws.StringValue(event.EventId),
+			LogicalID:  aws.StringValue(event.LogicalResourceId),
+			PhysicalID: aws.StringValue(event.PhysicalResourceId),
+			Type:       aws.StringValue(event.ResourceType),
+			Status:     aws.StringValue(event.ResourceStatus),
+			Reason:     aws.StringValue(event.ResourceStatusReason),
+		})
+	}
+	return results
+}
+
+func printEventsCsv(w io.Writer, events []*awsCF.StackEvent) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"Timestamp", "StackName", "StackID", "EventID", "LogicalID", "PhysicalID", "Type", "Status", "Reason"})
+	for _, event := range stackEvents(events) {
+		writer.Write([]string{
+			event.Timestamp.Format(time.RFC3339),
+			event.StackName,
+			event.StackID,
+			event.EventID,
+			event.LogicalID,
+			event.PhysicalID,
+			event.Type,
+			event.Status,
+			event.Reason,
+		})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// printEventTable - prints events in the same format they're streamed in
+func printEventTable(w io.Writer, stackName string, events []*awsCF.StackEvent, color bool) {
+	fmt.Fprintf(w, " %-19v | %-30v | %-40v | %v | %v\n", "Time", "Status", "Type", "LogicalID", "Status Reason")
+	for _, event := range events {
+		nested := aws.StringValue(event.StackName) != stackName && aws.StringValue(event.StackId) != stackName
+		printEvent(w, event, nested, color)
+	}
+}
+
+/*
+	eventStream
+	follows the events of a stack while it's being deployed or deleted, printing
+	each new event once. Events from before the stream started are skipped, and
+	the events of nested stacks can also be followed
+*/
+type eventStream struct {
+	cf *awsCF.CloudFormation
+
+	// stack - the stack name, replaced with the stack ID once it's known,
+	// so the events of a deleted stack can still be described
+	stack  string
+	since  time.Time
+	seen   map[string]bool
+	nested bool
+	color  bool
+
+	// pending - the stack didn't exist when the stream started
+	pending bool
+
+	// children - the streams of nested stacks, by stack ID
+	children map[string]*eventStream
+	order    []string
+}
+
+// newEventStream - follows the events of a stack from a point in time
+func newEventStream(cf *awsCF.CloudFormation, stack string, since time.Time, nested bool) *eventStream {
+	return &eventStream{
+		cf:       cf,
+		stack:    stack,
+		since:    since,
+		seen:     make(map[string]bool),
+		nested:   nested,
+		color:    isTerminal(os.Stdout),
+		children: make(map[string]*eventStream),
+	}
+}
+
+/*
+	startEventStream
+	follows the events of a stack from now, by skipping the events it already
+	has. A stack that doesn't exist yet is followed from its first event
+*/
+func startEventStream(cf *awsCF.CloudFormation, stackName string, nested bool) *eventStream {
+	stream := newEventStream(cf, stackName, time.Time{}, nested)
+
+	output, err := cf.DescribeStackEvents(&awsCF.DescribeStackEventsInput{StackName: aws.String(stackName)})
+	if err != nil {
+		stream.pending = true
+		return stream
+	}
+	for _, event := range output.StackEvents {
+		stream.seen[aws.StringValue(event.EventId)] = true
+		stream.stack = aws.StringValue(event.StackId)
+		if event.Timestamp != nil && event.Timestamp.After(stream.since) {
+			stream.since = *event.Timestamp
+		}
+	}
+	return stream
+}
+
+// poll - prints the events since the last poll, in the order they happened
+func (stream *eventStream) poll(w io.Writer) error {
+	events, err := stream.events()
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		printEvent(w, event, aws.StringValue(event.StackId) != stream.stack, stream.color)
+	}
+	return nil
+}
+
+/*
+	events
+	the events of the stack that haven't been seen yet, and of its nested
+	stacks when they're followed, in the order they happened
+*/
+func (stream *eventStream) events() ([]*awsCF.StackEvent, error) {
+	events, err := stream.newEvents()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		physicalID := aws.StringValue(event.PhysicalResourceId)
+		if stream.nested &&
+			aws.StringValue(event.ResourceType) == "AWS::CloudFormation::Stack" &&
+			len(physicalID) > 0 &&
+			physicalID != aws.StringValue(event.StackId) {
+			if _, ok := stream.children[physicalID]; !ok {
+				child := newEventStream(stream.cf, physicalID, stream.since, true)
+				stream.children[physicalID] = child
+				stream.order = append(stream.order, physicalID)
+			}
+		}
+	}
+
+	for _, stackID := range stream.order {
+		childEvents, err := stream.children[stackID].events()
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, childEvents...)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return aws.TimeValue(events[i].Timestamp).Before(aws.TimeValue(events[j].Timestamp))
+	})
+	return events, nil
+}
+
+// newEvents - the events that haven't been seen yet, oldest first
+func (stream *eventStream) newEvents() ([]*awsCF.StackEvent, error) {
+	var events []*awsCF.StackEvent
+	input := &awsCF.DescribeStackEventsInput{StackName: aws.String(stream.stack)}
+
+	// events are described newest first, so page back until a seen one
+	for {
+		output, err := stream.cf.DescribeStackEvents(input)
+		if err != nil {
+			if isStackNotFound(err) && stream.pending && len(stream.seen) == 0 {
+				// the stack hasn't been created yet
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		done := output.NextToken == nil
+		for _, event := range output.StackEvents {
+			if stream.seen[aws.StringValue(event.EventId)] ||
+				(event.Timestamp != nil && event.Timestamp.Before(stream.since)) {
+				done = true
+				break
+			}
+			events = append(events, event)
+		}
+		if done {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	// reverse them into the order they happened
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	for _, event := range events {
+		stream.seen[aws.StringValue(event.EventId)] = true
+		stream.stack = aws.StringValue(event.StackId)
+	}
+	return events, nil
+}
+
+/*
+	printEvent
+	prints an event on one line. The logical IDs of events from nested stacks
+	are prefixed with their stack name
+*/
+func printEvent(w io.Writer, event *awsCF.StackEvent, nested, color bool) {
+	logicalID := aws.StringValue(event.LogicalResourceId)
+	if nested {
+		logicalID = aws.StringValue(event.StackName) + "/" + logicalID
+	}
+
+	status := fmt.Sprintf("%-30v", aws.StringValue(event.ResourceStatus))
+	if color {
+		status = colorStatus(status)
+	}
+
+	timestamp := ""
+	if event.Timestamp != nil {
+		timestamp = event.Timestamp.Local().Format("2006-01-02 15:04:05")
+	}
+
+	line := fmt.Sprintf(" %-19v | %v | %-40v | %v", timestamp, status, aws.StringValue(event.ResourceType), logicalID)
+	if event.ResourceStatusReason != nil {
+		line += " | " + aws.StringValue(event.ResourceStatusReason)
+	}
+	fmt.Fprintln(w, line)
+}
+
+// failedStatus - whether a stack or resource status is a failure
+func failedStatus(status string) bool {
+	return strings.Contains(status, "FAILED") || strings.Contains(status, "ROLLBACK")
+}
+
+// colorStatus - colors failures red, and completions green
+func colorStatus(status string) string {
+	switch {
+	case failedStatus(status):
+		return "\x1b[31m" + status + "\x1b[0m"
+	case strings.Contains(status, "COMPLETE"):
+		return "\x1b[32m" + status + "\x1b[0m"
+	}
+	return status
+}
+
+// isTerminal - whether a file is a terminal, to only print colors to one
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
 }
@@ -0,0 +1,171 @@
+package tasks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testStackID = "arn:aws:cloudformation:ap-southeast-2:123456789012:stack/test/1"
+const testNestedStackID = "arn:aws:cloudformation:ap-southeast-2:123456789012:stack/test-nested/2"
+
+// fakeEvents - a page of stack events, newest first as cloudformation describes them
+func fakeEvents(nextToken string, events ...string) string {
+	page := "<StackEvents>" + strings.Join(events, "") + "</StackEvents>"
+	if len(nextToken) > 0 {
+		page += "<NextToken>" + nextToken + "</NextToken>"
+	}
+	return page
+}
+
+func fakeEvent(stackID, stackName, id, timestamp, logicalID, physicalID, resourceType, status, reason string) string {
+	event := fmt.Sprintf(
+		"<member><StackId>%v</StackId><StackName>%v</StackName><EventId>%v</EventId><Timestamp>%v</Timestamp><LogicalResourceId>%v</LogicalResourceId><PhysicalResourceId>%v</PhysicalResourceId><ResourceType>%v</ResourceType><ResourceStatus>%v</ResourceStatus>",
+		stackID, stackName, id, timestamp, logicalID, physicalID, resourceType, status,
+	)
+	if len(reason) > 0 {
+		event += "<ResourceStatusReason>" + reason + "</ResourceStatusReason>"
+	}
+	return event + "</member>"
+}
+
+func localTime(timestamp string) string {
+	t, _ := time.Parse(time.RFC3339, timestamp)
+	return t.Local().Format("2006-01-02 15:04:05")
+}
+
+func TestEventStream(t *testing.T) {
+	previous := fakeEvent(testStackID, "test", "1", "2018-01-01T00:00:00Z", "test", testStackID, "AWS::CloudFormation::Stack", "CREATE_COMPLETE", "")
+	updating := fakeEvent(testStackID, "test", "2", "2018-01-02T00:00:00Z", "test", testStackID, "AWS::CloudFormation::Stack", "UPDATE_IN_PROGRESS", "User Initiated")
+	nested := fakeEvent(testStackID, "test", "3", "2018-01-02T00:00:01Z", "nested", testNestedStackID, "AWS::CloudFormation::Stack", "UPDATE_IN_PROGRESS", "")
+	failed := fakeEvent(testStackID, "test", "4", "2018-01-02T00:00:02Z", "nested", testNestedStackID, "AWS::CloudFormation::Stack", "UPDATE_FAILED", "Embedded stack was not successfully updated")
+
+	cf, fake, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DescribeStackEvents": {
+			// started
+			fakeEvents("", previous),
+			// first poll, over two pages
+			fakeEvents("page2", failed, nested),
+			fakeEvents("", updating, previous),
+			// the nested stack
+			fakeEvents("",
+				fakeEvent(testNestedStackID, "test-nested", "n2", "2018-01-02T00:00:01Z", "queue", "queue-url", "AWS::SQS::Queue", "UPDATE_FAILED", "Invalid DelaySeconds"),
+				fakeEvent(testNestedStackID, "test-nested", "n1", "2017-12-01T00:00:00Z", "queue", "queue-url", "AWS::SQS::Queue", "CREATE_COMPLETE", ""),
+			),
+			// second poll, without new events
+			fakeEvents("", failed, nested, updating, previous),
+			fakeEvents("", fakeEvent(testNestedStackID, "test-nested", "n2", "2018-01-02T00:00:01Z", "queue", "queue-url", "AWS::SQS::Queue", "UPDATE_FAILED", "Invalid DelaySeconds")),
+		},
+	})
+	defer closeFake()
+
+	stream := startEventStream(cf, "test", true)
+	stream.color = false
+
+	var output bytes.Buffer
+	assert.Nil(t, stream.poll(&output))
+	assert.Equal(t, strings.Join([]string{
+		" " + localTime("2018-01-02T00:00:00Z") + " | UPDATE_IN_PROGRESS             | AWS::CloudFormation::Stack               | test | User Initiated",
+		" " + localTime("2018-01-02T00:00:01Z") + " | UPDATE_IN_PROGRESS             | AWS::CloudFormation::Stack               | nested",
+		" " + localTime("2018-01-02T00:00:01Z") + " | UPDATE_FAILED                  | AWS::SQS::Queue                          | test-nested/queue | Invalid DelaySeconds",
+		" " + localTime("2018-01-02T00:00:02Z") + " | UPDATE_FAILED                  | AWS::CloudFormation::Stack               | nested | Embedded stack was not successfully updated",
+		"",
+	}, "\n"), output.String())
+
+	output.Reset()
+	assert.Nil(t, stream.poll(&output))
+	assert.Equal(t, "", output.String())
+
+	assert.Equal(t, "test", fake.request("DescribeStackEvents").Get("StackName"))
+	assert.Len(t, fake.actions(), 6)
+}
+
+func TestEventStream_newStack(t *testing.T) {
+	cf, _, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DescribeStackEvents": {
+			fakeError("Stack with id test does not exist"),
+			fakeError("Stack with id test does not exist"),
+			fakeEvents("", fakeEvent(testStackID, "test", "1", "2018-01-01T00:00:00Z", "test", testStackID, "AWS::CloudFormation::Stack", "CREATE_IN_PROGRESS", "User Initiated")),
+		},
+	})
+	defer closeFake()
+
+	stream := startEventStream(cf, "test", false)
+	stream.color = false
+
+	var output bytes.Buffer
+	assert.Nil(t, stream.poll(&output))
+	assert.Equal(t, "", output.String())
+
+	assert.Nil(t, stream.poll(&output))
+	assert.Contains(t, output.String(), "CREATE_IN_PROGRESS")
+	assert.Equal(t, testStackID, stream.stack)
+}
+
+func TestColorStatus(t *testing.T) {
+	assert.Equal(t, "\x1b[31mUPDATE_ROLLBACK_COMPLETE\x1b[0m", colorStatus("UPDATE_ROLLBACK_COMPLETE"))
+	assert.Equal(t, "\x1b[31mCREATE_FAILED\x1b[0m", colorStatus("CREATE_FAILED"))
+	assert.Equal(t, "\x1b[32mCREATE_COMPLETE\x1b[0m", colorStatus("CREATE_COMPLETE"))
+	assert.Equal(t, "CREATE_IN_PROGRESS", colorStatus("CREATE_IN_PROGRESS"))
+}
+
+func TestPrintEvents_formats(t *testing.T) {
+	cf, fake, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DescribeStackEvents": {
+			fakeEvents("page2",
+				fakeEvent(testStackID, "test", "3", "2018-01-02T00:00:02Z", "test", testStackID, "AWS::CloudFormation::Stack", "DELETE_COMPLETE", ""),
+				fakeEvent(testStackID, "test", "2", "2018-01-02T00:00:01Z", "testBucket", "test-bucket", "AWS::S3::Bucket", "DELETE_FAILED", "The bucket you tried to delete is not empty, \"test-bucket\""),
+			),
+			fakeEvents("",
+				fakeEvent(testStackID, "test", "1", "2018-01-01T00:00:00Z", "testBucket", "test-bucket", "AWS::S3::Bucket", "CREATE_COMPLETE", ""),
+			),
+		},
+	})
+	defer closeFake()
+
+	since, err := parseSince("2018-01-01T12:00:00Z", time.Now())
+	assert.Nil(t, err)
+	events, err := newEventStream(cf, testStackID, since, false).events()
+	assert.Nil(t, err)
+	assert.Equal(t, testStackID, fake.request("DescribeStackEvents").Get("StackName"))
+	assert.Len(t, events, 2)
+
+	failed := eventFilter{Statuses: []string{"failed"}, Types: []string{"AWS::S3::Bucket"}}.apply(events)
+	assert.Len(t, failed, 1)
+	assert.Len(t, eventFilter{LogicalIDs: []string{"testTopic"}}.apply(events), 0)
+
+	output, err := json.Marshal(stackEvents(failed))
+	assert.Nil(t, err)
+	assert.Contains(t, string(output), `"timestamp":"2018-01-02T00:00:01Z","stackName":"test"`)
+	assert.Contains(t, string(output), `"logicalId":"testBucket","physicalId":"test-bucket","type":"AWS::S3::Bucket","status":"DELETE_FAILED"`)
+
+	var csv bytes.Buffer
+	assert.Nil(t, printEventsCsv(&csv, failed))
+	assert.Equal(t, `Timestamp,StackName,StackID,EventID,LogicalID,PhysicalID,Type,Status,Reason
+2018-01-02T00:00:01Z,test,`+testStackID+`,2,testBucket,test-bucket,AWS::S3::Bucket,DELETE_FAILED,"The bucket you tried to delete is not empty, ""test-bucket"""
+`, csv.String())
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	since, err := parseSince("2h", now)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2018, 6, 1, 10, 0, 0, 0, time.UTC), since)
+
+	since, err = parseSince("2018-06-01T09:00:00Z", now)
+	assert.Nil(t, err)
+	assert.True(t, since.Equal(time.Date(2018, 6, 1, 9, 0, 0, 0, time.UTC)))
+
+	since, err = parseSince("", now)
+	assert.Nil(t, err)
+	assert.True(t, since.IsZero())
+
+	_, err = parseSince("yesterday", now)
+	assert.EqualError(t, err, "Invalid --since yesterday, expected a time (eg. 2018-06-01T09:00:00Z) or a duration (eg. 2h)")
+}
@@ -0,0 +1,125 @@
+package tasks
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	"github.com/aws/aws-sdk-go/aws"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+// rootCause - a resource failure that caused a deployment to fail
+type rootCause struct {
+	// Path - the logical IDs of the nested stacks the resource is in, if any
+	Path  []string
+	Event *awsCF.StackEvent
+}
+
+// LogicalID - the logical ID of the resource, prefixed with the nested stacks it's in
+func (cause rootCause) LogicalID() string {
+	return strings.Join(append(append([]string{}, cause.Path...), aws.StringValue(cause.Event.LogicalResourceId)), "/")
+}
+
+/*
+	findRootCauses
+	finds the resources that failed first in a deployment, from the stack's
+	events since it started. Failures of nested stacks are followed into the
+	nested stack, and resources cancelled because of another failure are skipped
+*/
+func findRootCauses(cf *awsCF.CloudFormation, stack string, since time.Time) ([]rootCause, error) {
+	events, err := newEventStream(cf, stack, since, false).events()
+	if err != nil {
+		return nil, err
+	}
+
+	causes := []rootCause{}
+	for _, failure := range firstFailures(events) {
+		physicalID := aws.StringValue(failure.PhysicalResourceId)
+		if aws.StringValue(failure.ResourceType) == "AWS::CloudFormation::Stack" && len(physicalID) > 0 {
+			nestedCauses, err := findRootCauses(cf, physicalID, since)
+			if err != nil {
+				return nil, err
+			}
+			for _, cause := range nestedCauses {
+				cause.Path = append([]string{aws.StringValue(failure.LogicalResourceId)}, cause.Path...)
+				causes = append(causes, cause)
+			}
+			if len(nestedCauses) > 0 {
+				continue
+			}
+		}
+		causes = append(causes, rootCause{Event: failure})
+	}
+	return causes, nil
+}
+
+/*
+	firstFailures
+	the failed resource events before the stack started rolling back, or all of
+	them if none failed before then. Only the first failure of each resource is
+	kept
+*/
+func firstFailures(events []*awsCF.StackEvent) []*awsCF.StackEvent {
+	var beforeRollback, all []*awsCF.StackEvent
+	rollingBack := false
+	failed := make(map[string]bool)
+
+	for _, event := range events {
+		status := aws.StringValue(event.ResourceStatus)
+		if aws.StringValue(event.PhysicalResourceId) == aws.StringValue(event.StackId) {
+			// an event of the stack itself
+			if strings.HasSuffix(status, "ROLLBACK_IN_PROGRESS") {
+				rollingBack = true
+			}
+			continue
+		}
+
+		logicalID := aws.StringValue(event.LogicalResourceId)
+		if !strings.HasSuffix(status, "_FAILED") ||
+			strings.Contains(strings.ToLower(aws.StringValue(event.ResourceStatusReason)), "cancelled") ||
+			failed[logicalID] {
+			continue
+		}
+		failed[logicalID] = true
+
+		all = append(all, event)
+		if !rollingBack {
+			beforeRollback = append(beforeRollback, event)
+		}
+	}
+
+	if len(beforeRollback) > 0 {
+		return beforeRollback
+	}
+	return all
+}
+
+/*
+	printRootCauses
+	prints the resources that caused a deployment to fail, with the config
+	resource that generated them, when it isn't the resource itself
+*/
+func printRootCauses(w io.Writer, causes []rootCause, sources map[string]cloudformation.ResourceSource) {
+	fmt.Fprintln(w, "The deployment failed because of:")
+	for _, cause := range causes {
+		fmt.Fprintf(w, "  %v (%v) %v: %v\n",
+			cause.LogicalID(),
+			aws.StringValue(cause.Event.ResourceType),
+			aws.StringValue(cause.Event.ResourceStatus),
+			aws.StringValue(cause.Event.ResourceStatusReason),
+		)
+
+		// resources in nested stacks are from the config resource of the nested stack
+		logicalID := aws.StringValue(cause.Event.LogicalResourceId)
+		if len(cause.Path) > 0 {
+			logicalID = cause.Path[0]
+		}
+		source, ok := sources[logicalID]
+		if ok && (len(cause.Path) > 0 || source.ConfigResource != logicalID || len(source.Plugin) > 0) {
+			fmt.Fprintf(w, "    generated by %v\n", source)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package tasks
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindRootCauses(t *testing.T) {
+	stackEvent := func(id, timestamp, status, reason string) string {
+		return fakeEvent(testStackID, "test", id, timestamp, "test", testStackID, "AWS::CloudFormation::Stack", status, reason)
+	}
+	cf, _, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DescribeStackEvents": {
+			fakeEvents("",
+				stackEvent("8", "2018-01-02T00:00:08Z", "ROLLBACK_COMPLETE", ""),
+				fakeEvent(testStackID, "test", "7", "2018-01-02T00:00:07Z", "thingBucket", "", "AWS::S3::Bucket", "DELETE_FAILED", "Bucket not empty"),
+				stackEvent("6", "2018-01-02T00:00:06Z", "ROLLBACK_IN_PROGRESS", "The following resource(s) failed to create: [thingBucket, nested]."),
+				fakeEvent(testStackID, "test", "5", "2018-01-02T00:00:05Z", "thingBucket", "", "AWS::S3::Bucket", "CREATE_FAILED", "Resource creation cancelled"),
+				fakeEvent(testStackID, "test", "4", "2018-01-02T00:00:04Z", "nested", testNestedStackID, "AWS::CloudFormation::Stack", "CREATE_FAILED", "Embedded stack was not successfully created"),
+				fakeEvent(testStackID, "test", "3", "2018-01-02T00:00:03Z", "thingQueue", "", "AWS::SQS::Queue", "CREATE_FAILED", "Invalid DelaySeconds"),
+				fakeEvent(testStackID, "test", "2", "2018-01-02T00:00:02Z", "thingQueue", "", "AWS::SQS::Queue", "CREATE_IN_PROGRESS", ""),
+				stackEvent("1", "2018-01-02T00:00:01Z", "CREATE_IN_PROGRESS", "User Initiated"),
+			),
+			fakeEvents("",
+				fakeEvent(testNestedStackID, "test-nested", "n3", "2018-01-02T00:00:03Z", "role", "", "AWS::IAM::Role", "CREATE_FAILED", "Resource creation cancelled"),
+				fakeEvent(testNestedStackID, "test-nested", "n2", "2018-01-02T00:00:02Z", "function", "", "AWS::Lambda::Function", "CREATE_FAILED", "Unzipped size must be smaller than 262144000 bytes"),
+			),
+		},
+	})
+	defer closeFake()
+
+	causes, err := findRootCauses(cf, testStackID, time.Date(2018, 1, 2, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Len(t, causes, 2)
+	assert.Equal(t, "thingQueue", causes[0].LogicalID())
+	assert.Equal(t, "nested/function", causes[1].LogicalID())
+
+	var output bytes.Buffer
+	printRootCauses(&output, causes, map[string]cloudformation.ResourceSource{
+		"thingQueue":  {ConfigResource: "thing", ConfigType: "Test::Plugin::Thing", Plugin: "thing.so"},
+		"thingBucket": {ConfigResource: "thing", ConfigType: "Test::Plugin::Thing", Plugin: "thing.so"},
+		"nested":      {ConfigResource: "nested", ConfigType: "AWS::CloudFormation::Stack"},
+	})
+	assert.Equal(t, `The deployment failed because of:
+  thingQueue (AWS::SQS::Queue) CREATE_FAILED: Invalid DelaySeconds
+    generated by config resource thing (Test::Plugin::Thing, from plugin thing.so)
+  nested/function (AWS::Lambda::Function) CREATE_FAILED: Unzipped size must be smaller than 262144000 bytes
+    generated by config resource nested (AWS::CloudFormation::Stack)
+`, output.String())
+}
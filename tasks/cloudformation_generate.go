@@ -17,7 +17,7 @@ import (
 var Generate_Flags = []cli.Flag{
 	cli.StringFlag{
 		Name:  "format, f",
-		Usage: "cf output format (only yaml is supported)",
+		Usage: "cf output format (yaml or json)",
 		Value: "yaml",
 	},
 	cli.StringFlag{
@@ -36,6 +36,10 @@ var Generate_Flags = []cli.Flag{
 		Name:  "noBaseOutputs, b",
 		Usage: "disable generation of outputs for Base AWS types",
 	},
+	cli.BoolFlag{
+		Name:  "strict",
+		Usage: "fail on unknown resource types, unknown properties and duplicate keys, instead of warning",
+	},
 }
 
 func Generate(c *cli.Context) {
@@ -44,37 +48,53 @@ func Generate(c *cli.Context) {
 }
 
 func generateTemplate(c *cli.Context) ([]byte, cloudformation.YamlCloudformation) {
+	cf := generateStack(c)
+	return marshalStack(c, cf), cf
+}
+
+// marshalStack - a compiled stack in the format given by --format
+func marshalStack(c *cli.Context, cf cloudformation.YamlCloudformation) []byte {
+	var output []byte
+	var err error
 	switch c.String("format") {
 	case "yaml":
-		output, cf := generateYamlTemplate(c)
-		return output, cf
+		output, err = yaml.Marshal(cf)
+	case "json":
+		output, err = cloudformation.MarshalJson(cf)
 	default:
 		log.Fatal("Format not supported: ", c.String("format"))
 	}
-	return []byte{}, cloudformation.YamlCloudformation{}
+	checkError(err)
+	return output
 }
 
-func generateYamlTemplate(c *cli.Context) ([]byte, cloudformation.YamlCloudformation) {
-	paramMap := getParamMap(c)
+func generateStack(c *cli.Context) cloudformation.YamlCloudformation {
+	return generateStackForEnv(c, c.String("env"))
+}
 
-	cf, err := cloudformation.GenerateYamlStack(
-		cloudformation.GenerateParams{
-			Filename:           c.Args().Get(0),
-			EnvFile:            c.String("envFile"),
-			Env:                c.String("env"),
-			DisableBaseOutputs: c.Bool("noBaseOutputs"),
-			ParamMap:           paramMap,
-		})
-	checkError(err)
-	output, err := yaml.Marshal(cf)
+// generateStackForEnv - compiles the config for an environment, other than the one given by --env
+func generateStackForEnv(c *cli.Context, env string) cloudformation.YamlCloudformation {
+	cf, err := cloudformation.GenerateYamlStack(generateParams(c, env))
 	checkError(err)
-	return output, cf
+	return cf
+}
+
+// generateParams - the GenerateParams of the cf generate flags, for an environment
+func generateParams(c *cli.Context, env string) cloudformation.GenerateParams {
+	return cloudformation.GenerateParams{
+		Filename:           c.Args().Get(0),
+		EnvFile:            c.String("envFile"),
+		Env:                env,
+		DisableBaseOutputs: c.Bool("noBaseOutputs"),
+		ParamMap:           getParamMap(c),
+		Strict:             c.Bool("strict"),
+	}
 }
 
 func writeOutput(c *cli.Context, output []byte) {
 	filename := filepath.Base(c.Args().Get(0))
 	basename := strings.TrimSuffix(filename, filepath.Ext(filename))
-	path := fmt.Sprint("compiled/", basename, ".yaml")
+	path := fmt.Sprint("compiled/", basename, ".", c.String("format"))
 	os.Mkdir("./compiled", 0744)
 	err := ioutil.WriteFile(path, output, 0644)
 	checkError(err)
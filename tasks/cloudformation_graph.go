@@ -0,0 +1,68 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	"github.com/urfave/cli"
+)
+
+var Graph_Flags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "format, f",
+		Usage: "graph output format (dot or json)",
+		Value: "dot",
+	},
+
+	// cf generate flags
+	cli.StringFlag{
+		Name:  "env",
+		Usage: "environment config to use from ./config/environment.yaml",
+	},
+	cli.StringFlag{
+		Name:  "envFile",
+		Usage: "path to the environment.yaml file",
+	},
+	cli.StringSliceFlag{
+		Name:  "param, p",
+		Usage: "cloudformation parameters. eg. ( --param Env=dev --param BucketName=test )",
+	},
+	cli.BoolFlag{
+		Name:  "noBaseOutputs, b",
+		Usage: "disable generation of outputs for Base AWS types",
+	},
+	cli.BoolFlag{
+		Name:  "strict",
+		Usage: "fail on unknown resource types, unknown properties and duplicate keys, instead of warning",
+	},
+}
+
+func Graph(c *cli.Context) {
+	graph, err := cloudformation.BuildDependencyGraph(generateStack(c))
+	checkError(err)
+
+	switch c.String("format") {
+	case "dot":
+		filename := filepath.Base(c.Args().Get(0))
+		fmt.Print(graph.Dot(strings.TrimSuffix(filename, filepath.Ext(filename))))
+	case "json":
+		output, err := json.MarshalIndent(graph, "", "  ")
+		checkError(err)
+		fmt.Println(string(output))
+	default:
+		log.Fatal("Output format not supported: ", c.String("format"))
+	}
+
+	if graph.Cycle != nil {
+		log.WithFields(log.Fields{
+			"cycle": strings.Join(graph.Cycle, " -> "),
+		}).Error("Circular dependency between resources")
+		os.Exit(1)
+	}
+}
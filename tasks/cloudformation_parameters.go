@@ -0,0 +1,202 @@
+package tasks
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/aws/aws-sdk-go/aws"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/urfave/cli"
+)
+
+var UpdateParams_Flags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "region, r",
+		Usage: "region the stack is deployed to",
+		Value: "ap-southeast-2",
+	},
+	cli.StringFlag{
+		Name:  "env",
+		Usage: "environment config to use from ./config/environment.yaml",
+	},
+	cli.StringFlag{
+		Name:  "envFile",
+		Usage: "path to the environment.yaml file",
+	},
+	cli.StringSliceFlag{
+		Name:  "param, p",
+		Usage: "cloudformation parameters. eg. ( --param Env=dev --param BucketName=test )",
+	},
+	cli.BoolFlag{
+		Name:  "nestedEvents",
+		Usage: "also print the events of nested stacks",
+	},
+}
+
+/*
+	UpdateParams
+	updates the parameter values of a stack, keeping its template. Parameters
+	that aren't given keep their previous value
+*/
+func UpdateParams(c *cli.Context) {
+	stackName := c.Args().Get(0)
+	if len(stackName) == 0 {
+		log.Fatal("A stack name is required")
+	}
+
+	cf := getCF(c.GlobalString("profile"), c.String("region"), c.GlobalString("endpoint"))
+	status, err := cf.DescribeStacks(&awsCF.DescribeStacksInput{StackName: aws.String(stackName)})
+	checkError(err)
+	if len(status.Stacks) == 0 {
+		log.Fatal("Stack not found: ", stackName)
+	}
+	stack := status.Stacks[0]
+
+	// values are converted by the types the deployed template declares
+	declared, err := templateParameters(cf, &awsCF.GetTemplateSummaryInput{StackName: aws.String(stackName)})
+	checkError(err)
+	env := cloudformation.ResolveEnvironment(c.String("envFile"), c.String("env"))
+	values, errs := stackParameterValues(declared, env, getParamMap(c))
+	checkParameterErrors(errs)
+
+	parameters, changes, err := updateParameters(stack.Parameters, values)
+	checkError(err)
+
+	if len(changes) == 0 {
+		fmt.Println("No parameter values changed.")
+		return
+	}
+	printParameterChanges(os.Stdout, changes)
+
+	events := startEventStream(cf, stackName, c.Bool("nestedEvents"))
+	_, err = cf.UpdateStack(&awsCF.UpdateStackInput{
+		StackName:           aws.String(stackName),
+		UsePreviousTemplate: aws.Bool(true),
+		Parameters:          parameters,
+		Capabilities:        stack.Capabilities,
+	})
+	checkError(err)
+
+	waitForUpsert(cf, stackName, events, nil)
+}
+
+/*
+	stackParameterValues
+	converts the values of the parameters of a stack from the environment file,
+	overridden by --param. Values from the environment file are only used for
+	parameters of the stack, but --param values are kept so an unknown one is
+	rejected by updateParameters
+*/
+func stackParameterValues(declared types.ValueMap, env types.ValueMap, params map[string]string) (map[string]string, []error) {
+	merged := types.ValueMap{}
+	for k, v := range env {
+		merged[k] = v
+	}
+	for k, v := range params {
+		merged[k] = v
+	}
+
+	values, errs := cloudformation.ParameterValues(declared, merged)
+	for k, v := range params {
+		if _, ok := declared[k]; !ok {
+			values[k] = v
+		}
+	}
+	return values, errs
+}
+
+/*
+	updateParameters
+	sets the parameters of a deployed stack to new values, using the previous
+	value of the rest. The parameter values that change are returned with them,
+	and NoEcho values are masked
+*/
+func updateParameters(deployed []*awsCF.Parameter, values map[string]string) (parameters []*awsCF.Parameter, changes []cloudformation.ValueChange, err error) {
+	before := parameterValues(deployed)
+	for k := range values {
+		if _, ok := before[k]; !ok {
+			err = fmt.Errorf("%v is not a parameter of the stack", k)
+			return
+		}
+	}
+
+	after := make(map[string]string)
+	for _, k := range sortedParameterKeys(before) {
+		value, ok := values[k]
+		if !ok {
+			parameters = append(parameters, &awsCF.Parameter{ParameterKey: aws.String(k), UsePreviousValue: aws.Bool(true)})
+			after[k] = before[k]
+			continue
+		}
+		parameters = append(parameters, &awsCF.Parameter{ParameterKey: aws.String(k), ParameterValue: aws.String(value)})
+
+		after[k] = value
+		if before[k] == noEchoValue {
+			// NoEcho values can't be compared, or printed
+			after[k] = noEchoValue + " (new value)"
+		}
+	}
+
+	changes = cloudformation.DiffParameters(before, after)
+	return
+}
+
+/*
+	usePreviousValues
+	adds the parameters of a deployed stack that weren't given a value to the
+	parameters of an update, using their previous value. Parameters the new
+	template doesn't declare are left out
+*/
+func usePreviousValues(parameters []*awsCF.Parameter, deployed []*awsCF.Parameter, declared types.ValueMap) []*awsCF.Parameter {
+	given := parameterValues(parameters)
+	for _, k := range sortedParameterKeys(parameterValues(deployed)) {
+		if _, ok := given[k]; ok {
+			continue
+		}
+		if _, ok := declared[k]; !ok {
+			continue
+		}
+		log.WithFields(log.Fields{
+			"parameter": k,
+		}).Info("Using the previous value of parameter")
+		parameters = append(parameters, &awsCF.Parameter{
+			ParameterKey:     aws.String(k),
+			UsePreviousValue: aws.Bool(true),
+		})
+	}
+	return parameters
+}
+
+// checkParameterErrors - logs the invalid parameter values, and exits if there are any
+func checkParameterErrors(errs []error) {
+	if len(errs) == 0 {
+		return
+	}
+	for _, err := range errs {
+		log.WithFields(log.Fields{
+			"err": err,
+		}).Error("Invalid parameter value")
+	}
+	log.Fatal(fmt.Sprintf("%v invalid parameter value(s)", len(errs)))
+}
+
+func sortedParameterKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func printParameterChanges(w io.Writer, changes []cloudformation.ValueChange) {
+	fmt.Fprintln(w, "Parameters")
+	printChanges(w, "  ", changes)
+	fmt.Fprintln(w)
+}
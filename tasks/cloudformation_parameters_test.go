@@ -0,0 +1,124 @@
+package tasks
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/aws/aws-sdk-go/aws"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/assert"
+)
+
+func testParameter(key, value string) *awsCF.Parameter {
+	return &awsCF.Parameter{ParameterKey: aws.String(key), ParameterValue: aws.String(value)}
+}
+
+func testPreviousParameter(key string) *awsCF.Parameter {
+	return &awsCF.Parameter{ParameterKey: aws.String(key), UsePreviousValue: aws.Bool(true)}
+}
+
+func TestUsePreviousValues(t *testing.T) {
+	deployed := []*awsCF.Parameter{
+		testParameter("Env", "dev"),
+		testParameter("Size", "small"),
+		testParameter("Removed", "x"),
+	}
+	declared := types.ValueMap{
+		"Env":  map[string]interface{}{"Type": "String"},
+		"Size": map[string]interface{}{"Type": "String", "Default": "large"},
+		"New":  map[string]interface{}{"Type": "String", "Default": "y"},
+	}
+
+	parameters := usePreviousValues([]*awsCF.Parameter{testParameter("Env", "prod")}, deployed, declared)
+	assert.Equal(t, []*awsCF.Parameter{
+		testParameter("Env", "prod"),
+		testPreviousParameter("Size"),
+	}, parameters)
+}
+
+func TestUpdateParameters(t *testing.T) {
+	deployed := []*awsCF.Parameter{
+		testParameter("Env", "dev"),
+		testParameter("Size", "small"),
+		testParameter("Password", "****"),
+	}
+
+	parameters, changes, err := updateParameters(deployed, map[string]string{"Size": "large", "Password": "secret"})
+	assert.Nil(t, err)
+	assert.Equal(t, []*awsCF.Parameter{
+		testPreviousParameter("Env"),
+		testParameter("Password", "secret"),
+		testParameter("Size", "large"),
+	}, parameters)
+
+	var output bytes.Buffer
+	printParameterChanges(&output, changes)
+	assert.Equal(t, `Parameters
+  ~ Password: "****" => "**** (new value)"
+  ~ Size: "small" => "large"
+
+`, output.String())
+
+	_, changes, err = updateParameters(deployed, map[string]string{"Env": "dev"})
+	assert.Nil(t, err)
+	assert.Len(t, changes, 0)
+
+	_, _, err = updateParameters(deployed, map[string]string{"Nmae": "test"})
+	assert.EqualError(t, err, "Nmae is not a parameter of the stack")
+}
+
+func TestStackParameterValues(t *testing.T) {
+	cf, fake, close := newFakeCloudFormation(t, map[string][]string{
+		"GetTemplateSummary": {`<Parameters>
+			<member><ParameterKey>Size</ParameterKey><ParameterType>Number</ParameterType><ParameterConstraints/></member>
+			<member><ParameterKey>Zones</ParameterKey><ParameterType>CommaDelimitedList</ParameterType><ParameterConstraints/></member>
+			<member><ParameterKey>Public</ParameterKey><ParameterType>String</ParameterType><ParameterConstraints/></member>
+		</Parameters>`},
+	})
+	defer close()
+
+	// update-params converts the env file values by the types of the deployed template
+	declared, err := templateParameters(cf, &awsCF.GetTemplateSummaryInput{StackName: aws.String("test")})
+	assert.Nil(t, err)
+	assert.Equal(t, "test", fake.request("GetTemplateSummary").Get("StackName"))
+
+	values, errs := cloudformation.ParameterValues(declared, types.ValueMap{
+		"Size":   4,
+		"Zones":  []interface{}{"ap-southeast-2a", "ap-southeast-2b"},
+		"Public": true,
+		"Other":  "not a parameter",
+	})
+	assert.Len(t, errs, 0)
+	assert.Equal(t, map[string]string{
+		"Size":   "4",
+		"Zones":  "ap-southeast-2a,ap-southeast-2b",
+		"Public": "true",
+	}, values)
+}
+
+func TestStackParameterValues_params(t *testing.T) {
+	declared := types.ValueMap{
+		"Size":  map[string]interface{}{"Type": "Number"},
+		"Zones": map[string]interface{}{"Type": "CommaDelimitedList"},
+	}
+	env := types.ValueMap{
+		"Size":  4,
+		"Zones": []interface{}{"ap-southeast-2a", "ap-southeast-2b"},
+	}
+
+	// --param values override the env file, and are checked like its values
+	values, errs := stackParameterValues(declared, env, map[string]string{"Size": "8", "Nmae": "test"})
+	assert.Len(t, errs, 0)
+	assert.Equal(t, map[string]string{
+		"Size":  "8",
+		"Zones": "ap-southeast-2a,ap-southeast-2b",
+		"Nmae":  "test",
+	}, values)
+
+	_, errs = stackParameterValues(declared, env, map[string]string{"Size": "large"})
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], `Parameter Size: "large" is not a number`)
+	assert.Equal(t, 4, env["Size"])
+}
@@ -0,0 +1,146 @@
+package tasks
+
+import (
+	"fmt"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	"github.com/aws/aws-sdk-go/aws"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/urfave/cli"
+)
+
+/*
+	resolveStackOptions
+	the StackOptions of a config, with the StackOptions of the environment
+	given by --env replacing them
+*/
+func resolveStackOptions(c *cli.Context, configOptions cloudformation.StackOptions) (cloudformation.StackOptions, error) {
+	env := cloudformation.ResolveEnvironment(c.String("envFile"), c.String("env"))
+	envOptions, err := cloudformation.EnvironmentStackOptions(env)
+	if err != nil {
+		return configOptions, err
+	}
+	options := configOptions.Merge(envOptions)
+	return options, options.Validate()
+}
+
+// createStackOptions - sets the options of a stack being created
+func createStackOptions(input *awsCF.CreateStackInput, options cloudformation.StackOptions) {
+	input.Tags = stackTags(options.Tags)
+	input.RoleARN = optionalString(options.RoleARN)
+	if len(options.NotificationARNs) > 0 {
+		input.NotificationARNs = aws.StringSlice(options.NotificationARNs)
+	}
+	input.EnableTerminationProtection = options.EnableTerminationProtection
+	input.RollbackConfiguration = rollbackConfiguration(options.RollbackConfiguration)
+	if options.TimeoutInMinutes > 0 {
+		input.TimeoutInMinutes = aws.Int64(options.TimeoutInMinutes)
+	}
+	input.OnFailure = optionalString(options.OnFailure)
+}
+
+/*
+	updateStackOptions
+	sets the options of a stack being updated. Options that aren't set are left
+	out, so they keep their deployed value
+*/
+func updateStackOptions(input *awsCF.UpdateStackInput, options cloudformation.StackOptions) {
+	input.Tags = stackTags(options.Tags)
+	input.RoleARN = optionalString(options.RoleARN)
+	if len(options.NotificationARNs) > 0 {
+		input.NotificationARNs = aws.StringSlice(options.NotificationARNs)
+	}
+	input.RollbackConfiguration = rollbackConfiguration(options.RollbackConfiguration)
+
+	if options.TimeoutInMinutes > 0 || len(options.OnFailure) > 0 {
+		log.Info("TimeoutInMinutes and OnFailure only apply when a stack is created")
+	}
+}
+
+/*
+	changeSetOptions
+	sets the options of a change set. Change sets can't set termination
+	protection, which apply sets once they're executing
+*/
+func changeSetOptions(input *awsCF.CreateChangeSetInput, options cloudformation.StackOptions) {
+	input.Tags = stackTags(options.Tags)
+	input.RoleARN = optionalString(options.RoleARN)
+	if len(options.NotificationARNs) > 0 {
+		input.NotificationARNs = aws.StringSlice(options.NotificationARNs)
+	}
+	input.RollbackConfiguration = rollbackConfiguration(options.RollbackConfiguration)
+
+	if options.TimeoutInMinutes > 0 || len(options.OnFailure) > 0 {
+		log.Info("TimeoutInMinutes and OnFailure don't apply to change sets")
+	}
+}
+
+// applyTerminationProtection - updateTerminationProtection, for a stack by its name or ID
+func applyTerminationProtection(cf *awsCF.CloudFormation, stackName string, options cloudformation.StackOptions) error {
+	status, err := cf.DescribeStacks(&awsCF.DescribeStacksInput{StackName: aws.String(stackName)})
+	if err != nil {
+		return err
+	}
+	if len(status.Stacks) == 0 {
+		return fmt.Errorf("Stack not found: %v", stackName)
+	}
+	return updateTerminationProtection(cf, status.Stacks[0], options)
+}
+
+/*
+	updateTerminationProtection
+	enables or disables the termination protection of a deployed stack, when
+	EnableTerminationProtection is set and differs from it
+*/
+func updateTerminationProtection(cf *awsCF.CloudFormation, stack *awsCF.Stack, options cloudformation.StackOptions) error {
+	if options.EnableTerminationProtection == nil ||
+		aws.BoolValue(options.EnableTerminationProtection) == aws.BoolValue(stack.EnableTerminationProtection) {
+		return nil
+	}
+	log.WithFields(log.Fields{
+		"enabled": aws.BoolValue(options.EnableTerminationProtection),
+	}).Info("Updating termination protection")
+	_, err := cf.UpdateTerminationProtection(&awsCF.UpdateTerminationProtectionInput{
+		StackName:                   stack.StackId,
+		EnableTerminationProtection: options.EnableTerminationProtection,
+	})
+	return err
+}
+
+// stackTags - tags sorted by key, or nil if there aren't any
+func stackTags(tags map[string]string) []*awsCF.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	stackTags := []*awsCF.Tag{}
+	for _, k := range keys {
+		stackTags = append(stackTags, &awsCF.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+	return stackTags
+}
+
+func rollbackConfiguration(config *cloudformation.RollbackConfiguration) *awsCF.RollbackConfiguration {
+	if config == nil {
+		return nil
+	}
+	rollback := &awsCF.RollbackConfiguration{
+		MonitoringTimeInMinutes: aws.Int64(config.MonitoringTimeInMinutes),
+		RollbackTriggers:        []*awsCF.RollbackTrigger{},
+	}
+	for _, trigger := range config.RollbackTriggers {
+		rollback.RollbackTriggers = append(rollback.RollbackTriggers, &awsCF.RollbackTrigger{
+			Arn:  aws.String(trigger.Arn),
+			Type: aws.String(trigger.TriggerType()),
+		})
+	}
+	return rollback
+}
@@ -0,0 +1,100 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	"github.com/aws/aws-sdk-go/aws"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/assert"
+)
+
+func testStackOptions() cloudformation.StackOptions {
+	return cloudformation.StackOptions{
+		Tags:                        map[string]string{"Team": "api", "Env": "prod"},
+		RoleARN:                     "arn:aws:iam::123456789012:role/deploy",
+		NotificationARNs:            []string{"arn:aws:sns:ap-southeast-2:123456789012:deploys"},
+		EnableTerminationProtection: aws.Bool(true),
+		RollbackConfiguration: &cloudformation.RollbackConfiguration{
+			MonitoringTimeInMinutes: 10,
+			RollbackTriggers:        []cloudformation.RollbackTrigger{{Arn: "arn:aws:cloudwatch:ap-southeast-2:123456789012:alarm:errors"}},
+		},
+		TimeoutInMinutes: 30,
+		OnFailure:        "DELETE",
+	}
+}
+
+func TestCreateStackOptions(t *testing.T) {
+	input := &awsCF.CreateStackInput{}
+	createStackOptions(input, testStackOptions())
+	assert.Equal(t, &awsCF.CreateStackInput{
+		Tags: []*awsCF.Tag{
+			{Key: aws.String("Env"), Value: aws.String("prod")},
+			{Key: aws.String("Team"), Value: aws.String("api")},
+		},
+		RoleARN:                     aws.String("arn:aws:iam::123456789012:role/deploy"),
+		NotificationARNs:            aws.StringSlice([]string{"arn:aws:sns:ap-southeast-2:123456789012:deploys"}),
+		EnableTerminationProtection: aws.Bool(true),
+		RollbackConfiguration: &awsCF.RollbackConfiguration{
+			MonitoringTimeInMinutes: aws.Int64(10),
+			RollbackTriggers: []*awsCF.RollbackTrigger{{
+				Arn:  aws.String("arn:aws:cloudwatch:ap-southeast-2:123456789012:alarm:errors"),
+				Type: aws.String("AWS::CloudWatch::Alarm"),
+			}},
+		},
+		TimeoutInMinutes: aws.Int64(30),
+		OnFailure:        aws.String("DELETE"),
+	}, input)
+
+	input = &awsCF.CreateStackInput{}
+	createStackOptions(input, cloudformation.StackOptions{})
+	assert.Equal(t, &awsCF.CreateStackInput{}, input)
+}
+
+func TestUpdateStackOptions(t *testing.T) {
+	input := &awsCF.UpdateStackInput{}
+	updateStackOptions(input, testStackOptions())
+	assert.Len(t, input.Tags, 2)
+	assert.Equal(t, "arn:aws:iam::123456789012:role/deploy", aws.StringValue(input.RoleARN))
+	assert.Len(t, input.NotificationARNs, 1)
+	assert.Len(t, input.RollbackConfiguration.RollbackTriggers, 1)
+
+	// unset options keep their deployed value
+	input = &awsCF.UpdateStackInput{}
+	updateStackOptions(input, cloudformation.StackOptions{})
+	assert.Equal(t, &awsCF.UpdateStackInput{}, input)
+}
+
+func TestUpdateTerminationProtection(t *testing.T) {
+	cf, fake, close := newFakeCloudFormation(t, map[string][]string{
+		"UpdateTerminationProtection": {"<StackId>arn:aws:cloudformation:ap-southeast-2:123456789012:stack/test/1</StackId>"},
+	})
+	defer close()
+
+	stack := &awsCF.Stack{
+		StackId:                     aws.String("arn:aws:cloudformation:ap-southeast-2:123456789012:stack/test/1"),
+		EnableTerminationProtection: aws.Bool(false),
+	}
+	assert.Nil(t, updateTerminationProtection(cf, stack, cloudformation.StackOptions{}))
+	assert.Nil(t, updateTerminationProtection(cf, stack, cloudformation.StackOptions{EnableTerminationProtection: aws.Bool(false)}))
+	assert.Empty(t, fake.actions())
+
+	assert.Nil(t, updateTerminationProtection(cf, stack, cloudformation.StackOptions{EnableTerminationProtection: aws.Bool(true)}))
+	assert.Equal(t, []string{"UpdateTerminationProtection"}, fake.actions())
+	assert.Equal(t, "true", fake.request("UpdateTerminationProtection").Get("EnableTerminationProtection"))
+}
+
+func TestApplyTerminationProtection(t *testing.T) {
+	cf, fake, close := newFakeCloudFormation(t, map[string][]string{
+		"DescribeStacks":              {fakeStack("test", "CREATE_IN_PROGRESS")},
+		"UpdateTerminationProtection": {"<StackId>arn:aws:cloudformation:ap-southeast-2:123456789012:stack/test/1</StackId>"},
+	})
+	defer close()
+
+	stackID := "arn:aws:cloudformation:ap-southeast-2:123456789012:stack/test/1"
+	assert.Nil(t, applyTerminationProtection(cf, stackID, cloudformation.StackOptions{EnableTerminationProtection: aws.Bool(true)}))
+	assert.Equal(t, []string{"DescribeStacks", "UpdateTerminationProtection"}, fake.actions())
+	assert.Equal(t, stackID, fake.request("DescribeStacks").Get("StackName"))
+	assert.Equal(t, stackID, fake.request("UpdateTerminationProtection").Get("StackName"))
+	assert.Equal(t, "true", fake.request("UpdateTerminationProtection").Get("EnableTerminationProtection"))
+}
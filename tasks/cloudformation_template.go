@@ -0,0 +1,126 @@
+package tasks
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/aws/aws-sdk-go/aws"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/urfave/cli"
+)
+
+// stackTemplate - the template an upsert deploys, and the parameters it declares
+type stackTemplate struct {
+	Body       string
+	URL        string
+	Parameters types.ValueMap
+
+	// Template - the parsed template, to predict replacements with. Templates
+	// deployed from a url aren't downloaded, so it's nil for them
+	Template map[string]interface{}
+
+	// Sources - the config resources each resource was generated from
+	Sources map[string]cloudformation.ResourceSource
+
+	// Options - the StackOptions of the config
+	Options cloudformation.StackOptions
+}
+
+/*
+	loadStackTemplate
+	the template to upsert, from a url (--url), a compiled template (--template),
+	or by compiling the config given as an argument
+*/
+func loadStackTemplate(c *cli.Context, cf *awsCF.CloudFormation) (template stackTemplate, err error) {
+	switch {
+	case len(c.String("url")) > 0:
+		template.URL = templateURL(c.String("url"), c.String("region"))
+		template.Parameters, err = templateParameters(cf, &awsCF.GetTemplateSummaryInput{
+			TemplateURL: aws.String(template.URL),
+		})
+		return
+
+	case len(c.String("template")) > 0:
+		var data []byte
+		data, err = ioutil.ReadFile(c.String("template"))
+		if err != nil {
+			return
+		}
+		return parseStackTemplate(data)
+
+	default:
+		data, cfYaml := generateTemplate(c)
+		template, err = parseStackTemplate(data)
+		template.Sources = cfYaml.Sources
+		template.Options = cfYaml.StackOptions
+		return
+	}
+}
+
+// parseStackTemplate - a yaml or json template, with the parameters it declares
+func parseStackTemplate(data []byte) (template stackTemplate, err error) {
+	template.Body = string(data)
+	template.Template, err = cloudformation.ParseTemplate(data)
+	if err != nil {
+		return
+	}
+	template.Parameters = types.ValueMap{}
+	if parameters, ok := template.Template["Parameters"].(map[string]interface{}); ok {
+		template.Parameters = types.ValueMap(parameters)
+	}
+	return
+}
+
+/*
+	templateURL
+	the https url of a template in S3, for an s3://bucket/key url. Other urls
+	are used as they are
+*/
+func templateURL(url string, region string) string {
+	if !strings.HasPrefix(url, "s3://") {
+		return url
+	}
+	return fmt.Sprintf("https://s3.%v.amazonaws.com/%v", region, strings.TrimPrefix(url, "s3://"))
+}
+
+// templateStackName - the default stack name for a template, from its filename
+func templateStackName(path string) string {
+	filename := filepath.Base(path)
+	return strings.TrimSuffix(filename, filepath.Ext(filename))
+}
+
+/*
+	templateParameters
+	the parameters a template declares, from cloudformation, in the same form
+	as the Parameters of a template. Only the Type, Default and AllowedValues
+	of each parameter are known
+*/
+func templateParameters(cf *awsCF.CloudFormation, input *awsCF.GetTemplateSummaryInput) (types.ValueMap, error) {
+	summary, err := cf.GetTemplateSummary(input)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := types.ValueMap{}
+	for _, declaration := range summary.Parameters {
+		parameter := map[string]interface{}{
+			"Type": aws.StringValue(declaration.ParameterType),
+		}
+		if declaration.DefaultValue != nil {
+			parameter["Default"] = aws.StringValue(declaration.DefaultValue)
+		}
+		if declaration.ParameterConstraints != nil && len(declaration.ParameterConstraints.AllowedValues) > 0 {
+			allowed := []interface{}{}
+			for _, value := range declaration.ParameterConstraints.AllowedValues {
+				allowed = append(allowed, aws.StringValue(value))
+			}
+			parameter["AllowedValues"] = allowed
+		}
+		parameters[aws.StringValue(declaration.ParameterKey)] = parameter
+	}
+	return parameters, nil
+}
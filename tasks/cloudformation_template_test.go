@@ -0,0 +1,82 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/types"
+	"github.com/aws/aws-sdk-go/aws"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateURL(t *testing.T) {
+	assert.Equal(t,
+		"https://s3.ap-southeast-2.amazonaws.com/bucket/templates/test.yaml",
+		templateURL("s3://bucket/templates/test.yaml", "ap-southeast-2"),
+	)
+	assert.Equal(t,
+		"https://bucket.s3.amazonaws.com/test.yaml",
+		templateURL("https://bucket.s3.amazonaws.com/test.yaml", "ap-southeast-2"),
+	)
+}
+
+func TestTemplateStackName(t *testing.T) {
+	assert.Equal(t, "test", templateStackName("./compiled/test.yaml"))
+}
+
+func TestParseStackTemplate(t *testing.T) {
+	data := []byte(`
+AWSTemplateFormatVersion: "2010-09-09"
+Parameters:
+  Env:
+    Type: String
+    AllowedValues: [dev, prod]
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+`)
+
+	template, err := parseStackTemplate(data)
+	assert.Nil(t, err)
+	assert.Equal(t, string(data), template.Body)
+	assert.Empty(t, template.URL)
+	assert.Equal(t, types.ValueMap{
+		"Env": map[string]interface{}{
+			"Type":          "String",
+			"AllowedValues": []interface{}{"dev", "prod"},
+		},
+	}, template.Parameters)
+	assert.Contains(t, template.Template, "Resources")
+
+	template, err = parseStackTemplate([]byte(`{"Resources": {}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, types.ValueMap{}, template.Parameters)
+}
+
+func TestTemplateParameters(t *testing.T) {
+	cf, fake, close := newFakeCloudFormation(t, map[string][]string{
+		"GetTemplateSummary": {`<Parameters>
+			<member><ParameterKey>Env</ParameterKey><ParameterType>String</ParameterType>
+				<ParameterConstraints><AllowedValues><member>dev</member><member>prod</member></AllowedValues></ParameterConstraints>
+			</member>
+			<member><ParameterKey>Size</ParameterKey><ParameterType>Number</ParameterType><DefaultValue>2</DefaultValue><ParameterConstraints/></member>
+		</Parameters>`},
+	})
+	defer close()
+
+	parameters, err := templateParameters(cf, &awsCF.GetTemplateSummaryInput{
+		TemplateURL: aws.String("https://s3.ap-southeast-2.amazonaws.com/bucket/test.yaml"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "https://s3.ap-southeast-2.amazonaws.com/bucket/test.yaml", fake.request("GetTemplateSummary").Get("TemplateURL"))
+	assert.Equal(t, types.ValueMap{
+		"Env": map[string]interface{}{
+			"Type":          "String",
+			"AllowedValues": []interface{}{"dev", "prod"},
+		},
+		"Size": map[string]interface{}{
+			"Type":    "Number",
+			"Default": "2",
+		},
+	}, parameters)
+}
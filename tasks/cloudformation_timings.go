@@ -0,0 +1,245 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/urfave/cli"
+)
+
+var Timings_Flags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "region, r",
+		Usage: "region the stack is deployed to",
+		Value: "ap-southeast-2",
+	},
+	cli.BoolFlag{
+		Name:  "nested",
+		Usage: "also time the resources of nested stacks",
+	},
+	cli.StringFlag{
+		Name:  "format, f",
+		Usage: "timings output format (text or json)",
+		Value: "text",
+	},
+}
+
+func Timings(c *cli.Context) {
+	stackName := c.Args().Get(0)
+	if len(stackName) == 0 {
+		log.Fatal("A stack name or ID is required")
+	}
+
+	cf := getCF(c.GlobalString("profile"), c.String("region"), c.GlobalString("endpoint"))
+	report, err := stackTimings(cf, stackName, c.Bool("nested"))
+	checkError(err)
+
+	switch c.String("format") {
+	case "text":
+		printTimings(os.Stdout, report)
+		fmt.Println()
+		printGantt(os.Stdout, report, 50)
+	case "json":
+		output, err := json.MarshalIndent(report, "", "  ")
+		checkError(err)
+		fmt.Println(string(output))
+	default:
+		log.Fatal("Output format not supported: ", c.String("format"))
+	}
+}
+
+// timingReport - how long the last operation on a stack took, and each of its resources
+type timingReport struct {
+	StackName string           `json:"stackName"`
+	Operation string           `json:"operation"`
+	Status    string           `json:"status"`
+	Start     time.Time        `json:"start"`
+	End       time.Time        `json:"end"`
+	Seconds   float64          `json:"seconds"`
+	Resources []resourceTiming `json:"resources"`
+}
+
+// resourceTiming - how long a resource spent in a CREATE, UPDATE or DELETE_IN_PROGRESS status
+type resourceTiming struct {
+	LogicalID string    `json:"logicalId"`
+	Type      string    `json:"type"`
+	Operation string    `json:"operation"`
+	Status    string    `json:"status"`
+	Start     time.Time `json:"start"`
+	End       time.Time `json:"end"`
+	Seconds   float64   `json:"seconds"`
+}
+
+func (timing resourceTiming) duration() time.Duration {
+	return timing.End.Sub(timing.Start)
+}
+
+// operationStatuses - the stack statuses an operation starts with
+var operationStatuses = map[string]bool{
+	awsCF.StackStatusCreateInProgress: true,
+	awsCF.StackStatusUpdateInProgress: true,
+	awsCF.StackStatusDeleteInProgress: true,
+}
+
+/*
+	stackTimings
+	times the resources of the last operation on a stack, from its events. Each
+	time a resource is created, updated or deleted is timed separately, so the
+	resources deleted by a rollback are timed too
+*/
+func stackTimings(cf *awsCF.CloudFormation, stackName string, nested bool) (report timingReport, err error) {
+	report = timingReport{StackName: stackName, Resources: []resourceTiming{}}
+
+	start, err := lastOperation(cf, stackName)
+	if err != nil {
+		return
+	}
+	if start == nil {
+		err = fmt.Errorf("No operations found for stack %v", stackName)
+		return
+	}
+	report.Operation = strings.TrimSuffix(aws.StringValue(start.ResourceStatus), "_IN_PROGRESS")
+	report.Start = aws.TimeValue(start.Timestamp)
+	report.End = report.Start
+
+	// events are followed by stack ID, for deleted and nested stacks
+	stream := newEventStream(cf, aws.StringValue(start.StackId), report.Start, nested)
+	events, err := stream.events()
+	if err != nil {
+		return
+	}
+
+	inProgress := make(map[string]int)
+	for _, event := range events {
+		timestamp := aws.TimeValue(event.Timestamp)
+		status := aws.StringValue(event.ResourceStatus)
+		if timestamp.After(report.End) {
+			report.End = timestamp
+		}
+		if aws.StringValue(event.PhysicalResourceId) == aws.StringValue(event.StackId) {
+			// an event of the stack itself, nested stacks are timed as resources of their parent
+			if aws.StringValue(event.StackId) == aws.StringValue(start.StackId) {
+				report.Status = status
+			}
+			continue
+		}
+
+		logicalID := aws.StringValue(event.LogicalResourceId)
+		if aws.StringValue(event.StackId) != aws.StringValue(start.StackId) {
+			logicalID = aws.StringValue(event.StackName) + "/" + logicalID
+		}
+
+		if strings.HasSuffix(status, "_IN_PROGRESS") {
+			if _, ok := inProgress[logicalID]; !ok {
+				inProgress[logicalID] = len(report.Resources)
+				report.Resources = append(report.Resources, resourceTiming{
+					LogicalID: logicalID,
+					Type:      aws.StringValue(event.ResourceType),
+					Operation: strings.TrimSuffix(status, "_IN_PROGRESS"),
+					Status:    status,
+					Start:     timestamp,
+				})
+			}
+			continue
+		}
+		if i, ok := inProgress[logicalID]; ok {
+			report.Resources[i].Status = status
+			report.Resources[i].End = timestamp
+			delete(inProgress, logicalID)
+		}
+	}
+
+	// resources still in progress are timed until the last event
+	for _, i := range inProgress {
+		report.Resources[i].End = report.End
+	}
+	for i := range report.Resources {
+		report.Resources[i].Seconds = report.Resources[i].duration().Seconds()
+	}
+	report.Seconds = report.End.Sub(report.Start).Seconds()
+
+	sort.SliceStable(report.Resources, func(i, j int) bool {
+		return report.Resources[i].duration() > report.Resources[j].duration()
+	})
+	return
+}
+
+// lastOperation - the event that started the last create, update or delete of a stack
+func lastOperation(cf *awsCF.CloudFormation, stackName string) (*awsCF.StackEvent, error) {
+	input := &awsCF.DescribeStackEventsInput{StackName: aws.String(stackName)}
+	for {
+		output, err := cf.DescribeStackEvents(input)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range output.StackEvents {
+			if aws.StringValue(event.PhysicalResourceId) == aws.StringValue(event.StackId) &&
+				operationStatuses[aws.StringValue(event.ResourceStatus)] {
+				return event, nil
+			}
+		}
+		if output.NextToken == nil {
+			return nil, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// printTimings - prints the resources of an operation, slowest first
+func printTimings(w io.Writer, report timingReport) {
+	fmt.Fprintf(w, "%v of stack %v took %v (%v)\n", report.Operation, report.StackName, formatDuration(report.End.Sub(report.Start)), report.Status)
+	fmt.Fprintln(w)
+	row := func(columns ...interface{}) {
+		line := fmt.Sprintf(" %-10v | %-8v | %-30v | %-40v | %v", columns...)
+		fmt.Fprintln(w, strings.TrimRight(line, " "))
+	}
+	row("Duration", "Action", "LogicalID", "Type", "Status")
+	for _, timing := range report.Resources {
+		row(formatDuration(timing.duration()), timing.Operation, timing.LogicalID, timing.Type, timing.Status)
+	}
+}
+
+/*
+	printGantt
+	prints when each resource of an operation was in progress, in the order
+	they started, as bars scaled to width characters
+*/
+func printGantt(w io.Writer, report timingReport, width int) {
+	resources := append([]resourceTiming{}, report.Resources...)
+	sort.SliceStable(resources, func(i, j int) bool {
+		return resources[i].Start.Before(resources[j].Start)
+	})
+
+	total := report.End.Sub(report.Start)
+	column := func(t time.Time) int {
+		if total <= 0 {
+			return 0
+		}
+		return int(float64(width) * float64(t.Sub(report.Start)) / float64(total))
+	}
+
+	for _, timing := range resources {
+		start, end := column(timing.Start), column(timing.End)
+		if end >= width {
+			end = width - 1
+		}
+		if start > end {
+			start = end
+		}
+		bar := strings.Repeat(" ", start) + strings.Repeat("#", end-start+1) + strings.Repeat(" ", width-end-1)
+		fmt.Fprintf(w, " %-30v |%v| %v\n", timing.LogicalID, bar, formatDuration(timing.duration()))
+	}
+}
+
+func formatDuration(duration time.Duration) string {
+	return (duration / time.Second * time.Second).String()
+}
@@ -0,0 +1,57 @@
+package tasks
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStackTimings(t *testing.T) {
+	stackEvent := func(id, timestamp, status string) string {
+		return fakeEvent(testStackID, "test", id, timestamp, "test", testStackID, "AWS::CloudFormation::Stack", status, "")
+	}
+	resourceEvent := func(id, timestamp, logicalID, resourceType, status string) string {
+		return fakeEvent(testStackID, "test", id, timestamp, logicalID, logicalID+"-id", resourceType, status, "")
+	}
+	events := fakeEvents("",
+		stackEvent("9", "2018-01-02T00:40:00Z", "UPDATE_COMPLETE"),
+		resourceEvent("8", "2018-01-02T00:40:00Z", "cluster", "AWS::RDS::DBCluster", "UPDATE_COMPLETE"),
+		resourceEvent("7", "2018-01-02T00:10:00Z", "bucket", "AWS::S3::Bucket", "UPDATE_COMPLETE"),
+		resourceEvent("6", "2018-01-02T00:05:00Z", "cluster", "AWS::RDS::DBCluster", "UPDATE_IN_PROGRESS"),
+		resourceEvent("5", "2018-01-02T00:00:00Z", "bucket", "AWS::S3::Bucket", "UPDATE_IN_PROGRESS"),
+		resourceEvent("4", "2018-01-02T00:00:00Z", "bucket", "AWS::S3::Bucket", "UPDATE_IN_PROGRESS"),
+		stackEvent("3", "2018-01-02T00:00:00Z", "UPDATE_IN_PROGRESS"),
+		stackEvent("2", "2018-01-01T00:10:00Z", "CREATE_COMPLETE"),
+		stackEvent("1", "2018-01-01T00:00:00Z", "CREATE_IN_PROGRESS"),
+	)
+	cf, fake, closeFake := newFakeCloudFormation(t, map[string][]string{
+		"DescribeStackEvents": {events},
+	})
+	defer closeFake()
+
+	report, err := stackTimings(cf, "test", false)
+	assert.Nil(t, err)
+	assert.Equal(t, testStackID, fake.requests[1].Get("StackName"))
+	assert.Equal(t, "UPDATE", report.Operation)
+	assert.Equal(t, "UPDATE_COMPLETE", report.Status)
+	assert.Equal(t, float64(2400), report.Seconds)
+	assert.Len(t, report.Resources, 2)
+	assert.Equal(t, "cluster", report.Resources[0].LogicalID)
+	assert.Equal(t, float64(2100), report.Resources[0].Seconds)
+
+	var output bytes.Buffer
+	printTimings(&output, report)
+	assert.Equal(t, `UPDATE of stack test took 40m0s (UPDATE_COMPLETE)
+
+ Duration   | Action   | LogicalID                      | Type                                     | Status
+ 35m0s      | UPDATE   | cluster                        | AWS::RDS::DBCluster                      | UPDATE_COMPLETE
+ 10m0s      | UPDATE   | bucket                         | AWS::S3::Bucket                          | UPDATE_COMPLETE
+`, output.String())
+
+	output.Reset()
+	printGantt(&output, report, 8)
+	assert.Equal(t, ` bucket                         |###     | 10m0s
+ cluster                        | #######| 35m0s
+`, output.String())
+}
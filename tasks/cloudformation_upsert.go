@@ -3,6 +3,7 @@ package tasks
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -26,13 +27,33 @@ var Upsert_Flags = []cli.Flag{
 	},
 	cli.StringFlag{
 		Name:  "url",
-		Usage: "Url to use for the stack definition (s3)",
+		Usage: "url of a compiled template to deploy, instead of a config (s3://bucket/key or https)",
+	},
+	cli.StringFlag{
+		Name:  "template, t",
+		Usage: "path to a compiled template to deploy, instead of a config (eg. ./compiled/test.yaml)",
+	},
+
+	// artifact flags
+	cli.StringFlag{
+		Name:   "artifactBucket",
+		Usage:  "S3 bucket to upload templates too large to deploy directly to",
+		EnvVar: "KOMBUSTION_ARTIFACT_BUCKET",
+	},
+	cli.StringFlag{
+		Name:   "artifactPrefix",
+		Usage:  "key prefix for templates uploaded to the artifact bucket",
+		EnvVar: "KOMBUSTION_ARTIFACT_PREFIX",
+	},
+	cli.BoolFlag{
+		Name:  "compact",
+		Usage: "compact templates too large to deploy directly, to avoid uploading them",
 	},
 
 	// cf generate flags
 	cli.StringFlag{
 		Name:  "format, f",
-		Usage: "cf output format (only yaml is supported)",
+		Usage: "cf output format (yaml or json)",
 		Value: "yaml",
 	},
 	cli.StringFlag{
@@ -51,21 +72,35 @@ var Upsert_Flags = []cli.Flag{
 		Name:  "noBaseOutputs, b",
 		Usage: "disable generation of outputs for Base AWS types",
 	},
+	cli.BoolFlag{
+		Name:  "strict",
+		Usage: "fail on unknown resource types, unknown properties and duplicate keys, instead of warning",
+	},
 	cli.BoolFlag{
 		Name:  "allowIAMUpsert, i",
 		Usage: "gives the capability to perform upserts of IAM resources",
 	},
+	cli.BoolFlag{
+		Name:  "allow-replacement",
+		Usage: "allow updates that replace resources, because an immutable property changed",
+	},
+	cli.BoolFlag{
+		Name:  "nestedEvents",
+		Usage: "also print the events of nested stacks",
+	},
 }
 
 func Upsert(c *cli.Context) {
-	upsertStack(c, getCF(c.GlobalString("profile"), c.String("region")))
+	upsertStack(c, getCF(c.GlobalString("profile"), c.String("region"), c.GlobalString("endpoint")))
 }
 
 func upsertStack(c *cli.Context, cf *awsCF.CloudFormation) {
 	var err error
-	var status *awsCF.DescribeStacksOutput
 
 	stackName := c.Args().Get(0)
+	if len(stackName) == 0 && len(c.String("template")) > 0 {
+		stackName = templateStackName(c.String("template"))
+	}
 	if len(c.String("stackName")) > 0 {
 		stackName = c.String("stackName")
 	}
@@ -75,66 +110,100 @@ func upsertStack(c *cli.Context, cf *awsCF.CloudFormation) {
 		capabilities = aws.StringSlice([]string{"CAPABILITY_NAMED_IAM"})
 	}
 
-	if len(c.String("url")) > 0 {
-		// use cf template url
-		_, err = cf.DescribeStacks(&awsCF.DescribeStacksInput{StackName: aws.String(stackName)})
-		if err == nil { //update
-			_, err = cf.UpdateStack(&awsCF.UpdateStackInput{
-				StackName:    aws.String(stackName),
-				TemplateURL:  aws.String(c.String("url")),
-				Parameters:   resolveParametersS3(c),
-				Capabilities: capabilities,
-			})
-		} else { //create
-			_, err = cf.CreateStack(&awsCF.CreateStackInput{
-				StackName:    aws.String(stackName),
-				TemplateURL:  aws.String(c.String("url")),
-				Parameters:   resolveParametersS3(c),
-				Capabilities: capabilities,
-			})
-		}
-		checkError(err)
-	} else {
-		// use template from file
-		data, cfYaml := generateTemplate(c)
-		_, err = cf.DescribeStacks(&awsCF.DescribeStacksInput{StackName: aws.String(stackName)})
-		if err == nil { //update
-			_, err = cf.UpdateStack(&awsCF.UpdateStackInput{
-				StackName:    aws.String(stackName),
-				TemplateBody: aws.String(string(data)),
-				Parameters:   resolveParameters(c, cfYaml),
-				Capabilities: capabilities,
-			})
+	template, err := loadStackTemplate(c, cf)
+	checkError(err)
+	template, err = fitTemplate(template, getArtifactStore(c), c.Bool("compact"))
+	checkError(err)
+	parameters := resolveDeclaredParameters(c, c.String("env"), template.Parameters)
+	options, err := resolveStackOptions(c, template.Options)
+	checkError(err)
+
+	events := startEventStream(cf, stackName, c.Bool("nestedEvents"))
+
+	status, err := cf.DescribeStacks(&awsCF.DescribeStacksInput{StackName: aws.String(stackName)})
+	if err == nil { //update
+		if template.Template != nil {
+			checkError(checkReplacements(cf, stackName, template.Template, c.Bool("allow-replacement")))
 		} else {
-			_, err = cf.CreateStack(&awsCF.CreateStackInput{
-				StackName:    aws.String(stackName),
-				TemplateBody: aws.String(string(data)),
-				Parameters:   resolveParameters(c, cfYaml),
-				Capabilities: capabilities,
-			})
+			log.Info("Skipping the replacement check, for a template from a url")
 		}
-		checkError(err)
+
+		// parameters that aren't given keep their deployed value, instead of their default
+		if len(status.Stacks) > 0 {
+			parameters = usePreviousValues(parameters, status.Stacks[0].Parameters, template.Parameters)
+		}
+		checkError(checkRequiredParameters(template.Parameters, parameters))
+		input := &awsCF.UpdateStackInput{
+			StackName:    aws.String(stackName),
+			TemplateBody: optionalString(template.Body),
+			TemplateURL:  optionalString(template.URL),
+			Parameters:   parameters,
+			Capabilities: capabilities,
+		}
+		updateStackOptions(input, options)
+		if len(status.Stacks) > 0 {
+			checkError(updateTerminationProtection(cf, status.Stacks[0], options))
+		}
+		_, err = cf.UpdateStack(input)
+	} else { //create
+		checkError(checkRequiredParameters(template.Parameters, parameters))
+		input := &awsCF.CreateStackInput{
+			StackName:    aws.String(stackName),
+			TemplateBody: optionalString(template.Body),
+			TemplateURL:  optionalString(template.URL),
+			Parameters:   parameters,
+			Capabilities: capabilities,
+		}
+		createStackOptions(input, options)
+		_, err = cf.CreateStack(input)
+	}
+	checkError(err)
+
+	waitForUpsert(cf, stackName, events, template.Sources)
+}
+
+// optionalString - nil for an empty string, for inputs that take one of several fields
+func optionalString(value string) *string {
+	if len(value) == 0 {
+		return nil
 	}
+	return aws.String(value)
+}
 
-	// Make sure upsert works
+/*
+	waitForUpsert
+	polls a stack being created or updated, printing its events as they happen,
+	and exits once it's finished. When it fails, the resources that caused it
+	are summarised, with the config resources they were generated from
+*/
+func waitForUpsert(cf *awsCF.CloudFormation, stackName string, events *eventStream, sources map[string]cloudformation.ResourceSource) {
 	for {
-		time.Sleep(2 * time.Second)
-		status, err = cf.DescribeStacks(&awsCF.DescribeStacksInput{StackName: aws.String(stackName)})
+		time.Sleep(pollInterval)
+		checkError(events.poll(os.Stdout))
+		status, err := cf.DescribeStacks(&awsCF.DescribeStacksInput{StackName: aws.String(stackName)})
 		checkError(err)
 		if len(status.Stacks) > 0 {
 			stack := status.Stacks[0]
 			stackStatus := *stack.StackStatus
-			fmt.Println(stackStatus)
 			if stackStatus != awsCF.StackStatusCreateInProgress &&
+				stackStatus != awsCF.StackStatusReviewInProgress &&
 				stackStatus != awsCF.StackStatusUpdateInProgress &&
 				stackStatus != awsCF.StackStatusUpdateCompleteCleanupInProgress {
+				// print the events that happened since the last poll
+				checkError(events.poll(os.Stdout))
 				if stackStatus == awsCF.StackStatusCreateComplete ||
 					stackStatus == awsCF.StackStatusUpdateComplete {
 					os.Exit(0)
 				} else {
-					log.Error("Upsert Failed: ")
-					time.Sleep(2 * time.Second)
-					printStackEvents(cf, stackName)
+					causes, err := findRootCauses(cf, events.stack, events.since)
+					if err != nil {
+						log.Warn("Couldn't find the cause of the failure: ", err)
+					} else if len(causes) > 0 {
+						fmt.Println()
+						printRootCauses(os.Stdout, causes, sources)
+						fmt.Println()
+					}
+					log.Error("Upsert Failed: ", stackStatus)
 					os.Exit(1)
 				}
 			}
@@ -143,10 +212,25 @@ func upsertStack(c *cli.Context, cf *awsCF.CloudFormation) {
 }
 
 func resolveParameters(c *cli.Context, cfYaml cloudformation.YamlCloudformation) []*awsCF.Parameter {
+	return resolveParametersForEnv(c, c.String("env"), cfYaml)
+}
+
+// resolveParametersForEnv - resolves the parameters of a config, for an environment other than the one given by --env
+func resolveParametersForEnv(c *cli.Context, envName string, cfYaml cloudformation.YamlCloudformation) []*awsCF.Parameter {
+	return resolveDeclaredParameters(c, envName, cfYaml.Parameters)
+}
+
+/*
+	resolveDeclaredParameters
+	resolves the values of the parameters a template declares, from the
+	environment file and --param. Values are converted by the type of their
+	parameter, and invalid values are fatal
+*/
+func resolveDeclaredParameters(c *cli.Context, envName string, declared types.ValueMap) []*awsCF.Parameter {
 	results := []*awsCF.Parameter{}
 
 	// Get params from the envFile
-	env := cloudformation.ResolveEnvironment(c.String("envFile"), c.String("env"))
+	env := cloudformation.ResolveEnvironment(c.String("envFile"), envName)
 
 	// override envFile values with optional --param values
 	params := getParamMap(c)
@@ -154,45 +238,28 @@ func resolveParameters(c *cli.Context, cfYaml cloudformation.YamlCloudformation)
 		env[k] = v
 	}
 
-	// convert to aws Parameter list
-	for paramK := range cfYaml.Parameters {
-		for k, v := range env {
-			if paramK == k {
-				if s, ok := v.(string); ok {
-					// Filter to params in the stack
-					results = append(results, &awsCF.Parameter{
-						ParameterKey:   aws.String(k),
-						ParameterValue: aws.String(s),
-					})
-				}
-			}
-		}
+	// convert to aws Parameter list, filtered to params in the stack
+	values, errs := cloudformation.ParameterValues(declared, env)
+	checkParameterErrors(errs)
+	for _, k := range sortedParameterKeys(values) {
+		results = append(results, &awsCF.Parameter{
+			ParameterKey:   aws.String(k),
+			ParameterValue: aws.String(values[k]),
+		})
 	}
 
 	return results
 }
 
-func resolveParametersS3(c *cli.Context) []*awsCF.Parameter {
-	results := []*awsCF.Parameter{}
-
-	var params types.ValueMap
-
-	// override envFile values with optional --param values
-	paramMap := getParamMap(c)
-	for k, v := range paramMap {
-		params[k] = v
+// checkRequiredParameters - returns an error if a parameter without a Default isn't given a value
+func checkRequiredParameters(declared types.ValueMap, parameters []*awsCF.Parameter) error {
+	given := []string{}
+	for _, parameter := range parameters {
+		given = append(given, aws.StringValue(parameter.ParameterKey))
 	}
-
-	// convert to aws Parameter list
-	for k, v := range params {
-		if s, ok := v.(string); ok {
-			// Filter to params in the stack
-			results = append(results, &awsCF.Parameter{
-				ParameterKey:   aws.String(k),
-				ParameterValue: aws.String(s),
-			})
-		}
+	missing := cloudformation.MissingParameters(declared, given)
+	if len(missing) > 0 {
+		return fmt.Errorf("Missing a value for required parameter(s) %v, which have no Default", strings.Join(missing, ", "))
 	}
-
-	return results
+	return nil
 }
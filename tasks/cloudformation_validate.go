@@ -0,0 +1,89 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	yaml "github.com/KablamoOSS/yaml"
+	"github.com/urfave/cli"
+)
+
+var Validate_Flags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "format, f",
+		Usage: "problem output format (text or json)",
+		Value: "text",
+	},
+
+	// cf generate flags
+	cli.StringFlag{
+		Name:  "env",
+		Usage: "environment config to use from ./config/environment.yaml",
+	},
+	cli.StringFlag{
+		Name:  "envFile",
+		Usage: "path to the environment.yaml file",
+	},
+	cli.StringSliceFlag{
+		Name:  "param, p",
+		Usage: "cloudformation parameters. eg. ( --param Env=dev --param BucketName=test )",
+	},
+	cli.BoolFlag{
+		Name:  "noBaseOutputs, b",
+		Usage: "disable generation of outputs for Base AWS types",
+	},
+}
+
+func Validate(c *cli.Context) {
+	// duplicate keys, unknown types and properties, and resources that fail
+	// validation are reported as problems, rather than stopping the compile
+	params := generateParams(c, c.String("env"))
+	params.CollectProblems = true
+	cf, err := cloudformation.GenerateYamlStack(params)
+	checkError(err)
+	// the size limit is checked against the template as yaml, as cf generate
+	// writes it by default
+	templateBody, err := yaml.Marshal(cf)
+	checkError(err)
+	problems := cloudformation.ValidateStack(cf, templateBody)
+
+	switch c.String("format") {
+	case "text":
+		printProblems(os.Stdout, problems)
+	case "json":
+		printProblemsJson(os.Stdout, problems)
+	default:
+		log.Fatal("Output format not supported: ", c.String("format"))
+	}
+
+	if len(problems) > 0 {
+		os.Exit(1)
+	}
+}
+
+func printProblems(w io.Writer, problems []cloudformation.ValidationProblem) {
+	if len(problems) == 0 {
+		fmt.Fprintln(w, "No problems found.")
+		return
+	}
+
+	fmt.Fprintf(w, " %-12v | %-40v | %v \n", "Check", "Path", "Problem")
+	for _, problem := range problems {
+		fmt.Fprintf(w, " %-12v | %-40v | %v \n", problem.Check, problem.Path, problem.Message)
+	}
+	fmt.Fprintf(w, "\n%v problem(s) found.\n", len(problems))
+}
+
+func printProblemsJson(w io.Writer, problems []cloudformation.ValidationProblem) {
+	if problems == nil {
+		problems = []cloudformation.ValidationProblem{}
+	}
+	output, err := json.MarshalIndent(problems, "", "  ")
+	checkError(err)
+	fmt.Fprintln(w, string(output))
+}
@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/KablamoOSS/kombustion/cloudformation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintProblemsJson_invalidResource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kombustion")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	configPath := filepath.Join(dir, "queue.yaml")
+	assert.Nil(t, ioutil.WriteFile(configPath, []byte(`
+Resources:
+  testQueue:
+    Type: AWS::SQS::Queue
+    Properties:
+      QueueName: [a, b]
+`), 0644))
+
+	stack, err := cloudformation.GenerateYamlStack(cloudformation.GenerateParams{
+		Filename:           configPath,
+		DisableBaseOutputs: true,
+		CollectProblems:    true,
+	})
+	assert.Nil(t, err)
+
+	var output bytes.Buffer
+	printProblemsJson(&output, cloudformation.ValidateStack(stack, []byte{}))
+
+	var problems []cloudformation.ValidationProblem
+	assert.Nil(t, json.Unmarshal(output.Bytes(), &problems))
+	assert.Equal(t, []cloudformation.ValidationProblem{{
+		Path:    "Resources.testQueue",
+		Check:   "resource",
+		Message: "Invalid value for 'QueueName', expected String but got a List",
+	}}, problems)
+}
@@ -0,0 +1,109 @@
+package tasks
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsCF "github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+/*
+	fakeCloudFormation
+	a local stand-in for the cloudformation query API. Each action replies with
+	its queued results in order, repeating the last one. A result starting with
+	<Error> is sent as an error response
+*/
+type fakeCloudFormation struct {
+	sync.Mutex
+	t        *testing.T
+	results  map[string][]string
+	requests []url.Values
+}
+
+func newFakeCloudFormation(t *testing.T, results map[string][]string) (*awsCF.CloudFormation, *fakeCloudFormation, func()) {
+	fake := &fakeCloudFormation{t: t, results: results}
+	server := httptest.NewServer(fake)
+
+	pollInterval = 0
+	cf := awsCF.New(session.Must(session.NewSession()), &aws.Config{
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("ap-southeast-2"),
+		MaxRetries:  aws.Int(0),
+	})
+	return cf, fake, server.Close
+}
+
+func (fake *fakeCloudFormation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fake.Lock()
+	defer fake.Unlock()
+
+	if err := r.ParseForm(); err != nil {
+		fake.t.Fatal(err)
+	}
+	action := r.Form.Get("Action")
+	fake.requests = append(fake.requests, r.Form)
+
+	results, ok := fake.results[action]
+	if !ok || len(results) == 0 {
+		fake.t.Errorf("unexpected cloudformation request: %v", action)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	result := results[0]
+	if len(results) > 1 {
+		fake.results[action] = results[1:]
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	if strings.HasPrefix(result, "<Error>") {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "<ErrorResponse>%v<RequestId>test</RequestId></ErrorResponse>", result)
+		return
+	}
+	fmt.Fprintf(w,
+		`<%vResponse xmlns="http://cloudformation.amazonaws.com/doc/2010-05-15/"><%vResult>%v</%vResult><ResponseMetadata><RequestId>test</RequestId></ResponseMetadata></%vResponse>`,
+		action, action, result, action, action,
+	)
+}
+
+// actions - the actions requested, in order
+func (fake *fakeCloudFormation) actions() (actions []string) {
+	fake.Lock()
+	defer fake.Unlock()
+	for _, request := range fake.requests {
+		actions = append(actions, request.Get("Action"))
+	}
+	return
+}
+
+// request - the first request for an action
+func (fake *fakeCloudFormation) request(action string) url.Values {
+	fake.Lock()
+	defer fake.Unlock()
+	for _, request := range fake.requests {
+		if request.Get("Action") == action {
+			return request
+		}
+	}
+	return nil
+}
+
+func fakeError(message string) string {
+	return fmt.Sprintf("<Error><Type>Sender</Type><Code>ValidationError</Code><Message>%v</Message></Error>", message)
+}
+
+func fakeStack(stackName, status string) string {
+	return fmt.Sprintf(
+		"<Stacks><member><StackName>%v</StackName><StackId>arn:aws:cloudformation:ap-southeast-2:123456789012:stack/%v/1</StackId><StackStatus>%v</StackStatus><CreationTime>2018-01-01T00:00:00Z</CreationTime></member></Stacks>",
+		stackName, stackName, status,
+	)
+}
@@ -0,0 +1,49 @@
+package types
+
+import "strings"
+
+// IsIntrinsic - true if the value is an intrinsic function, eg. {"Ref": "MyParam"} or {"Fn::If": [...]}
+func IsIntrinsic(value interface{}) bool {
+	var key string
+	switch obj := value.(type) {
+	case map[string]interface{}:
+		if len(obj) != 1 {
+			return false
+		}
+		for k := range obj {
+			key = k
+		}
+	case ValueMap:
+		return IsIntrinsic(map[string]interface{}(obj))
+	case map[string]string:
+		if len(obj) != 1 {
+			return false
+		}
+		for k := range obj {
+			key = k
+		}
+	case map[interface{}]interface{}:
+		if len(obj) != 1 {
+			return false
+		}
+		for k := range obj {
+			s, ok := k.(string)
+			if !ok {
+				return false
+			}
+			key = s
+		}
+	default:
+		return false
+	}
+	return key == "Ref" || key == "Condition" || strings.HasPrefix(key, "Fn::")
+}
+
+// UnmarshalIntrinsic - unmarshals a yaml value, if it is an intrinsic function
+func UnmarshalIntrinsic(unmarshal func(interface{}) error) (interface{}, bool) {
+	var value interface{}
+	if err := unmarshal(&value); err != nil || !IsIntrinsic(value) {
+		return nil, false
+	}
+	return value, true
+}
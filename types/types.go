@@ -11,11 +11,25 @@ type ParserFunc func(string, string) (ValueMap, error)
 
 // CfResource - A Cloudformation Resource
 type CfResource struct {
-	Type       string      `yaml:"Type"`
-	Properties interface{} `yaml:"Properties"`
-	Condition  interface{} `yaml:"Condition,omitempty"`
-	Metadata   interface{} `yaml:"Metadata,omitempty"`
-	DependsOn  interface{} `yaml:"DependsOn,omitempty"`
+	Type                string      `yaml:"Type"`
+	Properties          interface{} `yaml:"Properties"`
+	Condition           interface{} `yaml:"Condition,omitempty"`
+	Metadata            interface{} `yaml:"Metadata,omitempty"`
+	DependsOn           interface{} `yaml:"DependsOn,omitempty"`
+	DeletionPolicy      interface{} `yaml:"DeletionPolicy,omitempty"`
+	UpdateReplacePolicy interface{} `yaml:"UpdateReplacePolicy,omitempty"`
+	CreationPolicy      interface{} `yaml:"CreationPolicy,omitempty"`
+	UpdatePolicy        interface{} `yaml:"UpdatePolicy,omitempty"`
+}
+
+// PropertySpec - a property of a resource or property type, from the cloudformation specification
+type PropertySpec struct {
+	// PropertyType - the full name of the property type of the property, or of
+	// its items for a List or Map, eg. AWS::EC2::SecurityGroup.Ingress
+	PropertyType string
+	// Container - List or Map, for properties that are one
+	Container  string
+	UpdateType string
 }
 
 // ResourceMap - a map of resouces
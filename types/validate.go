@@ -0,0 +1,226 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	yaml "github.com/KablamoOSS/yaml"
+)
+
+// PropertyValidator - validates the value of a named property
+type PropertyValidator func(name string, value interface{}) []error
+
+// ValidatePrimitive - validates a value against a spec PrimitiveType (String, Integer, Boolean etc.)
+func ValidatePrimitive(primitiveType string) PropertyValidator {
+	return func(name string, value interface{}) []error {
+		if value == nil || IsIntrinsic(value) {
+			return nil
+		}
+		if !isPrimitive(primitiveType, value) {
+			return []error{fmt.Errorf("Invalid value for '%v', expected %v but got %v", name, primitiveType, describeValue(value))}
+		}
+		return nil
+	}
+}
+
+// ValidateList - validates a value is a list, and validates each of its items
+func ValidateList(validateItem PropertyValidator) PropertyValidator {
+	return func(name string, value interface{}) (errs []error) {
+		if value == nil || IsIntrinsic(value) {
+			return nil
+		}
+		items, ok := listItems(value)
+		if !ok {
+			return []error{fmt.Errorf("Invalid value for '%v', expected List but got %v", name, describeValue(value))}
+		}
+		for i, item := range items {
+			errs = append(errs, validateItem(fmt.Sprintf("%v[%v]", name, i), item)...)
+		}
+		return
+	}
+}
+
+// ValidateMap - validates a value is a map, and validates each of its values
+func ValidateMap(validateItem PropertyValidator) PropertyValidator {
+	return func(name string, value interface{}) (errs []error) {
+		if value == nil || IsIntrinsic(value) {
+			return nil
+		}
+		items, ok := mapItems(value)
+		if !ok {
+			return []error{fmt.Errorf("Invalid value for '%v', expected Map but got %v", name, describeValue(value))}
+		}
+		keys := make([]string, 0, len(items))
+		for key := range items {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			errs = append(errs, validateItem(fmt.Sprintf("%v.%v", name, key), items[key])...)
+		}
+		return
+	}
+}
+
+// ValidatePropertyType - validates a value by unmarshalling it into a generated property type
+func ValidatePropertyType(newProperty func() Validatable) PropertyValidator {
+	return func(name string, value interface{}) []error {
+		if value == nil || IsIntrinsic(value) {
+			return nil
+		}
+		if property, ok := value.(Validatable); ok {
+			// already typed, eg. set by a plugin
+			return PrefixErrors(name, property.Validate())
+		}
+		if _, ok := mapItems(value); !ok {
+			return []error{fmt.Errorf("Invalid value for '%v', expected an object but got %v", name, describeValue(value))}
+		}
+
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return []error{fmt.Errorf("Invalid value for '%v': %v", name, err)}
+		}
+		property := newProperty()
+		if err = yaml.Unmarshal(data, property); err != nil {
+			return []error{fmt.Errorf("Invalid value for '%v': %v", name, err)}
+		}
+		return PrefixErrors(name, property.Validate())
+	}
+}
+
+// PrefixErrors - prefixes the errors of a nested property with the property name
+func PrefixErrors(name string, errs []error) []error {
+	prefixed := make([]error, len(errs))
+	for i, err := range errs {
+		prefixed[i] = fmt.Errorf("%v: %v", name, err)
+	}
+	return prefixed
+}
+
+// ValidationErrors - the errors from validating a resource, returned by its parser
+type ValidationErrors []error
+
+func (errs ValidationErrors) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+/*
+	isPrimitive
+	checks a value can be used as the primitive type. Like CloudFormation,
+	scalars written as strings are accepted (eg. "5" for an Integer)
+*/
+func isPrimitive(primitiveType string, value interface{}) bool {
+	if _, ok := value.(time.Time); ok {
+		return primitiveType == "String" || primitiveType == "Timestamp"
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(value))
+	switch primitiveType {
+	case "String":
+		return isScalar(v)
+
+	case "Integer", "Long":
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return true
+		case reflect.Float32, reflect.Float64:
+			return math.Trunc(v.Float()) == v.Float()
+		case reflect.String:
+			_, err := strconv.ParseInt(strings.TrimSpace(v.String()), 10, 64)
+			return err == nil
+		}
+		return false
+
+	case "Double":
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return true
+		case reflect.String:
+			_, err := strconv.ParseFloat(strings.TrimSpace(v.String()), 64)
+			return err == nil
+		}
+		return false
+
+	case "Boolean":
+		switch v.Kind() {
+		case reflect.Bool:
+			return true
+		case reflect.String:
+			_, err := strconv.ParseBool(strings.TrimSpace(v.String()))
+			return err == nil
+		}
+		return false
+
+	case "Timestamp":
+		return v.Kind() == reflect.String
+
+	case "Json":
+		return v.Kind() == reflect.String || v.Kind() == reflect.Map
+	}
+
+	// unknown primitive types can't be checked
+	return true
+}
+
+func isScalar(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func listItems(value interface{}) ([]interface{}, bool) {
+	if items, ok := value.([]interface{}); ok {
+		return items, true
+	}
+	v := reflect.Indirect(reflect.ValueOf(value))
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, false
+	}
+	items := make([]interface{}, v.Len())
+	for i := range items {
+		items[i] = v.Index(i).Interface()
+	}
+	return items, true
+}
+
+func mapItems(value interface{}) (map[string]interface{}, bool) {
+	if items, ok := value.(map[string]interface{}); ok {
+		return items, true
+	}
+	v := reflect.Indirect(reflect.ValueOf(value))
+	if v.Kind() != reflect.Map {
+		return nil, false
+	}
+	items := make(map[string]interface{}, v.Len())
+	for _, key := range v.MapKeys() {
+		items[fmt.Sprintf("%v", key.Interface())] = v.MapIndex(key).Interface()
+	}
+	return items, true
+}
+
+func describeValue(value interface{}) string {
+	if _, ok := listItems(value); ok {
+		return "a List"
+	}
+	if _, ok := mapItems(value); ok {
+		return "a Map"
+	}
+	return fmt.Sprintf("'%v'", value)
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testProperty struct {
+	Key   interface{} `yaml:"Key"`
+	Value interface{} `yaml:"Value"`
+}
+
+func (property testProperty) Validate() []error {
+	errs := []error{}
+	if property.Key == nil {
+		errs = append(errs, fmt.Errorf("Missing required field 'Key'"))
+	}
+	errs = append(errs, ValidatePrimitive("Integer")("Value", property.Value)...)
+	return errs
+}
+
+func TestValidatePrimitive(t *testing.T) {
+	valid := map[string][]interface{}{
+		"String":    {"test", 5, true},
+		"Integer":   {5, int64(5), 5.0, "5"},
+		"Double":    {5, 5.5, "5.5"},
+		"Boolean":   {true, "false"},
+		"Timestamp": {"2018-05-01T00:00:00Z"},
+		"Json":      {"{}", map[string]interface{}{"Version": "2012-10-17"}},
+	}
+	for primitiveType, values := range valid {
+		for _, value := range values {
+			assert.Empty(t, ValidatePrimitive(primitiveType)("Test", value), "%v: %v", primitiveType, value)
+		}
+	}
+
+	invalid := map[string][]interface{}{
+		"String":  {[]interface{}{"test"}, map[string]interface{}{"a": "b"}},
+		"Integer": {5.5, "five", true},
+		"Double":  {"five", []interface{}{5}},
+		"Boolean": {"yes", 1},
+		"Json":    {[]interface{}{}},
+	}
+	for primitiveType, values := range invalid {
+		for _, value := range values {
+			assert.Len(t, ValidatePrimitive(primitiveType)("Test", value), 1, "%v: %v", primitiveType, value)
+		}
+	}
+
+	assert.Empty(t, ValidatePrimitive("Integer")("Test", nil))
+	assert.Empty(t, ValidatePrimitive("Integer")("Test", map[string]interface{}{"Ref": "Size"}))
+}
+
+func TestValidateList(t *testing.T) {
+	validator := ValidateList(ValidatePrimitive("Integer"))
+	assert.Empty(t, validator("Test", []interface{}{1, "2"}))
+	assert.Empty(t, validator("Test", map[string]interface{}{"Fn::Split": []interface{}{",", "1,2"}}))
+
+	errs := validator("Test", []interface{}{1, "two"})
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], "Invalid value for 'Test[1]', expected Integer but got 'two'")
+
+	errs = validator("Test", "1")
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], "Invalid value for 'Test', expected List but got '1'")
+}
+
+func TestValidateMap(t *testing.T) {
+	validator := ValidateMap(ValidatePrimitive("String"))
+	assert.Empty(t, validator("Test", map[interface{}]interface{}{"a": "b"}))
+
+	errs := validator("Test", map[interface{}]interface{}{"a": []interface{}{}})
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], "Invalid value for 'Test.a', expected String but got a List")
+}
+
+func TestValidatePropertyType(t *testing.T) {
+	validator := ValidateList(ValidatePropertyType(func() Validatable { return &testProperty{} }))
+	assert.Empty(t, validator("Tags", []interface{}{
+		map[interface{}]interface{}{"Key": "Name", "Value": 5},
+		map[string]interface{}{"Fn::If": []interface{}{"IsProd", "a", "b"}},
+		testProperty{Key: "Name"},
+	}))
+
+	errs := validator("Tags", []interface{}{
+		map[interface{}]interface{}{"Value": "five"},
+		"Name",
+	})
+	assert.Len(t, errs, 3)
+	assert.EqualError(t, errs[0], "Tags[0]: Missing required field 'Key'")
+	assert.EqualError(t, errs[1], "Tags[0]: Invalid value for 'Value', expected Integer but got 'five'")
+	assert.EqualError(t, errs[2], "Invalid value for 'Tags[1]', expected an object but got 'Name'")
+}